@@ -14,6 +14,7 @@ val services = mapOf(
     "appflow" to ServiceSpec("AppFlow"),
     "appintegrations" to ServiceSpec("AppIntegrations"),
     "applicationinsights" to ServiceSpec("CloudWatch Application Insights"),
+    "applicationsignals" to ServiceSpec("CloudWatch Application Signals"),
     "appmesh" to ServiceSpec("App Mesh"),
     "apprunner" to ServiceSpec("App Runner"),
     "appstream" to ServiceSpec("AppStream 2.0", vpcLock = true, parallelismOverride = 10),
@@ -23,8 +24,10 @@ val services = mapOf(
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
+    "bcmdataexports" to ServiceSpec("BCM Data Exports"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
+    "chatbot" to ServiceSpec("Chatbot"),
     "chime" to ServiceSpec("Chime"),
     "cloud9" to ServiceSpec("Cloud9"),
     "cloudcontrol" to ServiceSpec("Cloud Control API"),
@@ -169,6 +172,8 @@ val services = mapOf(
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
+    "ssmcontacts" to ServiceSpec("SSM Incident Manager Contacts"),
+    "ssmincidents" to ServiceSpec("SSM Incident Manager Incidents"),
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
@@ -20,6 +20,7 @@ variable "service_labels" {
     "appintegrations",
     "applicationcostprofiler",
     "applicationinsights",
+    "applicationsignals",
     "appmesh",
     "apprunner",
     "appstream",
@@ -31,10 +32,12 @@ variable "service_labels" {
     "backup",
     "backupgateway",
     "batch",
+    "bcmdataexports",
     "billingconductor",
     "braket",
     "budgets",
     "ce",
+    "chatbot",
     "chime",
     "chimesdkidentity",
     "chimesdkmeetings",
@@ -212,6 +215,7 @@ variable "service_labels" {
     "networkmanager",
     "nimble",
     "opensearch",
+    "opensearchserverless",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -2,27 +2,46 @@
 package conns
 
 import (
+	accessanalyzer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	budgets_sdkv2 "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	codebuild_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	codedeploy_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	codepipeline_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	codestarconnections_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codestarconnections"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ecr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecs"
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/fis"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	imagebuilder_sdkv2 "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/ivschat"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	resourcegroups_sdkv2 "github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	s3control_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssoadmin_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -223,6 +242,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
@@ -332,11 +352,27 @@ type AWSClient struct {
 	Session                   *session.Session
 	TerraformVersion          string
 
-	ec2Client       lazyClient[*ec2_sdkv2.Client]
-	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
-	rdsClient       lazyClient[*rds_sdkv2.Client]
-	s3controlClient lazyClient[*s3control_sdkv2.Client]
-	ssmClient       lazyClient[*ssm_sdkv2.Client]
+	accessanalyzerClient      lazyClient[*accessanalyzer_sdkv2.Client]
+	budgetsClient             lazyClient[*budgets_sdkv2.Client]
+	cloudhsmv2Client          lazyClient[*cloudhsmv2_sdkv2.Client]
+	codebuildClient           lazyClient[*codebuild_sdkv2.Client]
+	codepipelineClient        lazyClient[*codepipeline_sdkv2.Client]
+	codestarconnectionsClient lazyClient[*codestarconnections_sdkv2.Client]
+	deployClient              lazyClient[*codedeploy_sdkv2.Client]
+	ec2Client                 lazyClient[*ec2_sdkv2.Client]
+	ecrClient                 lazyClient[*ecr_sdkv2.Client]
+	ecsClient                 lazyClient[*ecs_sdkv2.Client]
+	eksClient                 lazyClient[*eks_sdkv2.Client]
+	imagebuilderClient        lazyClient[*imagebuilder_sdkv2.Client]
+	lakeformationClient       lazyClient[*lakeformation_sdkv2.Client]
+	lambdaClient              lazyClient[*lambda_sdkv2.Client]
+	logsClient                lazyClient[*cloudwatchlogs_sdkv2.Client]
+	rdsClient                 lazyClient[*rds_sdkv2.Client]
+	resourcegroupsClient      lazyClient[*resourcegroups_sdkv2.Client]
+	s3controlClient           lazyClient[*s3control_sdkv2.Client]
+	ssmClient                 lazyClient[*ssm_sdkv2.Client]
+	ssoadminClient            lazyClient[*ssoadmin_sdkv2.Client]
+	xrayClient                lazyClient[*xray_sdkv2.Client]
 
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
@@ -361,10 +397,12 @@ type AWSClient struct {
 	AppSyncConn                      *appsync.AppSync
 	ApplicationCostProfilerConn      *applicationcostprofiler.ApplicationCostProfiler
 	ApplicationInsightsConn          *applicationinsights.ApplicationInsights
+	ApplicationSignalsClient         *applicationsignals.Client
 	AthenaConn                       *athena.Athena
 	AuditManagerClient               *auditmanager.Client
 	AutoScalingConn                  *autoscaling.AutoScaling
 	AutoScalingPlansConn             *autoscalingplans.AutoScalingPlans
+	BCMDataExportsClient             *bcmdataexports.Client
 	BackupConn                       *backup.Backup
 	BackupGatewayConn                *backupgateway.BackupGateway
 	BatchConn                        *batch.Batch
@@ -373,6 +411,7 @@ type AWSClient struct {
 	BudgetsConn                      *budgets.Budgets
 	CEConn                           *costexplorer.CostExplorer
 	CURConn                          *costandusagereportservice.CostandUsageReportService
+	ChatbotClient                    *chatbot.Client
 	ChimeConn                        *chime.Chime
 	ChimeSDKIdentityConn             *chimesdkidentity.ChimeSDKIdentity
 	ChimeSDKMeetingsConn             *chimesdkmeetings.ChimeSDKMeetings
@@ -545,6 +584,7 @@ type AWSClient struct {
 	NetworkManagerConn               *networkmanager.NetworkManager
 	NimbleConn                       *nimblestudio.NimbleStudio
 	OpenSearchConn                   *opensearchservice.OpenSearchService
+	OpenSearchServerlessConn         *opensearchserverless.OpenSearchServerless
 	OpsWorksConn                     *opsworks.OpsWorks
 	OpsWorksCMConn                   *opsworkscm.OpsWorksCM
 	OrganizationsConn                *organizations.Organizations
@@ -646,10 +686,62 @@ type AWSClient struct {
 	XRayConn                         *xray.XRay
 }
 
+func (client *AWSClient) AccessAnalyzerClient() *accessanalyzer_sdkv2.Client {
+	return client.accessanalyzerClient.Client()
+}
+
+func (client *AWSClient) BudgetsClient() *budgets_sdkv2.Client {
+	return client.budgetsClient.Client()
+}
+
+func (client *AWSClient) CloudHSMV2Client() *cloudhsmv2_sdkv2.Client {
+	return client.cloudhsmv2Client.Client()
+}
+
+func (client *AWSClient) CodeBuildClient() *codebuild_sdkv2.Client {
+	return client.codebuildClient.Client()
+}
+
+func (client *AWSClient) CodePipelineClient() *codepipeline_sdkv2.Client {
+	return client.codepipelineClient.Client()
+}
+
+func (client *AWSClient) CodeStarConnectionsClient() *codestarconnections_sdkv2.Client {
+	return client.codestarconnectionsClient.Client()
+}
+
+func (client *AWSClient) DeployClient() *codedeploy_sdkv2.Client {
+	return client.deployClient.Client()
+}
+
 func (client *AWSClient) EC2Client() *ec2_sdkv2.Client {
 	return client.ec2Client.Client()
 }
 
+func (client *AWSClient) ECRClient() *ecr_sdkv2.Client {
+	return client.ecrClient.Client()
+}
+
+func (client *AWSClient) ECSClient() *ecs_sdkv2.Client {
+	return client.ecsClient.Client()
+}
+
+func (client *AWSClient) EKSClient() *eks_sdkv2.Client {
+	return client.eksClient.Client()
+}
+
+func (client *AWSClient) ImageBuilderClient() *imagebuilder_sdkv2.Client {
+	return client.imagebuilderClient.Client()
+}
+
+func (client *AWSClient) LakeFormationClient() *lakeformation_sdkv2.Client {
+	return client.lakeformationClient.Client()
+}
+
+func (client *AWSClient) LambdaClient() *lambda_sdkv2.Client {
+	return client.lambdaClient.Client()
+}
+
 func (client *AWSClient) LogsClient() *cloudwatchlogs_sdkv2.Client {
 	return client.logsClient.Client()
 }
@@ -658,6 +750,10 @@ func (client *AWSClient) RDSClient() *rds_sdkv2.Client {
 	return client.rdsClient.Client()
 }
 
+func (client *AWSClient) ResourceGroupsClient() *resourcegroups_sdkv2.Client {
+	return client.resourcegroupsClient.Client()
+}
+
 func (client *AWSClient) S3ControlClient() *s3control_sdkv2.Client {
 	return client.s3controlClient.Client()
 }
@@ -665,3 +761,11 @@ func (client *AWSClient) S3ControlClient() *s3control_sdkv2.Client {
 func (client *AWSClient) SSMClient() *ssm_sdkv2.Client {
 	return client.ssmClient.Client()
 }
+
+func (client *AWSClient) SSOAdminClient() *ssoadmin_sdkv2.Client {
+	return client.ssoadminClient.Client()
+}
+
+func (client *AWSClient) XRayClient() *xray_sdkv2.Client {
+	return client.xrayClient.Client()
+}
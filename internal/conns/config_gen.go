@@ -3,26 +3,45 @@ package conns
 
 import (
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	budgets_sdkv2 "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	codebuild_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	codedeploy_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	codepipeline_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	codestarconnections_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codestarconnections"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ecr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecs"
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/fis"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	imagebuilder_sdkv2 "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/ivschat"
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	resourcegroups_sdkv2 "github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	s3control_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssoadmin_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
@@ -223,6 +242,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
@@ -509,6 +529,7 @@ func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
 	client.NetworkManagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
 	client.NimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
 	client.OpenSearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
+	client.OpenSearchServerlessConn = opensearchserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearchServerless])}))
 	client.OpsWorksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
 	client.OpsWorksCMConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
 	client.OrganizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
@@ -600,11 +621,26 @@ func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
 func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
+	client.ApplicationSignalsClient = applicationsignals.NewFromConfig(cfg, func(o *applicationsignals.Options) {
+		if endpoint := c.Endpoints[names.ApplicationSignals]; endpoint != "" {
+			o.EndpointResolver = applicationsignals.EndpointResolverFromURL(endpoint)
+		}
+	})
 	client.AuditManagerClient = auditmanager.NewFromConfig(cfg, func(o *auditmanager.Options) {
 		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
 			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
 		}
 	})
+	client.BCMDataExportsClient = bcmdataexports.NewFromConfig(cfg, func(o *bcmdataexports.Options) {
+		if endpoint := c.Endpoints[names.BCMDataExports]; endpoint != "" {
+			o.EndpointResolver = bcmdataexports.EndpointResolverFromURL(endpoint)
+		}
+	})
+	client.ChatbotClient = chatbot.NewFromConfig(cfg, func(o *chatbot.Options) {
+		if endpoint := c.Endpoints[names.Chatbot]; endpoint != "" {
+			o.EndpointResolver = chatbot.EndpointResolverFromURL(endpoint)
+		}
+	})
 	client.CloudControlClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
 		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
 			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
@@ -679,6 +715,55 @@ func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
 
 // sdkv2LazyConns initializes AWS SDK for Go v2 lazy-load clients.
 func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
+	client.accessanalyzerClient.init(&cfg, func() *accessanalyzer_sdkv2.Client {
+		return accessanalyzer_sdkv2.NewFromConfig(cfg, func(o *accessanalyzer_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.AccessAnalyzer]; endpoint != "" {
+				o.EndpointResolver = accessanalyzer_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.budgetsClient.init(&cfg, func() *budgets_sdkv2.Client {
+		return budgets_sdkv2.NewFromConfig(cfg, func(o *budgets_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.Budgets]; endpoint != "" {
+				o.EndpointResolver = budgets_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.cloudhsmv2Client.init(&cfg, func() *cloudhsmv2_sdkv2.Client {
+		return cloudhsmv2_sdkv2.NewFromConfig(cfg, func(o *cloudhsmv2_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.CloudHSMV2]; endpoint != "" {
+				o.EndpointResolver = cloudhsmv2_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.codebuildClient.init(&cfg, func() *codebuild_sdkv2.Client {
+		return codebuild_sdkv2.NewFromConfig(cfg, func(o *codebuild_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.CodeBuild]; endpoint != "" {
+				o.EndpointResolver = codebuild_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.codepipelineClient.init(&cfg, func() *codepipeline_sdkv2.Client {
+		return codepipeline_sdkv2.NewFromConfig(cfg, func(o *codepipeline_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.CodePipeline]; endpoint != "" {
+				o.EndpointResolver = codepipeline_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.codestarconnectionsClient.init(&cfg, func() *codestarconnections_sdkv2.Client {
+		return codestarconnections_sdkv2.NewFromConfig(cfg, func(o *codestarconnections_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.CodeStarConnections]; endpoint != "" {
+				o.EndpointResolver = codestarconnections_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.deployClient.init(&cfg, func() *codedeploy_sdkv2.Client {
+		return codedeploy_sdkv2.NewFromConfig(cfg, func(o *codedeploy_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.Deploy]; endpoint != "" {
+				o.EndpointResolver = codedeploy_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
 	client.ec2Client.init(&cfg, func() *ec2_sdkv2.Client {
 		return ec2_sdkv2.NewFromConfig(cfg, func(o *ec2_sdkv2.Options) {
 			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
@@ -686,6 +771,48 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			}
 		})
 	})
+	client.ecrClient.init(&cfg, func() *ecr_sdkv2.Client {
+		return ecr_sdkv2.NewFromConfig(cfg, func(o *ecr_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.ECR]; endpoint != "" {
+				o.EndpointResolver = ecr_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.ecsClient.init(&cfg, func() *ecs_sdkv2.Client {
+		return ecs_sdkv2.NewFromConfig(cfg, func(o *ecs_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.ECS]; endpoint != "" {
+				o.EndpointResolver = ecs_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.eksClient.init(&cfg, func() *eks_sdkv2.Client {
+		return eks_sdkv2.NewFromConfig(cfg, func(o *eks_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.EKS]; endpoint != "" {
+				o.EndpointResolver = eks_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.imagebuilderClient.init(&cfg, func() *imagebuilder_sdkv2.Client {
+		return imagebuilder_sdkv2.NewFromConfig(cfg, func(o *imagebuilder_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.ImageBuilder]; endpoint != "" {
+				o.EndpointResolver = imagebuilder_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.lakeformationClient.init(&cfg, func() *lakeformation_sdkv2.Client {
+		return lakeformation_sdkv2.NewFromConfig(cfg, func(o *lakeformation_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.LakeFormation]; endpoint != "" {
+				o.EndpointResolver = lakeformation_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.lambdaClient.init(&cfg, func() *lambda_sdkv2.Client {
+		return lambda_sdkv2.NewFromConfig(cfg, func(o *lambda_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.Lambda]; endpoint != "" {
+				o.EndpointResolver = lambda_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
 	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
 		return cloudwatchlogs_sdkv2.NewFromConfig(cfg, func(o *cloudwatchlogs_sdkv2.Options) {
 			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
@@ -700,6 +827,13 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			}
 		})
 	})
+	client.resourcegroupsClient.init(&cfg, func() *resourcegroups_sdkv2.Client {
+		return resourcegroups_sdkv2.NewFromConfig(cfg, func(o *resourcegroups_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.ResourceGroups]; endpoint != "" {
+				o.EndpointResolver = resourcegroups_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
 	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
 		return s3control_sdkv2.NewFromConfig(cfg, func(o *s3control_sdkv2.Options) {
 			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
@@ -714,4 +848,18 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			}
 		})
 	})
+	client.ssoadminClient.init(&cfg, func() *ssoadmin_sdkv2.Client {
+		return ssoadmin_sdkv2.NewFromConfig(cfg, func(o *ssoadmin_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.SSOAdmin]; endpoint != "" {
+				o.EndpointResolver = ssoadmin_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.xrayClient.init(&cfg, func() *xray_sdkv2.Client {
+		return xray_sdkv2.NewFromConfig(cfg, func(o *xray_sdkv2.Options) {
+			if endpoint := c.Endpoints[names.XRay]; endpoint != "" {
+				o.EndpointResolver = xray_sdkv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
 }
@@ -0,0 +1,300 @@
+package flex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// JSONOptions controls how JSON documents are compared by JSONEquivalent.
+type JSONOptions struct {
+	// Defaults holds values that the remote API injects into a document when they are omitted.
+	// A key that is present in only one of the documents is considered equal to its default.
+	// Nested maps describe the defaults of nested objects, including objects in arrays.
+	Defaults map[string]interface{}
+
+	// EmptyEquivalent treats empty strings, `{}` and `null` as equivalent documents.
+	EmptyEquivalent bool
+}
+
+type JSONOptionsFunc func(*JSONOptions)
+
+// WithJSONDefaults sets the default values injected by the remote API.
+func WithJSONDefaults(defaults map[string]interface{}) JSONOptionsFunc {
+	return func(o *JSONOptions) {
+		o.Defaults = defaults
+	}
+}
+
+// WithJSONEmptyEquivalent treats empty strings, `{}` and `null` as equivalent documents.
+func WithJSONEmptyEquivalent() JSONOptionsFunc {
+	return func(o *JSONOptions) {
+		o.EmptyEquivalent = true
+	}
+}
+
+// NormalizeJSON returns the canonical form of a JSON document: object keys are sorted,
+// insignificant whitespace is removed and numbers are written in their shortest form,
+// so that documents which differ only in formatting normalize to the same string.
+func NormalizeJSON(s string) (string, error) {
+	v, err := decodeJSON(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	writeCanonicalJSON(&b, v)
+
+	return b.String(), nil
+}
+
+// NormalizeJSONStateFunc is a schema.SchemaStateFunc that stores JSON documents in their canonical form.
+// Invalid JSON is stored unchanged so that validation can report the error.
+func NormalizeJSONStateFunc(v interface{}) string {
+	s, ok := v.(string)
+
+	if !ok {
+		return ""
+	}
+
+	normalized, err := NormalizeJSON(s)
+
+	if err != nil {
+		return s
+	}
+
+	return normalized
+}
+
+// JSONEquivalent reports whether two JSON documents are semantically equivalent.
+// Object key order and number formatting (e.g. `1`, `1.0` and `1e0`) are not significant.
+func JSONEquivalent(s1, s2 string, optFns ...JSONOptionsFunc) (bool, error) {
+	var options JSONOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.EmptyEquivalent && isEmptyJSON(s1) && isEmptyJSON(s2) {
+		return true, nil
+	}
+
+	v1, err := decodeJSON(s1)
+
+	if err != nil {
+		return false, err
+	}
+
+	v2, err := decodeJSON(s2)
+
+	if err != nil {
+		return false, err
+	}
+
+	var defaults interface{}
+	if options.Defaults != nil {
+		// Round-trip the defaults so that they are in the same form as decoded documents.
+		b, err := json.Marshal(options.Defaults)
+
+		if err != nil {
+			return false, fmt.Errorf("encoding JSON defaults: %w", err)
+		}
+
+		if defaults, err = decodeJSON(string(b)); err != nil {
+			return false, err
+		}
+	}
+
+	return jsonValuesEqual(v1, v2, defaults), nil
+}
+
+// SuppressEquivalentJSON returns a schema.SchemaDiffSuppressFunc that suppresses differences
+// between semantically equivalent JSON documents.
+func SuppressEquivalentJSON(optFns ...JSONOptionsFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		equivalent, err := JSONEquivalent(old, new, optFns...)
+
+		if err != nil {
+			return false
+		}
+
+		return equivalent
+	}
+}
+
+func isEmptyJSON(s string) bool {
+	switch strings.TrimSpace(s) {
+	case "", "{}", "null":
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeJSON(s string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if decoder.More() {
+		return nil, fmt.Errorf("decoding JSON: unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func jsonValuesEqual(v1, v2, defaults interface{}) bool {
+	switch v1 := v1.(type) {
+	case map[string]interface{}:
+		v2, ok := v2.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		defaults, _ := defaults.(map[string]interface{})
+
+		for k := range v1 {
+			if _, ok := v2[k]; !ok {
+				if d, ok := defaults[k]; !ok || !jsonValuesEqual(v1[k], d, nil) {
+					return false
+				}
+			}
+		}
+
+		for k, e2 := range v2 {
+			e1, ok := v1[k]
+			if !ok {
+				if d, ok := defaults[k]; !ok || !jsonValuesEqual(d, e2, nil) {
+					return false
+				}
+				continue
+			}
+
+			if !jsonValuesEqual(e1, e2, defaults[k]) {
+				return false
+			}
+		}
+
+		return true
+
+	case []interface{}:
+		v2, ok := v2.([]interface{})
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+
+		for i := range v1 {
+			if !jsonValuesEqual(v1[i], v2[i], defaults) {
+				return false
+			}
+		}
+
+		return true
+
+	case json.Number:
+		v2, ok := v2.(json.Number)
+		if !ok {
+			return false
+		}
+
+		r1, ok := new(big.Rat).SetString(v1.String())
+		if !ok {
+			return false
+		}
+
+		r2, ok := new(big.Rat).SetString(v2.String())
+		if !ok {
+			return false
+		}
+
+		return r1.Cmp(r2) == 0
+
+	default:
+		return v1 == v2
+	}
+}
+
+func writeCanonicalJSON(b *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeJSONString(b, k)
+			b.WriteByte(':')
+			writeCanonicalJSON(b, v[k])
+		}
+		b.WriteByte('}')
+
+	case []interface{}:
+		b.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeCanonicalJSON(b, e)
+		}
+		b.WriteByte(']')
+
+	case json.Number:
+		b.WriteString(canonicalJSONNumber(v))
+
+	case string:
+		writeJSONString(b, v)
+
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+
+	default:
+		b.WriteString("null")
+	}
+}
+
+func writeJSONString(b *strings.Builder, s string) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string never fails.
+	_ = encoder.Encode(s)
+	b.Write(bytes.TrimRight(buf.Bytes(), "\n"))
+}
+
+func canonicalJSONNumber(n json.Number) string {
+	r, ok := new(big.Rat).SetString(n.String())
+
+	if !ok {
+		return n.String()
+	}
+
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	// Use the shortest float64 representation only if it doesn't lose precision.
+	f, _ := r.Float64()
+	shortest := strconv.FormatFloat(f, 'g', -1, 64)
+
+	if v, ok := new(big.Rat).SetString(shortest); ok && v.Cmp(r) == 0 {
+		return shortest
+	}
+
+	return n.String()
+}
@@ -0,0 +1,199 @@
+package flex
+
+import (
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "object key order and whitespace",
+			input:    "{\n  \"b\": 1,\n  \"a\": [true, null, \"x\"]\n}",
+			expected: `{"a":[true,null,"x"],"b":1}`,
+		},
+		{
+			name:     "number formats",
+			input:    `{"a":1.0,"b":1e3,"c":0.10,"d":-0,"e":12345678901234567890}`,
+			expected: `{"a":1,"b":1000,"c":0.1,"d":0,"e":12345678901234567890}`,
+		},
+		{
+			name:     "nested",
+			input:    `{"z":{"y":{"x":[{"b":2,"a":1}]}}}`,
+			expected: `{"z":{"y":{"x":[{"a":1,"b":2}]}}}`,
+		},
+		{
+			name:     "no HTML escaping",
+			input:    `{"a":"<b>&</b>"}`,
+			expected: `{"a":"<b>&</b>"}`,
+		},
+		{
+			name:        "invalid",
+			input:       `{"a":`,
+			expectError: true,
+		},
+		{
+			name:        "trailing data",
+			input:       `{"a":1} {"b":2}`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeJSON(testCase.input)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestJSONEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		s1       string
+		s2       string
+		optFns   []JSONOptionsFunc
+		expected bool
+	}{
+		{
+			name:     "identical",
+			s1:       `{"a":1}`,
+			s2:       `{"a":1}`,
+			expected: true,
+		},
+		{
+			name:     "key order",
+			s1:       `{"a":1,"b":"two"}`,
+			s2:       `{"b":"two","a":1}`,
+			expected: true,
+		},
+		{
+			name:     "number formats",
+			s1:       `{"a":1,"b":1500}`,
+			s2:       `{"a":1.0,"b":1.5e3}`,
+			expected: true,
+		},
+		{
+			name:     "different numbers",
+			s1:       `{"a":1}`,
+			s2:       `{"a":1.000001}`,
+			expected: false,
+		},
+		{
+			name:     "number and string",
+			s1:       `{"a":1}`,
+			s2:       `{"a":"1"}`,
+			expected: false,
+		},
+		{
+			name:     "array order is significant",
+			s1:       `[1,2]`,
+			s2:       `[2,1]`,
+			expected: false,
+		},
+		{
+			name:     "missing key",
+			s1:       `{"a":1}`,
+			s2:       `{"a":1,"b":false}`,
+			expected: false,
+		},
+		{
+			name:     "injected default",
+			s1:       `{"a":1}`,
+			s2:       `{"a":1,"b":false}`,
+			optFns:   []JSONOptionsFunc{WithJSONDefaults(map[string]interface{}{"b": false})},
+			expected: true,
+		},
+		{
+			name:     "injected default either side",
+			s1:       `{"a":1,"b":false}`,
+			s2:       `{"a":1}`,
+			optFns:   []JSONOptionsFunc{WithJSONDefaults(map[string]interface{}{"b": false})},
+			expected: true,
+		},
+		{
+			name:     "non-default value",
+			s1:       `{"a":1}`,
+			s2:       `{"a":1,"b":true}`,
+			optFns:   []JSONOptionsFunc{WithJSONDefaults(map[string]interface{}{"b": false})},
+			expected: false,
+		},
+		{
+			name:   "nested injected default in array",
+			s1:     `{"items":[{"name":"x"},{"name":"y","weight":2}]}`,
+			s2:     `{"items":[{"name":"x","weight":1.0},{"name":"y","weight":2}]}`,
+			optFns: []JSONOptionsFunc{WithJSONDefaults(map[string]interface{}{"items": map[string]interface{}{"weight": 1}})},
+
+			expected: true,
+		},
+		{
+			name:     "empty not equivalent by default",
+			s1:       ``,
+			s2:       `{}`,
+			expected: false,
+		},
+		{
+			name:     "empty equivalent",
+			s1:       ``,
+			s2:       `{}`,
+			optFns:   []JSONOptionsFunc{WithJSONEmptyEquivalent()},
+			expected: true,
+		},
+		{
+			name:     "invalid",
+			s1:       `{"a":1}`,
+			s2:       `{"a":`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := JSONEquivalent(testCase.s1, testCase.s2, testCase.optFns...)
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeJSONStateFunc(t *testing.T) {
+	t.Parallel()
+
+	if got, expected := NormalizeJSONStateFunc(`{ "b": 1.0, "a": 2 }`), `{"a":2,"b":1}`; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := NormalizeJSONStateFunc(`{invalid`), `{invalid`; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,37 @@
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type boolDefaultValue struct {
+	value bool
+}
+
+// BoolDefaultValue returns a plan modifier that sets the specified value if the configured value is Null.
+// The attribute must be both Optional and Computed.
+func BoolDefaultValue(value bool) planmodifier.Bool {
+	return boolDefaultValue{
+		value: value,
+	}
+}
+
+func (m boolDefaultValue) Description(context.Context) string {
+	return fmt.Sprintf("If value is not configured, defaults to %t", m.value)
+}
+
+func (m boolDefaultValue) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m boolDefaultValue) PlanModifyBool(ctx context.Context, request planmodifier.BoolRequest, response *planmodifier.BoolResponse) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	response.PlanValue = types.BoolValue(m.value)
+}
@@ -0,0 +1,69 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBoolDefaultValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		configValue   types.Bool
+		plannedValue  types.Bool
+		defaultValue  bool
+		expectedValue types.Bool
+	}
+	tests := map[string]testCase{
+		"non-default configured": {
+			configValue:   types.BoolValue(true),
+			plannedValue:  types.BoolValue(true),
+			defaultValue:  false,
+			expectedValue: types.BoolValue(true),
+		},
+		"default configured": {
+			configValue:   types.BoolValue(false),
+			plannedValue:  types.BoolValue(false),
+			defaultValue:  false,
+			expectedValue: types.BoolValue(false),
+		},
+		"not configured, planned unknown": {
+			configValue:   types.BoolNull(),
+			plannedValue:  types.BoolUnknown(),
+			defaultValue:  false,
+			expectedValue: types.BoolValue(false),
+		},
+		"not configured, planned from state": {
+			configValue:   types.BoolNull(),
+			plannedValue:  types.BoolValue(true),
+			defaultValue:  false,
+			expectedValue: types.BoolValue(false),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := planmodifier.BoolRequest{
+				ConfigValue: test.configValue,
+				PlanValue:   test.plannedValue,
+			}
+			response := planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			BoolDefaultValue(test.defaultValue).PlanModifyBool(ctx, request, &response)
+
+			if diff := cmp.Diff(response.PlanValue, test.expectedValue); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
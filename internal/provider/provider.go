@@ -30,17 +30,21 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bcmdataexports"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
@@ -141,6 +145,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -181,9 +186,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
@@ -448,6 +456,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_auditmanager_evidence_folders": auditmanager.DataSourceEvidenceFolders(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -556,6 +566,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
 			"aws_ec2_host":                                   ec2.DataSourceHost(),
+			"aws_ec2_hosts":                                  ec2.DataSourceHosts(),
 			"aws_ec2_instance_type_offering":                 ec2.DataSourceInstanceTypeOffering(),
 			"aws_ec2_instance_type_offerings":                ec2.DataSourceInstanceTypeOfferings(),
 			"aws_ec2_instance_type":                          ec2.DataSourceInstanceType(),
@@ -625,10 +636,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
-			"aws_ecs_cluster":              ecs.DataSourceCluster(),
-			"aws_ecs_container_definition": ecs.DataSourceContainerDefinition(),
-			"aws_ecs_service":              ecs.DataSourceService(),
-			"aws_ecs_task_definition":      ecs.DataSourceTaskDefinition(),
+			"aws_ecs_cluster":                    ecs.DataSourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.DataSourceClusterCapacityProviders(),
+			"aws_ecs_container_definition":       ecs.DataSourceContainerDefinition(),
+			"aws_ecs_service":                    ecs.DataSourceService(),
+			"aws_ecs_task_definition":            ecs.DataSourceTaskDefinition(),
 
 			"aws_efs_access_point":  efs.DataSourceAccessPoint(),
 			"aws_efs_access_points": efs.DataSourceAccessPoints(),
@@ -699,8 +711,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
 			"aws_iam_users":                   iam.DataSourceUsers(),
 
-			"aws_identitystore_group": identitystore.DataSourceGroup(),
-			"aws_identitystore_user":  identitystore.DataSourceUser(),
+			"aws_identitystore_group":  identitystore.DataSourceGroup(),
+			"aws_identitystore_groups": identitystore.DataSourceGroups(),
+			"aws_identitystore_user":   identitystore.DataSourceUser(),
 
 			"aws_imagebuilder_component":                     imagebuilder.DataSourceComponent(),
 			"aws_imagebuilder_components":                    imagebuilder.DataSourceComponents(),
@@ -748,9 +761,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kms_secret":           kms.DataSourceSecret(),
 			"aws_kms_secrets":          kms.DataSourceSecrets(),
 
-			"aws_lakeformation_data_lake_settings": lakeformation.DataSourceDataLakeSettings(),
-			"aws_lakeformation_permissions":        lakeformation.DataSourcePermissions(),
-			"aws_lakeformation_resource":           lakeformation.DataSourceResource(),
+			"aws_lakeformation_data_lake_settings":    lakeformation.DataSourceDataLakeSettings(),
+			"aws_lakeformation_effective_permissions": lakeformation.DataSourceEffectivePermissions(),
+			"aws_lakeformation_permissions":           lakeformation.DataSourcePermissions(),
+			"aws_lakeformation_resource":              lakeformation.DataSourceResource(),
 
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
@@ -802,6 +816,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_opensearch_domain": opensearch.DataSourceDomain(),
 
+			"aws_opensearchserverless_collections":   opensearchserverless.DataSourceCollections(),
+			"aws_opensearchserverless_vpc_endpoints": opensearchserverless.DataSourceVPCEndpoints(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -823,7 +840,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_qldb_ledger": qldb.DataSourceLedger(),
 
-			"aws_ram_resource_share": ram.DataSourceResourceShare(),
+			"aws_ram_resource_share":   ram.DataSourceResourceShare(),
+			"aws_ram_shared_resources": ram.DataSourceSharedResources(),
 
 			"aws_ses_active_receipt_rule_set": ses.DataSourceActiveReceiptRuleSet(),
 			"aws_ses_domain_identity":         ses.DataSourceDomainIdentity(),
@@ -852,6 +870,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_redshiftserverless_credentials": redshiftserverless.DataSourceCredentials(),
 
+			"aws_resourcegroups_group_resources": resourcegroups.DataSourceGroupResources(),
+
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
@@ -897,8 +917,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_service_discovery_http_namespace": servicediscovery.DataSourceHTTPNamespace(),
 			"aws_service_discovery_service":        servicediscovery.DataSourceService(),
 
-			"aws_servicequotas_service":       servicequotas.DataSourceService(),
-			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
+			"aws_servicequotas_service":                   servicequotas.DataSourceService(),
+			"aws_servicequotas_service_quota":             servicequotas.DataSourceServiceQuota(),
+			"aws_servicequotas_service_quota_utilization": servicequotas.DataSourceServiceQuotaUtilization(),
 
 			"aws_sfn_activity":      sfn.DataSourceActivity(),
 			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
@@ -953,6 +974,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
+			"aws_account_primary_contact":   account.ResourcePrimaryContact(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -965,6 +987,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_applicationinsights_application": applicationinsights.ResourceApplication(),
 
+			"aws_applicationsignals_discovery":               applicationsignals.ResourceDiscovery(),
+			"aws_applicationsignals_service_level_objective": applicationsignals.ResourceServiceLevelObjective(),
+
 			"aws_prometheus_workspace":                amp.ResourceWorkspace(),
 			"aws_prometheus_alert_manager_definition": amp.ResourceAlertManagerDefinition(),
 			"aws_prometheus_rule_group_namespace":     amp.ResourceRuleGroupNamespace(),
@@ -1069,6 +1094,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_athena_named_query":  athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
 
+			"aws_auditmanager_assessment_delegation": auditmanager.ResourceAssessmentDelegation(),
+			"aws_auditmanager_assessment_report":     auditmanager.ResourceAssessmentReport(),
+
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
 			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
@@ -1096,6 +1124,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
 
+			"aws_bcmdataexports_export": bcmdataexports.ResourceExport(),
+
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
@@ -1104,6 +1134,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ce_cost_allocation_tag":  ce.ResourceCostAllocationTag(),
 			"aws_ce_cost_category":        ce.ResourceCostCategory(),
 
+			"aws_chatbot_slack_channel_configuration": chatbot.ResourceSlackChannelConfiguration(),
+			"aws_chatbot_teams_channel_configuration": chatbot.ResourceTeamsChannelConfiguration(),
+
 			"aws_chime_voice_connector":                         chime.ResourceVoiceConnector(),
 			"aws_chime_voice_connector_group":                   chime.ResourceVoiceConnectorGroup(),
 			"aws_chime_voice_connector_logging":                 chime.ResourceVoiceConnectorLogging(),
@@ -1121,6 +1154,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudformation_stack_set":          cloudformation.ResourceStackSet(),
 			"aws_cloudformation_stack_set_instance": cloudformation.ResourceStackSetInstance(),
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
+			"aws_cloudformation_type_activation":    cloudformation.ResourceTypeActivation(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
@@ -1176,6 +1210,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_codeartifact_repository":                    codeartifact.ResourceRepository(),
 			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(),
 
+			"aws_codebuild_fleet":             codebuild.ResourceFleet(),
 			"aws_codebuild_project":           codebuild.ResourceProject(),
 			"aws_codebuild_resource_policy":   codebuild.ResourceResourcePolicy(),
 			"aws_codebuild_report_group":      codebuild.ResourceReportGroup(),
@@ -1195,8 +1230,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_codepipeline_custom_action_type": codepipeline.ResourceCustomActionType(),
 			"aws_codepipeline_webhook":            codepipeline.ResourceWebhook(),
 
-			"aws_codestarconnections_connection": codestarconnections.ResourceConnection(),
-			"aws_codestarconnections_host":       codestarconnections.ResourceHost(),
+			"aws_codestarconnections_connection":         codestarconnections.ResourceConnection(),
+			"aws_codestarconnections_host":               codestarconnections.ResourceHost(),
+			"aws_codestarconnections_repository_link":    codestarconnections.ResourceRepositoryLink(),
+			"aws_codestarconnections_sync_configuration": codestarconnections.ResourceSyncConfiguration(),
 
 			"aws_codestarnotifications_notification_rule": codestarnotifications.ResourceNotificationRule(),
 
@@ -1279,9 +1316,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_devicefarm_test_grid_project": devicefarm.ResourceTestGridProject(),
 			"aws_devicefarm_upload":            devicefarm.ResourceUpload(),
 
-			"aws_detective_graph":               detective.ResourceGraph(),
-			"aws_detective_invitation_accepter": detective.ResourceInvitationAccepter(),
-			"aws_detective_member":              detective.ResourceMember(),
+			"aws_detective_graph":                      detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":        detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                     detective.ResourceMember(),
+			"aws_detective_organization_admin_account": detective.ResourceOrganizationAdminAccount(),
+			"aws_detective_organization_configuration": detective.ResourceOrganizationConfiguration(),
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
@@ -1355,6 +1394,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
 			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                   ec2.ResourceCapacityReservationFleet(),
+			"aws_ec2_capacity_reservation_split":                   ec2.ResourceCapacityReservationSplit(),
 			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
@@ -1362,6 +1403,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
 			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_image_block_public_access":                    ec2.ResourceImageBlockPublicAccess(),
+			"aws_ec2_instance_metadata_defaults":                   ec2.ResourceInstanceMetadataDefaults(),
 			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
@@ -1417,6 +1460,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
 			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
 			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_snapshot_create_volume_permissions":               ec2.ResourceSnapshotCreateVolumePermissions(),
 			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
 			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
 			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
@@ -1459,6 +1503,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ecr_registry_scanning_configuration": ecr.ResourceRegistryScanningConfiguration(),
 			"aws_ecr_replication_configuration":       ecr.ResourceReplicationConfiguration(),
 			"aws_ecr_repository":                      ecr.ResourceRepository(),
+			"aws_ecr_repository_creation_template":    ecr.ResourceRepositoryCreationTemplate(),
 			"aws_ecr_repository_policy":               ecr.ResourceRepositoryPolicy(),
 
 			"aws_ecrpublic_repository":        ecrpublic.ResourceRepository(),
@@ -1571,6 +1616,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
 			"aws_gamelift_game_server_group":  gamelift.ResourceGameServerGroup(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
+			"aws_gamelift_scaling_policy":     gamelift.ResourceScalingPolicy(),
 			"aws_gamelift_script":             gamelift.ResourceScript(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
@@ -1642,9 +1688,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
-			"aws_identitystore_group":            identitystore.ResourceGroup(),
-			"aws_identitystore_user":             identitystore.ResourceUser(),
-			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group":             identitystore.ResourceGroup(),
+			"aws_identitystore_user":              identitystore.ResourceUser(),
+			"aws_identitystore_group_membership":  identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group_memberships": identitystore.ResourceGroupMemberships(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1653,6 +1700,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_imagebuilder_image_pipeline":               imagebuilder.ResourceImagePipeline(),
 			"aws_imagebuilder_image_recipe":                 imagebuilder.ResourceImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration": imagebuilder.ResourceInfrastructureConfiguration(),
+			"aws_imagebuilder_lifecycle_policy":             imagebuilder.ResourceLifecyclePolicy(),
+			"aws_imagebuilder_workflow":                     imagebuilder.ResourceWorkflow(),
 
 			"aws_inspector_assessment_target":   inspector.ResourceAssessmentTarget(),
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
@@ -1664,8 +1713,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_fleet_metric":               iot.ResourceFleetMetric(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_logging_options":            iot.ResourceLoggingOptions(),
+			"aws_iot_package":                    iot.ResourcePackage(),
+			"aws_iot_package_version":            iot.ResourcePackageVersion(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
 			"aws_iot_policy_attachment":          iot.ResourcePolicyAttachment(),
 			"aws_iot_provisioning_template":      iot.ResourceProvisioningTemplate(),
@@ -1697,6 +1749,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kendra_data_source":                  kendra.ResourceDataSource(),
 			"aws_kendra_experience":                   kendra.ResourceExperience(),
 			"aws_kendra_faq":                          kendra.ResourceFaq(),
+			"aws_kendra_featured_results_set":         kendra.ResourceFeaturedResultsSet(),
 			"aws_kendra_index":                        kendra.ResourceIndex(),
 			"aws_kendra_query_suggestions_block_list": kendra.ResourceQuerySuggestionsBlockList(),
 			"aws_kendra_thesaurus":                    kendra.ResourceThesaurus(),
@@ -1722,8 +1775,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
+			"aws_lakeformation_data_cells_filter":  lakeformation.ResourceDataCellsFilter(),
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
 			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
+			"aws_lakeformation_lf_tag_expression":  lakeformation.ResourceLFTagExpression(),
+			"aws_lakeformation_opt_in":             lakeformation.ResourceOptIn(),
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
 			"aws_lakeformation_resource_lf_tags":   lakeformation.ResourceResourceLFTags(),
@@ -1768,6 +1824,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_lightsail_static_ip":                            lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":                 lightsail.ResourceStaticIPAttachment(),
 
+			"aws_location_api_key":             location.ResourceAPIKey(),
 			"aws_location_geofence_collection": location.ResourceGeofenceCollection(),
 			"aws_location_map":                 location.ResourceMap(),
 			"aws_location_place_index":         location.ResourcePlaceIndex(),
@@ -1793,6 +1850,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_medialive_channel":              medialive.ResourceChannel(),
 			"aws_medialive_input":                medialive.ResourceInput(),
+			"aws_medialive_input_device":         medialive.ResourceInputDevice(),
 			"aws_medialive_input_security_group": medialive.ResourceInputSecurityGroup(),
 			"aws_medialive_multiplex":            medialive.ResourceMultiplex(),
 
@@ -1849,6 +1907,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_opensearch_outbound_connection":         opensearch.ResourceOutboundConnection(),
 			"aws_opensearch_inbound_connection_accepter": opensearch.ResourceInboundConnectionAccepter(),
 
+			"aws_opensearchserverless_lifecycle_policy": opensearchserverless.ResourceLifecyclePolicy(),
+			"aws_opensearchserverless_security_config":  opensearchserverless.ResourceSecurityConfig(),
+
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ecs_cluster_layer": opsworks.ResourceECSClusterLayer(),
@@ -1874,6 +1935,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
 
+			"aws_outposts_order": outposts.ResourceOrder(),
+
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
 			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),
@@ -1902,6 +1965,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_db_cluster_snapshot":                       rds.ResourceClusterSnapshot(),
 			"aws_db_event_subscription":                     rds.ResourceEventSubscription(),
 			"aws_db_instance":                               rds.ResourceInstance(),
+			"aws_db_instance_activity_stream":               rds.ResourceInstanceActivityStream(),
 			"aws_db_instance_automated_backups_replication": rds.ResourceInstanceAutomatedBackupsReplication(),
 			"aws_db_instance_role_association":              rds.ResourceInstanceRoleAssociation(),
 			"aws_db_option_group":                           rds.ResourceOptionGroup(),
@@ -1914,6 +1978,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_db_snapshot":                               rds.ResourceSnapshot(),
 			"aws_db_snapshot_copy":                          rds.ResourceSnapshotCopy(),
 			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_certificate":                           rds.ResourceCertificate(),
 			"aws_rds_cluster":                               rds.ResourceCluster(),
 			"aws_rds_cluster_activity_stream":               rds.ResourceClusterActivityStream(),
 			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
@@ -1921,7 +1986,10 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
+			"aws_rds_instance_pending_maintenance_action":   rds.ResourceInstancePendingMaintenanceAction(),
+			"aws_rds_instance_state":                        rds.ResourceInstanceState(),
 			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
+			"aws_rds_tenant_database":                       rds.ResourceTenantDatabase(),
 
 			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
@@ -1950,7 +2018,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
-			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
+			"aws_resourcegroups_group":         resourcegroups.ResourceGroup(),
+			"aws_resourcegroups_resource":      resourcegroups.ResourceResource(),
+			"aws_resourcegroups_tag_sync_task": resourcegroups.ResourceTagSyncTask(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
 			"aws_rolesanywhere_trust_anchor": rolesanywhere.ResourceTrustAnchor(),
@@ -1998,6 +2068,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
 			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
 			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configurations":   s3.ResourceBucketIntelligentTieringConfigurations(),
 			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
 			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
 			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
@@ -2017,6 +2088,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
+			"aws_s3control_access_grant":                      s3control.ResourceAccessGrant(),
+			"aws_s3control_access_grants_instance":            s3control.ResourceAccessGrantsInstance(),
+			"aws_s3control_access_grants_location":            s3control.ResourceAccessGrantsLocation(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
 			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(),
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
@@ -2102,7 +2176,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
 
-			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_service_quota":        servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_template":             servicequotas.ResourceTemplate(),
+			"aws_servicequotas_template_association": servicequotas.ResourceTemplateAssociation(),
 
 			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(),
 			"aws_ses_configuration_set":            ses.ResourceConfigurationSet(),
@@ -2129,14 +2205,20 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_application_layer_automatic_response": shield.ResourceApplicationLayerAutomaticResponse(),
+			"aws_shield_proactive_engagement":                 shield.ResourceProactiveEngagement(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
 
+			"aws_snowball_address": snowball.ResourceAddress(),
+			"aws_snowball_cluster": snowball.ResourceCluster(),
+			"aws_snowball_job":     snowball.ResourceJob(),
+
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
@@ -2161,11 +2243,22 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
 
+			"aws_ssmcontacts_contact":         ssmcontacts.ResourceContact(),
+			"aws_ssmcontacts_contact_channel": ssmcontacts.ResourceContactChannel(),
+
+			"aws_ssmincidents_replication_set": ssmincidents.ResourceReplicationSet(),
+			"aws_ssmincidents_response_plan":   ssmincidents.ResourceResponsePlan(),
+
 			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_application":                        ssoadmin.ResourceApplication(),
+			"aws_ssoadmin_application_access_scope":           ssoadmin.ResourceApplicationAccessScope(),
+			"aws_ssoadmin_application_assignment":             ssoadmin.ResourceApplicationAssignment(),
+			"aws_ssoadmin_application_authentication_method":  ssoadmin.ResourceApplicationAuthenticationMethod(),
 			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
 			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
 			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
 			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_trusted_token_issuer":               ssoadmin.ResourceTrustedTokenIssuer(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -2238,9 +2331,11 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
-			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
-			"aws_xray_group":             xray.ResourceGroup(),
-			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
+			"aws_xray_encryption_config":         xray.ResourceEncryptionConfig(),
+			"aws_xray_group":                     xray.ResourceGroup(),
+			"aws_xray_resource_policy":           xray.ResourceResourcePolicy(),
+			"aws_xray_sampling_rule":             xray.ResourceSamplingRule(),
+			"aws_xray_trace_segment_destination": xray.ResourceTraceSegmentDestination(),
 		},
 	}
 
@@ -1,18 +1,23 @@
 package accessanalyzer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/accessanalyzer"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,12 +33,13 @@ const (
 
 func ResourceAnalyzer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAnalyzerCreate,
-		Read:   resourceAnalyzerRead,
-		Update: resourceAnalyzerUpdate,
-		Delete: resourceAnalyzerDelete,
+		CreateWithoutTimeout: resourceAnalyzerCreate,
+		ReadWithoutTimeout:   resourceAnalyzerRead,
+		UpdateWithoutTimeout: resourceAnalyzerUpdate,
+		DeleteWithoutTimeout: resourceAnalyzerDelete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,138 +56,351 @@ func ResourceAnalyzer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unused_access": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"analysis_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"exclusion": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"account_ids": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 2000,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidAccountID,
+																},
+															},
+															"resource_tags": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeMap,
+																	Elem: &schema.Schema{Type: schema.TypeString},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"unused_access_age": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  accessanalyzer.TypeAccount,
-				ValidateFunc: validation.StringInSlice([]string{
-					accessanalyzer.TypeAccount,
-					accessanalyzer.TypeOrganization,
-				}, false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.TypeAccount,
+				ValidateDiagFunc: enum.Validate[types.Type](),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: resourceAnalyzerCustomizeDiff,
 	}
 }
 
-func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+func resourceAnalyzerCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := verify.SetTagsDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if v, ok := diff.GetOk("configuration"); ok && len(v.([]interface{})) > 0 {
+		if analyzerType := types.Type(diff.Get("type").(string)); !analyzerTypeIsUnusedAccess(analyzerType) {
+			return fmt.Errorf("configuration can only be set for analyzers of type %s or %s", types.TypeAccountUnusedAccess, types.TypeOrganizationUnusedAccess)
+		}
+	}
+
+	return nil
+}
+
+func resourceAnalyzerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
-	analyzerName := d.Get("analyzer_name").(string)
 
+	analyzerName := d.Get("analyzer_name").(string)
 	input := &accessanalyzer.CreateAnalyzerInput{
 		AnalyzerName: aws.String(analyzerName),
 		ClientToken:  aws.String(resource.UniqueId()),
-		Tags:         Tags(tags.IgnoreAWS()),
-		Type:         aws.String(d.Get("type").(string)),
+		Type:         types.Type(d.Get("type").(string)),
 	}
 
-	// Handle Organizations eventual consistency
-	err := resource.Retry(organizationCreationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateAnalyzer(input)
-
-		if tfawserr.ErrMessageContains(err, accessanalyzer.ErrCodeValidationException, "You must create an organization") {
-			return resource.RetryableError(err)
-		}
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
 
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAWS().Map()
+	}
 
-		return nil
-	})
+	// Handle Organizations eventual consistency
+	_, err := tfresource.RetryWhen(organizationCreationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateAnalyzer(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.MessageContains(err, "ValidationException", "You must create an organization") {
+				return true, err
+			}
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.CreateAnalyzer(input)
-	}
+			return false, err
+		},
+	)
 
 	if err != nil {
-		return fmt.Errorf("error creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
+		return diag.Errorf("creating Access Analyzer Analyzer (%s): %s", analyzerName, err)
 	}
 
 	d.SetId(analyzerName)
 
-	return resourceAnalyzerRead(d, meta)
+	return resourceAnalyzerRead(ctx, d, meta)
 }
 
-func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+func resourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	input := &accessanalyzer.GetAnalyzerInput{
-		AnalyzerName: aws.String(d.Id()),
-	}
-
-	output, err := conn.GetAnalyzer(input)
+	analyzer, err := FindAnalyzerByName(ctx, conn, d.Id())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Access Analyzer Analyzer (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		return diag.Errorf("reading Access Analyzer Analyzer (%s): %s", d.Id(), err)
 	}
 
-	if output == nil || output.Analyzer == nil {
-		return fmt.Errorf("error getting Access Analyzer Analyzer (%s): empty response", d.Id())
+	d.Set("analyzer_name", analyzer.Name)
+	d.Set("arn", analyzer.Arn)
+	if analyzer.Configuration != nil {
+		if err := d.Set("configuration", []interface{}{flattenAnalyzerConfiguration(analyzer.Configuration)}); err != nil {
+			return diag.Errorf("setting configuration: %s", err)
+		}
+	} else {
+		d.Set("configuration", nil)
 	}
+	d.Set("type", analyzer.Type)
 
-	d.Set("analyzer_name", output.Analyzer.Name)
-	d.Set("arn", output.Analyzer.Arn)
-
-	tags := KeyValueTags(output.Analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := tftags.New(analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("setting tags_all: %s", err)
 	}
 
-	d.Set("type", output.Analyzer.Type)
-
 	return nil
 }
 
-func resourceAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+func resourceAnalyzerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("configuration") {
+		conn := meta.(*conns.AWSClient).AccessAnalyzerClient()
+
+		input := &accessanalyzer.UpdateAnalyzerInput{
+			AnalyzerName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Configuration = &types.AnalyzerConfigurationMemberUnusedAccess{}
+		}
+
+		_, err := conn.UpdateAnalyzer(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		}
+	}
 
 	if d.HasChange("tags_all") {
+		conn := meta.(*conns.AWSClient).AccessAnalyzerConn
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Access Analyzer Analyzer (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceAnalyzerRead(d, meta)
+	return resourceAnalyzerRead(ctx, d, meta)
 }
 
-func resourceAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+func resourceAnalyzerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient()
 
-	log.Printf("[DEBUG] Deleting Access Analyzer Analyzer: (%s)", d.Id())
-	_, err := conn.DeleteAnalyzer(&accessanalyzer.DeleteAnalyzerInput{
+	log.Printf("[DEBUG] Deleting Access Analyzer Analyzer: %s", d.Id())
+	_, err := conn.DeleteAnalyzer(ctx, &accessanalyzer.DeleteAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
 		ClientToken:  aws.String(resource.UniqueId()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Access Analyzer Analyzer (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting Access Analyzer Analyzer (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAnalyzerByName(ctx context.Context, conn *accessanalyzer.Client, name string) (*types.AnalyzerSummary, error) {
+	input := &accessanalyzer.GetAnalyzerInput{
+		AnalyzerName: aws.String(name),
+	}
+
+	output, err := conn.GetAnalyzer(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Analyzer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Analyzer, nil
+}
+
+func analyzerTypeIsUnusedAccess(analyzerType types.Type) bool {
+	return analyzerType == types.TypeAccountUnusedAccess || analyzerType == types.TypeOrganizationUnusedAccess
+}
+
+func expandAnalyzerConfiguration(tfMap map[string]interface{}) types.AnalyzerConfiguration {
+	if v, ok := tfMap["unused_access"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &types.AnalyzerConfigurationMemberUnusedAccess{
+			Value: expandUnusedAccessConfiguration(v[0].(map[string]interface{})),
+		}
 	}
 
 	return nil
 }
+
+func expandUnusedAccessConfiguration(tfMap map[string]interface{}) types.UnusedAccessConfiguration {
+	apiObject := types.UnusedAccessConfiguration{}
+
+	if v, ok := tfMap["analysis_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AnalysisRule = expandAnalysisRule(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["unused_access_age"].(int); ok && v != 0 {
+		apiObject.UnusedAccessAge = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRule(tfMap map[string]interface{}) *types.AnalysisRule {
+	apiObject := &types.AnalysisRule{}
+
+	if v, ok := tfMap["exclusion"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			criteria := types.AnalysisRuleCriteria{}
+
+			if v, ok := tfMap["account_ids"].([]interface{}); ok && len(v) > 0 {
+				criteria.AccountIds = flex.ExpandStringValueList(v)
+			}
+
+			if v, ok := tfMap["resource_tags"].([]interface{}); ok && len(v) > 0 {
+				for _, v := range v {
+					if v, ok := v.(map[string]interface{}); ok {
+						criteria.ResourceTags = append(criteria.ResourceTags, flex.ExpandStringValueMap(v))
+					}
+				}
+			}
+
+			apiObject.Exclusions = append(apiObject.Exclusions, criteria)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAnalyzerConfiguration(apiObject types.AnalyzerConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v, ok := apiObject.(*types.AnalyzerConfigurationMemberUnusedAccess); ok {
+		tfMap["unused_access"] = []interface{}{flattenUnusedAccessConfiguration(v.Value)}
+	}
+
+	return tfMap
+}
+
+func flattenUnusedAccessConfiguration(apiObject types.UnusedAccessConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"unused_access_age": aws.ToInt32(apiObject.UnusedAccessAge),
+	}
+
+	if v := apiObject.AnalysisRule; v != nil && len(v.Exclusions) > 0 {
+		var exclusions []interface{}
+
+		for _, criteria := range v.Exclusions {
+			var resourceTags []interface{}
+
+			for _, v := range criteria.ResourceTags {
+				resourceTags = append(resourceTags, v)
+			}
+
+			exclusions = append(exclusions, map[string]interface{}{
+				"account_ids":   criteria.AccountIds,
+				"resource_tags": resourceTags,
+			})
+		}
+
+		tfMap["analysis_rule"] = []interface{}{map[string]interface{}{
+			"exclusion": exclusions,
+		}}
+	}
+
+	return tfMap
+}
@@ -30,6 +30,8 @@ func ResourceArchiveRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceArchiveRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"analyzer_name": {
 				Type:     schema.TypeString,
@@ -42,8 +44,9 @@ func ResourceArchiveRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"criteria": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validArchiveRuleFilterCriteria,
 						},
 						"contains": {
 							Type:     schema.TypeList,
@@ -189,6 +192,41 @@ func resourceArchiveRuleDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceArchiveRuleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The analyzer name is unknown when the analyzer is created in the same plan.
+	analyzerName := diff.Get("analyzer_name").(string)
+
+	if analyzerName == "" || !diff.HasChange("filter") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient()
+
+	analyzer, err := FindAnalyzerByName(ctx, conn, analyzerName)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Access Analyzer Analyzer (%s): %w", analyzerName, err)
+	}
+
+	for _, tfMapRaw := range diff.Get("filter").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validateArchiveRuleFilterCriteriaForAnalyzerType(tfMap["criteria"].(string), analyzer.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func FindArchiveRule(ctx context.Context, conn *accessanalyzer.AccessAnalyzer, analyzerName, ruleName string) (*accessanalyzer.ArchiveRuleSummary, error) {
 	in := &accessanalyzer.GetArchiveRuleInput{
 		AnalyzerName: aws.String(analyzerName),
@@ -3,6 +3,7 @@ package accessanalyzer_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
@@ -143,6 +144,30 @@ func testAccAnalyzerArchiveRule_disappears(t *testing.T) {
 	})
 }
 
+func testAccAnalyzerArchiveRule_invalidFilter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filters := `
+filter {
+  criteria = "notAFilterKey"
+  eq       = ["true"]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccArchiveRuleConfig_updateFilters(rName, filters),
+				ExpectError: regexp.MustCompile(`is not a valid archive rule filter key`),
+			},
+		},
+	})
+}
+
 func testAccCheckArchiveRuleDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
 
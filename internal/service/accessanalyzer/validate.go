@@ -0,0 +1,92 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+)
+
+// Archive rule filter keys, see
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/access-analyzer-reference-filter-keys.html.
+var (
+	externalAccessFilterKeys = []string{
+		"action",
+		"error",
+		"id",
+		"isPublic",
+		"principal.AWS",
+		"principal.CanonicalUser",
+		"principal.Federated",
+		"principal.Service",
+		"resource",
+		"resourceOwnerAccount",
+		"resourceType",
+		"status",
+	}
+
+	unusedAccessFilterKeys = []string{
+		"findingType",
+		"id",
+		"resource",
+		"resourceOwnerAccount",
+		"resourceType",
+		"status",
+	}
+)
+
+const conditionFilterKeyPrefix = "condition."
+
+func validArchiveRuleFilterCriteria(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if isExternalAccessFilterKey(value) || isUnusedAccessFilterKey(value) {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not a valid archive rule filter key", k, value))
+
+	return
+}
+
+// validateArchiveRuleFilterCriteriaForAnalyzerType returns an error if the filter key
+// is not supported for findings generated by analyzers of the specified type.
+func validateArchiveRuleFilterCriteriaForAnalyzerType(criteria string, analyzerType types.Type) error {
+	if analyzerTypeIsUnusedAccess(analyzerType) {
+		if !isUnusedAccessFilterKey(criteria) {
+			return fmt.Errorf("filter key %q is not supported for unused access findings (analyzer type %s)", criteria, analyzerType)
+		}
+
+		return nil
+	}
+
+	if !isExternalAccessFilterKey(criteria) {
+		return fmt.Errorf("filter key %q is not supported for external access findings (analyzer type %s)", criteria, analyzerType)
+	}
+
+	return nil
+}
+
+func isExternalAccessFilterKey(v string) bool {
+	if strings.HasPrefix(v, conditionFilterKeyPrefix) && len(v) > len(conditionFilterKeyPrefix) {
+		return true
+	}
+
+	for _, key := range externalAccessFilterKeys {
+		if v == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isUnusedAccessFilterKey(v string) bool {
+	for _, key := range unusedAccessFilterKeys {
+		if v == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package accessanalyzer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+)
+
+func TestValidArchiveRuleFilterCriteria(t *testing.T) {
+	t.Parallel()
+
+	validKeys := []string{
+		"condition.aws:UserId",
+		"error",
+		"findingType",
+		"isPublic",
+		"principal.AWS",
+		"resourceType",
+	}
+
+	for _, v := range validKeys {
+		if _, errors := validArchiveRuleFilterCriteria(v, "criteria"); len(errors) != 0 {
+			t.Errorf("%q should be a valid filter key: %q", v, errors)
+		}
+	}
+
+	invalidKeys := []string{
+		"",
+		"condition.",
+		"IsPublic",
+		"principal",
+		"unknown",
+	}
+
+	for _, v := range invalidKeys {
+		if _, errors := validArchiveRuleFilterCriteria(v, "criteria"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid filter key", v)
+		}
+	}
+}
+
+func TestValidateArchiveRuleFilterCriteriaForAnalyzerType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		criteria     string
+		analyzerType types.Type
+		expectError  bool
+	}{
+		{"isPublic", types.TypeAccount, false},
+		{"condition.aws:UserId", types.TypeOrganization, false},
+		{"findingType", types.TypeAccount, true},
+		{"findingType", types.TypeAccountUnusedAccess, false},
+		{"resourceType", types.TypeOrganizationUnusedAccess, false},
+		{"isPublic", types.TypeAccountUnusedAccess, true},
+		{"condition.aws:UserId", types.TypeOrganizationUnusedAccess, true},
+	}
+
+	for _, testCase := range testCases {
+		err := validateArchiveRuleFilterCriteriaForAnalyzerType(testCase.criteria, testCase.analyzerType)
+
+		if testCase.expectError && err == nil {
+			t.Errorf("expected error for %q with analyzer type %s", testCase.criteria, testCase.analyzerType)
+		}
+
+		if !testCase.expectError && err != nil {
+			t.Errorf("unexpected error for %q with analyzer type %s: %s", testCase.criteria, testCase.analyzerType, err)
+		}
+	}
+}
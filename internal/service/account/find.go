@@ -38,3 +38,30 @@ func FindAlternateContactByAccountIDAndContactType(ctx context.Context, conn *ac
 
 	return output.AlternateContact, nil
 }
+
+func FindContactInformation(ctx context.Context, conn *account.Account, accountID string) (*account.ContactInformation, error) {
+	input := &account.GetContactInformationInput{}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetContactInformationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactInformation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactInformation, nil
+}
@@ -0,0 +1,146 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+)
+
+func TestAccAccountPrimaryContact_basic(t *testing.T) {
+	resourceName := "aws_account_primary_contact.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, account.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryContactConfig_basic(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "address_line_1", "123 Any Street"),
+					resource.TestCheckResourceAttr(resourceName, "city", "Seattle"),
+					resource.TestCheckResourceAttr(resourceName, "company_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "phone_number", "+64211111111"),
+					resource.TestCheckResourceAttr(resourceName, "postal_code", "98101"),
+					resource.TestCheckResourceAttr(resourceName, "state_or_region", "WA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrimaryContactConfig_basic(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "company_name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountPrimaryContact_accountID(t *testing.T) {
+	resourceName := "aws_account_primary_contact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, account.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryContactConfig_organization(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrimaryContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Primary Contact ID is set")
+		}
+
+		ctx := context.Background()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+
+		_, err := tfaccount.FindContactInformation(ctx, conn, rs.Primary.Attributes["account_id"])
+
+		return err
+	}
+}
+
+func testAccPrimaryContactConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_account_primary_contact" "test" {
+  address_line_1  = "123 Any Street"
+  city            = "Seattle"
+  company_name    = %[1]q
+  country_code    = "US"
+  full_name       = %[1]q
+  phone_number    = "+64211111111"
+  postal_code     = "98101"
+  state_or_region = "WA"
+}
+`, rName)
+}
+
+func testAccPrimaryContactConfig_organization(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_account_primary_contact" "test" {
+  account_id = data.aws_caller_identity.test.account_id
+
+  address_line_1  = "123 Any Street"
+  city            = "Seattle"
+  company_name    = %[1]q
+  country_code    = "US"
+  full_name       = %[1]q
+  phone_number    = "+64211111111"
+  postal_code     = "98101"
+  state_or_region = "WA"
+}
+`, rName))
+}
@@ -197,6 +197,14 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ACMPCAConn
+	certificateAuthorityARN := d.Get("certificate_authority_arn").(string)
+
+	// Certificates issued by a CA in short-lived certificate mode cannot be revoked, they simply expire.
+	// DescribeCertificateAuthority may be denied for CAs shared from another account, in which case revocation is attempted.
+	if ca, err := FindCertificateAuthorityByARN(conn, certificateAuthorityARN); err == nil && ca != nil && aws.StringValue(ca.UsageMode) == acmpca.CertificateAuthorityUsageModeShortLivedCertificate {
+		log.Printf("[DEBUG] ACM PCA Certificate (%s) was issued by a short-lived certificate CA, not revoking", d.Id())
+		return nil
+	}
 
 	block, _ := pem.Decode([]byte(d.Get("certificate").(string)))
 	if block == nil {
@@ -209,7 +217,7 @@ func resourceCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	input := &acmpca.RevokeCertificateInput{
-		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		CertificateAuthorityArn: aws.String(certificateAuthorityARN),
 		CertificateSerial:       aws.String(fmt.Sprintf("%x", cert.SerialNumber)),
 		RevocationReason:        aws.String(acmpca.RevocationReasonUnspecified),
 	}
@@ -221,6 +229,11 @@ func resourceCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
 		tfawserr.ErrMessageContains(err, acmpca.ErrCodeInvalidRequestException, "Self-signed certificate can not be revoked") {
 		return nil
 	}
+	// The default AWS RAM permissions for shared CAs allow issuance but not revocation.
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") && !arnBelongsToAccount(certificateAuthorityARN, meta.(*conns.AWSClient).AccountID) {
+		log.Printf("[WARN] Unable to revoke ACM PCA Certificate (%s) issued by shared Certificate Authority (%s), removing from state: %s", d.Id(), certificateAuthorityARN, err)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("error revoking ACM PCA Certificate (%s): %w", d.Id(), err)
 	}
@@ -228,6 +241,12 @@ func resourceCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func arnBelongsToAccount(s, accountID string) bool {
+	v, err := arn.Parse(s)
+
+	return err == nil && v.AccountID == accountID
+}
+
 func ValidTemplateARN(v interface{}, k string) (ws []string, errors []error) {
 	wsARN, errorsARN := verify.ValidARN(v, k)
 	ws = append(ws, wsARN...)
@@ -3,6 +3,7 @@ package acmpca
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -273,9 +274,12 @@ func ResourceCertificateAuthority() *schema.Resource {
 										Required: true,
 									},
 									"ocsp_custom_cname": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 253),
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(0, 253),
+											validation.StringDoesNotMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`), "must not include a protocol prefix"),
+										),
 									},
 								},
 							},
@@ -306,6 +310,7 @@ func ResourceCertificateAuthority() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(acmpca.CertificateAuthorityUsageMode_Values(), false),
 			},
 		},
@@ -498,6 +503,14 @@ func resourceCertificateAuthorityUpdate(d *schema.ResourceData, meta interface{}
 
 	if d.HasChange("revocation_configuration") {
 		input.RevocationConfiguration = expandRevocationConfiguration(d.Get("revocation_configuration").([]interface{}))
+
+		// An omitted OCSP custom CNAME leaves the existing value in place, so send an empty value to remove it.
+		if v := input.RevocationConfiguration; v != nil && v.OcspConfiguration != nil && v.OcspConfiguration.OcspCustomCname == nil {
+			if d.HasChange("revocation_configuration.0.ocsp_configuration.0.ocsp_custom_cname") && aws.BoolValue(v.OcspConfiguration.Enabled) {
+				v.OcspConfiguration.OcspCustomCname = aws.String("")
+			}
+		}
+
 		updateCertificateAuthority = true
 	}
 
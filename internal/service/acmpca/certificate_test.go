@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -230,6 +231,37 @@ func TestAccACMPCACertificate_Validity_absolute(t *testing.T) {
 	})
 }
 
+func TestAccACMPCACertificate_crossAccountRAM(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.root"
+
+	csrDomain := acctest.RandomDomainName()
+	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(t, 4096, csrDomain)
+	domain := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, acmpca.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_crossAccountRAM(rName, domain, acctest.TLSPEMEscapeNewlines(csr)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_chain"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn
 
@@ -517,3 +549,98 @@ func TestExpandValidityValue(t *testing.T) {
 		}
 	}
 }
+
+func testAccCertificateConfig_crossAccountRAM(rName, domain, csr string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "root" {
+  provider = "awsalternate"
+
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[2]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "root" {
+  provider = "awsalternate"
+
+  certificate_authority_arn = aws_acmpca_certificate_authority.root.arn
+
+  certificate       = aws_acmpca_certificate.root.certificate
+  certificate_chain = aws_acmpca_certificate.root.certificate_chain
+}
+
+resource "aws_acmpca_certificate" "root" {
+  provider = "awsalternate"
+
+  certificate_authority_arn   = aws_acmpca_certificate_authority.root.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.root.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 2
+  }
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+  permission_arns           = ["arn:${data.aws_partition.current.partition}:ram::aws:permission/AWSRAMBlankEndEntityCertificateAPICSRPassthroughIssuanceCertificateAuthority"]
+}
+
+resource "aws_ram_resource_association" "test" {
+  provider = "awsalternate"
+
+  resource_arn       = aws_acmpca_certificate_authority.root.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.root]
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.current.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_principal_association.test.resource_share_arn
+
+  depends_on = [aws_ram_resource_association.test]
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.root.arn
+  certificate_signing_request = "%[3]s"
+  signing_algorithm           = "SHA256WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/BlankEndEntityCertificate_APICSRPassthrough/V1"
+
+  validity {
+    type  = "DAYS"
+    value = 1
+  }
+
+  depends_on = [aws_ram_resource_share_accepter.test]
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName, domain, csr))
+}
@@ -0,0 +1,53 @@
+package applicationsignals
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ResourceDiscovery opts the account in to Application Signals service discovery.
+// Discovery creates the AWSServiceRoleForCloudWatchApplicationSignals service-linked role,
+// and there is no API to read or reverse the opt-in.
+func ResourceDiscovery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDiscoveryCreate,
+		ReadWithoutTimeout:   resourceDiscoveryRead,
+		DeleteWithoutTimeout: resourceDiscoveryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceDiscoveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	_, err := conn.StartDiscovery(ctx, &applicationsignals.StartDiscoveryInput{})
+
+	if err != nil {
+		return diag.Errorf("starting Application Signals Discovery (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceDiscoveryRead(ctx, d, meta)
+}
+
+func resourceDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDiscoveryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Application Signals Discovery (%s) cannot be stopped, removing from state only", d.Id())
+
+	return nil
+}
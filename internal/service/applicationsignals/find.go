@@ -0,0 +1,38 @@
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*types.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
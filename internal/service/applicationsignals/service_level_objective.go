@@ -0,0 +1,1105 @@
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceLevelObjective() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceLevelObjectiveCreate,
+		ReadWithoutTimeout:   resourceServiceLevelObjectiveRead,
+		UpdateWithoutTimeout: resourceServiceLevelObjectiveUpdate,
+		DeleteWithoutTimeout: resourceServiceLevelObjectiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// An SLO cannot be switched between period-based and request-based evaluation.
+			customdiff.ForceNewIfChange("sli", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
+			customdiff.ForceNewIfChange("request_based_sli", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"burn_rate_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"look_back_window_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"evaluation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"goal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attainment_goal": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"interval": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"calendar_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.DurationUnit](),
+												},
+												"start_time": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+											},
+										},
+									},
+									"rolling_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.DurationUnit](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"warning_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][-._0-9A-Za-z ]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, hyphens, periods, underscores and spaces"),
+				),
+			},
+			"request_based_sli": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"request_based_sli", "sli"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ServiceLevelIndicatorComparisonOperator](),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Computed: true,
+						},
+						"request_based_sli_metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.ServiceLevelIndicatorMetricType](),
+									},
+									"monitored_request_count_metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bad_count_metric":  metricDataQuerySchema(),
+												"good_count_metric": metricDataQuerySchema(),
+											},
+										},
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"total_request_count_metric": metricDataQuerySchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ServiceLevelIndicatorComparisonOperator](),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"sli_metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_data_query": metricDataQuerySchema(),
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.ServiceLevelIndicatorMetricType](),
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(60, 900),
+									},
+									"statistic": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(1, 20),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func metricDataQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"expression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"metric_stat": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metric": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimension": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 30,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+													"value": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 1024),
+													},
+												},
+											},
+										},
+										"metric_name": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"namespace": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+							"period": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"stat": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"unit": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.StandardUnit](),
+							},
+						},
+					},
+				},
+				"period": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"return_data": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceLevelObjectiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("burn_rate_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.BurnRateConfigurations = expandBurnRateConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("goal"); ok && len(v.([]interface{})) > 0 {
+		input.Goal = expandGoal(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("request_based_sli"); ok && len(v.([]interface{})) > 0 {
+		input.RequestBasedSliConfig = expandRequestBasedServiceLevelIndicatorConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sli"); ok && len(v.([]interface{})) > 0 {
+		input.SliConfig = expandServiceLevelIndicatorConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Application Signals Service Level Objective (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Slo.Arn))
+
+	return resourceServiceLevelObjectiveRead(ctx, d, meta)
+}
+
+func resourceServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	slo, err := FindServiceLevelObjectiveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Signals Service Level Objective (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.ToString(slo.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("burn_rate_configuration", flattenBurnRateConfigurations(slo.BurnRateConfigurations)); err != nil {
+		return diag.Errorf("setting burn_rate_configuration: %s", err)
+	}
+	d.Set("created_time", aws.ToTime(slo.CreatedTime).Format(time.RFC3339))
+	d.Set("description", slo.Description)
+	d.Set("evaluation_type", slo.EvaluationType)
+	if err := d.Set("goal", flattenGoal(slo.Goal)); err != nil {
+		return diag.Errorf("setting goal: %s", err)
+	}
+	d.Set("last_updated_time", aws.ToTime(slo.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", slo.Name)
+	if err := d.Set("request_based_sli", flattenRequestBasedServiceLevelIndicator(slo.RequestBasedSli)); err != nil {
+		return diag.Errorf("setting request_based_sli: %s", err)
+	}
+	if err := d.Set("sli", flattenServiceLevelIndicator(slo.Sli)); err != nil {
+		return diag.Errorf("setting sli: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceLevelObjectiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("burn_rate_configuration") {
+			// An empty list removes all burn rate configurations.
+			input.BurnRateConfigurations = expandBurnRateConfigurations(d.Get("burn_rate_configuration").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("goal") {
+			input.Goal = expandGoal(d.Get("goal").([]interface{}))
+		}
+
+		if d.HasChange("request_based_sli") {
+			input.RequestBasedSliConfig = expandRequestBasedServiceLevelIndicatorConfig(d.Get("request_based_sli").([]interface{}))
+		}
+
+		if d.HasChange("sli") {
+			input.SliConfig = expandServiceLevelIndicatorConfig(d.Get("sli").([]interface{}))
+		}
+
+		_, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Application Signals Service Level Objective (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Application Signals Service Level Objective (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceLevelObjectiveRead(ctx, d, meta)
+}
+
+func resourceServiceLevelObjectiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient
+
+	log.Printf("[DEBUG] Deleting Application Signals Service Level Objective: %s", d.Id())
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBurnRateConfigurations(tfList []interface{}) []types.BurnRateConfiguration {
+	apiObjects := make([]types.BurnRateConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.BurnRateConfiguration{}
+
+		if v, ok := tfMap["look_back_window_minutes"].(int); ok && v != 0 {
+			apiObject.LookBackWindowMinutes = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGoal(tfList []interface{}) *types.Goal {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.Goal{}
+
+	if v, ok := tfMap["attainment_goal"].(float64); ok && v != 0 {
+		apiObject.AttainmentGoal = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["interval"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Interval = expandInterval(v)
+	}
+
+	if v, ok := tfMap["warning_threshold"].(float64); ok && v != 0 {
+		apiObject.WarningThreshold = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandInterval(tfList []interface{}) types.Interval {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["calendar_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject := types.CalendarInterval{
+			Duration:     aws.Int32(int32(tfMap["duration"].(int))),
+			DurationUnit: types.DurationUnit(tfMap["duration_unit"].(string)),
+		}
+
+		if v, ok := tfMap["start_time"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartTime = aws.Time(t)
+		}
+
+		return &types.IntervalMemberCalendarInterval{Value: apiObject}
+	}
+
+	if v, ok := tfMap["rolling_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject := types.RollingInterval{
+			Duration:     aws.Int32(int32(tfMap["duration"].(int))),
+			DurationUnit: types.DurationUnit(tfMap["duration_unit"].(string)),
+		}
+
+		return &types.IntervalMemberRollingInterval{Value: apiObject}
+	}
+
+	return nil
+}
+
+func expandServiceLevelIndicatorConfig(tfList []interface{}) *types.ServiceLevelIndicatorConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.ServiceLevelIndicatorConfig{}
+
+	if v, ok := tfMap["comparison_operator"].(string); ok && v != "" {
+		apiObject.ComparisonOperator = types.ServiceLevelIndicatorComparisonOperator(v)
+	}
+
+	if v, ok := tfMap["metric_threshold"].(float64); ok {
+		apiObject.MetricThreshold = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["sli_metric"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SliMetricConfig = expandServiceLevelIndicatorMetricConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceLevelIndicatorMetricConfig(tfList []interface{}) *types.ServiceLevelIndicatorMetricConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.ServiceLevelIndicatorMetricConfig{}
+
+	if v, ok := tfMap["key_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.KeyAttributes = flex.ExpandStringValueMap(v)
+	}
+
+	// Metric data queries are returned for service operation SLIs too, so only send them for SLIs on CloudWatch metrics.
+	if v, ok := tfMap["metric_data_query"].([]interface{}); ok && len(v) > 0 && len(apiObject.KeyAttributes) == 0 {
+		apiObject.MetricDataQueries = expandMetricDataQueries(v)
+	}
+
+	if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+		apiObject.MetricType = types.ServiceLevelIndicatorMetricType(v)
+	}
+
+	if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+		apiObject.OperationName = aws.String(v)
+	}
+
+	if v, ok := tfMap["period_seconds"].(int); ok && v != 0 && len(apiObject.KeyAttributes) > 0 {
+		apiObject.PeriodSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["statistic"].(string); ok && v != "" && len(apiObject.KeyAttributes) > 0 {
+		apiObject.Statistic = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRequestBasedServiceLevelIndicatorConfig(tfList []interface{}) *types.RequestBasedServiceLevelIndicatorConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.RequestBasedServiceLevelIndicatorConfig{}
+
+	if v, ok := tfMap["comparison_operator"].(string); ok && v != "" {
+		apiObject.ComparisonOperator = types.ServiceLevelIndicatorComparisonOperator(v)
+	}
+
+	if v, ok := tfMap["metric_threshold"].(float64); ok && v != 0 {
+		apiObject.MetricThreshold = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["request_based_sli_metric"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RequestBasedSliMetricConfig = expandRequestBasedServiceLevelIndicatorMetricConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandRequestBasedServiceLevelIndicatorMetricConfig(tfList []interface{}) *types.RequestBasedServiceLevelIndicatorMetricConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.RequestBasedServiceLevelIndicatorMetricConfig{}
+
+	if v, ok := tfMap["key_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.KeyAttributes = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+		apiObject.MetricType = types.ServiceLevelIndicatorMetricType(v)
+	}
+
+	if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+		apiObject.OperationName = aws.String(v)
+	}
+
+	// As for period-based SLIs, metric data queries are only sent for SLIs on CloudWatch metrics.
+	if len(apiObject.KeyAttributes) == 0 {
+		if v, ok := tfMap["monitored_request_count_metric"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MonitoredRequestCountMetric = expandMonitoredRequestCountMetricDataQueries(v)
+		}
+
+		if v, ok := tfMap["total_request_count_metric"].([]interface{}); ok && len(v) > 0 {
+			apiObject.TotalRequestCountMetric = expandMetricDataQueries(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandMonitoredRequestCountMetricDataQueries(tfList []interface{}) types.MonitoredRequestCountMetricDataQueries {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["bad_count_metric"].([]interface{}); ok && len(v) > 0 {
+		return &types.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric{Value: expandMetricDataQueries(v)}
+	}
+
+	if v, ok := tfMap["good_count_metric"].([]interface{}); ok && len(v) > 0 {
+		return &types.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric{Value: expandMetricDataQueries(v)}
+	}
+
+	return nil
+}
+
+func expandMetricDataQueries(tfList []interface{}) []types.MetricDataQuery {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MetricDataQuery{
+			ReturnData: aws.Bool(tfMap["return_data"].(bool)),
+		}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MetricStat = expandMetricStat(v)
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			apiObject.Period = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricStat(tfList []interface{}) *types.MetricStat {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.MetricStat{}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Metric = expandMetric(v)
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject.Period = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject.Stat = aws.String(v)
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = types.StandardUnit(v)
+	}
+
+	return apiObject
+}
+
+func expandMetric(tfList []interface{}) *types.Metric {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.Metric{}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandDimensions(v)
+	}
+
+	if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+		apiObject.MetricName = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensions(tfList []interface{}) []types.Dimension {
+	var apiObjects []types.Dimension
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.Dimension{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenBurnRateConfigurations(apiObjects []types.BurnRateConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"look_back_window_minutes": aws.ToInt32(apiObject.LookBackWindowMinutes),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGoal(apiObject *types.Goal) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attainment_goal":   aws.ToFloat64(apiObject.AttainmentGoal),
+		"interval":          flattenInterval(apiObject.Interval),
+		"warning_threshold": aws.ToFloat64(apiObject.WarningThreshold),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInterval(apiObject types.Interval) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.IntervalMemberCalendarInterval:
+		tfMap["calendar_interval"] = []interface{}{map[string]interface{}{
+			"duration":      aws.ToInt32(v.Value.Duration),
+			"duration_unit": string(v.Value.DurationUnit),
+			"start_time":    aws.ToTime(v.Value.StartTime).Format(time.RFC3339),
+		}}
+	case *types.IntervalMemberRollingInterval:
+		tfMap["rolling_interval"] = []interface{}{map[string]interface{}{
+			"duration":      aws.ToInt32(v.Value.Duration),
+			"duration_unit": string(v.Value.DurationUnit),
+		}}
+	default:
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceLevelIndicator(apiObject *types.ServiceLevelIndicator) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator": string(apiObject.ComparisonOperator),
+		"metric_threshold":    aws.ToFloat64(apiObject.MetricThreshold),
+		"sli_metric":          flattenServiceLevelIndicatorMetric(apiObject.SliMetric),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceLevelIndicatorMetric(apiObject *types.ServiceLevelIndicatorMetric) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_attributes":    apiObject.KeyAttributes,
+		"metric_data_query": flattenMetricDataQueries(apiObject.MetricDataQueries),
+		"metric_type":       string(apiObject.MetricType),
+		"operation_name":    aws.ToString(apiObject.OperationName),
+	}
+
+	// The statistic and period of a service operation SLI are only returned as part of the generated metric data query.
+	if len(apiObject.KeyAttributes) > 0 && len(apiObject.MetricDataQueries) == 1 {
+		if v := apiObject.MetricDataQueries[0].MetricStat; v != nil {
+			tfMap["period_seconds"] = aws.ToInt32(v.Period)
+			tfMap["statistic"] = aws.ToString(v.Stat)
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRequestBasedServiceLevelIndicator(apiObject *types.RequestBasedServiceLevelIndicator) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator":      string(apiObject.ComparisonOperator),
+		"metric_threshold":         aws.ToFloat64(apiObject.MetricThreshold),
+		"request_based_sli_metric": flattenRequestBasedServiceLevelIndicatorMetric(apiObject.RequestBasedSliMetric),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRequestBasedServiceLevelIndicatorMetric(apiObject *types.RequestBasedServiceLevelIndicatorMetric) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_attributes":                 apiObject.KeyAttributes,
+		"metric_type":                    string(apiObject.MetricType),
+		"monitored_request_count_metric": flattenMonitoredRequestCountMetricDataQueries(apiObject.MonitoredRequestCountMetric),
+		"operation_name":                 aws.ToString(apiObject.OperationName),
+		"total_request_count_metric":     flattenMetricDataQueries(apiObject.TotalRequestCountMetric),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMonitoredRequestCountMetricDataQueries(apiObject types.MonitoredRequestCountMetricDataQueries) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		tfMap["bad_count_metric"] = flattenMetricDataQueries(v.Value)
+	case *types.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		tfMap["good_count_metric"] = flattenMetricDataQueries(v.Value)
+	default:
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetricDataQueries(apiObjects []types.MetricDataQuery) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":  aws.ToString(apiObject.AccountId),
+			"expression":  aws.ToString(apiObject.Expression),
+			"id":          aws.ToString(apiObject.Id),
+			"label":       aws.ToString(apiObject.Label),
+			"metric_stat": flattenMetricStat(apiObject.MetricStat),
+			"period":      aws.ToInt32(apiObject.Period),
+			"return_data": true,
+		}
+
+		if v := apiObject.ReturnData; v != nil {
+			tfMap["return_data"] = aws.ToBool(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMetricStat(apiObject *types.MetricStat) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric": flattenMetric(apiObject.Metric),
+		"period": aws.ToInt32(apiObject.Period),
+		"stat":   aws.ToString(apiObject.Stat),
+		"unit":   string(apiObject.Unit),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetric(apiObject *types.Metric) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dimension":   flattenDimensions(apiObject.Dimensions),
+		"metric_name": aws.ToString(apiObject.MetricName),
+		"namespace":   aws.ToString(apiObject.Namespace),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDimensions(apiObjects []types.Dimension) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.ToString(apiObject.Name),
+			"value": aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,403 @@
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "application-signals", regexp.MustCompile(`slo/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "PeriodBased"),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "0.02"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.metric_name", "ReadLatency"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.1.look_back_window_minutes", "360"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "50"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration_unit", "MONTH"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "0.05"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBased(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBased(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "RequestBased"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.good_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.total_request_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_applicationsignals_service_level_objective" {
+			continue
+		}
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceLevelObjectiveExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Application Signals Service Level Objective ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient
+
+	_, err := conn.ListServiceLevelObjectives(context.Background(), &applicationsignals.ListServiceLevelObjectivesInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_sli(rName string, threshold float64) string {
+	return fmt.Sprintf(`
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = %[2]g
+
+    sli_metric {
+      metric_data_query {
+        id = "m1"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            namespace   = "AWS/RDS"
+            metric_name = "ReadLatency"
+
+            dimension {
+              name  = "DBInstanceIdentifier"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+`, rName, threshold)
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName, 0.02))
+}
+
+func testAccServiceLevelObjectiveConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = "updated"
+%[2]s
+  goal {
+    attainment_goal   = 99.9
+    warning_threshold = 50
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2024-01-01T00:00:00Z"
+      }
+    }
+  }
+
+  burn_rate_configuration {
+    look_back_window_minutes = 60
+  }
+
+  burn_rate_configuration {
+    look_back_window_minutes = 360
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName, 0.05))
+}
+
+func testAccServiceLevelObjectiveConfig_requestBased(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  request_based_sli {
+    comparison_operator = "GreaterThan"
+    metric_threshold    = 99
+
+    request_based_sli_metric {
+      total_request_count_metric {
+        id = "total"
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            namespace   = "AWS/ApplicationELB"
+            metric_name = "RequestCount"
+
+            dimension {
+              name  = "LoadBalancer"
+              value = "app/%[1]s/0123456789abcdef"
+            }
+          }
+        }
+      }
+
+      monitored_request_count_metric {
+        good_count_metric {
+          id = "good"
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              namespace   = "AWS/ApplicationELB"
+              metric_name = "HTTPCode_Target_2XX_Count"
+
+              dimension {
+                name  = "LoadBalancer"
+                value = "app/%[1]s/0123456789abcdef"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName, 0.02), tagKey1, tagValue1)
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName, 0.02), tagKey1, tagValue1, tagKey2, tagValue2)
+}
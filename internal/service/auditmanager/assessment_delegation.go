@@ -0,0 +1,237 @@
+package auditmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceAssessmentDelegation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentDelegationCreate,
+		ReadWithoutTimeout:   resourceAssessmentDelegationRead,
+		DeleteWithoutTimeout: resourceAssessmentDelegationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350),
+			},
+			"control_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delegation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.RoleType](),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameAssessmentDelegation = "Assessment Delegation"
+)
+
+func resourceAssessmentDelegationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	assessmentID := d.Get("assessment_id").(string)
+	delegation := types.CreateDelegationRequest{
+		ControlSetId: aws.String(d.Get("control_set_id").(string)),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		RoleType:     types.RoleType(d.Get("role_type").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		delegation.Comment = aws.String(v.(string))
+	}
+
+	in := &auditmanager.BatchCreateDelegationByAssessmentInput{
+		AssessmentId:             aws.String(assessmentID),
+		CreateDelegationRequests: []types.CreateDelegationRequest{delegation},
+	}
+
+	out, err := conn.BatchCreateDelegationByAssessment(ctx, in)
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, assessmentID, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, assessmentID, errors.New("empty output"))
+	}
+
+	if len(out.Errors) > 0 {
+		err := out.Errors[0]
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, assessmentID, fmt.Errorf("%s: %s", aws.ToString(err.ErrorCode), aws.ToString(err.ErrorMessage)))
+	}
+
+	if len(out.Delegations) == 0 {
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentDelegation, assessmentID, errors.New("empty output"))
+	}
+
+	d.SetId(AssessmentDelegationCreateResourceID(assessmentID, aws.ToString(out.Delegations[0].Id)))
+
+	return resourceAssessmentDelegationRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelegationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionReading, ResNameAssessmentDelegation, d.Id(), err)
+	}
+
+	out, err := FindAssessmentDelegationByTwoPartKey(ctx, conn, assessmentID, delegationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AuditManager AssessmentDelegation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionReading, ResNameAssessmentDelegation, d.Id(), err)
+	}
+
+	d.Set("assessment_id", out.AssessmentId)
+	d.Set("comment", out.Comment)
+	d.Set("control_set_id", out.ControlSetId)
+	d.Set("delegation_id", out.Id)
+	d.Set("role_arn", out.RoleArn)
+	d.Set("role_type", out.RoleType)
+	d.Set("status", out.Status)
+
+	return nil
+}
+
+func resourceAssessmentDelegationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting AuditManager AssessmentDelegation %s", d.Id())
+
+	out, err := conn.BatchDeleteDelegationByAssessment(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+		AssessmentId:  aws.String(assessmentID),
+		DelegationIds: []string{delegationID},
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, d.Id(), err)
+	}
+
+	if out != nil && len(out.Errors) > 0 {
+		err := out.Errors[0]
+		return create.DiagError(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentDelegation, d.Id(), fmt.Errorf("%s: %s", aws.ToString(err.ErrorCode), aws.ToString(err.ErrorMessage)))
+	}
+
+	return nil
+}
+
+const assessmentDelegationResourceIDSeparator = ","
+
+func AssessmentDelegationCreateResourceID(assessmentID, delegationID string) string {
+	parts := []string{assessmentID, delegationID}
+	id := strings.Join(parts, assessmentDelegationResourceIDSeparator)
+
+	return id
+}
+
+func AssessmentDelegationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assessmentDelegationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ASSESSMENT_ID%[2]sDELEGATION_ID", id, assessmentDelegationResourceIDSeparator)
+}
+
+// FindAssessmentDelegationByTwoPartKey finds a delegation in one of the assessment's control sets.
+func FindAssessmentDelegationByTwoPartKey(ctx context.Context, conn *auditmanager.Client, assessmentID, delegationID string) (*types.Delegation, error) {
+	in := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(assessmentID),
+	}
+	out, err := conn.GetAssessment(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.Assessment == nil || out.Assessment.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, controlSet := range out.Assessment.Framework.ControlSets {
+		for _, delegation := range controlSet.Delegations {
+			if aws.ToString(delegation.Id) == delegationID {
+				return &delegation, nil
+			}
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: in,
+	}
+}
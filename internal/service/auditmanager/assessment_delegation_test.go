@@ -0,0 +1,149 @@
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerAssessmentDelegation_basic(t *testing.T) {
+	assessmentID := testAccAssessmentFromEnv(t)
+	controlSetID := testAccControlSetFromEnv(t)
+
+	var delegation types.Delegation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(assessmentID, controlSetID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &delegation),
+					resource.TestCheckResourceAttr(resourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Collect RDS backup evidence"),
+					resource.TestCheckResourceAttr(resourceName, "control_set_id", controlSetID),
+					resource.TestCheckResourceAttrSet(resourceName, "delegation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "role_type", string(types.RoleTypeResourceOwner)),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentDelegationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_delegation" {
+			continue
+		}
+
+		assessmentID, delegationID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfauditmanager.FindAssessmentDelegationByTwoPartKey(ctx, conn, assessmentID, delegationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.AuditManager, create.ErrActionCheckingDestroyed, tfauditmanager.ResNameAssessmentDelegation, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAssessmentDelegationExists(name string, delegation *types.Delegation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, name, errors.New("not set"))
+		}
+
+		assessmentID, delegationID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+
+		resp, err := tfauditmanager.FindAssessmentDelegationByTwoPartKey(context.Background(), conn, assessmentID, delegationID)
+
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentDelegation, rs.Primary.ID, err)
+		}
+
+		*delegation = *resp
+
+		return nil
+	}
+}
+
+func testAccAssessmentDelegationConfig_basic(assessmentID, controlSetID, rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[3]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_auditmanager_assessment_delegation" "test" {
+  assessment_id  = %[1]q
+  comment        = "Collect RDS backup evidence"
+  control_set_id = %[2]q
+  role_arn       = aws_iam_role.test.arn
+  role_type      = "RESOURCE_OWNER"
+}
+`, assessmentID, controlSetID, rName)
+}
@@ -0,0 +1,203 @@
+package auditmanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceAssessmentReport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentReportCreate,
+		ReadWithoutTimeout:   resourceAssessmentReportRead,
+		DeleteWithoutTimeout: resourceAssessmentReportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 300),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_\.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameAssessmentReport = "Assessment Report"
+)
+
+func resourceAssessmentReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	name := d.Get("name").(string)
+	in := &auditmanager.CreateAssessmentReportInput{
+		AssessmentId: aws.String(d.Get("assessment_id").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateAssessmentReport(ctx, in)
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentReport, name, err)
+	}
+
+	if out == nil || out.AssessmentReport == nil {
+		return create.DiagError(names.AuditManager, create.ErrActionCreating, ResNameAssessmentReport, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.AssessmentReport.Id))
+
+	// Generating the report collects the assessment's evidence, which can take some time.
+	if _, err := waitAssessmentReportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionWaitingForCreation, ResNameAssessmentReport, d.Id(), err)
+	}
+
+	return resourceAssessmentReportRead(ctx, d, meta)
+}
+
+func resourceAssessmentReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	out, err := FindAssessmentReportByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AuditManager AssessmentReport (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionReading, ResNameAssessmentReport, d.Id(), err)
+	}
+
+	d.Set("assessment_id", out.AssessmentId)
+	d.Set("author", out.Author)
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+	d.Set("status", out.Status)
+
+	return nil
+}
+
+func resourceAssessmentReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	log.Printf("[INFO] Deleting AuditManager AssessmentReport %s", d.Id())
+
+	_, err := conn.DeleteAssessmentReport(ctx, &auditmanager.DeleteAssessmentReportInput{
+		AssessmentId:       aws.String(d.Get("assessment_id").(string)),
+		AssessmentReportId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.AuditManager, create.ErrActionDeleting, ResNameAssessmentReport, d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitAssessmentReportCompleted(ctx context.Context, conn *auditmanager.Client, id string, timeout time.Duration) (*types.AssessmentReportMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.AssessmentReportStatusInProgress),
+		Target:  enum.Slice(types.AssessmentReportStatusComplete),
+		Refresh: statusAssessmentReport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.AssessmentReportMetadata); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusAssessmentReport(ctx context.Context, conn *auditmanager.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindAssessmentReportByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func FindAssessmentReportByID(ctx context.Context, conn *auditmanager.Client, id string) (*types.AssessmentReportMetadata, error) {
+	in := &auditmanager.ListAssessmentReportsInput{}
+	pages := auditmanager.NewListAssessmentReportsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, report := range page.AssessmentReports {
+			if aws.ToString(report.Id) == id {
+				return &report, nil
+			}
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: in,
+	}
+}
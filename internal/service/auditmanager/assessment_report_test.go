@@ -0,0 +1,115 @@
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerAssessmentReport_basic(t *testing.T) {
+	assessmentID := testAccAssessmentFromEnv(t)
+
+	var report types.AssessmentReportMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.AuditManagerEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentReportConfig_basic(assessmentID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentReportExists(resourceName, &report),
+					resource.TestCheckResourceAttr(resourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttrSet(resourceName, "author"),
+					resource.TestCheckResourceAttr(resourceName, "description", "RDS encryption and backup evidence"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", string(types.AssessmentReportStatusComplete)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentReportDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_report" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindAssessmentReportByID(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return create.Error(names.AuditManager, create.ErrActionCheckingDestroyed, tfauditmanager.ResNameAssessmentReport, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAssessmentReportExists(name string, report *types.AssessmentReportMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerClient
+
+		resp, err := tfauditmanager.FindAssessmentReportByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameAssessmentReport, rs.Primary.ID, err)
+		}
+
+		*report = *resp
+
+		return nil
+	}
+}
+
+func testAccAssessmentReportConfig_basic(assessmentID, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_assessment_report" "test" {
+  assessment_id = %[1]q
+  name          = %[2]q
+  description   = "RDS encryption and backup evidence"
+}
+`, assessmentID, rName)
+}
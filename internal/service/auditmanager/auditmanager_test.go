@@ -0,0 +1,23 @@
+package auditmanager_test
+
+import (
+	"os"
+	"testing"
+)
+
+// Audit Manager assessments are not yet managed by the provider, so an existing assessment is required.
+func testAccAssessmentFromEnv(t *testing.T) string {
+	assessmentID := os.Getenv("AUDITMANAGER_ASSESSMENT_ID")
+	if assessmentID == "" {
+		t.Skip("Environment variable AUDITMANAGER_ASSESSMENT_ID is not set")
+	}
+	return assessmentID
+}
+
+func testAccControlSetFromEnv(t *testing.T) string {
+	controlSetID := os.Getenv("AUDITMANAGER_CONTROL_SET_ID")
+	if controlSetID == "" {
+		t.Skip("Environment variable AUDITMANAGER_CONTROL_SET_ID is not set")
+	}
+	return controlSetID
+}
@@ -0,0 +1,191 @@
+package auditmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceEvidenceFolders() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEvidenceFoldersRead,
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"control_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"control_set_id"},
+			},
+			"control_set_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"control_id"},
+			},
+			"evidence_folders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assessment_report_selection_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"author": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evidence_resources_included_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_evidence": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameEvidenceFolders = "Evidence Folders Data Source"
+)
+
+func dataSourceEvidenceFoldersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerClient
+
+	assessmentID := d.Get("assessment_id").(string)
+
+	var folders []types.AssessmentEvidenceFolder
+	var err error
+	if v, ok := d.GetOk("control_id"); ok {
+		folders, err = findEvidenceFoldersByAssessmentControl(ctx, conn, assessmentID, d.Get("control_set_id").(string), v.(string))
+	} else {
+		folders, err = findEvidenceFoldersByAssessment(ctx, conn, assessmentID)
+	}
+
+	if err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionReading, DSNameEvidenceFolders, assessmentID, err)
+	}
+
+	d.SetId(assessmentID)
+
+	if err := d.Set("evidence_folders", flattenAssessmentEvidenceFolders(folders)); err != nil {
+		return create.DiagError(names.AuditManager, create.ErrActionSetting, DSNameEvidenceFolders, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findEvidenceFoldersByAssessment(ctx context.Context, conn *auditmanager.Client, assessmentID string) ([]types.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentInput{
+		AssessmentId: aws.String(assessmentID),
+	}
+
+	var out []types.AssessmentEvidenceFolder
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+func findEvidenceFoldersByAssessmentControl(ctx context.Context, conn *auditmanager.Client, assessmentID, controlSetID, controlID string) ([]types.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentControlInput{
+		AssessmentId: aws.String(assessmentID),
+		ControlId:    aws.String(controlID),
+		ControlSetId: aws.String(controlSetID),
+	}
+
+	var out []types.AssessmentEvidenceFolder
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentControlPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+func flattenAssessmentEvidenceFolders(apiObjects []types.AssessmentEvidenceFolder) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		m := map[string]interface{}{
+			"assessment_report_selection_count": int(apiObject.AssessmentReportSelectionCount),
+			"author":                            aws.ToString(apiObject.Author),
+			"control_id":                        aws.ToString(apiObject.ControlId),
+			"control_name":                      aws.ToString(apiObject.ControlName),
+			"control_set_id":                    aws.ToString(apiObject.ControlSetId),
+			"data_source":                       aws.ToString(apiObject.DataSource),
+			"evidence_resources_included_count": int(apiObject.EvidenceResourcesIncludedCount),
+			"id":                                aws.ToString(apiObject.Id),
+			"name":                              aws.ToString(apiObject.Name),
+			"total_evidence":                    int(apiObject.TotalEvidence),
+		}
+
+		if v := apiObject.Date; v != nil {
+			m["date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, m)
+	}
+
+	return tfList
+}
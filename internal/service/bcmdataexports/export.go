@@ -0,0 +1,602 @@
+package bcmdataexports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExportCreate,
+		ReadWithoutTimeout:   resourceExportRead,
+		UpdateWithoutTimeout: resourceExportUpdate,
+		DeleteWithoutTimeout: resourceExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceExportCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_query": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query_statement": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validQueryStatement,
+									},
+									"table_configurations": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"table_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(tableNames(), false),
+												},
+												"table_properties": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"destination_configurations": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+												"s3_output_configurations": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"compression": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.CompressionOption](),
+															},
+															"format": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.FormatOption](),
+															},
+															"output_type": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.S3OutputType](),
+															},
+															"overwrite": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.OverwriteOption](),
+															},
+														},
+													},
+												},
+												"s3_prefix": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+												"s3_region": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"export_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z\-_]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+							),
+						},
+						"refresh_cadence": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"frequency": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.FrequencyOption](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BCMDataExportsClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	export := expandExport(d.Get("export").([]interface{}))
+	name := aws.ToString(export.Name)
+	input := &bcmdataexports.CreateExportInput{
+		Export: export,
+	}
+
+	if len(tags) > 0 {
+		input.ResourceTags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateExport(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating BCM Data Exports Export (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ExportArn))
+
+	return resourceExportRead(ctx, d, meta)
+}
+
+func resourceExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BCMDataExportsClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	export, err := FindExportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] BCM Data Exports Export (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading BCM Data Exports Export (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", export.ExportArn)
+	if err := d.Set("export", flattenExport(export)); err != nil {
+		return diag.Errorf("setting export: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for BCM Data Exports Export (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceExportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BCMDataExportsClient
+
+	if d.HasChange("export") {
+		input := &bcmdataexports.UpdateExportInput{
+			Export:    expandExport(d.Get("export").([]interface{})),
+			ExportArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateExport(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating BCM Data Exports Export (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating BCM Data Exports Export (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceExportRead(ctx, d, meta)
+}
+
+func resourceExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BCMDataExportsClient
+
+	log.Printf("[DEBUG] Deleting BCM Data Exports Export: %s", d.Id())
+	_, err := conn.DeleteExport(ctx, &bcmdataexports.DeleteExportInput{
+		ExportArn: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting BCM Data Exports Export (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceExportCustomizeDiff ensures that table configurations only reference the table selected by the query statement.
+func resourceExportCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("export.0.data_query.0.query_statement") {
+		return nil
+	}
+
+	table, ok := queryStatementTableName(diff.Get("export.0.data_query.0.query_statement").(string))
+
+	if !ok {
+		return nil
+	}
+
+	v, ok := diff.GetOk("export.0.data_query.0.table_configurations")
+
+	if !ok {
+		return nil
+	}
+
+	for _, tfMapRaw := range v.(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["table_name"].(string); ok && v != "" && v != table {
+			return fmt.Errorf("table_configurations table_name (%s) does not match the table selected by query_statement (%s)", v, table)
+		}
+	}
+
+	return nil
+}
+
+func expandExport(tfList []interface{}) *types.Export {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.Export{}
+
+	if v, ok := tfMap["data_query"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DataQuery = expandDataQuery(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_configurations"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DestinationConfigurations = expandDestinationConfigurations(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["refresh_cadence"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RefreshCadence = expandRefreshCadence(v)
+	}
+
+	return apiObject
+}
+
+func expandDataQuery(tfList []interface{}) *types.DataQuery {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.DataQuery{}
+
+	if v, ok := tfMap["query_statement"].(string); ok && v != "" {
+		apiObject.QueryStatement = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_configurations"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TableConfigurations = expandTableConfigurations(v.List())
+	}
+
+	return apiObject
+}
+
+func expandTableConfigurations(tfList []interface{}) map[string]map[string]string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string]map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		properties := make(map[string]string)
+
+		if v, ok := tfMap["table_properties"].(map[string]interface{}); ok {
+			for k, v := range v {
+				properties[k] = v.(string)
+			}
+		}
+
+		apiObject[tfMap["table_name"].(string)] = properties
+	}
+
+	return apiObject
+}
+
+func expandDestinationConfigurations(tfList []interface{}) *types.DestinationConfigurations {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.DestinationConfigurations{}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3Destination = expandS3Destination(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Destination(tfList []interface{}) *types.S3Destination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.S3Destination{}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_output_configurations"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3OutputConfigurations = expandS3OutputConfigurations(v)
+	}
+
+	if v, ok := tfMap["s3_prefix"].(string); ok {
+		apiObject.S3Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_region"].(string); ok && v != "" {
+		apiObject.S3Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3OutputConfigurations(tfList []interface{}) *types.S3OutputConfigurations {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.S3OutputConfigurations{}
+
+	if v, ok := tfMap["compression"].(string); ok && v != "" {
+		apiObject.Compression = types.CompressionOption(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.FormatOption(v)
+	}
+
+	if v, ok := tfMap["output_type"].(string); ok && v != "" {
+		apiObject.OutputType = types.S3OutputType(v)
+	}
+
+	if v, ok := tfMap["overwrite"].(string); ok && v != "" {
+		apiObject.Overwrite = types.OverwriteOption(v)
+	}
+
+	return apiObject
+}
+
+func expandRefreshCadence(tfList []interface{}) *types.RefreshCadence {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.RefreshCadence{}
+
+	if v, ok := tfMap["frequency"].(string); ok && v != "" {
+		apiObject.Frequency = types.FrequencyOption(v)
+	}
+
+	return apiObject
+}
+
+func flattenExport(apiObject *types.Export) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"data_query":                 flattenDataQuery(apiObject.DataQuery),
+		"description":                aws.ToString(apiObject.Description),
+		"destination_configurations": flattenDestinationConfigurations(apiObject.DestinationConfigurations),
+		"export_arn":                 aws.ToString(apiObject.ExportArn),
+		"name":                       aws.ToString(apiObject.Name),
+		"refresh_cadence":            flattenRefreshCadence(apiObject.RefreshCadence),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDataQuery(apiObject *types.DataQuery) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"query_statement":      aws.ToString(apiObject.QueryStatement),
+		"table_configurations": flattenTableConfigurations(apiObject.TableConfigurations),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTableConfigurations(apiObject map[string]map[string]string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for table, properties := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"table_name":       table,
+			"table_properties": properties,
+		})
+	}
+
+	return tfList
+}
+
+func flattenDestinationConfigurations(apiObject *types.DestinationConfigurations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_destination": flattenS3Destination(apiObject.S3Destination),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Destination(apiObject *types.S3Destination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket":                aws.ToString(apiObject.S3Bucket),
+		"s3_output_configurations": flattenS3OutputConfigurations(apiObject.S3OutputConfigurations),
+		"s3_prefix":                aws.ToString(apiObject.S3Prefix),
+		"s3_region":                aws.ToString(apiObject.S3Region),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3OutputConfigurations(apiObject *types.S3OutputConfigurations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"compression": string(apiObject.Compression),
+		"format":      string(apiObject.Format),
+		"output_type": string(apiObject.OutputType),
+		"overwrite":   string(apiObject.Overwrite),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRefreshCadence(apiObject *types.RefreshCadence) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"frequency": string(apiObject.Frequency),
+	}
+
+	return []interface{}{tfMap}
+}
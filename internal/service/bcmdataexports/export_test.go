@@ -0,0 +1,375 @@
+package bcmdataexports_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbcmdataexports "github.com/hashicorp/terraform-provider-aws/internal/service/bcmdataexports"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBCMDataExportsExport_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bcmdataexports_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "bcm-data-exports", regexp.MustCompile(`export/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "export.0.data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export.0.destination_configurations.0.s3_destination.0.s3_output_configurations.0.format", "TEXT_OR_CSV"),
+					resource.TestCheckResourceAttr(resourceName, "export.0.refresh_cadence.0.frequency", "SYNCHRONOUS"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBCMDataExportsExport_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bcmdataexports_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfbcmdataexports.ResourceExport(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBCMDataExportsExport_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bcmdataexports_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "export.0.description", ""),
+				),
+			},
+			{
+				Config: testAccExportConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "export.0.description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "export.0.destination_configurations.0.s3_destination.0.s3_output_configurations.0.compression", "PARQUET"),
+					resource.TestCheckResourceAttr(resourceName, "export.0.destination_configurations.0.s3_destination.0.s3_output_configurations.0.format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBCMDataExportsExport_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bcmdataexports_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExportConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccExportConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBCMDataExportsExport_queryStatementInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExportConfig_queryStatement(rName, "SELECT identity_line_item_id FROM UNKNOWN_TABLE", "COST_AND_USAGE_REPORT"),
+				ExpectError: regexp.MustCompile(`selects from unsupported table "UNKNOWN_TABLE"`),
+			},
+			{
+				Config:      testAccExportConfig_queryStatement(rName, "DELETE FROM COST_AND_USAGE_REPORT", "COST_AND_USAGE_REPORT"),
+				ExpectError: regexp.MustCompile(`must be of the form "SELECT <columns> FROM <table>"`),
+			},
+		},
+	})
+}
+
+func testAccCheckExportDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BCMDataExportsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_bcmdataexports_export" {
+			continue
+		}
+
+		_, err := tfbcmdataexports.FindExportByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("BCM Data Exports Export %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExportExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No BCM Data Exports Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BCMDataExportsClient
+
+		_, err := tfbcmdataexports.FindExportByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	// Data Exports is only available in us-east-1.
+	acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BCMDataExportsClient
+
+	_, err := conn.ListExports(context.Background(), &bcmdataexports.ListExportsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccExportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "EnableAWSDataExportsToWriteToS3AndCheckPolicy"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "billingreports.amazonaws.com",
+          "bcm-data-exports.amazonaws.com",
+        ]
+      }
+      Action = [
+        "s3:PutObject",
+        "s3:GetBucketPolicy",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+      Condition = {
+        StringLike = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+          "aws:SourceArn" = [
+            "arn:${data.aws_partition.current.partition}:cur:us-east-1:${data.aws_caller_identity.current.account_id}:definition/*",
+            "arn:${data.aws_partition.current.partition}:bcm-data-exports:us-east-1:${data.aws_caller_identity.current.account_id}:export/*",
+          ]
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccExportConfig_export(rName, description, queryStatement, tableName, format, compression string) string {
+	return fmt.Sprintf(`
+  export {
+    name        = %[1]q
+    description = %[2]q
+
+    data_query {
+      query_statement = %[3]q
+
+      table_configurations {
+        table_name = %[4]q
+
+        table_properties = {
+          INCLUDE_MANUAL_DISCOUNT_COMPATIBILITY = "FALSE"
+          INCLUDE_RESOURCES                     = "FALSE"
+          INCLUDE_SPLIT_COST_ALLOCATION_DATA    = "FALSE"
+          TIME_GRANULARITY                      = "HOURLY"
+        }
+      }
+    }
+
+    destination_configurations {
+      s3_destination {
+        s3_bucket = aws_s3_bucket.test.bucket
+        s3_prefix = "prefix"
+        s3_region = aws_s3_bucket.test.region
+
+        s3_output_configurations {
+          compression = %[6]q
+          format      = %[5]q
+          output_type = "CUSTOM"
+          overwrite   = "OVERWRITE_REPORT"
+        }
+      }
+    }
+
+    refresh_cadence {
+      frequency = "SYNCHRONOUS"
+    }
+  }
+`, rName, description, queryStatement, tableName, format, compression)
+}
+
+const testAccExportQueryStatement = "SELECT identity_line_item_id, identity_time_interval, line_item_product_code, line_item_unblended_cost FROM COST_AND_USAGE_REPORT"
+
+func testAccExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_bcmdataexports_export" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+%[1]s
+}
+`, testAccExportConfig_export(rName, "", testAccExportQueryStatement, "COST_AND_USAGE_REPORT", "TEXT_OR_CSV", "GZIP")))
+}
+
+func testAccExportConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_bcmdataexports_export" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+%[1]s
+}
+`, testAccExportConfig_export(rName, "updated", testAccExportQueryStatement, "COST_AND_USAGE_REPORT", "PARQUET", "PARQUET")))
+}
+
+func testAccExportConfig_queryStatement(rName, queryStatement, tableName string) string {
+	return acctest.ConfigCompose(testAccExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_bcmdataexports_export" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+%[1]s
+}
+`, testAccExportConfig_export(rName, "", queryStatement, tableName, "TEXT_OR_CSV", "GZIP")))
+}
+
+func testAccExportConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_bcmdataexports_export" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+%[1]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, testAccExportConfig_export(rName, "", testAccExportQueryStatement, "COST_AND_USAGE_REPORT", "TEXT_OR_CSV", "GZIP"), tagKey1, tagValue1))
+}
+
+func testAccExportConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_bcmdataexports_export" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+%[1]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, testAccExportConfig_export(rName, "", testAccExportQueryStatement, "COST_AND_USAGE_REPORT", "TEXT_OR_CSV", "GZIP"), tagKey1, tagValue1, tagKey2, tagValue2))
+}
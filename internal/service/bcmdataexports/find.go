@@ -0,0 +1,38 @@
+package bcmdataexports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindExportByARN(ctx context.Context, conn *bcmdataexports.Client, arn string) (*types.Export, error) {
+	input := &bcmdataexports.GetExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.GetExport(ctx, input)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Export == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Export, nil
+}
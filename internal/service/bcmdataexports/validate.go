@@ -0,0 +1,74 @@
+package bcmdataexports
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// https://docs.aws.amazon.com/cur/latest/userguide/table-dictionary-cur2.html
+	tableNameCostAndUsageReport = "COST_AND_USAGE_REPORT"
+
+	queryStatementMaxLength = 36000
+)
+
+func tableNames() []string {
+	return []string{
+		tableNameCostAndUsageReport,
+	}
+}
+
+var queryStatementRegexp = regexp.MustCompile(`(?is)^\s*SELECT\s+(.+?)\s+FROM\s+([A-Za-z0-9_]+)(\s+.*)?$`)
+
+// queryStatementTableName returns the name of the table that a Data Exports query statement selects from.
+func queryStatementTableName(statement string) (string, bool) {
+	match := queryStatementRegexp.FindStringSubmatch(trimQueryStatement(statement))
+
+	if match == nil {
+		return "", false
+	}
+
+	return strings.ToUpper(match[2]), true
+}
+
+// trimQueryStatement strips surrounding whitespace and any statement terminators.
+func trimQueryStatement(statement string) string {
+	return strings.TrimRight(strings.TrimSpace(statement), "; \t\r\n")
+}
+
+func validQueryStatement(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+		return
+	}
+
+	if len(value) > queryStatementMaxLength {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, queryStatementMaxLength))
+	}
+
+	// Data Exports accepts a single SQL statement.
+	if strings.Contains(trimQueryStatement(value), ";") {
+		errors = append(errors, fmt.Errorf("%q must contain a single SQL statement: %q", k, value))
+		return
+	}
+
+	table, ok := queryStatementTableName(value)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must be of the form \"SELECT <columns> FROM <table>\": %q", k, value))
+		return
+	}
+
+	for _, v := range tableNames() {
+		if table == v {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q selects from unsupported table %q, expected one of: %s", k, table, strings.Join(tableNames(), ", ")))
+
+	return
+}
@@ -0,0 +1,58 @@
+package bcmdataexports
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidQueryStatement(t *testing.T) {
+	validStatements := []string{
+		"SELECT identity_line_item_id FROM COST_AND_USAGE_REPORT",
+		"select identity_line_item_id, line_item_unblended_cost from cost_and_usage_report",
+		"SELECT *\nFROM COST_AND_USAGE_REPORT\nWHERE line_item_product_code = 'AmazonEC2'",
+		"  SELECT identity_line_item_id FROM COST_AND_USAGE_REPORT;  ",
+	}
+	for _, v := range validStatements {
+		_, errors := validQueryStatement(v, "query_statement")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Data Exports query statement: %q", v, errors)
+		}
+	}
+
+	invalidStatements := []string{
+		"",
+		"   ",
+		"DELETE FROM COST_AND_USAGE_REPORT",
+		"SELECT identity_line_item_id",
+		"SELECT identity_line_item_id FROM UNKNOWN_TABLE",
+		"SELECT identity_line_item_id FROM COST_AND_USAGE_REPORT; SELECT 1 FROM COST_AND_USAGE_REPORT",
+		"SELECT identity_line_item_id FROM COST_AND_USAGE_REPORT WHERE " + strings.Repeat("x", 36000),
+	}
+	for _, v := range invalidStatements {
+		_, errors := validQueryStatement(v, "query_statement")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Data Exports query statement", v)
+		}
+	}
+}
+
+func TestQueryStatementTableName(t *testing.T) {
+	testCases := []struct {
+		statement string
+		expected  string
+		ok        bool
+	}{
+		{"SELECT a FROM COST_AND_USAGE_REPORT", "COST_AND_USAGE_REPORT", true},
+		{"select a from cost_and_usage_report where b = 'c'", "COST_AND_USAGE_REPORT", true},
+		{"SELECT a FROM COST_AND_USAGE_REPORT;", "COST_AND_USAGE_REPORT", true},
+		{"UPDATE COST_AND_USAGE_REPORT SET a = 1", "", false},
+	}
+
+	for _, testCase := range testCases {
+		table, ok := queryStatementTableName(testCase.statement)
+
+		if ok != testCase.ok || table != testCase.expected {
+			t.Errorf("queryStatementTableName(%q) = (%q, %t), expected (%q, %t)", testCase.statement, table, ok, testCase.expected, testCase.ok)
+		}
+	}
+}
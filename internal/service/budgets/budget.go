@@ -2,15 +2,21 @@ package budgets
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	budgets_sdkv2 "github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,7 +24,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/shopspring/decimal"
@@ -35,6 +45,8 @@ func ResourceBudget() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceBudgetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:         schema.TypeString,
@@ -48,9 +60,10 @@ func ResourceBudget() *schema.Resource {
 				Computed: true,
 			},
 			"auto_adjust_data": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"planned_limit"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"auto_adjust_type": {
@@ -93,7 +106,7 @@ func ResourceBudget() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"cost_filter"},
+				ConflictsWith: []string{"cost_filter", "filter_expression"},
 				Deprecated:    "Use the attribute \"cost_filter\" instead.",
 			},
 			"cost_filter": {
@@ -115,7 +128,7 @@ func ResourceBudget() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"cost_filters"},
+				ConflictsWith: []string{"cost_filters", "filter_expression"},
 			},
 			"cost_types": {
 				Type:     schema.TypeList,
@@ -182,6 +195,13 @@ func ResourceBudget() *schema.Resource {
 					},
 				},
 			},
+			"filter_expression": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          budgetFilterExpressionSchema(budgetFilterExpressionMaxDepth),
+				ConflictsWith: []string{"cost_filter", "cost_filters"},
+			},
 			"limit_amount": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -234,7 +254,7 @@ func ResourceBudget() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: verify.ValidARN,
+								ValidateFunc: validSNSTopicARN,
 							},
 						},
 						"threshold": {
@@ -270,7 +290,7 @@ func ResourceBudget() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"limit_amount", "limit_unit"},
+				ConflictsWith: []string{"auto_adjust_data", "limit_amount", "limit_unit"},
 			},
 			"time_period_end": {
 				Type:         schema.TypeString,
@@ -321,9 +341,21 @@ func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(BudgetCreateResourceID(accountID, aws.StringValue(budget.BudgetName)))
 
+	if v, ok := d.GetOk("filter_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		filterExpression := expandBudgetFilterExpression(v.([]interface{})[0].(map[string]interface{}))
+
+		if err := updateBudgetFilterExpression(ctx, meta.(*conns.AWSClient).BudgetsClient(), accountID, name, filterExpression); err != nil {
+			return diag.Errorf("setting Budget (%s) filter expression: %s", d.Id(), err)
+		}
+	}
+
 	notificationsRaw := d.Get("notification").(*schema.Set).List()
 	notifications, subscribers := expandBudgetNotificationsUnmarshal(notificationsRaw)
 
+	if err := validateNotificationTopicPolicies(ctx, meta.(*conns.AWSClient).SNSConn, subscribers); err != nil {
+		return diag.Errorf("creating Budget (%s) notifications: %s", d.Id(), err)
+	}
+
 	err = createBudgetNotifications(ctx, conn, notifications, subscribers, *budget.BudgetName, accountID)
 
 	if err != nil {
@@ -378,6 +410,21 @@ func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("setting auto_adjust_data: %s", err)
 	}
 
+	// The filter expression is only available from the AWS SDK for Go v2 Budgets client.
+	budgetSDKv2, err := findBudgetByTwoPartKeySDKv2(ctx, meta.(*conns.AWSClient).BudgetsClient(), accountID, budgetName)
+
+	if err != nil {
+		return diag.Errorf("reading Budget (%s) filter expression: %s", d.Id(), err)
+	}
+
+	if budgetSDKv2.FilterExpression != nil {
+		if err := d.Set("filter_expression", []interface{}{flattenBudgetFilterExpression(budgetSDKv2.FilterExpression)}); err != nil {
+			return diag.Errorf("setting filter_expression: %s", err)
+		}
+	} else {
+		d.Set("filter_expression", nil)
+	}
+
 	if budget.BudgetLimit != nil {
 		d.Set("limit_amount", budget.BudgetLimit.Amount)
 		d.Set("limit_unit", budget.BudgetLimit.Unit)
@@ -462,7 +509,7 @@ func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceBudgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).BudgetsConn
 
-	accountID, _, err := BudgetParseResourceID(d.Id())
+	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -483,7 +530,16 @@ func resourceBudgetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("updating Budget (%s): %s", d.Id(), err)
 	}
 
-	err = updateBudgetNotifications(ctx, conn, d)
+	// The budget update above replaces any existing filter expression.
+	if v, ok := d.GetOk("filter_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		filterExpression := expandBudgetFilterExpression(v.([]interface{})[0].(map[string]interface{}))
+
+		if err := updateBudgetFilterExpression(ctx, meta.(*conns.AWSClient).BudgetsClient(), accountID, budgetName, filterExpression); err != nil {
+			return diag.Errorf("updating Budget (%s) filter expression: %s", d.Id(), err)
+		}
+	}
+
+	err = updateBudgetNotifications(ctx, conn, meta.(*conns.AWSClient).SNSConn, d)
 
 	if err != nil {
 		return diag.Errorf("updating Budget (%s) notifications: %s", d.Id(), err)
@@ -563,6 +619,52 @@ func FindBudgetByTwoPartKey(ctx context.Context, conn *budgets.Budgets, accountI
 	return output.Budget, nil
 }
 
+func findBudgetByTwoPartKeySDKv2(ctx context.Context, conn *budgets_sdkv2.Client, accountID, budgetName string) (*types.Budget, error) {
+	input := &budgets_sdkv2.DescribeBudgetInput{
+		AccountId:  aws_sdkv2.String(accountID),
+		BudgetName: aws_sdkv2.String(budgetName),
+	}
+
+	output, err := conn.DescribeBudget(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Budget == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Budget, nil
+}
+
+// updateBudgetFilterExpression replaces the cost filters of an existing budget with a filter expression.
+func updateBudgetFilterExpression(ctx context.Context, conn *budgets_sdkv2.Client, accountID, budgetName string, filterExpression *types.Expression) error {
+	budget, err := findBudgetByTwoPartKeySDKv2(ctx, conn, accountID, budgetName)
+
+	if err != nil {
+		return err
+	}
+
+	budget.CalculatedSpend = nil
+	budget.CostFilters = nil
+	budget.FilterExpression = filterExpression
+
+	_, err = conn.UpdateBudget(ctx, &budgets_sdkv2.UpdateBudgetInput{
+		AccountId: aws_sdkv2.String(accountID),
+		NewBudget: budget,
+	})
+
+	return err
+}
+
 func findNotifications(ctx context.Context, conn *budgets.Budgets, accountID, budgetName string) ([]*budgets.Notification, error) {
 	input := &budgets.DescribeNotificationsForBudgetInput{
 		AccountId:  aws.String(accountID),
@@ -669,7 +771,7 @@ func createBudgetNotifications(ctx context.Context, conn *budgets.Budgets, notif
 	return nil
 }
 
-func updateBudgetNotifications(ctx context.Context, conn *budgets.Budgets, d *schema.ResourceData) error {
+func updateBudgetNotifications(ctx context.Context, conn *budgets.Budgets, snsConn *sns.SNS, d *schema.ResourceData) error {
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
 	if err != nil {
@@ -684,6 +786,10 @@ func updateBudgetNotifications(ctx context.Context, conn *budgets.Budgets, d *sc
 		removeNotifications, _ := expandBudgetNotificationsUnmarshal(os.Difference(ns).List())
 		addNotifications, addSubscribers := expandBudgetNotificationsUnmarshal(ns.Difference(os).List())
 
+		if err := validateNotificationTopicPolicies(ctx, snsConn, addSubscribers); err != nil {
+			return fmt.Errorf("creating Budget (%s) notifications: %s", d.Id(), err)
+		}
+
 		for _, notification := range removeNotifications {
 			input := &budgets.DeleteNotificationInput{
 				AccountId:    aws.String(accountID),
@@ -714,11 +820,14 @@ func flattenAutoAdjustData(autoAdjustData *budgets.AutoAdjustData) []map[string]
 	}
 
 	attrs := map[string]interface{}{
-		"auto_adjust_type":      aws.StringValue(autoAdjustData.AutoAdjustType),
-		"last_auto_adjust_time": aws.TimeValue(autoAdjustData.LastAutoAdjustTime).Format(time.RFC3339),
+		"auto_adjust_type": aws.StringValue(autoAdjustData.AutoAdjustType),
 	}
 
-	if *autoAdjustData.HistoricalOptions != (budgets.HistoricalOptions{}) { // nosemgrep: ci.prefer-aws-go-sdk-pointer-conversion-conditional
+	if autoAdjustData.LastAutoAdjustTime != nil {
+		attrs["last_auto_adjust_time"] = aws.TimeValue(autoAdjustData.LastAutoAdjustTime).Format(time.RFC3339)
+	}
+
+	if autoAdjustData.HistoricalOptions != nil && *autoAdjustData.HistoricalOptions != (budgets.HistoricalOptions{}) { // nosemgrep: ci.prefer-aws-go-sdk-pointer-conversion-conditional
 		attrs["historical_options"] = flattenHistoricalOptions(autoAdjustData.HistoricalOptions)
 	}
 
@@ -840,6 +949,11 @@ func expandBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, error) {
 		}
 	}
 
+	// Cost filters are replaced by the filter expression, which is set separately.
+	if _, ok := d.GetOk("filter_expression"); ok {
+		budgetCostFilters = nil
+	}
+
 	budgetTimePeriodStart, err := timePeriodTimestampFromString(d.Get("time_period_start").(string))
 
 	if err != nil {
@@ -1033,6 +1147,219 @@ func expandSubscribers(rawList interface{}, subscriptionType string) []*budgets.
 	return result
 }
 
+// budgetFilterExpressionMaxDepth is the number of levels of "and", "or" and "not" operators
+// that can be nested in a filter expression.
+const budgetFilterExpressionMaxDepth = 2
+
+func budgetFilterExpressionSchema(depth int) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cost_categories": budgetFilterExpressionValuesSchema(&schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			}),
+			"dimensions": budgetFilterExpressionValuesSchema(&schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.Dimension](),
+			}),
+			"tags": budgetFilterExpressionValuesSchema(&schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			}),
+		},
+	}
+
+	if depth > 0 {
+		r.Schema["and"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MinItems: 2,
+			Elem:     budgetFilterExpressionSchema(depth - 1),
+		}
+		r.Schema["not"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     budgetFilterExpressionSchema(depth - 1),
+		}
+		r.Schema["or"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MinItems: 2,
+			Elem:     budgetFilterExpressionSchema(depth - 1),
+		}
+	}
+
+	return r
+}
+
+func budgetFilterExpressionValuesSchema(key *schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": key,
+				"match_options": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[types.MatchOption](),
+					},
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(0, 1024),
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandBudgetFilterExpression(tfMap map[string]interface{}) *types.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Expression{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		apiObject.And = expandBudgetFilterExpressions(v)
+	}
+
+	if v, ok := tfMap["cost_categories"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CostCategories = &types.CostCategoryValues{
+			Key:          aws_sdkv2.String(tfMap["key"].(string)),
+			MatchOptions: expandBudgetFilterExpressionMatchOptions(tfMap["match_options"].(*schema.Set)),
+			Values:       flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := tfMap["dimensions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Dimensions = &types.ExpressionDimensionValues{
+			Key:          types.Dimension(tfMap["key"].(string)),
+			MatchOptions: expandBudgetFilterExpressionMatchOptions(tfMap["match_options"].(*schema.Set)),
+			Values:       flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandBudgetFilterExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Or = expandBudgetFilterExpressions(v)
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tags = &types.TagValues{
+			Key:          aws_sdkv2.String(tfMap["key"].(string)),
+			MatchOptions: expandBudgetFilterExpressionMatchOptions(tfMap["match_options"].(*schema.Set)),
+			Values:       flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandBudgetFilterExpressions(tfList []interface{}) []types.Expression {
+	var apiObjects []types.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandBudgetFilterExpression(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandBudgetFilterExpressionMatchOptions(tfSet *schema.Set) []types.MatchOption {
+	var apiObjects []types.MatchOption
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, types.MatchOption(v))
+	}
+
+	return apiObjects
+}
+
+func flattenBudgetFilterExpression(apiObject *types.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; len(v) > 0 {
+		tfMap["and"] = flattenBudgetFilterExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_categories"] = []interface{}{map[string]interface{}{
+			"key":           aws_sdkv2.ToString(v.Key),
+			"match_options": flex.FlattenStringValueList(enum.Slice(v.MatchOptions...)),
+			"values":        flex.FlattenStringValueList(v.Values),
+		}}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimensions"] = []interface{}{map[string]interface{}{
+			"key":           string(v.Key),
+			"match_options": flex.FlattenStringValueList(enum.Slice(v.MatchOptions...)),
+			"values":        flex.FlattenStringValueList(v.Values),
+		}}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenBudgetFilterExpression(v)}
+	}
+
+	if v := apiObject.Or; len(v) > 0 {
+		tfMap["or"] = flattenBudgetFilterExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{map[string]interface{}{
+			"key":           aws_sdkv2.ToString(v.Key),
+			"match_options": flex.FlattenStringValueList(enum.Slice(v.MatchOptions...)),
+			"values":        flex.FlattenStringValueList(v.Values),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenBudgetFilterExpressions(apiObjects []types.Expression) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenBudgetFilterExpression(&apiObject))
+	}
+
+	return tfList
+}
+
 func suppressEquivalentBudgetLimitAmount(k, old, new string, d *schema.ResourceData) bool {
 	d1, err := decimal.NewFromString(old)
 
@@ -1109,3 +1436,172 @@ func validTimePeriodTimestamp(v interface{}, k string) (ws []string, errors []er
 
 	return
 }
+
+func validSNSTopicARN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != sns.ServiceName {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an SNS topic ARN", k, value))
+	}
+
+	return
+}
+
+func resourceBudgetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("auto_adjust_data")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	autoAdjustType := tfMap["auto_adjust_type"].(string)
+	historicalOptions, _ := tfMap["historical_options"].([]interface{})
+
+	switch autoAdjustType {
+	case budgets.AutoAdjustTypeHistorical:
+		if len(historicalOptions) == 0 {
+			return fmt.Errorf(`"auto_adjust_data.0.historical_options" is required when "auto_adjust_type" is %q`, autoAdjustType)
+		}
+	case budgets.AutoAdjustTypeForecast:
+		if len(historicalOptions) > 0 {
+			return fmt.Errorf(`"auto_adjust_data.0.historical_options" cannot be set when "auto_adjust_type" is %q`, autoAdjustType)
+		}
+	}
+
+	return nil
+}
+
+// validateNotificationTopicPolicies checks that every SNS topic subscribed to a notification
+// allows AWS Budgets to publish to it.
+// Topics whose policy cannot be read, e.g. topics in another account, are not checked.
+func validateNotificationTopicPolicies(ctx context.Context, conn *sns.SNS, subscribers [][]*budgets.Subscriber) error {
+	for _, subscribers := range subscribers {
+		for _, subscriber := range subscribers {
+			if aws.StringValue(subscriber.SubscriptionType) != budgets.SubscriptionTypeSns {
+				continue
+			}
+
+			topicARN := aws.StringValue(subscriber.Address)
+
+			// Retry for IAM eventual consistency, as the topic policy may be applied concurrently.
+			_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
+				func() (interface{}, error) {
+					attributes, err := tfsns.FindTopicAttributesByARN(ctx, conn, topicARN)
+
+					if err != nil {
+						return nil, err
+					}
+
+					allowed, err := TopicPolicyAllowsBudgetsPublish(attributes[tfsns.TopicAttributeNamePolicy])
+
+					if err != nil {
+						return nil, err
+					}
+
+					if !allowed {
+						return nil, errTopicPolicyDeniesBudgets
+					}
+
+					return nil, nil
+				},
+				func(err error) (bool, error) {
+					if errors.Is(err, errTopicPolicyDeniesBudgets) {
+						return true, err
+					}
+
+					return false, err
+				},
+			)
+
+			if errors.Is(err, errTopicPolicyDeniesBudgets) {
+				return fmt.Errorf("SNS Topic (%s): %w", topicARN, err)
+			}
+
+			if err != nil {
+				log.Printf("[WARN] Unable to verify SNS Topic (%s) policy: %s", topicARN, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+var errTopicPolicyDeniesBudgets = errors.New("topic policy does not allow the budgets.amazonaws.com service principal to publish (SNS:Publish)")
+
+// TopicPolicyAllowsBudgetsPublish returns whether the SNS topic policy contains a statement
+// allowing the AWS Budgets service principal to publish to the topic.
+func TopicPolicyAllowsBudgetsPublish(policy string) (bool, error) {
+	if policy == "" {
+		return false, nil
+	}
+
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("parsing topic policy: %w", err)
+	}
+
+	for _, statement := range doc.Statements {
+		if statement == nil || !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		if !policyStatementValuesContain(statement.Actions, "*", "sns:*", "sns:Publish") {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			switch principal.Type {
+			case "*":
+				return true, nil
+			case "AWS":
+				if policyStatementValuesContain(principal.Identifiers, "*") {
+					return true, nil
+				}
+			case "Service":
+				if policyStatementValuesContain(principal.Identifiers, "budgets.amazonaws.com") {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// policyStatementValuesContain returns whether a policy element, either a string or a list of strings,
+// contains any of the specified values, ignoring case.
+func policyStatementValuesContain(v interface{}, values ...string) bool {
+	var elements []string
+
+	switch v := v.(type) {
+	case string:
+		elements = []string{v}
+	case []string:
+		elements = v
+	case []interface{}:
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				elements = append(elements, e)
+			}
+		}
+	}
+
+	for _, element := range elements {
+		for _, value := range values {
+			if strings.EqualFold(element, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
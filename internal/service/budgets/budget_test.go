@@ -3,6 +3,7 @@ package budgets_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -41,6 +42,67 @@ func TestTimePeriodSecondsToString(t *testing.T) {
 	}
 }
 
+func TestTopicPolicyAllowsBudgetsPublish(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy string
+		want   bool
+	}{
+		{
+			name:   "empty",
+			policy: "",
+			want:   false,
+		},
+		{
+			name:   "budgets service principal",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"budgets.amazonaws.com"},"Action":"SNS:Publish","Resource":"*"}]}`,
+			want:   true,
+		},
+		{
+			name:   "budgets in service principal list",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["events.amazonaws.com","budgets.amazonaws.com"]},"Action":["sns:Publish"],"Resource":"*"}]}`,
+			want:   true,
+		},
+		{
+			name:   "default topic policy",
+			policy: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:Publish"],"Resource":"*","Condition":{"StringEquals":{"AWS:SourceOwner":"123456789012"}}}]}`,
+			want:   true,
+		},
+		{
+			name:   "other service principal",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"events.amazonaws.com"},"Action":"SNS:Publish","Resource":"*"}]}`,
+			want:   false,
+		},
+		{
+			name:   "deny",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"budgets.amazonaws.com"},"Action":"SNS:Publish","Resource":"*"}]}`,
+			want:   false,
+		},
+		{
+			name:   "other action",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"budgets.amazonaws.com"},"Action":"SNS:Subscribe","Resource":"*"}]}`,
+			want:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfbudgets.TopicPolicyAllowsBudgetsPublish(testCase.policy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccBudgetsBudget_basic(t *testing.T) {
 	var budget budgets.Budget
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -276,6 +338,27 @@ func TestAccBudgetsBudget_autoAdjustDataHistorical(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_autoAdjustDataInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, budgets.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetConfig_autoAdjustDataForecastHistoricalOptions(rName),
+				ExpectError: regexp.MustCompile(`"auto_adjust_data.0.historical_options" cannot be set`),
+			},
+			{
+				Config:      testAccBudgetConfig_autoAdjustDataHistoricalNoOptions(rName),
+				ExpectError: regexp.MustCompile(`"auto_adjust_data.0.historical_options" is required`),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	var budget budgets.Budget
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -378,6 +461,53 @@ func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_filterExpression(t *testing.T) {
+	var budget budgets.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, budgets.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetConfig_filterExpression(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "cost_filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.dimensions.0.key", "REGION"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.dimensions.0.match_options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter_expression.0.dimensions.0.match_options.*", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.dimensions.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter_expression.0.dimensions.0.values.*", acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBudgetConfig_filterExpressionUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.and.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.and.0.dimensions.0.key", "REGION"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter_expression.0.and.0.dimensions.0.values.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.and.1.not.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.and.1.not.0.tags.0.key", "Environment"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter_expression.0.and.1.not.0.tags.0.values.*", "test"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_notifications(t *testing.T) {
 	var budget budgets.Budget
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -462,6 +592,34 @@ func TestAccBudgetsBudget_notifications(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_notificationsTopicPolicy(t *testing.T) {
+	var budget budgets.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, budgets.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetConfig_notificationsTopicPolicy(rName, "events.amazonaws.com"),
+				ExpectError: regexp.MustCompile(`does not allow the budgets.amazonaws.com service principal to publish`),
+			},
+			{
+				Config: testAccBudgetConfig_notificationsTopicPolicy(rName, "budgets.amazonaws.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification.*.subscriber_sns_topic_arns.*", snsTopicResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_plannedLimits(t *testing.T) {
 	var budget budgets.Budget
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -664,6 +822,37 @@ resource "aws_budgets_budget" "test" {
 `, rName)
 }
 
+func testAccBudgetConfig_autoAdjustDataForecastHistoricalOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "FORECAST"
+    historical_options {
+      budget_adjustment_period = 2
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBudgetConfig_autoAdjustDataHistoricalNoOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name        = %[1]q
+  budget_type = "COST"
+  time_unit   = "MONTHLY"
+
+  auto_adjust_data {
+    auto_adjust_type = "HISTORICAL"
+  }
+}
+`, rName)
+}
+
 func testAccBudgetConfig_costTypes(rName, startDate, endDate string) string {
 	return fmt.Sprintf(`
 resource "aws_budgets_budget" "test" {
@@ -720,6 +909,58 @@ resource "aws_budgets_budget" "test" {
 `, rName, startDate, endDate, acctest.AlternateRegion(), acctest.ThirdRegion())
 }
 
+func testAccBudgetConfig_filterExpression(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  filter_expression {
+    dimensions {
+      key           = "REGION"
+      match_options = ["EQUALS"]
+      values        = [%[2]q]
+    }
+  }
+}
+`, rName, acctest.Region())
+}
+
+func testAccBudgetConfig_filterExpressionUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  filter_expression {
+    and {
+      dimensions {
+        key           = "REGION"
+        match_options = ["EQUALS"]
+        values        = [%[2]q]
+      }
+    }
+
+    and {
+      not {
+        tags {
+          key           = "Environment"
+          match_options = ["EQUALS"]
+          values        = ["test"]
+        }
+      }
+    }
+  }
+}
+`, rName, acctest.AlternateRegion())
+}
+
 func testAccBudgetConfig_notifications(rName, emailAddress1, emailAddress2 string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -772,6 +1013,48 @@ resource "aws_budgets_budget" "test" {
 `, rName, emailAddress1)
 }
 
+func testAccBudgetConfig_notificationsTopicPolicy(rName, servicePrincipal string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn = aws_sns_topic.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = %[2]q
+      }
+      Action   = "SNS:Publish"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  notification {
+    comparison_operator       = "GREATER_THAN"
+    notification_type         = "ACTUAL"
+    threshold                 = 100
+    threshold_type            = "PERCENTAGE"
+    subscriber_sns_topic_arns = [aws_sns_topic.test.arn]
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName, servicePrincipal)
+}
+
 func testAccBudgetConfig_plannedLimits(rName, config string) string {
 	return fmt.Sprintf(`
 resource "aws_budgets_budget" "test" {
@@ -3,12 +3,14 @@ package ce
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +39,7 @@ func ResourceAnomalyMonitor() *schema.Resource {
 			"monitor_dimension": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				ForceNew:      true,
 				ConflictsWith: []string{"monitor_specification"},
 				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
 			},
@@ -65,7 +68,10 @@ func ResourceAnomalyMonitor() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAnomalyMonitorCustomizeDiff,
+		),
 	}
 }
 
@@ -230,3 +236,77 @@ func resourceAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+// resourceAnomalyMonitorCustomizeDiff validates that the monitor dimension or specification matches the monitor type.
+func resourceAnomalyMonitorCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	switch monitorType := diff.Get("monitor_type").(string); monitorType {
+	case costexplorer.MonitorTypeDimensional:
+		if diff.NewValueKnown("monitor_dimension") && diff.Get("monitor_dimension").(string) == "" {
+			return fmt.Errorf(`"monitor_dimension" is required when "monitor_type" is %q`, monitorType)
+		}
+	case costexplorer.MonitorTypeCustom:
+		if !diff.NewValueKnown("monitor_specification") {
+			break
+		}
+
+		v := diff.Get("monitor_specification").(string)
+
+		if v == "" {
+			return fmt.Errorf(`"monitor_specification" is required when "monitor_type" is %q`, monitorType)
+		}
+
+		expression := &costexplorer.Expression{}
+
+		if err := json.Unmarshal([]byte(v), expression); err != nil {
+			return fmt.Errorf(`parsing "monitor_specification": %w`, err)
+		}
+
+		if err := validAnomalyMonitorSpecification(expression); err != nil {
+			return fmt.Errorf(`invalid "monitor_specification": %w`, err)
+		}
+	}
+
+	return nil
+}
+
+// validAnomalyMonitorSpecification validates the dimensions used in a CUSTOM monitor specification.
+// Linked account monitors are expressed as a LINKED_ACCOUNT dimension whose values are account IDs.
+func validAnomalyMonitorSpecification(expression *costexplorer.Expression) error {
+	if expression == nil {
+		return nil
+	}
+
+	if v := expression.Dimensions; v != nil {
+		key := aws.StringValue(v.Key)
+
+		if _, errs := validation.StringInSlice(costexplorer.Dimension_Values(), false)(key, "Dimensions.Key"); len(errs) > 0 {
+			return errs[0]
+		}
+
+		if key == costexplorer.DimensionLinkedAccount {
+			if len(v.Values) == 0 {
+				return fmt.Errorf("dimension %q requires at least one value", key)
+			}
+
+			for _, value := range aws.StringValueSlice(v.Values) {
+				if _, errs := verify.ValidAccountID(value, "Dimensions.Values"); len(errs) > 0 {
+					return errs[0]
+				}
+			}
+		}
+	}
+
+	for _, v := range expression.And {
+		if err := validAnomalyMonitorSpecification(v); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range expression.Or {
+		if err := validAnomalyMonitorSpecification(v); err != nil {
+			return err
+		}
+	}
+
+	return validAnomalyMonitorSpecification(expression.Not)
+}
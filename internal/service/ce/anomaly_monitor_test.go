@@ -184,6 +184,76 @@ func TestAccCEAnomalyMonitor_Dimensional(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalyMonitor_linkedAccount(t *testing.T) {
+	var monitor costexplorer.AnomalyMonitor
+	resourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig_linkedAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName, &monitor),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_specification"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_monitorTypeMismatch(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_monitorType(rName, "DIMENSIONAL"),
+				ExpectError: regexp.MustCompile(`"monitor_dimension" is required when "monitor_type" is "DIMENSIONAL"`),
+			},
+			{
+				Config:      testAccAnomalyMonitorConfig_monitorType(rName, "CUSTOM"),
+				ExpectError: regexp.MustCompile(`"monitor_specification" is required when "monitor_type" is "CUSTOM"`),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_invalidSpecification(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_specificationDimension(rName, "LINKED_ACCOUNT", "not-an-account"),
+				ExpectError: regexp.MustCompile(`doesn't look like AWS Account ID`),
+			},
+			{
+				Config:      testAccAnomalyMonitorConfig_specificationDimension(rName, "LINKED_ACCOUNTS", "123456789012"),
+				ExpectError: regexp.MustCompile(`expected Dimensions.Key to be one of`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnomalyMonitorExists(n string, anomalyMonitor *costexplorer.AnomalyMonitor) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CEConn
@@ -333,3 +403,52 @@ resource "aws_ce_anomaly_monitor" "test" {
 }
 `, rName)
 }
+
+func testAccAnomalyMonitorConfig_linkedAccount(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    And            = null
+    CostCategories = null
+    Dimensions = {
+      Key          = "LINKED_ACCOUNT"
+      MatchOptions = null
+      Values       = [data.aws_caller_identity.current.account_id]
+    }
+    Not  = null
+    Or   = null
+    Tags = null
+  })
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorConfig_monitorType(rName, monitorType string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = %[2]q
+}
+`, rName, monitorType)
+}
+
+func testAccAnomalyMonitorConfig_specificationDimension(rName, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Dimensions = {
+      Key    = %[2]q
+      Values = [%[3]q]
+    }
+  })
+}
+`, rName, key, value)
+}
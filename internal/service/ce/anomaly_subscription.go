@@ -2,12 +2,14 @@ package ce
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -70,9 +72,20 @@ func ResourceAnomalySubscription() *schema.Resource {
 			},
 			"threshold": {
 				Type:         schema.TypeFloat,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"threshold", "threshold_expression"},
+				Deprecated:   "Use the attribute \"threshold_expression\" instead.",
 				ValidateFunc: validation.FloatAtLeast(0.0),
 			},
+			"threshold_expression": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				Computed:     true,
+				Elem:         schemaCostCategoryRule(),
+				ExactlyOneOf: []string{"threshold", "threshold_expression"},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -85,7 +98,10 @@ func ResourceAnomalySubscription() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAnomalySubscriptionCustomizeDiff,
+		),
 	}
 }
 
@@ -100,7 +116,6 @@ func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 			Frequency:        aws.String(d.Get("frequency").(string)),
 			MonitorArnList:   aws.StringSlice(expandAnomalySubscriptionMonitorARNList(d.Get("monitor_arn_list").([]interface{}))),
 			Subscribers:      expandAnomalySubscriptionSubscribers(d.Get("subscriber").(*schema.Set).List()),
-			Threshold:        aws.Float64(d.Get("threshold").(float64)),
 		},
 	}
 
@@ -108,6 +123,14 @@ func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 		input.AnomalySubscription.AccountId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("threshold"); ok {
+		input.AnomalySubscription.Threshold = aws.Float64(v.(float64))
+	}
+
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnomalySubscription.ThresholdExpression = expandCostExpression(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.ResourceTags = Tags(tags.IgnoreAWS())
 	}
@@ -150,6 +173,13 @@ func resourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData
 	d.Set("monitor_arn_list", subscription.MonitorArnList)
 	d.Set("subscriber", flattenAnomalySubscriptionSubscribers(subscription.Subscribers))
 	d.Set("threshold", subscription.Threshold)
+	if subscription.ThresholdExpression != nil {
+		if err := d.Set("threshold_expression", []interface{}{flattenCostCategoryRuleExpression(subscription.ThresholdExpression)}); err != nil {
+			return create.DiagError(names.CE, create.ErrActionReading, ResNameAnomalySubscription, d.Id(), err)
+		}
+	} else {
+		d.Set("threshold_expression", nil)
+	}
 	d.Set("name", subscription.SubscriptionName)
 
 	tags, err := ListTags(conn, aws.StringValue(subscription.SubscriptionArn))
@@ -194,7 +224,12 @@ func resourceAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceDa
 		requestUpdate = true
 	}
 
-	if d.HasChange("threshold") {
+	if d.HasChange("threshold_expression") {
+		if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ThresholdExpression = expandCostExpression(v.([]interface{})[0].(map[string]interface{}))
+			requestUpdate = true
+		}
+	} else if d.HasChange("threshold") {
 		input.Threshold = aws.Float64(d.Get("threshold").(float64))
 		requestUpdate = true
 	}
@@ -289,3 +324,37 @@ func flattenAnomalySubscriptionSubscribers(subscribers []*costexplorer.Subscribe
 
 	return rawSubscribers
 }
+
+// resourceAnomalySubscriptionCustomizeDiff validates that the subscriber types are supported by the alert frequency.
+// Individual (IMMEDIATE) alerts can only be delivered to SNS topics and daily or weekly summaries only by email.
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	frequency := diff.Get("frequency").(string)
+
+	if frequency == "" {
+		return nil
+	}
+
+	for _, v := range diff.Get("subscriber").(*schema.Set).List() {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		subscriberType := tfMap["type"].(string)
+
+		if subscriberType == "" {
+			continue
+		}
+
+		if frequency == costexplorer.AnomalySubscriptionFrequencyImmediate && subscriberType != costexplorer.SubscriberTypeSns {
+			return fmt.Errorf("subscriber type %q is not supported with frequency %q, only %q subscribers are supported", subscriberType, frequency, costexplorer.SubscriberTypeSns)
+		}
+
+		if frequency != costexplorer.AnomalySubscriptionFrequencyImmediate && subscriberType != costexplorer.SubscriberTypeEmail {
+			return fmt.Errorf("subscriber type %q is not supported with frequency %q, only %q subscribers are supported", subscriberType, frequency, costexplorer.SubscriberTypeEmail)
+		}
+	}
+
+	return nil
+}
@@ -257,6 +257,74 @@ func TestAccCEAnomalySubscription_Threshold(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_ThresholdExpression(t *testing.T) {
+	var subscription costexplorer.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpression(rName, costexplorer.DimensionAnomalyTotalImpactPercentage, "50", address),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", "ANOMALY_TOTAL_IMPACT_PERCENTAGE"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.match_options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.match_options.*", "GREATER_THAN_OR_EQUAL"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "threshold_expression.0.dimension.0.values.*", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						"key": "ANOMALY_TOTAL_IMPACT_ABSOLUTE",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						"key": "ANOMALY_TOTAL_IMPACT_PERCENTAGE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_frequencySubscriberMismatch(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_frequency(rName, "IMMEDIATE", address),
+				ExpectError: regexp.MustCompile(`subscriber type "EMAIL" is not supported with frequency "IMMEDIATE"`),
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	var subscription costexplorer.AnomalySubscription
 	resourceName := "aws_ce_anomaly_subscription.test"
@@ -609,6 +677,72 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName, rThreshold, address))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpression(rName, key, value, address string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "WEEKLY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[4]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = %[2]q
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = [%[3]q]
+    }
+  }
+}
+`, rName, key, value, address))
+}
+
+func testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address string) string {
+	return acctest.ConfigCompose(
+		testAccAnomalySubscriptionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "WEEKLY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+        values        = ["100"]
+      }
+    }
+
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+        values        = ["50"]
+      }
+    }
+  }
+}
+`, rName, address))
+}
+
 func testAccAnomalySubscriptionConfig_tags1(rName string, tagKey1, tagValue1 string, address string) string {
 	return acctest.ConfigCompose(
 		testAccAnomalySubscriptionConfigBase(rName),
@@ -0,0 +1,15 @@
+package chatbot
+
+const (
+	loggingLevelError = "ERROR"
+	loggingLevelInfo  = "INFO"
+	loggingLevelNone  = "NONE"
+)
+
+func loggingLevel_Values() []string {
+	return []string{
+		loggingLevelError,
+		loggingLevelInfo,
+		loggingLevelNone,
+	}
+}
@@ -0,0 +1,68 @@
+package chatbot
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSlackChannelConfigurationByARN(ctx context.Context, conn *chatbot.Client, arn string) (*types.SlackChannelConfiguration, error) {
+	input := &chatbot.DescribeSlackChannelConfigurationsInput{
+		ChatConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSlackChannelConfigurations(ctx, input)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.SlackChannelConfigurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.SlackChannelConfigurations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.SlackChannelConfigurations[0], nil
+}
+
+func FindTeamsChannelConfigurationByARN(ctx context.Context, conn *chatbot.Client, arn string) (*types.TeamsChannelConfiguration, error) {
+	input := &chatbot.GetMicrosoftTeamsChannelConfigurationInput{
+		ChatConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetMicrosoftTeamsChannelConfiguration(ctx, input)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelConfiguration, nil
+}
@@ -0,0 +1,279 @@
+package chatbot
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTeamsChannelConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTeamsChannelConfigurationCreate,
+		ReadWithoutTimeout:   resourceTeamsChannelConfigurationRead,
+		UpdateWithoutTimeout: resourceTeamsChannelConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTeamsChannelConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.ARNPartitionMatchesProvider("iam_role_arn"),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"chat_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validConfigurationName,
+			},
+			"guardrail_policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARNCheck(verify.ARNService("iam"), verify.ARNResourcePrefix("policy/")),
+				},
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARNCheck(verify.ARNService("iam"), verify.ARNResourcePrefix("role/")),
+			},
+			"logging_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(loggingLevel_Values(), false),
+			},
+			"sns_topic_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARNCheck(verify.ARNService("sns")),
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"user_authorization_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTeamsChannelConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChatbotClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("configuration_name").(string)
+	input := &chatbot.CreateMicrosoftTeamsChannelConfigurationInput{
+		ChannelId:         aws.String(d.Get("channel_id").(string)),
+		ConfigurationName: aws.String(name),
+		IamRoleArn:        aws.String(d.Get("iam_role_arn").(string)),
+		TeamId:            aws.String(d.Get("team_id").(string)),
+		TenantId:          aws.String(d.Get("tenant_id").(string)),
+	}
+
+	if v, ok := d.GetOk("channel_name"); ok {
+		input.ChannelName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("guardrail_policy_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.GuardrailPolicyArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("logging_level"); ok {
+		input.LoggingLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.SnsTopicArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("team_name"); ok {
+		input.TeamName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("user_authorization_required"); ok {
+		input.UserAuthorizationRequired = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateMicrosoftTeamsChannelConfiguration(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Chatbot Microsoft Teams Channel Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ChannelConfiguration.ChatConfigurationArn))
+
+	return resourceTeamsChannelConfigurationRead(ctx, d, meta)
+}
+
+func resourceTeamsChannelConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChatbotClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configuration, err := FindTeamsChannelConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Chatbot Microsoft Teams Channel Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Chatbot Microsoft Teams Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", configuration.ChannelId)
+	d.Set("channel_name", configuration.ChannelName)
+	d.Set("chat_configuration_arn", configuration.ChatConfigurationArn)
+	d.Set("configuration_name", configuration.ConfigurationName)
+	d.Set("guardrail_policy_arns", configuration.GuardrailPolicyArns)
+	d.Set("iam_role_arn", configuration.IamRoleArn)
+	d.Set("logging_level", configuration.LoggingLevel)
+	d.Set("sns_topic_arns", configuration.SnsTopicArns)
+	d.Set("team_id", configuration.TeamId)
+	d.Set("team_name", configuration.TeamName)
+	d.Set("tenant_id", configuration.TenantId)
+	d.Set("user_authorization_required", configuration.UserAuthorizationRequired)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Chatbot Microsoft Teams Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTeamsChannelConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChatbotClient
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &chatbot.UpdateMicrosoftTeamsChannelConfigurationInput{
+			ChannelId:                 aws.String(d.Get("channel_id").(string)),
+			ChatConfigurationArn:      aws.String(d.Id()),
+			GuardrailPolicyArns:       flex.ExpandStringValueSet(d.Get("guardrail_policy_arns").(*schema.Set)),
+			IamRoleArn:                aws.String(d.Get("iam_role_arn").(string)),
+			LoggingLevel:              aws.String(d.Get("logging_level").(string)),
+			UserAuthorizationRequired: aws.Bool(d.Get("user_authorization_required").(bool)),
+		}
+
+		if v, ok := d.GetOk("channel_name"); ok {
+			input.ChannelName = aws.String(v.(string))
+		}
+
+		// The update replaces the topics, so an empty list removes every SNS topic association.
+		input.SnsTopicArns = flex.ExpandStringValueSet(d.Get("sns_topic_arns").(*schema.Set))
+		if input.SnsTopicArns == nil {
+			input.SnsTopicArns = []string{}
+		}
+
+		_, err := conn.UpdateMicrosoftTeamsChannelConfiguration(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Chatbot Microsoft Teams Channel Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Chatbot Microsoft Teams Channel Configuration (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTeamsChannelConfigurationRead(ctx, d, meta)
+}
+
+func resourceTeamsChannelConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ChatbotClient
+
+	log.Printf("[DEBUG] Deleting Chatbot Microsoft Teams Channel Configuration: %s", d.Id())
+	_, err := conn.DeleteMicrosoftTeamsChannelConfiguration(ctx, &chatbot.DeleteMicrosoftTeamsChannelConfigurationInput{
+		ChatConfigurationArn: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Chatbot Microsoft Teams Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotTeamsChannelConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	teamID := os.Getenv("CHATBOT_TEAMS_TEAM_ID")
+	channelID := os.Getenv("CHATBOT_TEAMS_CHANNEL_ID")
+	tenantID := os.Getenv("CHATBOT_TEAMS_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTeams(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, teamID, channelID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexp.MustCompile(`chat-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	teamID := os.Getenv("CHATBOT_TEAMS_TEAM_ID")
+	channelID := os.Getenv("CHATBOT_TEAMS_CHANNEL_ID")
+	tenantID := os.Getenv("CHATBOT_TEAMS_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTeams(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, teamID, channelID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfchatbot.ResourceTeamsChannelConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	teamID := os.Getenv("CHATBOT_TEAMS_TEAM_ID")
+	channelID := os.Getenv("CHATBOT_TEAMS_CHANNEL_ID")
+	tenantID := os.Getenv("CHATBOT_TEAMS_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTeams(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, teamID, channelID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "0"),
+				),
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_updated(rName, teamID, channelID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "guardrail_policy_arns.*", "data.aws_iam_policy.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "sns_topic_arns.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "true"),
+				),
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, teamID, channelID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	teamID := os.Getenv("CHATBOT_TEAMS_TEAM_ID")
+	channelID := os.Getenv("CHATBOT_TEAMS_CHANNEL_ID")
+	tenantID := os.Getenv("CHATBOT_TEAMS_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTeams(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags1(rName, teamID, channelID, tenantID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags2(rName, teamID, channelID, tenantID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags1(rName, teamID, channelID, tenantID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTeamsChannelConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_chatbot_teams_channel_configuration" {
+			continue
+		}
+
+		_, err := tfchatbot.FindTeamsChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Chatbot Teams Channel Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTeamsChannelConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chatbot Teams Channel Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient
+
+		_, err := tfchatbot.FindTeamsChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// Microsoft Teams tenants can only be authorized for Chatbot in the console, so an authorized team and channel must be supplied.
+func testAccPreCheckTeams(t *testing.T) {
+	for _, envVar := range []string{"CHATBOT_TEAMS_TEAM_ID", "CHATBOT_TEAMS_CHANNEL_ID", "CHATBOT_TEAMS_TENANT_ID"} {
+		if os.Getenv(envVar) == "" {
+			t.Skipf("%s env var must be set for Chatbot Teams Channel Configuration acceptance tests", envVar)
+		}
+	}
+}
+
+func testAccTeamsChannelConfigurationConfig_basic(rName, teamID, channelID, tenantID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  team_id            = %[2]q
+  channel_id         = %[3]q
+  tenant_id          = %[4]q
+}
+`, rName, teamID, channelID, tenantID))
+}
+
+func testAccTeamsChannelConfigurationConfig_updated(rName, teamID, channelID, tenantID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  team_id            = %[2]q
+  channel_id         = %[3]q
+  tenant_id          = %[4]q
+
+  guardrail_policy_arns       = [data.aws_iam_policy.test.arn]
+  logging_level               = "INFO"
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  user_authorization_required = true
+}
+`, rName, teamID, channelID, tenantID))
+}
+
+func testAccTeamsChannelConfigurationConfig_tags1(rName, teamID, channelID, tenantID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  team_id            = %[2]q
+  channel_id         = %[3]q
+  tenant_id          = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+  }
+}
+`, rName, teamID, channelID, tenantID, tagKey1, tagValue1))
+}
+
+func testAccTeamsChannelConfigurationConfig_tags2(rName, teamID, channelID, tenantID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  team_id            = %[2]q
+  channel_id         = %[3]q
+  tenant_id          = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+    %[7]q = %[8]q
+  }
+}
+`, rName, teamID, channelID, tenantID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,12 @@
+package chatbot
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validConfigurationName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z\-_]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+)
@@ -0,0 +1,385 @@
+package cloudformation
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	typeConfigurationAliasDefault = "default"
+)
+
+// ResourceTypeActivation activates a public third-party extension so that it can be used
+// in stack templates in the current account and Region.
+func ResourceTypeActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTypeActivationCreate,
+		ReadWithoutTimeout:   resourceTypeActivationRead,
+		UpdateWithoutTimeout: resourceTypeActivationUpdate,
+		DeleteWithoutTimeout: resourceTypeActivationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"configuration_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  typeConfigurationAliasDefault,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]{1,256}$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"latest_public_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 512),
+								validation.StringMatch(regexp.MustCompile(`[\.\-_/#A-Za-z0-9]+`), "must contain only alphanumeric, period, hyphen, forward slash, and octothorp characters"),
+							),
+						},
+						"log_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"major_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"public_type_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"public_type_arn", "publisher_id"},
+			},
+			"public_version_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"publisher_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+				RequiredWith: []string{"publisher_id", "type", "type_name"},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudformation.ThirdPartyType_Values(), false),
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(10, 204),
+					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}(::MODULE){0,1}`), "three alphanumeric character sections separated by double colons (::)"),
+				),
+			},
+			"type_name_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(10, 204),
+					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}(::MODULE){0,1}`), "three alphanumeric character sections separated by double colons (::)"),
+				),
+			},
+			"version_bump": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudformation.VersionBump_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceTypeActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	input := expandActivateTypeInput(d)
+
+	if v, ok := d.GetOk("public_type_arn"); ok {
+		input.PublicTypeArn = aws.String(v.(string))
+	} else {
+		input.PublisherId = aws.String(d.Get("publisher_id").(string))
+		input.Type = aws.String(d.Get("type").(string))
+		input.TypeName = aws.String(d.Get("type_name").(string))
+	}
+
+	if v, ok := d.GetOk("type_name_alias"); ok {
+		input.TypeNameAlias = aws.String(v.(string))
+	}
+
+	output, err := conn.ActivateTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("activating CloudFormation Type: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if v, ok := d.GetOk("configuration"); ok {
+		if err := setTypeConfiguration(ctx, conn, d.Id(), d.Get("configuration_alias").(string), v.(string)); err != nil {
+			return diag.Errorf("setting CloudFormation Type (%s) configuration: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTypeActivationRead(ctx, d, meta)
+}
+
+func resourceTypeActivationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	output, err := FindActivatedTypeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation Type Activation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudFormation Type Activation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("auto_update", output.AutoUpdate)
+	d.Set("execution_role_arn", output.ExecutionRoleArn)
+	d.Set("latest_public_version", output.LatestPublicVersion)
+	if output.LoggingConfig != nil {
+		if err := d.Set("logging_config", []interface{}{flattenLoggingConfig(output.LoggingConfig)}); err != nil {
+			return diag.Errorf("setting logging_config: %s", err)
+		}
+	} else {
+		d.Set("logging_config", nil)
+	}
+	d.Set("public_type_arn", output.OriginalTypeArn)
+	d.Set("public_version_number", output.PublicVersionNumber)
+	d.Set("publisher_id", output.PublisherId)
+	d.Set("type", output.Type)
+	d.Set("type_name", output.OriginalTypeName)
+	d.Set("type_name_alias", output.TypeName)
+
+	if _, ok := d.GetOk("configuration"); ok {
+		alias := d.Get("configuration_alias").(string)
+		configuration, err := FindTypeConfigurationByTypeARNAndAlias(ctx, conn, d.Id(), alias)
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("configuration", nil)
+			d.Set("configuration_arn", nil)
+		case err != nil:
+			return diag.Errorf("reading CloudFormation Type (%s) configuration (%s): %s", d.Id(), alias, err)
+		default:
+			d.Set("configuration", configuration.Configuration)
+			d.Set("configuration_alias", configuration.Alias)
+			d.Set("configuration_arn", configuration.Arn)
+		}
+	} else {
+		d.Set("configuration_arn", nil)
+	}
+
+	return nil
+}
+
+func resourceTypeActivationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	// Re-activating an already activated extension updates its activation settings in place.
+	if d.HasChanges("auto_update", "execution_role_arn", "logging_config", "major_version", "version_bump") {
+		input := expandActivateTypeInput(d)
+		input.PublicTypeArn = aws.String(d.Get("public_type_arn").(string))
+		input.TypeNameAlias = aws.String(d.Get("type_name_alias").(string))
+
+		_, err := conn.ActivateTypeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudFormation Type Activation (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Type configurations cannot be deleted, only overwritten.
+	if d.HasChanges("configuration", "configuration_alias") {
+		if v, ok := d.GetOk("configuration"); ok {
+			if err := setTypeConfiguration(ctx, conn, d.Id(), d.Get("configuration_alias").(string), v.(string)); err != nil {
+				return diag.Errorf("setting CloudFormation Type (%s) configuration: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceTypeActivationRead(ctx, d, meta)
+}
+
+func resourceTypeActivationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	log.Printf("[DEBUG] Deleting CloudFormation Type Activation: %s", d.Id())
+	_, err := conn.DeactivateTypeWithContext(ctx, &cloudformation.DeactivateTypeInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deactivating CloudFormation Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandActivateTypeInput(d *schema.ResourceData) *cloudformation.ActivateTypeInput {
+	input := &cloudformation.ActivateTypeInput{}
+
+	// Use GetOkExists so that an explicit `false` disables automatic updates.
+	if v, ok := d.GetOkExists("auto_update"); ok {
+		input.AutoUpdate = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		input.ExecutionRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("version_bump"); ok {
+		input.VersionBump = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func setTypeConfiguration(ctx context.Context, conn *cloudformation.CloudFormation, typeARN, alias, configuration string) error {
+	input := &cloudformation.SetTypeConfigurationInput{
+		Configuration:      aws.String(configuration),
+		ConfigurationAlias: aws.String(alias),
+		TypeArn:            aws.String(typeARN),
+	}
+
+	_, err := conn.SetTypeConfigurationWithContext(ctx, input)
+
+	return err
+}
+
+func FindActivatedTypeByARN(ctx context.Context, conn *cloudformation.CloudFormation, arn string) (*cloudformation.DescribeTypeOutput, error) {
+	output, err := FindTypeByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !aws.BoolValue(output.IsActivated) {
+		return nil, &resource.NotFoundError{
+			Message: "not activated",
+		}
+	}
+
+	return output, nil
+}
+
+func FindTypeConfigurationByTypeARNAndAlias(ctx context.Context, conn *cloudformation.CloudFormation, typeARN, alias string) (*cloudformation.TypeConfigurationDetails, error) {
+	input := &cloudformation.BatchDescribeTypeConfigurationsInput{
+		TypeConfigurationIdentifiers: []*cloudformation.TypeConfigurationIdentifier{{
+			TypeArn:                aws.String(typeARN),
+			TypeConfigurationAlias: aws.String(alias),
+		}},
+	}
+
+	output, err := conn.BatchDescribeTypeConfigurationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeConfigurationNotFoundException, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TypeConfigurations) == 0 || output.TypeConfigurations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// A type without any configuration set is returned with an empty configuration.
+	if configuration := output.TypeConfigurations[0]; aws.StringValue(configuration.Configuration) != "" {
+		return configuration, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
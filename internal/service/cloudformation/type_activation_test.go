@@ -0,0 +1,232 @@
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Public third-party extension published by AWS Quick Starts.
+const (
+	testAccTypeActivationPublisherID = "408988dff9e863704bcc72e7e13f8d645cee8311"
+	testAccTypeActivationTypeName    = "AWSQS::EKS::Cluster"
+)
+
+func TestAccCloudFormationTypeActivation_basic(t *testing.T) {
+	typeNameAlias := fmt.Sprintf("TfAcc::Test%s::Cluster", sdkacctest.RandString(8))
+	resourceName := "aws_cloudformation_type_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeActivationConfig_basic(typeNameAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeActivationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cloudformation", regexp.MustCompile(`type/resource/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_alias", "default"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_type_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_version_number"),
+					resource.TestCheckResourceAttr(resourceName, "publisher_id", testAccTypeActivationPublisherID),
+					resource.TestCheckResourceAttr(resourceName, "type", cloudformation.ThirdPartyTypeResource),
+					resource.TestCheckResourceAttr(resourceName, "type_name", testAccTypeActivationTypeName),
+					resource.TestCheckResourceAttr(resourceName, "type_name_alias", typeNameAlias),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration_alias"},
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationTypeActivation_disappears(t *testing.T) {
+	typeNameAlias := fmt.Sprintf("TfAcc::Test%s::Cluster", sdkacctest.RandString(8))
+	resourceName := "aws_cloudformation_type_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeActivationConfig_basic(typeNameAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeActivationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudformation.ResourceTypeActivation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationTypeActivation_autoUpdate(t *testing.T) {
+	typeNameAlias := fmt.Sprintf("TfAcc::Test%s::Cluster", sdkacctest.RandString(8))
+	resourceName := "aws_cloudformation_type_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeActivationConfig_autoUpdate(typeNameAlias, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeActivationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration_alias"},
+			},
+			{
+				Config: testAccTypeActivationConfig_autoUpdate(typeNameAlias, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeActivationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationTypeActivation_executionRoleARN(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	typeNameAlias := fmt.Sprintf("TfAcc::Test%s::Cluster", sdkacctest.RandString(8))
+	resourceName := "aws_cloudformation_type_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypeActivationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypeActivationConfig_executionRoleARN(rName, typeNameAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTypeActivationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTypeActivationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation Type Activation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+
+		_, err := tfcloudformation.FindActivatedTypeByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTypeActivationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_type_activation" {
+			continue
+		}
+
+		_, err := tfcloudformation.FindActivatedTypeByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFormation Type Activation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTypeActivationConfig_basic(typeNameAlias string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_type_activation" "test" {
+  publisher_id    = %[1]q
+  type            = "RESOURCE"
+  type_name       = %[2]q
+  type_name_alias = %[3]q
+}
+`, testAccTypeActivationPublisherID, testAccTypeActivationTypeName, typeNameAlias)
+}
+
+func testAccTypeActivationConfig_autoUpdate(typeNameAlias string, autoUpdate bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_type_activation" "test" {
+  auto_update     = %[4]t
+  publisher_id    = %[1]q
+  type            = "RESOURCE"
+  type_name       = %[2]q
+  type_name_alias = %[3]q
+}
+`, testAccTypeActivationPublisherID, testAccTypeActivationTypeName, typeNameAlias, autoUpdate)
+}
+
+func testAccTypeActivationConfig_executionRoleARN(rName, typeNameAlias string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[4]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "resources.cloudformation.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_cloudformation_type_activation" "test" {
+  execution_role_arn = aws_iam_role.test.arn
+  publisher_id       = %[1]q
+  type               = "RESOURCE"
+  type_name          = %[2]q
+  type_name_alias    = %[3]q
+}
+`, testAccTypeActivationPublisherID, testAccTypeActivationTypeName, typeNameAlias, rName)
+}
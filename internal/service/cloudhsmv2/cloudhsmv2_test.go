@@ -7,9 +7,11 @@ import (
 func TestAccCloudHSMV2_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			"basic":      testAccCluster_basic,
-			"disappears": testAccCluster_disappears,
-			"tags":       testAccCluster_Tags,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"basic":                 testAccCluster_basic,
+			"disappears":            testAccCluster_disappears,
+			"mode":                  testAccCluster_mode,
+			"tags":                  testAccCluster_Tags,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
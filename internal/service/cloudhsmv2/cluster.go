@@ -1,28 +1,34 @@
 package cloudhsmv2
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"strconv"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -32,6 +38,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.BackupRetentionTypeDays,
+							ValidateDiagFunc: enum.Validate[types.BackupRetentionType](),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
+
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -41,8 +69,15 @@ func ResourceCluster() *schema.Resource {
 			"hsm_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice(HSMType_Values(), false),
+			},
+
+			"mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ClusterMode](),
 			},
 
 			"subnet_ids": {
@@ -110,156 +145,216 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &cloudhsmv2.CreateClusterInput{
 		HsmType:   aws.String(d.Get("hsm_type").(string)),
-		SubnetIds: flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		SubnetIds: flex.ExpandStringValueSet(d.Get("subnet_ids").(*schema.Set)),
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		input.TagList = Tags(tags.IgnoreAWS())
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("mode"); ok {
+		input.Mode = types.ClusterMode(v.(string))
 	}
 
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] CloudHSMv2 Cluster create %s", input)
+	if len(tags) > 0 {
+		input.TagList = tagsV2(tags.IgnoreAWS())
+	}
 
-	output, err := conn.CreateCluster(input)
+	output, err := conn.CreateCluster(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating CloudHSMv2 Cluster: %w", err)
+		return diag.Errorf("creating CloudHSMv2 Cluster: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.Cluster.ClusterId))
-	log.Printf("[INFO] CloudHSMv2 Cluster ID: %s", d.Id())
-	log.Println("[INFO] Waiting for CloudHSMv2 Cluster to be available")
+	d.SetId(aws.ToString(output.Cluster.ClusterId))
 
 	if input.SourceBackupId != nil {
-		if _, err := waitClusterActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-			return fmt.Errorf("error waiting for CloudHSMv2 Cluster (%s) creation: %w", d.Id(), err)
+		if _, err := waitClusterActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) create: %s", d.Id(), err)
 		}
 	} else {
-		if _, err := waitClusterUninitialized(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-			return fmt.Errorf("error waiting for CloudHSMv2 Cluster (%s) creation: %w", d.Id(), err)
+		if _, err := waitClusterUninitialized(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) create: %s", d.Id(), err)
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	cluster, err := FindCluster(conn, d.Id())
-
-	if err != nil {
-		return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): %w", d.Id(), err)
-	}
-
-	if cluster == nil {
-		if d.IsNewResource() {
-			return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): not found after creation", d.Id())
-		}
+	cluster, err := FindClusterByID(ctx, conn, d.Id())
 
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if aws.StringValue(cluster.State) == cloudhsmv2.ClusterStateDeleted {
-		if d.IsNewResource() {
-			return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): %s after creation", d.Id(), aws.StringValue(cluster.State))
-		}
-
-		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if err != nil {
+		return diag.Errorf("reading CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
-	log.Printf("[INFO] Reading CloudHSMv2 Cluster Information: %s", d.Id())
-
+	if err := d.Set("backup_retention_policy", flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)); err != nil {
+		return diag.Errorf("setting backup_retention_policy: %s", err)
+	}
+	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
+		return diag.Errorf("setting cluster_certificates: %s", err)
+	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
+	d.Set("hsm_type", cluster.HsmType)
+	d.Set("mode", cluster.Mode)
 	d.Set("security_group_id", cluster.SecurityGroup)
-	d.Set("vpc_id", cluster.VpcId)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
-	d.Set("hsm_type", cluster.HsmType)
-	if err := d.Set("cluster_certificates", readClusterCertificates(cluster)); err != nil {
-		return fmt.Errorf("error setting cluster_certificates: %s", err)
-	}
-
-	var subnets []string
-	for _, sn := range cluster.SubnetMapping {
-		subnets = append(subnets, aws.StringValue(sn))
+	var subnetIDs []string
+	for _, v := range cluster.SubnetMapping {
+		subnetIDs = append(subnetIDs, v)
 	}
-	if err := d.Set("subnet_ids", subnets); err != nil {
-		return fmt.Errorf("Error saving Subnet IDs to state for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	if err := d.Set("subnet_ids", subnetIDs); err != nil {
+		return diag.Errorf("setting subnet_ids: %s", err)
 	}
+	d.Set("vpc_id", cluster.VpcId)
 
-	tags := KeyValueTags(cluster.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := keyValueTagsV2(cluster.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client()
+
+	if d.HasChanges("backup_retention_policy", "hsm_type") {
+		input := &cloudhsmv2.ModifyClusterInput{
+			ClusterId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("backup_retention_policy") {
+			if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("hsm_type") {
+			input.HsmType = aws.String(d.Get("hsm_type").(string))
+		}
+
+		if _, err := conn.ModifyCluster(ctx, input); err != nil {
+			return diag.Errorf("updating CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitClusterStable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+		if err := UpdateTagsWithContext(ctx, meta.(*conns.AWSClient).CloudHSMV2Conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating CloudHSMv2 Cluster (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
-	input := &cloudhsmv2.DeleteClusterInput{
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client()
+
+	log.Printf("[DEBUG] Deleting CloudHSMv2 Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(ctx, &cloudhsmv2.DeleteClusterInput{
 		ClusterId: aws.String(d.Id()),
-	}
+	})
 
-	_, err := conn.DeleteCluster(input)
+	if errs.IsA[*types.CloudHsmResourceNotFoundException](err) {
+		return nil
+	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting CloudHSMv2 Cluster (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for CloudHSMv2 Cluster (%s) deletion: %w", d.Id(), err)
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func readClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface{} {
+func HSMType_Values() []string {
+	return []string{
+		"hsm1.medium",
+		"hsm2m.medium",
+	}
+}
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *types.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BackupRetentionPolicy{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = types.BackupRetentionType(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *types.BackupRetentionPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": apiObject.Type,
+	}
+
+	if v, err := strconv.Atoi(aws.ToString(apiObject.Value)); err == nil {
+		tfMap["value"] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCertificates(cluster *types.Cluster) []map[string]interface{} {
 	certs := map[string]interface{}{}
 	if cluster.Certificates != nil {
-		if aws.StringValue(cluster.State) == cloudhsmv2.ClusterStateUninitialized {
-			certs["cluster_csr"] = aws.StringValue(cluster.Certificates.ClusterCsr)
-			certs["aws_hardware_certificate"] = aws.StringValue(cluster.Certificates.AwsHardwareCertificate)
-			certs["hsm_certificate"] = aws.StringValue(cluster.Certificates.HsmCertificate)
-			certs["manufacturer_hardware_certificate"] = aws.StringValue(cluster.Certificates.ManufacturerHardwareCertificate)
-		} else if aws.StringValue(cluster.State) == cloudhsmv2.ClusterStateActive {
-			certs["cluster_certificate"] = aws.StringValue(cluster.Certificates.ClusterCertificate)
+		if cluster.State == types.ClusterStateUninitialized {
+			certs["cluster_csr"] = aws.ToString(cluster.Certificates.ClusterCsr)
+			certs["aws_hardware_certificate"] = aws.ToString(cluster.Certificates.AwsHardwareCertificate)
+			certs["hsm_certificate"] = aws.ToString(cluster.Certificates.HsmCertificate)
+			certs["manufacturer_hardware_certificate"] = aws.ToString(cluster.Certificates.ManufacturerHardwareCertificate)
+		} else if cluster.State == types.ClusterStateActive {
+			certs["cluster_certificate"] = aws.ToString(cluster.Certificates.ClusterCertificate)
 		}
 	}
 	if len(certs) > 0 {
@@ -267,3 +362,28 @@ func readClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface
 	}
 	return []map[string]interface{}{}
 }
+
+// tagsV2 returns cloudhsmv2 service tags for the AWS SDK for Go v2.
+func tagsV2(tags tftags.KeyValueTags) []types.Tag {
+	result := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		result = append(result, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return result
+}
+
+// keyValueTagsV2 creates tftags.KeyValueTags from cloudhsmv2 service tags for the AWS SDK for Go v2.
+func keyValueTagsV2(tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
@@ -1,18 +1,20 @@
 package cloudhsmv2
 
 import (
-	"fmt"
+	"context"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func DataSourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceClusterRead,
+		ReadWithoutTimeout: dataSourceClusterRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -74,57 +76,54 @@ func DataSourceCluster() *schema.Resource {
 	}
 }
 
-func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client()
 
 	clusterId := d.Get("cluster_id").(string)
-	filters := []*string{&clusterId}
 	log.Printf("[DEBUG] Reading CloudHSM v2 Cluster %s", clusterId)
-	result := int64(1)
 	input := &cloudhsmv2.DescribeClustersInput{
-		Filters: map[string][]*string{
-			"clusterIds": filters,
+		Filters: map[string][]string{
+			"clusterIds": {clusterId},
 		},
-		MaxResults: &result,
+		MaxResults: aws.Int32(1),
 	}
-	state := d.Get("cluster_state").(string)
-	states := []*string{&state}
-	if len(state) > 0 {
-		input.Filters["states"] = states
+	if state := d.Get("cluster_state").(string); len(state) > 0 {
+		input.Filters["states"] = []string{state}
 	}
-	out, err := conn.DescribeClusters(input)
+	out, err := conn.DescribeClusters(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error describing CloudHSM v2 Cluster: %w", err)
+		return diag.Errorf("describing CloudHSM v2 Cluster: %s", err)
 	}
 
-	var cluster *cloudhsmv2.Cluster
+	var cluster *types.Cluster
 	for _, c := range out.Clusters {
-		if aws.StringValue(c.ClusterId) == clusterId {
-			cluster = c
+		if aws.ToString(c.ClusterId) == clusterId {
+			c := c
+			cluster = &c
 			break
 		}
 	}
 
 	if cluster == nil {
-		return fmt.Errorf("cluster with id %s not found", clusterId)
+		return diag.Errorf("cluster with id %s not found", clusterId)
 	}
 
 	d.SetId(clusterId)
 	d.Set("vpc_id", cluster.VpcId)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set("cluster_state", cluster.State)
-	if err := d.Set("cluster_certificates", readClusterCertificates(cluster)); err != nil {
-		return fmt.Errorf("error setting cluster_certificates: %w", err)
+	if err := d.Set("cluster_certificates", flattenCertificates(cluster)); err != nil {
+		return diag.Errorf("setting cluster_certificates: %s", err)
 	}
 
 	var subnets []string
 	for _, sn := range cluster.SubnetMapping {
-		subnets = append(subnets, *sn)
+		subnets = append(subnets, sn)
 	}
 
 	if err := d.Set("subnet_ids", subnets); err != nil {
-		return fmt.Errorf("[DEBUG] Error saving Subnet IDs to state for CloudHSM v2 Cluster (%s): %w", d.Id(), err)
+		return diag.Errorf("setting subnet_ids: %s", err)
 	}
 
 	return nil
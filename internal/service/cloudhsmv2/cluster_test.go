@@ -1,17 +1,18 @@
 package cloudhsmv2_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudhsmv2 "github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccCluster_basic(t *testing.T) {
@@ -72,6 +73,70 @@ func testAccCluster_disappears(t *testing.T) {
 	})
 }
 
+func testAccCluster_mode(t *testing.T) {
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_mode("hsm2m.medium", "NON_FIPS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hsm_type", "hsm2m.medium"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "NON_FIPS"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates"},
+			},
+		},
+	})
+}
+
+func testAccCluster_backupRetentionPolicy(t *testing.T) {
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates"},
+			},
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "30"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCluster_Tags(t *testing.T) {
 	resourceName := "aws_cloudhsm_v2_cluster.test"
 
@@ -151,6 +216,29 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 `)
 }
 
+func testAccClusterConfig_mode(hsmType, mode string) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = %[1]q
+  mode       = %[2]q
+  subnet_ids = aws_subnet.test[*].id
+}
+`, hsmType, mode))
+}
+
+func testAccClusterConfig_backupRetentionPolicy(days int) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = "hsm1.medium"
+  subnet_ids = aws_subnet.test[*].id
+
+  backup_retention_policy {
+    value = %[1]d
+  }
+}
+`, days))
+}
+
 func testAccClusterConfig_tags1(tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
 resource "aws_cloudhsm_v2_cluster" "test" {
@@ -179,21 +267,24 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudhsm_v2_cluster" {
 			continue
 		}
-		cluster, err := tfcloudhsmv2.FindCluster(conn, rs.Primary.ID)
+
+		_, err := tfcloudhsmv2.FindClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
 
 		if err != nil {
 			return err
 		}
 
-		if cluster != nil && aws.StringValue(cluster.State) != cloudhsmv2.ClusterStateDeleted {
-			return fmt.Errorf("CloudHSM cluster still exists %s", cluster)
-		}
+		return fmt.Errorf("CloudHSM cluster %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -201,13 +292,13 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 
 func testAccCheckClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client()
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		_, err := tfcloudhsmv2.FindCluster(conn, it.Primary.ID)
+		_, err := tfcloudhsmv2.FindClusterByID(context.Background(), conn, it.Primary.ID)
 
 		if err != nil {
 			return fmt.Errorf("CloudHSM cluster not found: %s", err)
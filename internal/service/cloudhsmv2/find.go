@@ -1,51 +1,59 @@
 package cloudhsmv2
 
 import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindCluster(conn *cloudhsmv2.CloudHSMV2, id string) (*cloudhsmv2.Cluster, error) {
-	input := &cloudhsmv2.DescribeClustersInput{
-		Filters: map[string][]*string{
-			"clusterIds": aws.StringSlice([]string{id}),
+func FindClusterByID(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string) (*types.Cluster, error) {
+	input := &cloudhsmv2_sdkv2.DescribeClustersInput{
+		Filters: map[string][]string{
+			"clusterIds": {id},
 		},
 	}
 
-	var result *cloudhsmv2.Cluster
+	pages := cloudhsmv2_sdkv2.NewDescribeClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-	err := conn.DescribeClustersPages(input, func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+		if err != nil {
+			return nil, err
 		}
 
 		for _, cluster := range page.Clusters {
-			if cluster == nil {
+			cluster := cluster
+
+			if aws_sdkv2.ToString(cluster.ClusterId) != id {
 				continue
 			}
 
-			if aws.StringValue(cluster.ClusterId) == id {
-				result = cluster
-				break
+			if state := cluster.State; state == types.ClusterStateDeleted {
+				return nil, &resource.NotFoundError{
+					Message:     string(state),
+					LastRequest: input,
+				}
 			}
-		}
 
-		return !lastPage
-	})
-
-	if err != nil {
-		return nil, err
+			return &cluster, nil
+		}
 	}
 
-	return result, nil
+	return nil, tfresource.NewEmptyResultError(input)
 }
 
-func FindHSM(conn *cloudhsmv2.CloudHSMV2, hsmID string, eniID string) (*cloudhsmv2.Hsm, error) {
+func FindHSM(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, hsmID string, eniID string) (*cloudhsmv2.Hsm, error) {
 	input := &cloudhsmv2.DescribeClustersInput{}
 
 	var result *cloudhsmv2.Hsm
 
-	err := conn.DescribeClustersPages(input, func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
+	err := conn.DescribeClustersPagesWithContext(ctx, input, func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
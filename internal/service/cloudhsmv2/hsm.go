@@ -1,24 +1,25 @@
 package cloudhsmv2
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func ResourceHSM() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHSMCreate,
-		Read:   resourceHSMRead,
-		Delete: resourceHSMDelete,
+		CreateWithoutTimeout: resourceHSMCreate,
+		ReadWithoutTimeout:   resourceHSMRead,
+		DeleteWithoutTimeout: resourceHSMDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -72,29 +73,30 @@ func ResourceHSM() *schema.Resource {
 	}
 }
 
-func resourceHSMCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHSMCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+	client := meta.(*conns.AWSClient).CloudHSMV2Client()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	// A cluster accepts only one HSM membership change at a time, so HSMs
+	// placed in several Availability Zones are created one after another.
+	cluster, err := waitClusterStable(ctx, client, clusterID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) to become stable: %s", clusterID, err)
+	}
 
 	input := &cloudhsmv2.CreateHsmInput{
-		ClusterId: aws.String(d.Get("cluster_id").(string)),
+		ClusterId: aws.String(clusterID),
 	}
 
 	if v, ok := d.GetOk("availability_zone"); ok {
 		input.AvailabilityZone = aws.String(v.(string))
 	} else {
-		cluster, err := FindCluster(conn, d.Get("cluster_id").(string))
-
-		if err != nil {
-			return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): %w", d.Id(), err)
-		}
-
-		if cluster == nil {
-			return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): not found for subnet mappings", d.Id())
-		}
-
-		subnetId := d.Get("subnet_id").(string)
+		subnetID := d.Get("subnet_id").(string)
 		for az, sn := range cluster.SubnetMapping {
-			if aws.StringValue(sn) == subnetId {
+			if sn == subnetID {
 				input.AvailabilityZone = aws.String(az)
 			}
 		}
@@ -104,35 +106,37 @@ func resourceHSMCreate(d *schema.ResourceData, meta interface{}) error {
 		input.IpAddress = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] CloudHSMv2 HSM create %s", input)
-
-	output, err := conn.CreateHsm(input)
+	output, err := conn.CreateHsmWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating CloudHSMv2 HSM: %w", err)
+		return diag.Errorf("creating CloudHSMv2 HSM: %s", err)
 	}
 
 	d.SetId(aws.StringValue(output.Hsm.HsmId))
 
-	if _, err := waitHSMActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for CloudHSMv2 HSM (%s) creation: %w", d.Id(), err)
+	if _, err := waitHSMActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CloudHSMv2 HSM (%s) create: %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterStable(ctx, client, clusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CloudHSMv2 Cluster (%s) to become stable: %s", clusterID, err)
 	}
 
-	return resourceHSMRead(d, meta)
+	return resourceHSMRead(ctx, d, meta)
 }
 
-func resourceHSMRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHSMRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
 
-	hsm, err := FindHSM(conn, d.Id(), d.Get("hsm_eni_id").(string))
+	hsm, err := FindHSM(ctx, conn, d.Id(), d.Get("hsm_eni_id").(string))
 
 	if err != nil {
-		return fmt.Errorf("error reading CloudHSMv2 HSM (%s): %w", d.Id(), err)
+		return diag.Errorf("reading CloudHSMv2 HSM (%s): %s", d.Id(), err)
 	}
 
 	if hsm == nil {
 		if d.IsNewResource() {
-			return fmt.Errorf("error reading CloudHSMv2 HSM (%s): not found after creation", d.Id())
+			return diag.Errorf("reading CloudHSMv2 HSM (%s): not found after creation", d.Id())
 		}
 
 		log.Printf("[WARN] CloudHSMv2 HSM (%s) not found, removing from state", d.Id())
@@ -158,7 +162,7 @@ func resourceHSMRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceHSMDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHSMDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
 	clusterId := d.Get("cluster_id").(string)
 
@@ -168,18 +172,18 @@ func resourceHSMDelete(d *schema.ResourceData, meta interface{}) error {
 		HsmId:     aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteHsm(input)
+	_, err := conn.DeleteHsmWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, cloudhsmv2.ErrCodeCloudHsmResourceNotFoundException) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting CloudHSMv2 HSM (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting CloudHSMv2 HSM (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitHSMDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for CloudHSMv2 HSM (%s) deletion: %w", d.Id(), err)
+	if _, err := waitHSMDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CloudHSMv2 HSM (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
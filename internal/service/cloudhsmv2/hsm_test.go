@@ -1,6 +1,7 @@
 package cloudhsmv2_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -213,7 +214,7 @@ func testAccCheckHSMDestroy(s *terraform.State) error {
 			continue
 		}
 
-		hsm, err := tfcloudhsmv2.FindHSM(conn, rs.Primary.ID, rs.Primary.Attributes["hsm_eni_id"])
+		hsm, err := tfcloudhsmv2.FindHSM(context.Background(), conn, rs.Primary.ID, rs.Primary.Attributes["hsm_eni_id"])
 
 		if err != nil {
 			return err
@@ -236,7 +237,7 @@ func testAccCheckHSMExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		_, err := tfcloudhsmv2.FindHSM(conn, it.Primary.ID, it.Primary.Attributes["hsm_eni_id"])
+		_, err := tfcloudhsmv2.FindHSM(context.Background(), conn, it.Primary.ID, it.Primary.Attributes["hsm_eni_id"])
 		if err != nil {
 			return fmt.Errorf("CloudHSM cluster not found: %s", err)
 		}
@@ -1,30 +1,34 @@
 package cloudhsmv2
 
 import (
+	"context"
+
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func statusClusterState(conn *cloudhsmv2.CloudHSMV2, id string) resource.StateRefreshFunc {
+func statusClusterState(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		cluster, err := FindCluster(conn, id)
+		output, err := FindClusterByID(ctx, conn, id)
 
-		if err != nil {
-			return nil, "", err
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
-		if cluster == nil {
-			return nil, "", nil
+		if err != nil {
+			return nil, "", err
 		}
 
-		return cluster, aws.StringValue(cluster.State), err
+		return output, string(output.State), nil
 	}
 }
 
-func statusHSMState(conn *cloudhsmv2.CloudHSMV2, id string) resource.StateRefreshFunc {
+func statusHSMState(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		hsm, err := FindHSM(conn, id, "")
+		hsm, err := FindHSM(ctx, conn, id, "")
 
 		if err != nil {
 			return nil, "", err
@@ -1,86 +1,116 @@
 package cloudhsmv2
 
 import (
+	"context"
 	"time"
 
+	cloudhsmv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
-func waitClusterActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+func waitClusterActive(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			cloudhsmv2.ClusterStateCreateInProgress,
-			cloudhsmv2.ClusterStateInitializeInProgress,
-		},
-		Target:     []string{cloudhsmv2.ClusterStateActive},
-		Refresh:    statusClusterState(conn, id),
+		Pending:    enum.Slice(types.ClusterStateCreateInProgress, types.ClusterStateInitializeInProgress),
+		Target:     enum.Slice(types.ClusterStateActive),
+		Refresh:    statusClusterState(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+	if v, ok := outputRaw.(*types.Cluster); ok {
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitClusterDeleted(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{cloudhsmv2.ClusterStateDeleteInProgress},
-		Target:     []string{cloudhsmv2.ClusterStateDeleted},
-		Refresh:    statusClusterState(conn, id),
+		Pending:    enum.Slice(types.ClusterStateDeleteInProgress),
+		Target:     []string{},
+		Refresh:    statusClusterState(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*types.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUninitialized(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    enum.Slice(types.ClusterStateCreateInProgress, types.ClusterStateInitializeInProgress),
+		Target:     enum.Slice(types.ClusterStateUninitialized),
+		Refresh:    statusClusterState(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+	if v, ok := outputRaw.(*types.Cluster); ok {
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitClusterUninitialized(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+// waitClusterStable waits for any in-progress cluster operation (creation,
+// modification or HSM membership changes) to complete.
+func waitClusterStable(ctx context.Context, conn *cloudhsmv2_sdkv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{
-			cloudhsmv2.ClusterStateCreateInProgress,
-			cloudhsmv2.ClusterStateInitializeInProgress,
-		},
-		Target:     []string{cloudhsmv2.ClusterStateUninitialized},
-		Refresh:    statusClusterState(conn, id),
+		Pending: enum.Slice(
+			types.ClusterStateCreateInProgress,
+			types.ClusterStateInitializeInProgress,
+			types.ClusterStateModifyInProgress,
+			types.ClusterStateRollbackInProgress,
+			types.ClusterStateUpdateInProgress,
+		),
+		Target: enum.Slice(
+			types.ClusterStateActive,
+			types.ClusterStateDegraded,
+			types.ClusterStateInitialized,
+			types.ClusterStateUninitialized,
+		),
+		Refresh:    statusClusterState(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+	if v, ok := outputRaw.(*types.Cluster); ok {
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitHSMActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Hsm, error) {
+func waitHSMActive(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Hsm, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{cloudhsmv2.HsmStateCreateInProgress},
 		Target:     []string{cloudhsmv2.HsmStateActive},
-		Refresh:    statusHSMState(conn, id),
+		Refresh:    statusHSMState(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
@@ -89,17 +119,17 @@ func waitHSMActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration
 	return nil, err
 }
 
-func waitHSMDeleted(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Hsm, error) {
+func waitHSMDeleted(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Hsm, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{cloudhsmv2.HsmStateDeleteInProgress},
 		Target:     []string{},
-		Refresh:    statusHSMState(conn, id),
+		Refresh:    statusHSMState(ctx, conn, id),
 		Timeout:    timeout,
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -35,6 +37,30 @@ func ResourceCompositeAlarm() *schema.Resource {
 				Default:  true,
 				ForceNew: true,
 			},
+			"actions_suppressor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1600),
+						},
+						"extension_period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"wait_period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"alarm_actions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -89,7 +115,10 @@ func ResourceCompositeAlarm() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCompositeAlarmCustomizeDiff,
+		),
 	}
 }
 
@@ -171,6 +200,14 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("actions_enabled", alarm.ActionsEnabled)
 
+	if alarm.ActionsSuppressor != nil {
+		if err := d.Set("actions_suppressor", []interface{}{flattenActionsSuppressor(alarm)}); err != nil {
+			return diag.Errorf("error setting actions_suppressor: %s", err)
+		}
+	} else {
+		d.Set("actions_suppressor", nil)
+	}
+
 	if err := d.Set("alarm_actions", flex.FlattenStringSet(alarm.AlarmActions)); err != nil {
 		return diag.Errorf("error setting alarm_actions: %s", err)
 	}
@@ -272,6 +309,14 @@ func expandPutCompositeAlarmInput(d *schema.ResourceData, meta interface{}) clou
 		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
 	}
 
+	if v, ok := d.GetOk("actions_suppressor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		out.ActionsSuppressor = aws.String(tfMap["alarm"].(string))
+		out.ActionsSuppressorExtensionPeriod = aws.Int64(int64(tfMap["extension_period"].(int)))
+		out.ActionsSuppressorWaitPeriod = aws.Int64(int64(tfMap["wait_period"].(int)))
+	}
+
 	if v, ok := d.GetOk("alarm_actions"); ok {
 		out.AlarmActions = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -302,3 +347,42 @@ func expandPutCompositeAlarmInput(d *schema.ResourceData, meta interface{}) clou
 
 	return out
 }
+
+func flattenActionsSuppressor(alarm *cloudwatch.CompositeAlarm) map[string]interface{} {
+	return map[string]interface{}{
+		"alarm":            aws.StringValue(alarm.ActionsSuppressor),
+		"extension_period": aws.Int64Value(alarm.ActionsSuppressorExtensionPeriod),
+		"wait_period":      aws.Int64Value(alarm.ActionsSuppressorWaitPeriod),
+	}
+}
+
+func resourceCompositeAlarmCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("alarm_rule") || !diff.NewValueKnown("alarm_rule") || !diff.NewValueKnown("alarm_name") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+	name := diff.Get("alarm_name").(string)
+
+	// Rules of other composite alarms are read from CloudWatch, so a cycle introduced by
+	// another alarm changed in the same plan is only detected by the service on apply.
+	cycle, err := findCompositeAlarmRuleCycle(name, diff.Get("alarm_rule").(string), func(alarmName string) (string, error) {
+		alarm, err := FindCompositeAlarmByName(ctx, conn, alarmName)
+
+		if err != nil || alarm == nil {
+			return "", err
+		}
+
+		return aws.StringValue(alarm.AlarmRule), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("checking CloudWatch Composite Alarm (%s) alarm_rule for cycles: %w", name, err)
+	}
+
+	if len(cycle) > 0 {
+		return fmt.Errorf("alarm_rule of CloudWatch Composite Alarm (%s) creates a cycle: %s", name, strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
@@ -377,6 +377,71 @@ func TestAccCloudWatchCompositeAlarm_updateAlarmRule(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
+	suffix := sdkacctest.RandString(8)
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 120, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "actions_suppressor.0.alarm", "aws_cloudwatch_metric_alarm.suppressor", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCompositeAlarmConfig_actionsSuppressor(suffix, 300, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.extension_period", "120"),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.0.wait_period", "300"),
+				),
+			},
+			{
+				Config: testAccCompositeAlarmConfig_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_suppressor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchCompositeAlarm_alarmRuleCycle(t *testing.T) {
+	suffix := sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositeAlarmConfig_cycle(suffix, false),
+			},
+			{
+				Config:      testAccCompositeAlarmConfig_cycle(suffix, true),
+				ExpectError: regexp.MustCompile(`creates a cycle`),
+			},
+		},
+	})
+}
+
 func testAccCheckCompositeAlarmDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
 
@@ -616,3 +681,53 @@ resource "aws_cloudwatch_composite_alarm" "test" {
 }
 `, suffix))
 }
+
+func testAccCompositeAlarmConfig_actionsSuppressor(suffix string, waitPeriod, extensionPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccCompositeAlarmBaseConfig(suffix),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "suppressor" {
+  alarm_name          = "tf-test-suppressor-%[1]s"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "StatusCheckFailed"
+  namespace           = "AWS/EC2"
+  period              = 60
+  statistic           = "Maximum"
+  threshold           = 1
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = "tf-test-composite-%[1]s"
+  alarm_rule = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+
+  actions_suppressor {
+    alarm            = aws_cloudwatch_metric_alarm.suppressor.alarm_name
+    extension_period = %[3]d
+    wait_period      = %[2]d
+  }
+}
+`, suffix, waitPeriod, extensionPeriod))
+}
+
+func testAccCompositeAlarmConfig_cycle(suffix string, cycle bool) string {
+	// The parent's rule refers to the child by name rather than by reference to avoid a dependency cycle.
+	rule := `ALARM(${aws_cloudwatch_metric_alarm.test[0].alarm_name})`
+	if cycle {
+		rule = fmt.Sprintf("ALARM(tf-test-composite-child-%s)", suffix)
+	}
+
+	return acctest.ConfigCompose(
+		testAccCompositeAlarmBaseConfig(suffix),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "parent" {
+  alarm_name = "tf-test-composite-parent-%[1]s"
+  alarm_rule = %[2]q
+}
+
+resource "aws_cloudwatch_composite_alarm" "child" {
+  alarm_name = "tf-test-composite-child-%[1]s"
+  alarm_rule = "ALARM(${aws_cloudwatch_composite_alarm.parent.alarm_name})"
+}
+`, suffix, rule))
+}
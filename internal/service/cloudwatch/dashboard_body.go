@@ -0,0 +1,87 @@
+package cloudwatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// NormalizeDashboardBody returns a dashboard body JSON document in canonical form.
+// Cross-account metrics and widgets may specify the source account ID ("accountId") as either a
+// JSON number or a string; CloudWatch always returns it as a 12-digit string, so numbers are
+// converted to that representation.
+func NormalizeDashboardBody(v string) (string, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(v))
+	decoder.UseNumber()
+
+	var body interface{}
+
+	if err := decoder.Decode(&body); err != nil {
+		return "", err
+	}
+
+	if err := normalizeDashboardAccountIDs(body); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.NormalizeJSON(string(b))
+}
+
+func normalizeDashboardAccountIDs(v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if n, ok := value.(json.Number); ok && key == "accountId" {
+				id, err := strconv.ParseInt(n.String(), 10, 64)
+
+				if err != nil {
+					return fmt.Errorf("invalid accountId (%s): %w", n, err)
+				}
+
+				v[key] = fmt.Sprintf("%012d", id)
+
+				continue
+			}
+
+			if err := normalizeDashboardAccountIDs(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := normalizeDashboardAccountIDs(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// suppressEquivalentDashboardBodies suppresses differences between dashboard bodies that are
+// equivalent once their account IDs are normalized.
+func suppressEquivalentDashboardBodies(k, old, new string, d *schema.ResourceData) bool {
+	oldBody, err := NormalizeDashboardBody(old)
+
+	if err != nil {
+		return false
+	}
+
+	newBody, err := NormalizeDashboardBody(new)
+
+	if err != nil {
+		return false
+	}
+
+	return flex.SuppressEquivalentJSON()(k, oldBody, newBody, d)
+}
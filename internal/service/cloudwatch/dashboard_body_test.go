@@ -0,0 +1,64 @@
+package cloudwatch
+
+import (
+	"testing"
+)
+
+func TestNormalizeDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "no account ID",
+			input:    `{"widgets": [{"type": "text", "x": 0, "y": 7, "width": 3, "height": 3, "properties": {"markdown": "Hello world"}}]}`,
+			expected: `{"widgets":[{"height":3,"properties":{"markdown":"Hello world"},"type":"text","width":3,"x":0,"y":7}]}`,
+		},
+		{
+			name:     "string account ID",
+			input:    `{"widgets": [{"type": "metric", "properties": {"accountId": "012345678901", "metrics": [["AWS/RDS", "CPUUtilization", {"accountId": "012345678901"}]]}}]}`,
+			expected: `{"widgets":[{"properties":{"accountId":"012345678901","metrics":[["AWS/RDS","CPUUtilization",{"accountId":"012345678901"}]]},"type":"metric"}]}`,
+		},
+		{
+			name:     "numeric account ID",
+			input:    `{"widgets": [{"type": "metric", "properties": {"accountId": 12345678901, "metrics": [["AWS/RDS", "CPUUtilization", {"accountId": 12345678901}]]}}]}`,
+			expected: `{"widgets":[{"properties":{"accountId":"012345678901","metrics":[["AWS/RDS","CPUUtilization",{"accountId":"012345678901"}]]},"type":"metric"}]}`,
+		},
+		{
+			name:     "other numbers preserved",
+			input:    `{"widgets": [{"type": "metric", "properties": {"period": 300, "yAxis": {"left": {"min": 0.5}}}}]}`,
+			expected: `{"widgets":[{"properties":{"period":300,"yAxis":{"left":{"min":0.5}}},"type":"metric"}]}`,
+		},
+		{
+			name:          "invalid account ID",
+			input:         `{"widgets": [{"properties": {"accountId": 1.5}}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "invalid JSON",
+			input:         `{"widgets": [`,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeDashboardBody(testCase.input)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("NormalizeDashboardBody() err %t, want %t: %s", got, want, err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("NormalizeDashboardBody() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -112,6 +112,33 @@ func TestAccCloudWatchDashboard_updateName(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_crossAccountMetric(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_crossAccountMetric(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_name", testAccDashboardName(rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardExists(n string, dashboard *cloudwatch.GetDashboardOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -241,6 +268,39 @@ EOF
 `, rInt, updatedWidget)
 }
 
+func testAccDashboardConfig_crossAccountMetric(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = "terraform-test-dashboard-%[1]d"
+
+  dashboard_body = jsonencode({
+    widgets = [{
+      type   = "metric"
+      x      = 0
+      y      = 0
+      width  = 12
+      height = 6
+
+      properties = {
+        accountId = tonumber(data.aws_caller_identity.current.account_id)
+        metrics = [
+          ["AWS/RDS", "CPUUtilization", { accountId = tonumber(data.aws_caller_identity.current.account_id) }],
+        ]
+        period = 300
+        region = data.aws_region.current.name
+        stat   = "Average"
+        title  = "RDS CPU"
+      }
+    }]
+  })
+}
+`, rInt)
+}
+
 func testAccCheckDashboardBodyIsExpected(resourceName, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
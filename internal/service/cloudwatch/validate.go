@@ -3,6 +3,7 @@ package cloudwatch
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func validDashboardName(v interface{}, k string) (ws []string, errors []error) {
@@ -36,3 +37,65 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+var alarmRuleFunctionRegexp = regexp.MustCompile(`\b(?:ALARM|OK|INSUFFICIENT_DATA)\s*\(\s*(?:"([^"]*)"|'([^']*)'|([^\s()]+))\s*\)`)
+
+// alarmRuleAlarmNames returns the names of the alarms referenced by a composite alarm rule.
+// Alarms referenced by ARN are returned by name.
+func alarmRuleAlarmNames(rule string) []string {
+	var names []string
+
+	for _, match := range alarmRuleFunctionRegexp.FindAllStringSubmatch(rule, -1) {
+		name := match[1] + match[2] + match[3]
+
+		// arn:${Partition}:cloudwatch:${Region}:${Account}:alarm:${AlarmName}
+		if strings.HasPrefix(name, "arn:") {
+			if i := strings.Index(name, ":alarm:"); i != -1 {
+				name = name[i+len(":alarm:"):]
+			}
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// findCompositeAlarmRuleCycle follows the composite alarms referenced by rule and returns the
+// chain of alarm names that leads back to name, if any. lookup returns the rule of a composite
+// alarm, or an empty string if the alarm is not a composite alarm.
+func findCompositeAlarmRuleCycle(name, rule string, lookup func(string) (string, error)) ([]string, error) {
+	visited := map[string]bool{}
+
+	var visit func(path []string, rule string) ([]string, error)
+	visit = func(path []string, rule string) ([]string, error) {
+		for _, referenced := range alarmRuleAlarmNames(rule) {
+			if referenced == name {
+				return append(path, referenced), nil
+			}
+
+			if visited[referenced] {
+				continue
+			}
+			visited[referenced] = true
+
+			referencedRule, err := lookup(referenced)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if referencedRule == "" {
+				continue
+			}
+
+			if cycle, err := visit(append(path[:len(path):len(path)], referenced), referencedRule); err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	return visit([]string{name}, rule)
+}
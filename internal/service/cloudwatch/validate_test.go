@@ -65,3 +65,65 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestAlarmRuleAlarmNames(t *testing.T) {
+	rule := `ALARM("cpu-high") OR OK(arn:aws:cloudwatch:us-east-1:123456789012:alarm:memory-high) AND NOT INSUFFICIENT_DATA('disk full') OR ALARM(network)`
+	expected := []string{"cpu-high", "memory-high", "disk full", "network"}
+
+	got := alarmRuleAlarmNames(rule)
+
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %q at index %d, got %q", expected[i], i, got[i])
+		}
+	}
+}
+
+func TestFindCompositeAlarmRuleCycle(t *testing.T) {
+	rules := map[string]string{
+		"b": `ALARM("c") OR ALARM("metric")`,
+		"c": `ALARM("a")`,
+		"d": `ALARM("metric")`,
+	}
+	lookup := func(name string) (string, error) {
+		return rules[name], nil
+	}
+
+	cases := []struct {
+		Name     string
+		Rule     string
+		Expected string
+	}{
+		{
+			Name:     "a",
+			Rule:     `ALARM("d") OR ALARM("metric")`,
+			Expected: "",
+		},
+		{
+			Name:     "a",
+			Rule:     `ALARM("a")`,
+			Expected: "a -> a",
+		},
+		{
+			Name:     "a",
+			Rule:     `ALARM("d") OR ALARM("b")`,
+			Expected: "a -> b -> c -> a",
+		},
+	}
+
+	for _, tc := range cases {
+		cycle, err := findCompositeAlarmRuleCycle(tc.Name, tc.Rule, lookup)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := strings.Join(cycle, " -> "); got != tc.Expected {
+			t.Errorf("rule %q: expected cycle %q, got %q", tc.Rule, tc.Expected, got)
+		}
+	}
+}
@@ -168,10 +168,10 @@ func resourceRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("upstream") {
-		if v, ok := d.GetOk("upstream"); ok {
-			params.Upstreams = expandUpstreams(v.([]interface{}))
-			needsUpdate = true
-		}
+		// Upstreams are searched in the order that they are listed.
+		// An empty list removes all upstreams.
+		params.Upstreams = expandUpstreams(d.Get("upstream").([]interface{}))
+		needsUpdate = true
 	}
 
 	if needsUpdate {
@@ -182,32 +182,38 @@ func resourceRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("external_connections") {
-		if v, ok := d.GetOk("external_connections"); ok {
-			externalConnection := v.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.AssociateExternalConnectionInput{
+		// A repository can have only one external connection, so the old connection
+		// must be disassociated before the new one is associated.
+		o, n := d.GetChange("external_connections")
+
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			externalConnection := v[0].(map[string]interface{})
+			input := &codeartifact.DisassociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.AssociateExternalConnection(input)
-			if err != nil {
-				return fmt.Errorf("error associating external connection to CodeArtifact repository: %w", err)
+			_, err := conn.DisassociateExternalConnection(input)
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, codeartifact.ErrCodeResourceNotFoundException) {
+				return fmt.Errorf("error disassociating external connection to CodeArtifact repository: %w", err)
 			}
-		} else {
-			oldConn, _ := d.GetChange("external_connections")
-			externalConnection := oldConn.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.DisassociateExternalConnectionInput{
+		}
+
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			externalConnection := v[0].(map[string]interface{})
+			input := &codeartifact.AssociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.DisassociateExternalConnection(input)
+			_, err := conn.AssociateExternalConnection(input)
 			if err != nil {
-				return fmt.Errorf("error disassociating external connection to CodeArtifact repository: %w", err)
+				return fmt.Errorf("error associating external connection to CodeArtifact repository: %w", err)
 			}
 		}
 	}
@@ -256,16 +262,12 @@ func resourceRepositoryRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("administrator_account", sm.Repository.AdministratorAccount)
 	d.Set("description", sm.Repository.Description)
 
-	if sm.Repository.Upstreams != nil {
-		if err := d.Set("upstream", flattenUpstreams(sm.Repository.Upstreams)); err != nil {
-			return fmt.Errorf("[WARN] Error setting upstream: %w", err)
-		}
+	if err := d.Set("upstream", flattenUpstreams(sm.Repository.Upstreams)); err != nil {
+		return fmt.Errorf("[WARN] Error setting upstream: %w", err)
 	}
 
-	if sm.Repository.ExternalConnections != nil {
-		if err := d.Set("external_connections", flattenExternalConnections(sm.Repository.ExternalConnections)); err != nil {
-			return fmt.Errorf("[WARN] Error setting external_connections: %w", err)
-		}
+	if err := d.Set("external_connections", flattenExternalConnections(sm.Repository.ExternalConnections)); err != nil {
+		return fmt.Errorf("[WARN] Error setting external_connections: %w", err)
 	}
 
 	tags, err := ListTags(conn, arn)
@@ -199,6 +199,22 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream1", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_upstreams2Reordered(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream1", rName)),
+				),
+			},
+			{
+				Config: testAccRepositoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -214,7 +230,7 @@ func testAccRepository_externalConnection(t *testing.T) {
 		CheckDestroy:             testAccCheckRepositoryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRepositoryConfig_externalConnection(rName),
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -236,7 +252,7 @@ func testAccRepository_externalConnection(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccRepositoryConfig_externalConnection(rName),
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -245,6 +261,16 @@ func testAccRepository_externalConnection(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "external_connections.0.status", "AVAILABLE"),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:pypi"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.external_connection_name", "public:pypi"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.package_format", "pypi"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.status", "AVAILABLE"),
+				),
+			},
 		},
 	})
 }
@@ -420,19 +446,46 @@ resource "aws_codeartifact_repository" "test" {
 `, rName)
 }
 
-func testAccRepositoryConfig_externalConnection(rName string) string {
+func testAccRepositoryConfig_upstreams2Reordered(rName string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "upstream1" {
+  repository = "%[1]s-upstream1"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "upstream2" {
+  repository = "%[1]s-upstream2"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
 resource "aws_codeartifact_repository" "test" {
   repository = %[1]q
   domain     = aws_codeartifact_domain.test.domain
 
-  external_connections {
-    external_connection_name = "public:npmjs"
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream2.repository
+  }
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream1.repository
   }
 }
 `, rName)
 }
 
+func testAccRepositoryConfig_externalConnection(rName, externalConnectionName string) string {
+	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+
+  external_connections {
+    external_connection_name = %[2]q
+  }
+}
+`, rName, externalConnectionName)
+}
+
 func testAccRepositoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_codeartifact_repository" "test" {
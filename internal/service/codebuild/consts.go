@@ -1,8 +1,13 @@
 package codebuild
 
-import "time"
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
 
 const (
+	ResNameFleet       = "Fleet"
 	ResNameReportGroup = "Report Group"
 	ResNameWebhook     = "Webhook"
 )
@@ -10,3 +15,13 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// GitHub Actions runner webhooks are not yet modeled by the AWS SDK for Go v1.
+	webhookEventWorkflowJobQueued = "WORKFLOW_JOB_QUEUED"
+	webhookFilterTypeWorkflowName = "WORKFLOW_NAME"
+)
+
+func webhookFilterType_Values() []string {
+	return append(codebuild.WebhookFilterType_Values(), webhookFilterTypeWorkflowName)
+}
@@ -1,6 +1,10 @@
 package codebuild
 
 import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	codebuild_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -33,12 +37,12 @@ func FindReportGroupByARN(conn *codebuild.CodeBuild, arn string) (*codebuild.Rep
 	return reportGroup, nil
 }
 
-func FindProjectByARN(conn *codebuild.CodeBuild, arn string) (*codebuild.Project, error) {
+func FindProjectByARN(ctx context.Context, conn *codebuild.CodeBuild, arn string) (*codebuild.Project, error) {
 	input := &codebuild.BatchGetProjectsInput{
 		Names: []*string{aws.String(arn)},
 	}
 
-	output, err := conn.BatchGetProjects(input)
+	output, err := conn.BatchGetProjectsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +58,30 @@ func FindProjectByARN(conn *codebuild.CodeBuild, arn string) (*codebuild.Project
 	return output.Projects[0], nil
 }
 
+// FindProjectFleetARNByARN returns the ARN of the reserved capacity fleet a project
+// runs its builds on, or an empty string when the project uses on-demand capacity.
+func FindProjectFleetARNByARN(ctx context.Context, conn *codebuild_sdkv2.Client, arn string) (string, error) {
+	input := &codebuild_sdkv2.BatchGetProjectsInput{
+		Names: []string{arn},
+	}
+
+	output, err := conn.BatchGetProjects(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || len(output.Projects) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if environment := output.Projects[0].Environment; environment != nil && environment.Fleet != nil {
+		return aws_sdkv2.ToString(environment.Fleet.FleetArn), nil
+	}
+
+	return "", nil
+}
+
 func FindResourcePolicyByARN(conn *codebuild.CodeBuild, arn string) (*codebuild.GetResourcePolicyOutput, error) {
 	input := &codebuild.GetResourcePolicyInput{
 		ResourceArn: aws.String(arn),
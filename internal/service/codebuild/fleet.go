@@ -0,0 +1,640 @@
+package codebuild
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCreate,
+		ReadWithoutTimeout:   resourceFleetRead,
+		UpdateWithoutTimeout: resourceFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"compute_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.ComputeType](),
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.EnvironmentType](),
+			},
+			"fleet_service_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 128),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\-_]{1,127}$`), "must start with a letter or number and contain only letters, numbers, hyphens and underscores"),
+				),
+			},
+			"overflow_behavior": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.FleetOverflowBehavior](),
+			},
+			"scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"scaling_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.FleetScalingType](),
+						},
+						"target_tracking_scaling_configs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.FleetScalingMetricType](),
+									},
+									"target_value": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &codebuild.CreateFleetInput{
+		BaseCapacity:    aws.Int32(int32(d.Get("base_capacity").(int))),
+		ComputeType:     types.ComputeType(d.Get("compute_type").(string)),
+		EnvironmentType: types.EnvironmentType(d.Get("environment_type").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("fleet_service_role"); ok {
+		input.FleetServiceRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		input.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("overflow_behavior"); ok {
+		input.OverflowBehavior = types.FleetOverflowBehavior(v.(string))
+	}
+
+	if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScalingConfiguration = expandScalingConfigurationInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = fleetTags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandFleetVPCConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateFleet(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionCreating, ResNameFleet, name, err)
+	}
+
+	d.SetId(aws.ToString(output.Fleet.Arn))
+
+	if _, err := waitFleetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionWaitingForCreation, ResNameFleet, d.Id(), err)
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindFleetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CodeBuild, create.ErrActionReading, ResNameFleet, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionReading, ResNameFleet, d.Id(), err)
+	}
+
+	d.Set("arn", fleet.Arn)
+	d.Set("base_capacity", fleet.BaseCapacity)
+	d.Set("compute_type", fleet.ComputeType)
+	d.Set("created", aws.ToTime(fleet.Created).Format(time.RFC3339))
+	d.Set("environment_type", fleet.EnvironmentType)
+	d.Set("fleet_service_role", fleet.FleetServiceRole)
+	d.Set("image_id", fleet.ImageId)
+	d.Set("last_modified", aws.ToTime(fleet.LastModified).Format(time.RFC3339))
+	d.Set("name", fleet.Name)
+	d.Set("overflow_behavior", fleet.OverflowBehavior)
+	if err := d.Set("scaling_configuration", flattenScalingConfigurationOutput(fleet.ScalingConfiguration)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+	if err := d.Set("status", flattenFleetStatus(fleet.Status)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+	if err := d.Set("vpc_config", flattenFleetVPCConfig(fleet.VpcConfig)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	tags := fleetKeyValueTags(fleet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildClient()
+
+	input := &codebuild.UpdateFleetInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("base_capacity") {
+		input.BaseCapacity = aws.Int32(int32(d.Get("base_capacity").(int)))
+	}
+
+	if d.HasChange("compute_type") {
+		input.ComputeType = types.ComputeType(d.Get("compute_type").(string))
+	}
+
+	if d.HasChange("environment_type") {
+		input.EnvironmentType = types.EnvironmentType(d.Get("environment_type").(string))
+	}
+
+	if d.HasChange("fleet_service_role") {
+		input.FleetServiceRole = aws.String(d.Get("fleet_service_role").(string))
+	}
+
+	if d.HasChange("image_id") {
+		input.ImageId = aws.String(d.Get("image_id").(string))
+	}
+
+	if d.HasChange("overflow_behavior") {
+		input.OverflowBehavior = types.FleetOverflowBehavior(d.Get("overflow_behavior").(string))
+	}
+
+	if d.HasChange("scaling_configuration") {
+		// An empty scaling configuration removes any existing auto scaling.
+		input.ScalingConfiguration = &types.ScalingConfigurationInput{}
+
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfiguration = expandScalingConfigurationInput(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		// UpdateFleet replaces the full set of tags.
+		input.Tags = fleetTags(tftags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAWS())
+	}
+
+	if d.HasChange("vpc_config") {
+		input.VpcConfig = &types.VpcConfig{}
+
+		if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VpcConfig = expandFleetVPCConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	_, err := conn.UpdateFleet(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionUpdating, ResNameFleet, d.Id(), err)
+	}
+
+	if _, err := waitFleetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionWaitingForUpdate, ResNameFleet, d.Id(), err)
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildClient()
+
+	log.Printf("[INFO] Deleting CodeBuild Fleet: %s", d.Id())
+	_, err := conn.DeleteFleet(ctx, &codebuild.DeleteFleetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionDeleting, ResNameFleet, d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.CodeBuild, create.ErrActionWaitingForDeletion, ResNameFleet, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindFleetByARN(ctx context.Context, conn *codebuild.Client, arn string) (*types.Fleet, error) {
+	input := &codebuild.BatchGetFleetsInput{
+		Names: []string{arn},
+	}
+
+	output, err := conn.BatchGetFleets(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Fleets) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output.Fleets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.Fleets[0], nil
+}
+
+func statusFleet(ctx context.Context, conn *codebuild.Client, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFleetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.Status.StatusCode), nil
+	}
+}
+
+func waitFleetCreated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodeCreating),
+		Target:  enum.Slice(types.FleetStatusCodeActive),
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetUpdated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodeUpdating, types.FleetStatusCodeRotating),
+		Target:  enum.Slice(types.FleetStatusCodeActive),
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodePendingDeletion, types.FleetStatusCodeDeleting),
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandScalingConfigurationInput(tfMap map[string]interface{}) *types.ScalingConfigurationInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ScalingConfigurationInput{}
+
+	if v, ok := tfMap["max_capacity"].(int); ok && v != 0 {
+		apiObject.MaxCapacity = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["scaling_type"].(string); ok && v != "" {
+		apiObject.ScalingType = types.FleetScalingType(v)
+	}
+
+	if v, ok := tfMap["target_tracking_scaling_configs"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TargetTrackingScalingConfigs = expandTargetTrackingScalingConfigurations(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingConfigurations(tfList []interface{}) []types.TargetTrackingScalingConfiguration {
+	var apiObjects []types.TargetTrackingScalingConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.TargetTrackingScalingConfiguration{}
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			apiObject.MetricType = types.FleetScalingMetricType(v)
+		}
+
+		if v, ok := tfMap["target_value"].(float64); ok {
+			apiObject.TargetValue = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFleetVPCConfig(tfMap map[string]interface{}) *types.VpcConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Subnets = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["vpc_id"].(string); ok && v != "" {
+		apiObject.VpcId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenScalingConfigurationOutput(apiObject *types.ScalingConfigurationOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"desired_capacity":                aws.ToInt32(apiObject.DesiredCapacity),
+		"max_capacity":                    aws.ToInt32(apiObject.MaxCapacity),
+		"scaling_type":                    string(apiObject.ScalingType),
+		"target_tracking_scaling_configs": flattenTargetTrackingScalingConfigurations(apiObject.TargetTrackingScalingConfigs),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTargetTrackingScalingConfigurations(apiObjects []types.TargetTrackingScalingConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"metric_type":  string(apiObject.MetricType),
+			"target_value": aws.ToFloat64(apiObject.TargetValue),
+		})
+	}
+
+	return tfList
+}
+
+func flattenFleetStatus(apiObject *types.FleetStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"context":     string(apiObject.Context),
+		"message":     aws.ToString(apiObject.Message),
+		"status_code": string(apiObject.StatusCode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFleetVPCConfig(apiObject *types.VpcConfig) []interface{} {
+	if apiObject == nil || aws.ToString(apiObject.VpcId) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": apiObject.SecurityGroupIds,
+		"subnets":            apiObject.Subnets,
+		"vpc_id":             aws.ToString(apiObject.VpcId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+// fleetTags returns codebuild service tags for the fleet API, which is only
+// available in the AWS SDK for Go v2.
+func fleetTags(tags tftags.KeyValueTags) []types.Tag {
+	result := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		result = append(result, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return result
+}
+
+// fleetKeyValueTags creates tftags.KeyValueTags from codebuild fleet tags.
+func fleetKeyValueTags(tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
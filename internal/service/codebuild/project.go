@@ -8,15 +8,20 @@ import (
 	"regexp"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	codebuild_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -25,10 +30,10 @@ import (
 
 func ResourceProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectCreate,
-		Read:   resourceProjectRead,
-		Update: resourceProjectUpdate,
-		Delete: resourceProjectDelete,
+		CreateWithoutTimeout: resourceProjectCreate,
+		ReadWithoutTimeout:   resourceProjectRead,
+		UpdateWithoutTimeout: resourceProjectUpdate,
+		DeleteWithoutTimeout: resourceProjectDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -236,6 +241,20 @@ func ResourceProject() *schema.Resource {
 								},
 							},
 						},
+						"fleet": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fleet_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"image": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -726,12 +745,26 @@ func ResourceProject() *schema.Resource {
 				}
 				return fmt.Errorf(`cache location is required when cache type is %q`, cacheType.(string))
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				// Plan time validation for the buildspec of projects without a source
+				if sourceType := diff.Get("source.0.type").(string); sourceType != codebuild.SourceTypeNoSource {
+					return nil
+				}
+				if diff.NewValueKnown("source.0.buildspec") && diff.Get("source.0.buildspec").(string) == "" {
+					return fmt.Errorf("`buildspec` must be set when source's `type` is `NO_SOURCE`")
+				}
+				if diff.NewValueKnown("source.0.location") && diff.Get("source.0.location").(string) != "" {
+					return fmt.Errorf("`location` must be empty when source's `type` is `NO_SOURCE`")
+				}
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 	}
 }
 
-func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -745,16 +778,6 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	projectBatchConfig := expandBuildBatchConfig(d)
 	projectFileSystemLocations := expandProjectFileSystemLocations(d)
 
-	if aws.StringValue(projectSource.Type) == codebuild.SourceTypeNoSource {
-		if aws.StringValue(projectSource.Buildspec) == "" {
-			return fmt.Errorf("`buildspec` must be set when source's `type` is `NO_SOURCE`")
-		}
-
-		if aws.StringValue(projectSource.Location) != "" {
-			return fmt.Errorf("`location` must be empty when source's `type` is `NO_SOURCE`")
-		}
-	}
-
 	params := &codebuild.CreateProjectInput{
 		Environment:         projectEnv,
 		Name:                aws.String(d.Get("name").(string)),
@@ -814,10 +837,10 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 
 	var resp *codebuild.CreateProjectOutput
 	// Handle IAM eventual consistency
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var err error
 
-		resp, err = conn.CreateProject(params)
+		resp, err = conn.CreateProjectWithContext(ctx, params)
 		if err != nil {
 			// InvalidInputException: CodeBuild is not authorized to perform
 			// InvalidInputException: Not authorized to perform DescribeSecurityGroups
@@ -832,14 +855,20 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		resp, err = conn.CreateProject(params)
+		resp, err = conn.CreateProjectWithContext(ctx, params)
 	}
 	if err != nil {
-		return fmt.Errorf("Error creating CodeBuild project: %w", err)
+		return errs.AppendErrorf(diags, "creating CodeBuild Project: %s", err)
 	}
 
 	d.SetId(aws.StringValue(resp.Project.Arn))
 
+	if v, ok := d.GetOk("environment.0.fleet.0.fleet_arn"); ok {
+		if err := updateProjectEnvironmentFleet(ctx, meta.(*conns.AWSClient).CodeBuildClient(), d.Id(), projectEnv, v.(string)); err != nil {
+			return errs.AppendErrorf(diags, "updating CodeBuild Project (%s) fleet: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("project_visibility"); ok && v.(string) != codebuild.ProjectVisibilityTypePrivate {
 		visInput := &codebuild.UpdateProjectVisibilityInput{
 			ProjectArn:        aws.String(d.Id()),
@@ -850,12 +879,12 @@ func resourceProjectCreate(d *schema.ResourceData, meta interface{}) error {
 			visInput.ResourceAccessRole = aws.String(v.(string))
 		}
 
-		_, err = conn.UpdateProjectVisibility(visInput)
+		_, err = conn.UpdateProjectVisibilityWithContext(ctx, visInput)
 		if err != nil {
-			return fmt.Errorf("Error updating CodeBuild project (%s) visibility: %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "updating CodeBuild Project (%s) visibility: %s", d.Id(), err)
 		}
 	}
-	return resourceProjectRead(d, meta)
+	return append(diags, resourceProjectRead(ctx, d, meta)...)
 }
 
 func expandProjectSecondarySourceVersions(ssv *schema.Set) []*codebuild.ProjectSourceVersion {
@@ -1335,65 +1364,86 @@ func expandProjectSourceData(data map[string]interface{}) codebuild.ProjectSourc
 	return projectSource
 }
 
-func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	project, err := FindProjectByARN(conn, d.Id())
+	project, err := FindProjectByARN(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] CodeBuild Project (%s) not found, removing from state", d.Id())
 		d.SetId("")
-		return nil
+		return diags
 	}
 
 	if err != nil {
-		return fmt.Errorf("error Listing CodeBuild Projects: %w", err)
+		return errs.AppendErrorf(diags, "reading CodeBuild Project (%s): %s", d.Id(), err)
 	}
 
 	if err := d.Set("artifacts", flattenProjectArtifacts(project.Artifacts)); err != nil {
-		return fmt.Errorf("error setting artifacts: %w", err)
+		return errs.AppendErrorf(diags, "setting artifacts: %s", err)
+	}
+
+	environment := flattenProjectEnvironment(project.Environment)
+
+	// The fleet is only available in the AWS SDK for Go v2.
+	// Look it up only for projects configured with a fleet, or on import.
+	if _, ok := d.GetOk("environment.0.fleet.0.fleet_arn"); ok || len(d.Get("environment").([]interface{})) == 0 {
+		fleetARN, err := FindProjectFleetARNByARN(ctx, meta.(*conns.AWSClient).CodeBuildClient(), d.Id())
+
+		if err != nil {
+			return errs.AppendErrorf(diags, "reading CodeBuild Project (%s) fleet: %s", d.Id(), err)
+		}
+
+		if fleetARN != "" {
+			environment[0].(map[string]interface{})["fleet"] = []interface{}{
+				map[string]interface{}{
+					"fleet_arn": fleetARN,
+				},
+			}
+		}
 	}
 
-	if err := d.Set("environment", flattenProjectEnvironment(project.Environment)); err != nil {
-		return fmt.Errorf("error setting environment: %w", err)
+	if err := d.Set("environment", environment); err != nil {
+		return errs.AppendErrorf(diags, "setting environment: %s", err)
 	}
 
 	if err := d.Set("file_system_locations", flattenProjectFileSystemLocations(project.FileSystemLocations)); err != nil {
-		return fmt.Errorf("error setting file_system_locations: %w", err)
+		return errs.AppendErrorf(diags, "setting file_system_locations: %s", err)
 	}
 
 	if err := d.Set("cache", flattenProjectCache(project.Cache)); err != nil {
-		return fmt.Errorf("error setting cache: %w", err)
+		return errs.AppendErrorf(diags, "setting cache: %s", err)
 	}
 
 	if err := d.Set("logs_config", flattenLogsConfig(project.LogsConfig)); err != nil {
-		return fmt.Errorf("error setting logs_config: %w", err)
+		return errs.AppendErrorf(diags, "setting logs_config: %s", err)
 	}
 
 	if err := d.Set("secondary_artifacts", flattenProjectSecondaryArtifacts(project.SecondaryArtifacts)); err != nil {
-		return fmt.Errorf("error setting secondary_artifacts: %w", err)
+		return errs.AppendErrorf(diags, "setting secondary_artifacts: %s", err)
 	}
 
 	if err := d.Set("secondary_sources", flattenProjectSecondarySources(project.SecondarySources)); err != nil {
-		return fmt.Errorf("error setting secondary_sources: %w", err)
+		return errs.AppendErrorf(diags, "setting secondary_sources: %s", err)
 	}
 
 	if err := d.Set("secondary_source_version", flattenProjectSecondarySourceVersions(project.SecondarySourceVersions)); err != nil {
-		return fmt.Errorf("error setting secondary_source_version: %w", err)
+		return errs.AppendErrorf(diags, "setting secondary_source_version: %s", err)
 	}
 
 	if err := d.Set("source", flattenProjectSource(project.Source)); err != nil {
-		return fmt.Errorf("error setting source: %w", err)
+		return errs.AppendErrorf(diags, "setting source: %s", err)
 	}
 
 	if err := d.Set("vpc_config", flattenVPCConfig(project.VpcConfig)); err != nil {
-		return fmt.Errorf("error setting vpc_config: %w", err)
+		return errs.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
 	if err := d.Set("build_batch_config", flattenBuildBatchConfig(project.BuildBatchConfig)); err != nil {
-		return fmt.Errorf("error setting build_batch_config: %w", err)
+		return errs.AppendErrorf(diags, "setting build_batch_config: %s", err)
 	}
 
 	d.Set("arn", project.Arn)
@@ -1420,17 +1470,18 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return errs.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return errs.AppendErrorf(diags, "setting tags_all: %s", err)
 	}
 
-	return nil
+	return diags
 }
 
-func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -1445,9 +1496,9 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 			visInput.ResourceAccessRole = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateProjectVisibility(visInput)
+		_, err := conn.UpdateProjectVisibilityWithContext(ctx, visInput)
 		if err != nil {
-			return fmt.Errorf("Error updating CodeBuild project (%s) visibility: %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "updating CodeBuild Project (%s) visibility: %s", d.Id(), err)
 		}
 	}
 
@@ -1570,8 +1621,8 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 		params.Tags = Tags(tags.IgnoreAWS())
 
 		// Handle IAM eventual consistency
-		err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateProject(params)
+		err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+			_, err := conn.UpdateProjectWithContext(ctx, params)
 			if err != nil {
 				// InvalidInputException: CodeBuild is not authorized to perform
 				// InvalidInputException: Not authorized to perform DescribeSecurityGroups
@@ -1586,23 +1637,36 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateProject(params)
+			_, err = conn.UpdateProjectWithContext(ctx, params)
 		}
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating CodeBuild project (%s): %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "updating CodeBuild Project (%s): %s", d.Id(), err)
+		}
+
+		if v, ok := d.GetOk("environment.0.fleet.0.fleet_arn"); ok && d.HasChange("environment") {
+			if err := updateProjectEnvironmentFleet(ctx, meta.(*conns.AWSClient).CodeBuildClient(), d.Id(), expandProjectEnvironment(d), v.(string)); err != nil {
+				return errs.AppendErrorf(diags, "updating CodeBuild Project (%s) fleet: %s", d.Id(), err)
+			}
 		}
 	}
 
-	return resourceProjectRead(d, meta)
+	return append(diags, resourceProjectRead(ctx, d, meta)...)
 }
 
-func resourceProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildConn
 
-	_, err := conn.DeleteProject(&codebuild.DeleteProjectInput{
+	log.Printf("[INFO] Deleting CodeBuild Project: %s", d.Id())
+	_, err := conn.DeleteProjectWithContext(ctx, &codebuild.DeleteProjectInput{
 		Name: aws.String(d.Id()),
 	})
-	return err
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "deleting CodeBuild Project (%s): %s", d.Id(), err)
+	}
+
+	return diags
 }
 
 func flattenProjectFileSystemLocations(apiObjects []*codebuild.ProjectFileSystemLocation) []interface{} {
@@ -1791,6 +1855,43 @@ func flattenProjectEnvironment(environment *codebuild.ProjectEnvironment) []inte
 	return []interface{}{envConfig}
 }
 
+// updateProjectEnvironmentFleet runs a project's builds on a reserved capacity fleet.
+// Fleets are only available in the AWS SDK for Go v2, so the environment is resent
+// through that client with the fleet attached.
+func updateProjectEnvironmentFleet(ctx context.Context, conn *codebuild_sdkv2.Client, arn string, environment *codebuild.ProjectEnvironment, fleetARN string) error {
+	apiObject := &awstypes.ProjectEnvironment{
+		Certificate:              environment.Certificate,
+		ComputeType:              awstypes.ComputeType(aws.StringValue(environment.ComputeType)),
+		Fleet:                    &awstypes.ProjectFleet{FleetArn: aws_sdkv2.String(fleetARN)},
+		Image:                    environment.Image,
+		ImagePullCredentialsType: awstypes.ImagePullCredentialsType(aws.StringValue(environment.ImagePullCredentialsType)),
+		PrivilegedMode:           environment.PrivilegedMode,
+		Type:                     awstypes.EnvironmentType(aws.StringValue(environment.Type)),
+	}
+
+	for _, v := range environment.EnvironmentVariables {
+		apiObject.EnvironmentVariables = append(apiObject.EnvironmentVariables, awstypes.EnvironmentVariable{
+			Name:  v.Name,
+			Type:  awstypes.EnvironmentVariableType(aws.StringValue(v.Type)),
+			Value: v.Value,
+		})
+	}
+
+	if v := environment.RegistryCredential; v != nil {
+		apiObject.RegistryCredential = &awstypes.RegistryCredential{
+			Credential:         v.Credential,
+			CredentialProvider: awstypes.CredentialProviderType(aws.StringValue(v.CredentialProvider)),
+		}
+	}
+
+	_, err := conn.UpdateProject(ctx, &codebuild_sdkv2.UpdateProjectInput{
+		Environment: apiObject,
+		Name:        aws_sdkv2.String(arn),
+	})
+
+	return err
+}
+
 func flattenRegistryCredential(registryCredential *codebuild.RegistryCredential) []interface{} {
 	if registryCredential == nil {
 		return []interface{}{}
@@ -1,6 +1,7 @@
 package codebuild_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -2526,6 +2527,42 @@ func TestAccCodeBuildProject_Environment_registryCredential(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildProject_Environment_fleet(t *testing.T) {
+	var project codebuild.Project
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_project.test"
+	fleetResourceName := "aws_codebuild_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codebuild.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_environmentFleet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.fleet.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment.0.fleet.0.fleet_arn", fleetResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "environment.0.fleet.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildProject_disappears(t *testing.T) {
 	var project codebuild.Project
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2564,7 +2601,7 @@ func testAccCheckProjectExists(n string, project *codebuild.Project) resource.Te
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
 
-		output, err := tfcodebuild.FindProjectByARN(conn, rs.Primary.ID)
+		output, err := tfcodebuild.FindProjectByARN(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -2587,7 +2624,7 @@ func testAccCheckProjectDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := tfcodebuild.FindProjectByARN(conn, rs.Primary.ID)
+		_, err := tfcodebuild.FindProjectByARN(context.Background(), conn, rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
@@ -2615,7 +2652,7 @@ func testAccCheckProjectCertificate(project *codebuild.Project, expectedCertific
 func testAccPreCheck(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
 
-	_, err := tfcodebuild.FindProjectByARN(conn, "tf-acc-test-precheck")
+	_, err := tfcodebuild.FindProjectByARN(context.Background(), conn, "tf-acc-test-precheck")
 
 	if acctest.PreCheckSkipError(err) {
 		t.Skipf("skipping acceptance testing: %s", err)
@@ -2730,6 +2767,41 @@ resource "aws_codebuild_project" "test" {
 `, rName, testAccGitHubSourceLocationFromEnv()))
 }
 
+func testAccProjectConfig_environmentFleet(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_Base_ServiceRole(rName), fmt.Sprintf(`
+resource "aws_codebuild_fleet" "test" {
+  name             = %[1]q
+  base_capacity    = 1
+  compute_type     = "BUILD_GENERAL1_SMALL"
+  environment_type = "LINUX_CONTAINER"
+}
+
+resource "aws_codebuild_project" "test" {
+  name         = %[1]q
+  service_role = aws_iam_role.test.arn
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/amazonlinux2-x86_64-standard:5.0"
+    type         = "LINUX_CONTAINER"
+
+    fleet {
+      fleet_arn = aws_codebuild_fleet.test.arn
+    }
+  }
+
+  source {
+    location = %[2]q
+    type     = "GITHUB"
+  }
+}
+`, rName, testAccGitHubSourceLocationFromEnv()))
+}
+
 func testAccProjectConfig_visibility(rName, visibility string) string {
 	return acctest.ConfigCompose(testAccProjectConfig_Base_ServiceRole(rName), fmt.Sprintf(`
 resource "aws_codebuild_project" "test" {
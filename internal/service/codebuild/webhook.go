@@ -2,6 +2,7 @@ package codebuild
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -27,6 +28,8 @@ func ResourceWebhook() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceWebhookFilterGroupsCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:     schema.TypeString,
@@ -56,7 +59,7 @@ func ResourceWebhook() *schema.Resource {
 									"type": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringInSlice(codebuild.WebhookFilterType_Values(), false),
+										ValidateFunc: validation.StringInSlice(webhookFilterType_Values(), false),
 									},
 									"exclude_matched_pattern": {
 										Type:     schema.TypeBool,
@@ -92,6 +95,42 @@ func ResourceWebhook() *schema.Resource {
 	}
 }
 
+// resourceWebhookFilterGroupsCustomizeDiff ensures that filter groups for GitHub Actions
+// runner builds only match workflow job queued events.
+func resourceWebhookFilterGroupsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	for _, tfMapRaw := range diff.Get("filter_group").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var workflowName bool
+		var events []string
+
+		for _, filterRaw := range tfMap["filter"].([]interface{}) {
+			filter, ok := filterRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			switch filter["type"].(string) {
+			case webhookFilterTypeWorkflowName:
+				workflowName = true
+			case codebuild.WebhookFilterTypeEvent:
+				events = append(events, filter["pattern"].(string))
+			}
+		}
+
+		if workflowName && (len(events) != 1 || events[0] != webhookEventWorkflowJobQueued) {
+			return fmt.Errorf("a filter_group with a %s filter must have exactly one %s filter with pattern %s", webhookFilterTypeWorkflowName, codebuild.WebhookFilterTypeEvent, webhookEventWorkflowJobQueued)
+		}
+	}
+
+	return nil
+}
+
 func resourceWebhookCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CodeBuildConn
 
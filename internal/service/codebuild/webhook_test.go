@@ -255,6 +255,43 @@ func TestAccCodeBuildWebhook_filterGroup(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildWebhook_gitHubActionsRunner(t *testing.T) {
+	var webhook codebuild.Webhook
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codebuild.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookConfig_gitHubActionsRunner(rName, "PUSH"),
+				ExpectError: regexp.MustCompile(`must have exactly one EVENT filter with pattern WORKFLOW_JOB_QUEUED`),
+			},
+			{
+				Config: testAccWebhookConfig_gitHubActionsRunner(rName, "WORKFLOW_JOB_QUEUED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhookExists(resourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.0.filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.0.filter.0.type", "EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.0.filter.0.pattern", "WORKFLOW_JOB_QUEUED"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.0.filter.1.type", "WORKFLOW_NAME"),
+					resource.TestCheckResourceAttr(resourceName, "filter_group.0.filter.1.pattern", "build"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
 func testAccCheckWebhookFilter(webhook *codebuild.Webhook, expectedFilters [][]*codebuild.WebhookFilter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if webhook == nil {
@@ -429,3 +466,25 @@ resource "aws_codebuild_webhook" "test" {
 }
 `)
 }
+
+func testAccWebhookConfig_gitHubActionsRunner(rName, event string) string {
+	return acctest.ConfigCompose(
+		testAccProjectConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_codebuild_webhook" "test" {
+  project_name = aws_codebuild_project.test.name
+
+  filter_group {
+    filter {
+      type    = "EVENT"
+      pattern = %[1]q
+    }
+
+    filter {
+      type    = "WORKFLOW_NAME"
+      pattern = "build"
+    }
+  }
+}
+`, event))
+}
@@ -10,15 +10,18 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/codepipeline"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -63,9 +66,9 @@ func ResourcePipeline() *schema.Resource {
 										Required: true,
 									},
 									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(codepipeline.EncryptionKeyType_Values(), false),
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.EncryptionKeyType](),
 									},
 								},
 							},
@@ -80,13 +83,19 @@ func ResourcePipeline() *schema.Resource {
 							Computed: true,
 						},
 						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(codepipeline.ArtifactStoreType_Values(), false),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ArtifactStoreType](),
 						},
 					},
 				},
 			},
+			"execution_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.ExecutionModeSuperseded),
+				ValidateDiagFunc: enum.Validate[types.ExecutionMode](),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -96,6 +105,12 @@ func ResourcePipeline() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
 				),
 			},
+			"pipeline_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.PipelineTypeV1),
+				ValidateDiagFunc: enum.Validate[types.PipelineType](),
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -113,9 +128,9 @@ func ResourcePipeline() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"category": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(codepipeline.ActionCategory_Values(), false),
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.ActionCategory](),
 									},
 									"configuration": {
 										Type:     schema.TypeMap,
@@ -154,9 +169,9 @@ func ResourcePipeline() *schema.Resource {
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
 									"owner": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(codepipeline.ActionOwner_Values(), false),
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.ActionOwner](),
 									},
 									"provider": {
 										Type:             schema.TypeString,
@@ -190,6 +205,16 @@ func ResourcePipeline() *schema.Resource {
 								},
 							},
 						},
+						"before_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": stageConditionSchema(),
+								},
+							},
+						},
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -198,19 +223,290 @@ func ResourcePipeline() *schema.Resource {
 								validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
 							),
 						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": stageConditionSchema(),
+									"result": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.Result](),
+									},
+									"retry_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retry_mode": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[types.StageRetryMode](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": stageConditionSchema(),
+								},
+							},
+						},
 					},
 				},
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pull_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches": gitFilterCriteriaSchema(),
+												"events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 3,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.GitPullRequestEventType](),
+													},
+												},
+												"file_paths": gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches":   gitFilterCriteriaSchema(),
+												"file_paths": gitFilterCriteriaSchema(),
+												"tags":       gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 100),
+											validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+										),
+									},
+								},
+							},
+						},
+						"provider_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.PipelineTriggerProviderType](),
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9@\-_]+`), ""),
+							),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourcePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func stageConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"result": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[types.Result](),
+				},
+				"rule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"commands": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 50,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"configuration": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"input_artifacts": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 100),
+									validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+								),
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"role_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"rule_type_id": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"category": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleCategory](),
+										},
+										"owner": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleOwner](),
+										},
+										"provider": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 35),
+										},
+										"version": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 9),
+										},
+									},
+								},
+							},
+							"timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(5, 86400),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func gitFilterCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 8,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"includes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 8,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
 		},
+	}
+}
+
+// resourcePipelineCustomizeDiff rejects V2 pipeline features on V1 pipelines at plan time.
+func resourcePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("pipeline_type").(string) != string(types.PipelineTypeV1) {
+		return nil
+	}
+
+	if v := diff.Get("execution_mode").(string); v != string(types.ExecutionModeSuperseded) {
+		return fmt.Errorf("execution_mode %s requires pipeline_type %s", v, types.PipelineTypeV2)
+	}
+
+	if v := diff.Get("trigger").([]interface{}); len(v) > 0 {
+		return fmt.Errorf("trigger requires pipeline_type %s", types.PipelineTypeV2)
+	}
 
-		CustomizeDiff: verify.SetTagsDiff,
+	if v := diff.Get("variable").([]interface{}); len(v) > 0 {
+		return fmt.Errorf("variable requires pipeline_type %s", types.PipelineTypeV2)
 	}
+
+	return nil
 }
 
 func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -226,24 +522,30 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
+		input.Tags = pipelineTags(tags.IgnoreAWS())
 	}
 
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout, func() (interface{}, error) {
-		return conn.CreatePipelineWithContext(ctx, input)
-	}, codepipeline.ErrCodeInvalidStructureException, "not authorized")
+	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePipeline(ctx, input)
+	}, func(err error) (bool, error) {
+		if v, ok := errs.As[*types.InvalidStructureException](err); ok && strings.Contains(v.ErrorMessage(), "not authorized") {
+			return true, err
+		}
+
+		return false, err
+	})
 
 	if err != nil {
 		return diag.Errorf("creating CodePipeline (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*codepipeline.CreatePipelineOutput).Pipeline.Name))
+	d.SetId(aws.ToString(outputRaw.(*codepipeline.CreatePipelineOutput).Pipeline.Name))
 
 	return resourcePipelineRead(ctx, d, meta)
 }
 
 func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -276,12 +578,23 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("setting stage: %s", err)
 	}
 
-	arn := aws.StringValue(metadata.PipelineArn)
+	if err := d.Set("trigger", flattenTriggerDeclarations(pipeline.Triggers)); err != nil {
+		return diag.Errorf("setting trigger: %s", err)
+	}
+
+	if err := d.Set("variable", flattenVariableDeclarations(pipeline.Variables)); err != nil {
+		return diag.Errorf("setting variable: %s", err)
+	}
+
+	arn := aws.ToString(metadata.PipelineArn)
 	d.Set("arn", arn)
+	d.Set("execution_mode", pipeline.ExecutionMode)
 	d.Set("name", pipeline.Name)
+	d.Set("pipeline_type", pipeline.PipelineType)
 	d.Set("role_arn", pipeline.RoleArn)
 
-	tags, err := ListTagsWithContext(ctx, conn, arn)
+	// Tags are still managed through the AWS SDK for Go v1 client shared with the other resources in this package.
+	tags, err := ListTagsWithContext(ctx, meta.(*conns.AWSClient).CodePipelineConn, arn)
 
 	if err != nil {
 		return diag.Errorf("listing tags for CodePipeline (%s): %s", arn, err)
@@ -302,7 +615,7 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineClient()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		pipeline, err := expandPipelineDeclaration(d)
@@ -311,7 +624,7 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return diag.FromErr(err)
 		}
 
-		_, err = conn.UpdatePipelineWithContext(ctx, &codepipeline.UpdatePipelineInput{
+		_, err = conn.UpdatePipeline(ctx, &codepipeline.UpdatePipelineInput{
 			Pipeline: pipeline,
 		})
 
@@ -324,7 +637,7 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		o, n := d.GetChange("tags_all")
 		arn := d.Get("arn").(string)
 
-		if err := UpdateTagsWithContext(ctx, conn, arn, o, n); err != nil {
+		if err := UpdateTagsWithContext(ctx, meta.(*conns.AWSClient).CodePipelineConn, arn, o, n); err != nil {
 			return diag.Errorf("updating CodePipeline (%s) tags: %s", arn, err)
 		}
 	}
@@ -333,14 +646,14 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineClient()
 
 	log.Printf("[INFO] Deleting CodePipeline: %s", d.Id())
-	_, err := conn.DeletePipelineWithContext(ctx, &codepipeline.DeletePipelineInput{
+	_, err := conn.DeletePipeline(ctx, &codepipeline.DeletePipelineInput{
 		Name: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, codepipeline.ErrCodePipelineNotFoundException) {
+	if errs.IsA[*types.PipelineNotFoundException](err) {
 		return nil
 	}
 
@@ -351,14 +664,14 @@ func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func FindPipelineByName(ctx context.Context, conn *codepipeline.CodePipeline, name string) (*codepipeline.GetPipelineOutput, error) {
+func FindPipelineByName(ctx context.Context, conn *codepipeline.Client, name string) (*codepipeline.GetPipelineOutput, error) {
 	input := &codepipeline.GetPipelineInput{
 		Name: aws.String(name),
 	}
 
-	output, err := conn.GetPipelineWithContext(ctx, input)
+	output, err := conn.GetPipeline(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, codepipeline.ErrCodePipelineNotFoundException) {
+	if errs.IsA[*types.PipelineNotFoundException](err) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -421,8 +734,8 @@ func hashGitHubToken(token string) string {
 	return gitHubTokenHashPrefix + hex.EncodeToString(sum[:])
 }
 
-func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDeclaration, error) {
-	apiObject := &codepipeline.PipelineDeclaration{}
+func expandPipelineDeclaration(d *schema.ResourceData) (*types.PipelineDeclaration, error) {
+	apiObject := &types.PipelineDeclaration{}
 
 	if v, ok := d.GetOk("artifact_store"); ok && v.(*schema.Set).Len() > 0 {
 		artifactStores := expandArtifactStores(v.(*schema.Set).List())
@@ -433,7 +746,8 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 				if region != "" {
 					return nil, errors.New("region cannot be set for a single-region CodePipeline")
 				}
-				apiObject.ArtifactStore = v
+				v := v
+				apiObject.ArtifactStore = &v
 			}
 
 		default:
@@ -449,10 +763,18 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		}
 	}
 
+	if v, ok := d.GetOk("execution_mode"); ok {
+		apiObject.ExecutionMode = types.ExecutionMode(v.(string))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		apiObject.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pipeline_type"); ok {
+		apiObject.PipelineType = types.PipelineType(v.(string))
+	}
+
 	if v, ok := d.GetOk("role_arn"); ok {
 		apiObject.RoleArn = aws.String(v.(string))
 	}
@@ -461,15 +783,23 @@ func expandPipelineDeclaration(d *schema.ResourceData) (*codepipeline.PipelineDe
 		apiObject.Stages = expandStageDeclarations(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Triggers = expandTriggerDeclarations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		apiObject.Variables = expandVariableDeclarations(v.([]interface{}))
+	}
+
 	return apiObject, nil
 }
 
-func expandArtifactStore(tfMap map[string]interface{}) *codepipeline.ArtifactStore {
+func expandArtifactStore(tfMap map[string]interface{}) *types.ArtifactStore {
 	if tfMap == nil {
 		return nil
 	}
 
-	apiObject := &codepipeline.ArtifactStore{}
+	apiObject := &types.ArtifactStore{}
 
 	if v, ok := tfMap["encryption_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.EncryptionKey = expandEncryptionKey(v[0].(map[string]interface{}))
@@ -480,18 +810,18 @@ func expandArtifactStore(tfMap map[string]interface{}) *codepipeline.ArtifactSto
 	}
 
 	if v, ok := tfMap["type"].(string); ok && v != "" {
-		apiObject.Type = aws.String(v)
+		apiObject.Type = types.ArtifactStoreType(v)
 	}
 
 	return apiObject
 }
 
-func expandArtifactStores(tfList []interface{}) map[string]*codepipeline.ArtifactStore {
+func expandArtifactStores(tfList []interface{}) map[string]types.ArtifactStore {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	apiObjects := make(map[string]*codepipeline.ArtifactStore, 0)
+	apiObjects := make(map[string]types.ArtifactStore, 0)
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -512,54 +842,66 @@ func expandArtifactStores(tfList []interface{}) map[string]*codepipeline.Artifac
 			region = v
 		}
 
-		apiObjects[region] = apiObject
+		apiObjects[region] = *apiObject
 	}
 
 	return apiObjects
 }
 
-func expandEncryptionKey(tfMap map[string]interface{}) *codepipeline.EncryptionKey {
+func expandEncryptionKey(tfMap map[string]interface{}) *types.EncryptionKey {
 	if tfMap == nil {
 		return nil
 	}
 
-	apiObject := &codepipeline.EncryptionKey{}
+	apiObject := &types.EncryptionKey{}
 
 	if v, ok := tfMap["id"].(string); ok && v != "" {
 		apiObject.Id = aws.String(v)
 	}
 
 	if v, ok := tfMap["type"].(string); ok && v != "" {
-		apiObject.Type = aws.String(v)
+		apiObject.Type = types.EncryptionKeyType(v)
 	}
 
 	return apiObject
 }
 
-func expandStageDeclaration(tfMap map[string]interface{}) *codepipeline.StageDeclaration {
+func expandStageDeclaration(tfMap map[string]interface{}) *types.StageDeclaration {
 	if tfMap == nil {
 		return nil
 	}
 
-	apiObject := &codepipeline.StageDeclaration{}
+	apiObject := &types.StageDeclaration{}
 
 	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
 		apiObject.Actions = expandActionDeclarations(v)
 	}
 
+	if v, ok := tfMap["before_entry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BeforeEntry = expandBeforeEntryConditions(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["name"].(string); ok && v != "" {
 		apiObject.Name = aws.String(v)
 	}
 
+	if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnFailure = expandFailureConditions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["on_success"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnSuccess = expandSuccessConditions(v[0].(map[string]interface{}))
+	}
+
 	return apiObject
 }
 
-func expandStageDeclarations(tfList []interface{}) []*codepipeline.StageDeclaration {
+func expandStageDeclarations(tfList []interface{}) []types.StageDeclaration {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*codepipeline.StageDeclaration
+	var apiObjects []types.StageDeclaration
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -574,78 +916,91 @@ func expandStageDeclarations(tfList []interface{}) []*codepipeline.StageDeclarat
 			continue
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		apiObjects = append(apiObjects, *apiObject)
 	}
 
 	return apiObjects
 }
 
-func expandActionDeclaration(tfMap map[string]interface{}) *codepipeline.ActionDeclaration {
+func expandBeforeEntryConditions(tfMap map[string]interface{}) *types.BeforeEntryConditions {
 	if tfMap == nil {
 		return nil
 	}
 
-	apiObject := &codepipeline.ActionDeclaration{
-		ActionTypeId: &codepipeline.ActionTypeId{},
-	}
+	apiObject := &types.BeforeEntryConditions{}
 
-	if v, ok := tfMap["category"].(string); ok && v != "" {
-		apiObject.ActionTypeId.Category = aws.String(v)
+	if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
 	}
 
-	if v, ok := tfMap["configuration"].(map[string]interface{}); ok && len(v) > 0 {
-		apiObject.Configuration = flex.ExpandStringMap(v)
-	}
+	return apiObject
+}
 
-	if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
-		apiObject.InputArtifacts = expandInputArtifacts(v)
+func expandFailureConditions(tfMap map[string]interface{}) *types.FailureConditions {
+	if tfMap == nil {
+		return nil
 	}
 
-	if v, ok := tfMap["name"].(string); ok && v != "" {
-		apiObject.Name = aws.String(v)
-	}
+	apiObject := &types.FailureConditions{}
 
-	if v, ok := tfMap["namespace"].(string); ok && v != "" {
-		apiObject.Namespace = aws.String(v)
+	if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
 	}
 
-	if v, ok := tfMap["output_artifacts"].([]interface{}); ok && len(v) > 0 {
-		apiObject.OutputArtifacts = expandOutputArtifacts(v)
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
 	}
 
-	if v, ok := tfMap["owner"].(string); ok && v != "" {
-		apiObject.ActionTypeId.Owner = aws.String(v)
+	if v, ok := tfMap["retry_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RetryConfiguration = &types.RetryConfiguration{}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			apiObject.RetryConfiguration.RetryMode = types.StageRetryMode(v)
+		}
 	}
 
-	if v, ok := tfMap["provider"].(string); ok && v != "" {
-		apiObject.ActionTypeId.Provider = aws.String(v)
+	return apiObject
+}
+
+func expandSuccessConditions(tfMap map[string]interface{}) *types.SuccessConditions {
+	if tfMap == nil {
+		return nil
 	}
 
-	if v, ok := tfMap["region"].(string); ok && v != "" {
-		apiObject.Region = aws.String(v)
+	apiObject := &types.SuccessConditions{}
+
+	if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
 	}
 
-	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
-		apiObject.RoleArn = aws.String(v)
+	return apiObject
+}
+
+func expandCondition(tfMap map[string]interface{}) *types.Condition {
+	if tfMap == nil {
+		return nil
 	}
 
-	if v, ok := tfMap["run_order"].(int); ok && v != 0 {
-		apiObject.RunOrder = aws.Int64(int64(v))
+	apiObject := &types.Condition{}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
 	}
 
-	if v, ok := tfMap["version"].(string); ok && v != "" {
-		apiObject.ActionTypeId.Version = aws.String(v)
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRuleDeclarations(v)
 	}
 
 	return apiObject
 }
 
-func expandActionDeclarations(tfList []interface{}) []*codepipeline.ActionDeclaration {
+func expandConditions(tfList []interface{}) []types.Condition {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*codepipeline.ActionDeclaration
+	var apiObjects []types.Condition
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -654,146 +1009,509 @@ func expandActionDeclarations(tfList []interface{}) []*codepipeline.ActionDeclar
 			continue
 		}
 
-		apiObject := expandActionDeclaration(tfMap)
+		apiObject := expandCondition(tfMap)
 
 		if apiObject == nil {
 			continue
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		apiObjects = append(apiObjects, *apiObject)
 	}
 
 	return apiObjects
 }
 
-func expandInputArtifacts(tfList []interface{}) []*codepipeline.InputArtifact {
-	if len(tfList) == 0 {
+func expandRuleDeclaration(tfMap map[string]interface{}) *types.RuleDeclaration {
+	if tfMap == nil {
 		return nil
 	}
 
-	var apiObjects []*codepipeline.InputArtifact
+	apiObject := &types.RuleDeclaration{}
 
-	for _, v := range tfList {
-		v, ok := v.(string)
+	if v, ok := tfMap["commands"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Commands = flex.ExpandStringValueList(v)
+	}
 
-		if !ok {
-			continue
+	if v, ok := tfMap["configuration"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Configuration = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputArtifacts = expandInputArtifacts(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rule_type_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RuleTypeId = &types.RuleTypeId{}
+
+		if v, ok := tfMap["category"].(string); ok && v != "" {
+			apiObject.RuleTypeId.Category = types.RuleCategory(v)
 		}
 
-		apiObject := &codepipeline.InputArtifact{
-			Name: aws.String(v),
+		if v, ok := tfMap["owner"].(string); ok && v != "" {
+			apiObject.RuleTypeId.Owner = types.RuleOwner(v)
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		if v, ok := tfMap["provider"].(string); ok && v != "" {
+			apiObject.RuleTypeId.Provider = aws.String(v)
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			apiObject.RuleTypeId.Version = aws.String(v)
+		}
 	}
 
-	return apiObjects
+	if v, ok := tfMap["timeout_in_minutes"].(int); ok && v != 0 {
+		apiObject.TimeoutInMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
 }
 
-func expandOutputArtifacts(tfList []interface{}) []*codepipeline.OutputArtifact {
+func expandRuleDeclarations(tfList []interface{}) []types.RuleDeclaration {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*codepipeline.OutputArtifact
+	var apiObjects []types.RuleDeclaration
 
-	for _, v := range tfList {
-		v, ok := v.(string)
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
 
 		if !ok {
 			continue
 		}
 
-		apiObject := &codepipeline.OutputArtifact{
-			Name: aws.String(v),
+		apiObject := expandRuleDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		apiObjects = append(apiObjects, *apiObject)
 	}
 
 	return apiObjects
 }
 
-func flattenArtifactStore(apiObject *codepipeline.ArtifactStore) map[string]interface{} {
-	if apiObject == nil {
+func expandActionDeclaration(tfMap map[string]interface{}) *types.ActionDeclaration {
+	if tfMap == nil {
 		return nil
 	}
 
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.EncryptionKey; v != nil {
-		tfMap["encryption_key"] = []interface{}{flattenEncryptionKey(v)}
+	apiObject := &types.ActionDeclaration{
+		ActionTypeId: &types.ActionTypeId{},
 	}
 
-	if v := apiObject.Location; v != nil {
-		tfMap["location"] = aws.StringValue(v)
+	if v, ok := tfMap["category"].(string); ok && v != "" {
+		apiObject.ActionTypeId.Category = types.ActionCategory(v)
 	}
 
-	if v := apiObject.Type; v != nil {
-		tfMap["type"] = aws.StringValue(v)
+	if v, ok := tfMap["configuration"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Configuration = flex.ExpandStringValueMap(v)
 	}
 
-	return tfMap
-}
+	if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputArtifacts = expandInputArtifacts(v)
+	}
 
-func flattenArtifactStores(apiObjects map[string]*codepipeline.ArtifactStore) []interface{} {
-	if len(apiObjects) == 0 {
-		return nil
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
 	}
 
-	var tfList []interface{}
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_artifacts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OutputArtifacts = expandOutputArtifacts(v)
+	}
+
+	if v, ok := tfMap["owner"].(string); ok && v != "" {
+		apiObject.ActionTypeId.Owner = types.ActionOwner(v)
+	}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.ActionTypeId.Provider = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["run_order"].(int); ok && v != 0 {
+		apiObject.RunOrder = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.ActionTypeId.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandActionDeclarations(tfList []interface{}) []types.ActionDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.ActionDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandActionDeclaration(tfMap)
 
-	for region, apiObject := range apiObjects {
 		if apiObject == nil {
 			continue
 		}
 
-		tfMap := flattenArtifactStore(apiObject)
-		tfMap["region"] = region
+		apiObjects = append(apiObjects, *apiObject)
+	}
 
-		tfList = append(tfList, tfMap)
+	return apiObjects
+}
+
+func expandInputArtifacts(tfList []interface{}) []types.InputArtifact {
+	if len(tfList) == 0 {
+		return nil
 	}
 
-	return tfList
+	var apiObjects []types.InputArtifact
+
+	for _, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.InputArtifact{
+			Name: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandOutputArtifacts(tfList []interface{}) []types.OutputArtifact {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.OutputArtifact
+
+	for _, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.OutputArtifact{
+			Name: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTriggerDeclaration(tfMap map[string]interface{}) *types.PipelineTriggerDeclaration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipelineTriggerDeclaration{}
+
+	if v, ok := tfMap["git_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GitConfiguration = expandGitConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["provider_type"].(string); ok && v != "" {
+		apiObject.ProviderType = types.PipelineTriggerProviderType(v)
+	}
+
+	return apiObject
+}
+
+func expandTriggerDeclarations(tfList []interface{}) []types.PipelineTriggerDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PipelineTriggerDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandTriggerDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
 }
 
-func flattenEncryptionKey(apiObject *codepipeline.EncryptionKey) map[string]interface{} {
+func expandGitConfiguration(tfMap map[string]interface{}) *types.GitConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.GitConfiguration{}
+
+	if v, ok := tfMap["pull_request"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PullRequest = expandGitPullRequestFilters(v)
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Push = expandGitPushFilters(v)
+	}
+
+	if v, ok := tfMap["source_action_name"].(string); ok && v != "" {
+		apiObject.SourceActionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGitPullRequestFilters(tfList []interface{}) []types.GitPullRequestFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.GitPullRequestFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.GitPullRequestFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &types.GitBranchFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["events"].([]interface{}); ok && len(v) > 0 {
+			for _, v := range flex.ExpandStringValueList(v) {
+				apiObject.Events = append(apiObject.Events, types.GitPullRequestEventType(v))
+			}
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &types.GitFilePathFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitPushFilters(tfList []interface{}) []types.GitPushFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.GitPushFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.GitPushFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &types.GitBranchFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &types.GitFilePathFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Tags = &types.GitTagFilterCriteria{Excludes: excludes, Includes: includes}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandGitFilterCriteria returns the includes and excludes patterns shared by
+// the branch, file path and tag filter criteria types.
+func expandGitFilterCriteria(tfMap map[string]interface{}) ([]string, []string) {
+	var includes, excludes []string
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		excludes = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		includes = flex.ExpandStringValueList(v)
+	}
+
+	return includes, excludes
+}
+
+func expandVariableDeclarations(tfList []interface{}) []types.PipelineVariableDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.PipelineVariableDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PipelineVariableDeclaration{}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenArtifactStore(apiObject *types.ArtifactStore) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
-	tfMap := map[string]interface{}{}
+	tfMap := map[string]interface{}{
+		"type": apiObject.Type,
+	}
 
-	if v := apiObject.Id; v != nil {
-		tfMap["id"] = aws.StringValue(v)
+	if v := apiObject.EncryptionKey; v != nil {
+		tfMap["encryption_key"] = []interface{}{flattenEncryptionKey(v)}
 	}
 
-	if v := apiObject.Type; v != nil {
-		tfMap["type"] = aws.StringValue(v)
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = aws.ToString(v)
 	}
 
 	return tfMap
 }
 
-func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject *codepipeline.StageDeclaration) map[string]interface{} {
+func flattenArtifactStores(apiObjects map[string]types.ArtifactStore) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for region, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfMap := flattenArtifactStore(&apiObject)
+		tfMap["region"] = region
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEncryptionKey(apiObject *types.EncryptionKey) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
+	tfMap := map[string]interface{}{
+		"type": apiObject.Type,
+	}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject types.StageDeclaration) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.Actions; v != nil {
 		tfMap["action"] = flattenActionDeclarations(d, i, v)
 	}
 
+	if v := apiObject.BeforeEntry; v != nil {
+		tfMap["before_entry"] = []interface{}{map[string]interface{}{
+			"condition": flattenConditions(v.Conditions),
+		}}
+	}
+
 	if v := apiObject.Name; v != nil {
-		tfMap["name"] = aws.StringValue(v)
+		tfMap["name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.OnFailure; v != nil {
+		tfMap["on_failure"] = []interface{}{flattenFailureConditions(v)}
+	}
+
+	if v := apiObject.OnSuccess; v != nil {
+		tfMap["on_success"] = []interface{}{map[string]interface{}{
+			"condition": flattenConditions(v.Conditions),
+		}}
 	}
 
 	return tfMap
 }
 
-func flattenStageDeclarations(d *schema.ResourceData, apiObjects []*codepipeline.StageDeclaration) []interface{} {
+func flattenStageDeclarations(d *schema.ResourceData, apiObjects []types.StageDeclaration) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -801,46 +1519,115 @@ func flattenStageDeclarations(d *schema.ResourceData, apiObjects []*codepipeline
 	var tfList []interface{}
 
 	for i, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
 		tfList = append(tfList, flattenStageDeclaration(d, i, apiObject))
 	}
 
 	return tfList
 }
 
-func flattenActionDeclaration(d *schema.ResourceData, i, j int, apiObject *codepipeline.ActionDeclaration) map[string]interface{} {
+func flattenFailureConditions(apiObject *types.FailureConditions) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
-	var actionProvider string
-	tfMap := map[string]interface{}{}
+	tfMap := map[string]interface{}{
+		"condition": flattenConditions(apiObject.Conditions),
+		"result":    apiObject.Result,
+	}
 
-	if apiObject := apiObject.ActionTypeId; apiObject != nil {
-		if v := apiObject.Category; v != nil {
-			tfMap["category"] = aws.StringValue(v)
+	if v := apiObject.RetryConfiguration; v != nil {
+		tfMap["retry_configuration"] = []interface{}{map[string]interface{}{
+			"retry_mode": v.RetryMode,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenConditions(apiObjects []types.Condition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"result": apiObject.Result,
+			"rule":   flattenRuleDeclarations(apiObject.Rules),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRuleDeclarations(apiObjects []types.RuleDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"commands":      apiObject.Commands,
+			"configuration": apiObject.Configuration,
+		}
+
+		if v := apiObject.InputArtifacts; len(v) > 0 {
+			tfMap["input_artifacts"] = flattenInputArtifacts(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Region; v != nil {
+			tfMap["region"] = aws.ToString(v)
+		}
+
+		if v := apiObject.RoleArn; v != nil {
+			tfMap["role_arn"] = aws.ToString(v)
 		}
 
-		if v := apiObject.Owner; v != nil {
-			tfMap["owner"] = aws.StringValue(v)
+		if v := apiObject.RuleTypeId; v != nil {
+			tfMap["rule_type_id"] = []interface{}{map[string]interface{}{
+				"category": v.Category,
+				"owner":    v.Owner,
+				"provider": aws.ToString(v.Provider),
+				"version":  aws.ToString(v.Version),
+			}}
 		}
 
+		if v := apiObject.TimeoutInMinutes; v != nil {
+			tfMap["timeout_in_minutes"] = aws.ToInt32(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenActionDeclaration(d *schema.ResourceData, i, j int, apiObject types.ActionDeclaration) map[string]interface{} {
+	var actionProvider string
+	tfMap := map[string]interface{}{}
+
+	if apiObject := apiObject.ActionTypeId; apiObject != nil {
+		tfMap["category"] = apiObject.Category
+		tfMap["owner"] = apiObject.Owner
+
 		if v := apiObject.Provider; v != nil {
-			actionProvider = aws.StringValue(v)
+			actionProvider = aws.ToString(v)
 			tfMap["provider"] = actionProvider
 		}
 
 		if v := apiObject.Version; v != nil {
-			tfMap["version"] = aws.StringValue(v)
+			tfMap["version"] = aws.ToString(v)
 		}
 	}
 
 	if v := apiObject.Configuration; v != nil {
-		v := aws.StringValueMap(v)
-
 		// The AWS API returns "****" for the OAuthToken value. Copy the value from the configuration.
 		if actionProvider == providerGitHub {
 			if _, ok := v[gitHubActionConfigurationOAuthToken]; ok {
@@ -857,11 +1644,11 @@ func flattenActionDeclaration(d *schema.ResourceData, i, j int, apiObject *codep
 	}
 
 	if v := apiObject.Name; v != nil {
-		tfMap["name"] = aws.StringValue(v)
+		tfMap["name"] = aws.ToString(v)
 	}
 
 	if v := apiObject.Namespace; v != nil {
-		tfMap["namespace"] = aws.StringValue(v)
+		tfMap["namespace"] = aws.ToString(v)
 	}
 
 	if v := apiObject.OutputArtifacts; len(v) > 0 {
@@ -869,21 +1656,21 @@ func flattenActionDeclaration(d *schema.ResourceData, i, j int, apiObject *codep
 	}
 
 	if v := apiObject.Region; v != nil {
-		tfMap["region"] = aws.StringValue(v)
+		tfMap["region"] = aws.ToString(v)
 	}
 
 	if v := apiObject.RoleArn; v != nil {
-		tfMap["role_arn"] = aws.StringValue(v)
+		tfMap["role_arn"] = aws.ToString(v)
 	}
 
 	if v := apiObject.RunOrder; v != nil {
-		tfMap["run_order"] = aws.Int64Value(v)
+		tfMap["run_order"] = aws.ToInt32(v)
 	}
 
 	return tfMap
 }
 
-func flattenActionDeclarations(d *schema.ResourceData, i int, apiObjects []*codepipeline.ActionDeclaration) []interface{} {
+func flattenActionDeclarations(d *schema.ResourceData, i int, apiObjects []types.ActionDeclaration) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -891,48 +1678,158 @@ func flattenActionDeclarations(d *schema.ResourceData, i int, apiObjects []*code
 	var tfList []interface{}
 
 	for j, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
 		tfList = append(tfList, flattenActionDeclaration(d, i, j, apiObject))
 	}
 
 	return tfList
 }
 
-func flattenInputArtifacts(apiObjects []*codepipeline.InputArtifact) []string {
+func flattenInputArtifacts(apiObjects []types.InputArtifact) []string {
 	if len(apiObjects) == 0 {
 		return nil
 	}
 
-	var tfList []*string
+	var tfList []string
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
+		tfList = append(tfList, aws.ToString(apiObject.Name))
+	}
+
+	return tfList
+}
+
+func flattenOutputArtifacts(apiObjects []types.OutputArtifact) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []string
 
-		tfList = append(tfList, apiObject.Name)
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.ToString(apiObject.Name))
 	}
 
-	return aws.StringValueSlice(tfList)
+	return tfList
 }
 
-func flattenOutputArtifacts(apiObjects []*codepipeline.OutputArtifact) []string {
+func flattenTriggerDeclarations(apiObjects []types.PipelineTriggerDeclaration) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
 
-	var tfList []*string
+	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
+		tfMap := map[string]interface{}{
+			"provider_type": apiObject.ProviderType,
+		}
+
+		if v := apiObject.GitConfiguration; v != nil {
+			tfMap["git_configuration"] = []interface{}{flattenGitConfiguration(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitConfiguration(apiObject *types.GitConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"source_action_name": aws.ToString(apiObject.SourceActionName),
+	}
+
+	if v := apiObject.PullRequest; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfMap := map[string]interface{}{
+				"events": enum.Slice(apiObject.Events...),
+			}
+
+			if v := apiObject.Branches; v != nil {
+				tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+			}
+
+			if v := apiObject.FilePaths; v != nil {
+				tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+
+		tfMap["pull_request"] = tfList
+	}
+
+	if v := apiObject.Push; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfMap := map[string]interface{}{}
+
+			if v := apiObject.Branches; v != nil {
+				tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+			}
+
+			if v := apiObject.FilePaths; v != nil {
+				tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+			}
+
+			if v := apiObject.Tags; v != nil {
+				tfMap["tags"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+			}
+
+			tfList = append(tfList, tfMap)
 		}
 
-		tfList = append(tfList, apiObject.Name)
+		tfMap["push"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenGitFilterCriteria(includes, excludes []string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"excludes": excludes,
+		"includes": includes,
+	}}
+}
+
+func flattenVariableDeclarations(apiObjects []types.PipelineVariableDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.ToString(apiObject.DefaultValue),
+			"description":   aws.ToString(apiObject.Description),
+			"name":          aws.ToString(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func pipelineTags(tags tftags.KeyValueTags) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
 	}
 
-	return aws.StringValueSlice(tfList)
+	return apiObjects
 }
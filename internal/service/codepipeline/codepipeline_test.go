@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -19,7 +20,7 @@ import (
 )
 
 func TestAccCodePipeline_basic(t *testing.T) {
-	var p1, p2 codepipeline.PipelineDeclaration
+	var p1, p2 types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
@@ -125,7 +126,7 @@ func TestAccCodePipeline_basic(t *testing.T) {
 }
 
 func TestAccCodePipeline_disappears(t *testing.T) {
-	var p codepipeline.PipelineDeclaration
+	var p types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -152,7 +153,7 @@ func TestAccCodePipeline_disappears(t *testing.T) {
 }
 
 func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
-	var p codepipeline.PipelineDeclaration
+	var p types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -194,7 +195,7 @@ func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
 }
 
 func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
-	var p codepipeline.PipelineDeclaration
+	var p types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -227,7 +228,7 @@ func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
 }
 
 func TestAccCodePipeline_tags(t *testing.T) {
-	var p1, p2, p3 codepipeline.PipelineDeclaration
+	var p1, p2, p3 types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -283,7 +284,7 @@ func TestAccCodePipeline_tags(t *testing.T) {
 }
 
 func TestAccCodePipeline_MultiRegion_basic(t *testing.T) {
-	var p codepipeline.PipelineDeclaration
+	var p types.PipelineDeclaration
 	resourceName := "aws_codepipeline.test"
 
 	name := sdkacctest.RandString(10)
@@ -324,7 +325,7 @@ func TestAccCodePipeline_MultiRegion_basic(t *testing.T) {
 }
 
 func TestAccCodePipeline_MultiRegion_update(t *testing.T) {
-	var p1, p2 codepipeline.PipelineDeclaration
+	var p1, p2 types.PipelineDeclaration
 	resourceName := "aws_codepipeline.test"
 
 	name := sdkacctest.RandString(10)
@@ -379,7 +380,7 @@ func TestAccCodePipeline_MultiRegion_update(t *testing.T) {
 }
 
 func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
-	var p1, p2 codepipeline.PipelineDeclaration
+	var p1, p2 types.PipelineDeclaration
 	resourceName := "aws_codepipeline.test"
 
 	name := sdkacctest.RandString(10)
@@ -444,7 +445,7 @@ func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
 }
 
 func TestAccCodePipeline_withNamespace(t *testing.T) {
-	var p1 codepipeline.PipelineDeclaration
+	var p1 types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -478,7 +479,7 @@ func TestAccCodePipeline_withNamespace(t *testing.T) {
 func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	githubToken := envvar.SkipIfEmpty(t, envvar.GithubToken, "token with GitHub permissions to repository for CodePipeline source configuration")
 
-	var v codepipeline.PipelineDeclaration
+	var v types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -552,7 +553,7 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 }
 
 func TestAccCodePipeline_ecr(t *testing.T) {
-	var p codepipeline.PipelineDeclaration
+	var p types.PipelineDeclaration
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
@@ -599,7 +600,163 @@ func TestAccCodePipeline_ecr(t *testing.T) {
 	})
 }
 
-func testAccCheckPipelineExists(n string, v *codepipeline.PipelineDeclaration) resource.TestCheckFunc {
+func TestAccCodePipeline_pipelineTypeV2(t *testing.T) {
+	var p1, p2 types.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_pipelineTypeV2(name, "QUEUED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "execution_mode", "QUEUED"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "test_var"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "value"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.description", "Test variable"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodePipelineConfig_pipelineTypeV2(name, "PARALLEL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &p2),
+					resource.TestCheckResourceAttr(resourceName, "execution_mode", "PARALLEL"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", "V2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_pipelineTypeV1Invalid(t *testing.T) {
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodePipelineConfig_pipelineTypeV1Queued(name),
+				ExpectError: regexp.MustCompile(`execution_mode QUEUED requires pipeline_type V2`),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_triggers(t *testing.T) {
+	var p1, p2 types.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_triggerPush(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", "CodeStarSourceConnection"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "main"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.includes.0", "src/**"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.excludes.0", "docs/**"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodePipelineConfig_triggerPullRequest(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &p2),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.0", "OPEN"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.1", "UPDATED"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.branches.0.includes.0", "main"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_stageConditions(t *testing.T) {
+	var p types.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_stageConditions(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.result", "FAIL"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.name", "VariableCheck"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.category", "Rule"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.owner", "AWS"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "VariableCheck"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", "RETRY"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.0.retry_mode", "FAILED_ACTIONS"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineExists(n string, v *types.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -610,7 +767,7 @@ func testAccCheckPipelineExists(n string, v *codepipeline.PipelineDeclaration) r
 			return fmt.Errorf("No CodePipeline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineClient()
 
 		output, err := tfcodepipeline.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
 
@@ -625,7 +782,7 @@ func testAccCheckPipelineExists(n string, v *codepipeline.PipelineDeclaration) r
 }
 
 func testAccCheckPipelineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codepipeline" {
@@ -1659,3 +1816,376 @@ resource "aws_codepipeline" "test" {
 }
 `, rName))
 }
+
+func testAccCodePipelineConfig_pipelineTypeV2(rName, executionMode string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+  pipeline_type  = "V2"
+  execution_mode = %[2]q
+
+  variable {
+    name          = "test_var"
+    default_value = "value"
+    description   = "Test variable"
+  }
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName, executionMode))
+}
+
+func testAccCodePipelineConfig_pipelineTypeV1Queued(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+  execution_mode = "QUEUED"
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccCodePipelineConfig_triggerPush(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      push {
+        branches {
+          includes = ["main"]
+        }
+
+        file_paths {
+          includes = ["src/**"]
+          excludes = ["docs/**"]
+        }
+      }
+    }
+  }
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccCodePipelineConfig_triggerPullRequest(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      pull_request {
+        events = ["OPEN", "UPDATED"]
+
+        branches {
+          includes = ["main"]
+        }
+      }
+    }
+  }
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
+
+func testAccCodePipelineConfig_stageConditions(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+  pipeline_type = "V2"
+
+  variable {
+    name          = "deploy_branch"
+    default_value = "main"
+  }
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "VariableCheck"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "VariableCheck"
+            version  = "1"
+          }
+
+          configuration = {
+            Variable = "#{variables.deploy_branch}"
+            Value    = "main"
+            Operator = "EQ"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = "RETRY"
+
+      retry_configuration {
+        retry_mode = "FAILED_ACTIONS"
+      }
+    }
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName))
+}
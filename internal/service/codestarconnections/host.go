@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceHost() *schema.Resource {
@@ -58,6 +60,8 @@ func ResourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -88,11 +92,15 @@ func ResourceHost() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
 func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &codestarconnections.CreateHostInput{
@@ -102,6 +110,10 @@ func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
 		VpcConfiguration: expandHostVPCConfiguration(d.Get("vpc_configuration").([]interface{})),
 	}
 
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
 	log.Printf("[DEBUG] Creating CodeStar Connections Host: %s", input)
 	output, err := conn.CreateHost(input)
 
@@ -120,6 +132,8 @@ func resourceHostCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CodeStarConnectionsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	output, err := FindHostByARN(conn, d.Id())
 
@@ -140,6 +154,23 @@ func resourceHostRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("status", output.Status)
 	d.Set("vpc_configuration", flattenHostVPCConfiguration(output.VpcConfiguration))
 
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("listing tags for CodeStar Connections Host (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
@@ -164,6 +195,14 @@ func resourceHostUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating CodeStar Connections Host (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceHostRead(d, meta)
 }
 
@@ -0,0 +1,235 @@
+package codestarconnections
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRepositoryLink() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryLinkCreate,
+		ReadWithoutTimeout:   resourceRepositoryLinkRead,
+		UpdateWithoutTimeout: resourceRepositoryLinkUpdate,
+		DeleteWithoutTimeout: resourceRepositoryLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRepositoryLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &codestarconnections.CreateRepositoryLinkInput{
+		ConnectionArn:  aws.String(d.Get("connection_arn").(string)),
+		OwnerId:        aws.String(d.Get("owner_id").(string)),
+		RepositoryName: aws.String(d.Get("repository_name").(string)),
+		Tags:           repositoryLinkTags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("encryption_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRepositoryLink(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CodeStar Connections Repository Link: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.RepositoryLinkInfo.RepositoryLinkId))
+
+	return resourceRepositoryLinkRead(ctx, d, meta)
+}
+
+func resourceRepositoryLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindRepositoryLinkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeStar Connections Repository Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.ToString(output.RepositoryLinkArn)
+	d.Set("arn", arn)
+	d.Set("connection_arn", output.ConnectionArn)
+	d.Set("encryption_key_arn", output.EncryptionKeyArn)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("provider_type", output.ProviderType)
+	d.Set("repository_link_id", output.RepositoryLinkId)
+	d.Set("repository_name", output.RepositoryName)
+
+	tags, err := ListTagsWithContext(ctx, meta.(*conns.AWSClient).CodeStarConnectionsConn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for CodeStar Connections Repository Link (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRepositoryLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	if d.HasChanges("connection_arn", "encryption_key_arn") {
+		input := &codestarconnections.UpdateRepositoryLinkInput{
+			ConnectionArn:    aws.String(d.Get("connection_arn").(string)),
+			RepositoryLinkId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("encryption_key_arn"); ok {
+			input.EncryptionKeyArn = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateRepositoryLink(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, meta.(*conns.AWSClient).CodeStarConnectionsConn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CodeStar Connections Repository Link (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRepositoryLinkRead(ctx, d, meta)
+}
+
+func resourceRepositoryLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Repository Link: %s", d.Id())
+	_, err := conn.DeleteRepositoryLink(ctx, &codestarconnections.DeleteRepositoryLinkInput{
+		RepositoryLinkId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindRepositoryLinkByID(ctx context.Context, conn *codestarconnections.Client, id string) (*types.RepositoryLinkInfo, error) {
+	input := &codestarconnections.GetRepositoryLinkInput{
+		RepositoryLinkId: aws.String(id),
+	}
+
+	output, err := conn.GetRepositoryLink(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RepositoryLinkInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RepositoryLinkInfo, nil
+}
+
+func repositoryLinkTags(tags tftags.KeyValueTags) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,236 @@
+package codestarconnections_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodestarconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCodeStarConnectionsRepositoryLink_basic(t *testing.T) {
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+	connectionARN := testAccRepositoryLinkConnectionARNFromEnv(t)
+	owner, repository := testAccRepositoryLinkRepositoryFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, repository),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "codestar-connections", regexp.MustCompile("repository-link/.+")),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", owner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", repository),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsRepositoryLink_disappears(t *testing.T) {
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+	connectionARN := testAccRepositoryLinkConnectionARNFromEnv(t)
+	owner, repository := testAccRepositoryLinkRepositoryFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, repository),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcodestarconnections.ResourceRepositoryLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsRepositoryLink_tags(t *testing.T) {
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+	connectionARN := testAccRepositoryLinkConnectionARNFromEnv(t)
+	owner, repository := testAccRepositoryLinkRepositoryFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, owner, repository, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags2(connectionARN, owner, repository, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, owner, repository, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryLinkExists(n string, v *types.RepositoryLinkInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No CodeStar Connections Repository Link ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient()
+
+		output, err := tfcodestarconnections.FindRepositoryLinkByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRepositoryLinkDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codestarconnections_repository_link" {
+			continue
+		}
+
+		_, err := tfcodestarconnections.FindRepositoryLinkByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CodeStar Connections Repository Link %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// Repository links can only be created against a connection in the AVAILABLE
+// state, which requires completing the provider handshake in the console.
+func testAccRepositoryLinkConnectionARNFromEnv(t *testing.T) string {
+	connectionARN := os.Getenv("CODESTAR_CONNECTIONS_CONNECTION_ARN")
+
+	if connectionARN == "" {
+		t.Skip("CODESTAR_CONNECTIONS_CONNECTION_ARN must be set to an AVAILABLE connection for this acceptance test")
+	}
+
+	return connectionARN
+}
+
+func testAccRepositoryLinkRepositoryFromEnv(t *testing.T) (string, string) {
+	repository := os.Getenv("CODESTAR_CONNECTIONS_REPOSITORY")
+	owner, name, found := strings.Cut(repository, "/")
+
+	if !found || owner == "" || name == "" {
+		t.Skip("CODESTAR_CONNECTIONS_REPOSITORY must be set to a repository (owner/name) reachable through the connection for this acceptance test")
+	}
+
+	return owner, name
+}
+
+func testAccRepositoryLinkConfig_basic(connectionARN, owner, repository string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+}
+`, connectionARN, owner, repository)
+}
+
+func testAccRepositoryLinkConfig_tags1(connectionARN, owner, repository, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, connectionARN, owner, repository, tagKey1, tagValue1)
+}
+
+func testAccRepositoryLinkConfig_tags2(connectionARN, owner, repository, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, connectionARN, owner, repository, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,270 @@
+package codestarconnections
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSyncConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSyncConfigurationCreate,
+		ReadWithoutTimeout:   resourceSyncConfigurationRead,
+		UpdateWithoutTimeout: resourceSyncConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSyncConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"config_file": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"publish_deployment_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.PublishDeploymentStatusEnabled),
+				ValidateDiagFunc: enum.Validate[types.PublishDeploymentStatus](),
+			},
+			"repository_link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sync_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.SyncConfigurationType](),
+			},
+			"trigger_resource_update_on": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.TriggerResourceUpdateOnAnyChange),
+				ValidateDiagFunc: enum.Validate[types.TriggerResourceUpdateOn](),
+			},
+		},
+	}
+}
+
+func resourceSyncConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	resourceName := d.Get("resource_name").(string)
+	syncType := d.Get("sync_type").(string)
+	id := SyncConfigurationCreateResourceID(resourceName, syncType)
+	input := &codestarconnections.CreateSyncConfigurationInput{
+		Branch:                  aws.String(d.Get("branch").(string)),
+		ConfigFile:              aws.String(d.Get("config_file").(string)),
+		PublishDeploymentStatus: types.PublishDeploymentStatus(d.Get("publish_deployment_status").(string)),
+		RepositoryLinkId:        aws.String(d.Get("repository_link_id").(string)),
+		ResourceName:            aws.String(resourceName),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		SyncType:                types.SyncConfigurationType(syncType),
+		TriggerResourceUpdateOn: types.TriggerResourceUpdateOn(d.Get("trigger_resource_update_on").(string)),
+	}
+
+	_, err := conn.CreateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CodeStar Connections Sync Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSyncConfigurationRead(ctx, d, meta)
+}
+
+func resourceSyncConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	resourceName, syncType, err := SyncConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSyncConfigurationByTwoPartKey(ctx, conn, resourceName, syncType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeStar Connections Sync Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("branch", output.Branch)
+	d.Set("config_file", output.ConfigFile)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("provider_type", output.ProviderType)
+	d.Set("publish_deployment_status", output.PublishDeploymentStatus)
+	d.Set("repository_link_id", output.RepositoryLinkId)
+	d.Set("repository_name", output.RepositoryName)
+	d.Set("resource_name", output.ResourceName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("sync_type", output.SyncType)
+	d.Set("trigger_resource_update_on", output.TriggerResourceUpdateOn)
+
+	return nil
+}
+
+func resourceSyncConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	resourceName, syncType, err := SyncConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &codestarconnections.UpdateSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     types.SyncConfigurationType(syncType),
+	}
+
+	if d.HasChange("branch") {
+		input.Branch = aws.String(d.Get("branch").(string))
+	}
+
+	if d.HasChange("config_file") {
+		input.ConfigFile = aws.String(d.Get("config_file").(string))
+	}
+
+	if d.HasChange("publish_deployment_status") {
+		input.PublishDeploymentStatus = types.PublishDeploymentStatus(d.Get("publish_deployment_status").(string))
+	}
+
+	if d.HasChange("repository_link_id") {
+		input.RepositoryLinkId = aws.String(d.Get("repository_link_id").(string))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("trigger_resource_update_on") {
+		input.TriggerResourceUpdateOn = types.TriggerResourceUpdateOn(d.Get("trigger_resource_update_on").(string))
+	}
+
+	_, err = conn.UpdateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceSyncConfigurationRead(ctx, d, meta)
+}
+
+func resourceSyncConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient()
+
+	resourceName, syncType, err := SyncConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Sync Configuration: %s", d.Id())
+	_, err = conn.DeleteSyncConfiguration(ctx, &codestarconnections.DeleteSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     types.SyncConfigurationType(syncType),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const syncConfigurationIDSeparator = ","
+
+func SyncConfigurationCreateResourceID(resourceName, syncType string) string {
+	parts := []string{resourceName, syncType}
+	id := strings.Join(parts, syncConfigurationIDSeparator)
+
+	return id
+}
+
+func SyncConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, syncConfigurationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ResourceName%[2]sSyncType", id, syncConfigurationIDSeparator)
+}
+
+func FindSyncConfigurationByTwoPartKey(ctx context.Context, conn *codestarconnections.Client, resourceName, syncType string) (*types.SyncConfiguration, error) {
+	input := &codestarconnections.GetSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     types.SyncConfigurationType(syncType),
+	}
+
+	output, err := conn.GetSyncConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SyncConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SyncConfiguration, nil
+}
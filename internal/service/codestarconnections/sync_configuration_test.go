@@ -0,0 +1,188 @@
+package codestarconnections_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodestarconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCodeStarConnectionsSyncConfiguration_basic(t *testing.T) {
+	var v types.SyncConfiguration
+	resourceName := "aws_codestarconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	connectionARN := testAccRepositoryLinkConnectionARNFromEnv(t)
+	owner, repository := testAccRepositoryLinkRepositoryFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repository, "main", "ANY_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "config_file", "deployment.yaml"),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", owner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", "aws_codestarconnections_repository_link.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", repository),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", "CFN_STACK_SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", "ANY_CHANGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repository, "release", "FILE_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch", "release"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", "FILE_CHANGE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsSyncConfiguration_disappears(t *testing.T) {
+	var v types.SyncConfiguration
+	resourceName := "aws_codestarconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	connectionARN := testAccRepositoryLinkConnectionARNFromEnv(t)
+	owner, repository := testAccRepositoryLinkRepositoryFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codestarconnections.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repository, "main", "ANY_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcodestarconnections.ResourceSyncConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSyncConfigurationExists(n string, v *types.SyncConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No CodeStar Connections Sync Configuration ID is set")
+		}
+
+		resourceName, syncType, err := tfcodestarconnections.SyncConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient()
+
+		output, err := tfcodestarconnections.FindSyncConfigurationByTwoPartKey(context.Background(), conn, resourceName, syncType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSyncConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codestarconnections_sync_configuration" {
+			continue
+		}
+
+		resourceName, syncType, err := tfcodestarconnections.SyncConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcodestarconnections.FindSyncConfigurationByTwoPartKey(context.Background(), conn, resourceName, syncType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CodeStar Connections Sync Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repository, branch, triggerResourceUpdateOn string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudformation.sync.codeconnections.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[2]q
+  owner_id        = %[3]q
+  repository_name = %[4]q
+}
+
+resource "aws_codestarconnections_sync_configuration" "test" {
+  branch                     = %[5]q
+  config_file                = "deployment.yaml"
+  repository_link_id         = aws_codestarconnections_repository_link.test.id
+  resource_name              = %[1]q
+  role_arn                   = aws_iam_role.test.arn
+  sync_type                  = "CFN_STACK_SYNC"
+  trigger_resource_update_on = %[6]q
+}
+`, rName, connectionARN, owner, repository, branch, triggerResourceUpdateOn)
+}
@@ -206,6 +206,86 @@ func ResourceTask() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"task_report_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.ReportOutputType_Values(), false),
+						},
+						"report_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+						},
+						"report_overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"deleted_override": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+									},
+									"skipped_override": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+									},
+									"transferred_override": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+									},
+									"verified_override": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_access_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"s3_bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"subdirectory": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"s3_object_versioning": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datasync.ObjectVersionIds_Values(), false),
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -244,6 +324,10 @@ func resourceTaskCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Schedule = expandTaskSchedule(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("task_report_config"); ok {
+		input.TaskReportConfig = expandTaskReportConfig(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating DataSync Task: %s", input)
 	output, err := conn.CreateTask(input)
 
@@ -294,6 +378,9 @@ func resourceTaskRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting schedule: %w", err)
 	}
 	d.Set("source_location_arn", output.SourceLocationArn)
+	if err := d.Set("task_report_config", flattenTaskReportConfig(output.TaskReportConfig)); err != nil {
+		return fmt.Errorf("error setting task_report_config: %w", err)
+	}
 
 	tags, err := ListTags(conn, d.Id())
 
@@ -347,6 +434,15 @@ func resourceTaskUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.Schedule = expandTaskSchedule(d.Get("schedule").([]interface{}))
 		}
 
+		if d.HasChanges("task_report_config") {
+			input.TaskReportConfig = expandTaskReportConfig(d.Get("task_report_config").([]interface{}))
+
+			// An empty configuration turns task reports off.
+			if input.TaskReportConfig == nil {
+				input.TaskReportConfig = &datasync.TaskReportConfig{}
+			}
+		}
+
 		log.Printf("[DEBUG] Updating DataSync Task: %s", input)
 		if _, err := conn.UpdateTask(input); err != nil {
 			return fmt.Errorf("error updating DataSync Task (%s): %w", d.Id(), err)
@@ -469,6 +565,145 @@ func flattenTaskSchedule(schedule *datasync.TaskSchedule) []interface{} {
 	return []interface{}{m}
 }
 
+func expandTaskReportConfig(l []interface{}) *datasync.TaskReportConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	reportConfig := &datasync.TaskReportConfig{
+		Destination: expandReportDestinationS3(m["s3_destination"].([]interface{})),
+	}
+
+	if v, ok := m["output_type"].(string); ok && v != "" {
+		reportConfig.OutputType = aws.String(v)
+	}
+
+	if v, ok := m["report_level"].(string); ok && v != "" {
+		reportConfig.ReportLevel = aws.String(v)
+	}
+
+	if v, ok := m["report_overrides"].([]interface{}); ok && len(v) > 0 {
+		reportConfig.Overrides = expandReportOverrides(v)
+	}
+
+	if v, ok := m["s3_object_versioning"].(string); ok && v != "" {
+		reportConfig.ObjectVersionIds = aws.String(v)
+	}
+
+	return reportConfig
+}
+
+func expandReportDestinationS3(l []interface{}) *datasync.ReportDestination {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	destination := &datasync.ReportDestinationS3{
+		BucketAccessRoleArn: aws.String(m["bucket_access_role_arn"].(string)),
+		S3BucketArn:         aws.String(m["s3_bucket_arn"].(string)),
+	}
+
+	if v, ok := m["subdirectory"].(string); ok && v != "" {
+		destination.Subdirectory = aws.String(v)
+	}
+
+	return &datasync.ReportDestination{
+		S3: destination,
+	}
+}
+
+func expandReportOverrides(l []interface{}) *datasync.ReportOverrides {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	overrides := &datasync.ReportOverrides{}
+
+	if v, ok := m["deleted_override"].(string); ok && v != "" {
+		overrides.Deleted = &datasync.ReportOverride{ReportLevel: aws.String(v)}
+	}
+
+	if v, ok := m["skipped_override"].(string); ok && v != "" {
+		overrides.Skipped = &datasync.ReportOverride{ReportLevel: aws.String(v)}
+	}
+
+	if v, ok := m["transferred_override"].(string); ok && v != "" {
+		overrides.Transferred = &datasync.ReportOverride{ReportLevel: aws.String(v)}
+	}
+
+	if v, ok := m["verified_override"].(string); ok && v != "" {
+		overrides.Verified = &datasync.ReportOverride{ReportLevel: aws.String(v)}
+	}
+
+	return overrides
+}
+
+func flattenTaskReportConfig(reportConfig *datasync.TaskReportConfig) []interface{} {
+	if reportConfig == nil || reportConfig.Destination == nil || reportConfig.Destination.S3 == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"output_type":          aws.StringValue(reportConfig.OutputType),
+		"report_level":         aws.StringValue(reportConfig.ReportLevel),
+		"report_overrides":     flattenReportOverrides(reportConfig.Overrides),
+		"s3_destination":       flattenReportDestinationS3(reportConfig.Destination.S3),
+		"s3_object_versioning": aws.StringValue(reportConfig.ObjectVersionIds),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenReportDestinationS3(destination *datasync.ReportDestinationS3) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket_access_role_arn": aws.StringValue(destination.BucketAccessRoleArn),
+		"s3_bucket_arn":          aws.StringValue(destination.S3BucketArn),
+		"subdirectory":           aws.StringValue(destination.Subdirectory),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenReportOverrides(overrides *datasync.ReportOverrides) []interface{} {
+	if overrides == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if overrides.Deleted != nil {
+		m["deleted_override"] = aws.StringValue(overrides.Deleted.ReportLevel)
+	}
+
+	if overrides.Skipped != nil {
+		m["skipped_override"] = aws.StringValue(overrides.Skipped.ReportLevel)
+	}
+
+	if overrides.Transferred != nil {
+		m["transferred_override"] = aws.StringValue(overrides.Transferred.ReportLevel)
+	}
+
+	if overrides.Verified != nil {
+		m["verified_override"] = aws.StringValue(overrides.Verified.ReportLevel)
+	}
+
+	if len(m) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{m}
+}
+
 func expandFilterRules(l []interface{}) []*datasync.FilterRule {
 	filterRules := []*datasync.FilterRule{}
 
@@ -128,6 +128,50 @@ func TestAccDataSyncTask_schedule(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
+	var task1 datasync.DescribeTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datasync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskConfig_taskReportConfig(rName, "ERRORS_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.output_type", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_level", "ERRORS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_overrides.0.deleted_override", "SUCCESSES_AND_ERRORS"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_report_config.0.s3_destination.0.bucket_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_report_config.0.s3_destination.0.s3_bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.s3_destination.0.subdirectory", "reports/"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.s3_object_versioning", "INCLUDE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTaskConfig_taskReportConfig(rName, "SUCCESSES_AND_ERRORS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_level", "SUCCESSES_AND_ERRORS"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncTask_cloudWatchLogGroupARN(t *testing.T) {
 	var task1 datasync.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1061,6 +1105,35 @@ resource "aws_datasync_task" "test" {
 `, rName, cron))
 }
 
+func testAccTaskConfig_taskReportConfig(rName, reportLevel string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseLocationS3(rName),
+		testAccTaskConfig_baseLocationNFS(rName),
+		fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_nfs.test.arn
+
+  task_report_config {
+    output_type          = "STANDARD"
+    report_level         = %[2]q
+    s3_object_versioning = "INCLUDE"
+
+    report_overrides {
+      deleted_override = "SUCCESSES_AND_ERRORS"
+    }
+
+    s3_destination {
+      bucket_access_role_arn = aws_iam_role.test.arn
+      s3_bucket_arn          = aws_s3_bucket.test.arn
+      subdirectory           = "reports/"
+    }
+  }
+}
+`, rName, reportLevel))
+}
+
 func testAccTaskConfig_cloudWatchLogGroupARN(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTaskConfig_baseLocationS3(rName),
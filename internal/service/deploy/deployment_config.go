@@ -1,24 +1,30 @@
 package deploy
 
 import (
-	"fmt"
+	"context"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/codedeploy"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceDeploymentConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDeploymentConfigCreate,
-		Read:   resourceDeploymentConfigRead,
-		Delete: resourceDeploymentConfigDelete,
+		CreateWithoutTimeout: resourceDeploymentConfigCreate,
+		ReadWithoutTimeout:   resourceDeploymentConfigRead,
+		DeleteWithoutTimeout: resourceDeploymentConfigDelete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -29,15 +35,11 @@ func ResourceDeploymentConfig() *schema.Resource {
 			},
 
 			"compute_platform": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					codedeploy.ComputePlatformServer,
-					codedeploy.ComputePlatformLambda,
-					codedeploy.ComputePlatformEcs,
-				}, false),
-				Default: codedeploy.ComputePlatformServer,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ComputePlatform](),
+				Default:          string(types.ComputePlatformServer),
 			},
 
 			"minimum_healthy_hosts": {
@@ -48,13 +50,10 @@ func ResourceDeploymentConfig() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								codedeploy.MinimumHealthyHostsTypeHostCount,
-								codedeploy.MinimumHealthyHostsTypeFleetPercent,
-							}, false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsType](),
 						},
 						"value": {
 							Type:     schema.TypeInt,
@@ -73,15 +72,11 @@ func ResourceDeploymentConfig() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								codedeploy.TrafficRoutingTypeAllAtOnce,
-								codedeploy.TrafficRoutingTypeTimeBasedCanary,
-								codedeploy.TrafficRoutingTypeTimeBasedLinear,
-							}, false),
-							Default: codedeploy.TrafficRoutingTypeAllAtOnce,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.TrafficRoutingType](),
+							Default:          string(types.TrafficRoutingTypeAllAtOnce),
 						},
 
 						"time_based_canary": {
@@ -131,6 +126,50 @@ func ResourceDeploymentConfig() *schema.Resource {
 				},
 			},
 
+			"zonal_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_zone_monitor_duration_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"minimum_healthy_hosts_per_zone": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsPerZoneType](),
+									},
+									"value": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"monitor_duration_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
 			"deployment_config_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -139,100 +178,132 @@ func ResourceDeploymentConfig() *schema.Resource {
 	}
 }
 
-func resourceDeploymentConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DeployConn
+func resourceDeploymentConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployClient()
 
+	name := d.Get("deployment_config_name").(string)
 	input := &codedeploy.CreateDeploymentConfigInput{
-		DeploymentConfigName: aws.String(d.Get("deployment_config_name").(string)),
-		ComputePlatform:      aws.String(d.Get("compute_platform").(string)),
+		DeploymentConfigName: aws.String(name),
+		ComputePlatform:      types.ComputePlatform(d.Get("compute_platform").(string)),
 		MinimumHealthyHosts:  expandMinimumHealthHostsConfig(d),
 		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
+		ZonalConfig:          expandZonalConfig(d),
 	}
 
-	_, err := conn.CreateDeploymentConfig(input)
+	_, err := conn.CreateDeploymentConfig(ctx, input)
+
 	if err != nil {
-		return err
+		return diag.Errorf("creating CodeDeploy Deployment Config (%s): %s", name, err)
 	}
 
-	d.SetId(d.Get("deployment_config_name").(string))
+	d.SetId(name)
 
-	return resourceDeploymentConfigRead(d, meta)
+	return resourceDeploymentConfigRead(ctx, d, meta)
 }
 
-func resourceDeploymentConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DeployConn
+func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployClient()
 
-	input := &codedeploy.GetDeploymentConfigInput{
-		DeploymentConfigName: aws.String(d.Id()),
-	}
+	config, err := FindDeploymentConfigByName(ctx, conn, d.Id())
 
-	resp, err := conn.GetDeploymentConfig(input)
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, codedeploy.ErrCodeDeploymentConfigDoesNotExistException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] CodeDeploy Deployment Config (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("finding CodeDeploy Deployment Config (%s): %w", d.Id(), err)
+		return diag.Errorf("reading CodeDeploy Deployment Config (%s): %s", d.Id(), err)
 	}
 
-	if resp.DeploymentConfigInfo == nil {
-		return fmt.Errorf("Cannot find DeploymentConfig %q", d.Id())
+	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHostsConfig(config.MinimumHealthyHosts)); err != nil {
+		return diag.Errorf("setting minimum_healthy_hosts: %s", err)
 	}
 
-	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHostsConfig(resp.DeploymentConfigInfo.MinimumHealthyHosts)); err != nil {
-		return err
+	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(config.TrafficRoutingConfig)); err != nil {
+		return diag.Errorf("setting traffic_routing_config: %s", err)
 	}
 
-	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(resp.DeploymentConfigInfo.TrafficRoutingConfig)); err != nil {
-		return err
+	if err := d.Set("zonal_config", flattenZonalConfig(config.ZonalConfig)); err != nil {
+		return diag.Errorf("setting zonal_config: %s", err)
 	}
 
-	d.Set("deployment_config_id", resp.DeploymentConfigInfo.DeploymentConfigId)
-	d.Set("deployment_config_name", resp.DeploymentConfigInfo.DeploymentConfigName)
-	d.Set("compute_platform", resp.DeploymentConfigInfo.ComputePlatform)
+	d.Set("deployment_config_id", config.DeploymentConfigId)
+	d.Set("deployment_config_name", config.DeploymentConfigName)
+	d.Set("compute_platform", config.ComputePlatform)
 
 	return nil
 }
 
-func resourceDeploymentConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DeployConn
+func resourceDeploymentConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeployClient()
 
-	input := &codedeploy.DeleteDeploymentConfigInput{
+	log.Printf("[INFO] Deleting CodeDeploy Deployment Config: %s", d.Id())
+	_, err := conn.DeleteDeploymentConfig(ctx, &codedeploy.DeleteDeploymentConfigInput{
 		DeploymentConfigName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.DeploymentConfigDoesNotExistException](err) {
+		return nil
 	}
 
-	_, err := conn.DeleteDeploymentConfig(input)
-	return err
+	if err != nil {
+		return diag.Errorf("deleting CodeDeploy Deployment Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
 }
 
-func expandMinimumHealthHostsConfig(d *schema.ResourceData) *codedeploy.MinimumHealthyHosts {
+func FindDeploymentConfigByName(ctx context.Context, conn *codedeploy.Client, name string) (*types.DeploymentConfigInfo, error) {
+	input := &codedeploy.GetDeploymentConfigInput{
+		DeploymentConfigName: aws.String(name),
+	}
+
+	output, err := conn.GetDeploymentConfig(ctx, input)
+
+	if errs.IsA[*types.DeploymentConfigDoesNotExistException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeploymentConfigInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeploymentConfigInfo, nil
+}
+
+func expandMinimumHealthHostsConfig(d *schema.ResourceData) *types.MinimumHealthyHosts {
 	hosts, ok := d.GetOk("minimum_healthy_hosts")
 	if !ok {
 		return nil
 	}
 	host := hosts.([]interface{})[0].(map[string]interface{})
 
-	minimumHealthyHost := codedeploy.MinimumHealthyHosts{
-		Type:  aws.String(host["type"].(string)),
-		Value: aws.Int64(int64(host["value"].(int))),
+	minimumHealthyHost := types.MinimumHealthyHosts{
+		Type:  types.MinimumHealthyHostsType(host["type"].(string)),
+		Value: int32(host["value"].(int)),
 	}
 
 	return &minimumHealthyHost
 }
 
-func expandTrafficRoutingConfig(d *schema.ResourceData) *codedeploy.TrafficRoutingConfig {
+func expandTrafficRoutingConfig(d *schema.ResourceData) *types.TrafficRoutingConfig {
 	block, ok := d.GetOk("traffic_routing_config")
 	if !ok {
 		return nil
 	}
 	config := block.([]interface{})[0].(map[string]interface{})
-	trafficRoutingConfig := codedeploy.TrafficRoutingConfig{}
+	trafficRoutingConfig := types.TrafficRoutingConfig{}
 
 	if trafficType, ok := config["type"]; ok {
-		trafficRoutingConfig.Type = aws.String(trafficType.(string))
+		trafficRoutingConfig.Type = types.TrafficRoutingType(trafficType.(string))
 	}
 	if canary, ok := config["time_based_canary"]; ok && len(canary.([]interface{})) > 0 {
 		canaryConfig := canary.([]interface{})[0].(map[string]interface{})
@@ -246,29 +317,54 @@ func expandTrafficRoutingConfig(d *schema.ResourceData) *codedeploy.TrafficRouti
 	return &trafficRoutingConfig
 }
 
-func expandTrafficTimeBasedCanaryConfig(config map[string]interface{}) *codedeploy.TimeBasedCanary {
-	canary := codedeploy.TimeBasedCanary{}
+func expandTrafficTimeBasedCanaryConfig(config map[string]interface{}) *types.TimeBasedCanary {
+	canary := types.TimeBasedCanary{}
 	if interval, ok := config["interval"]; ok {
-		canary.CanaryInterval = aws.Int64(int64(interval.(int)))
+		canary.CanaryInterval = int32(interval.(int))
 	}
 	if percentage, ok := config["percentage"]; ok {
-		canary.CanaryPercentage = aws.Int64(int64(percentage.(int)))
+		canary.CanaryPercentage = int32(percentage.(int))
 	}
 	return &canary
 }
 
-func expandTrafficTimeBasedLinearConfig(config map[string]interface{}) *codedeploy.TimeBasedLinear {
-	linear := codedeploy.TimeBasedLinear{}
+func expandTrafficTimeBasedLinearConfig(config map[string]interface{}) *types.TimeBasedLinear {
+	linear := types.TimeBasedLinear{}
 	if interval, ok := config["interval"]; ok {
-		linear.LinearInterval = aws.Int64(int64(interval.(int)))
+		linear.LinearInterval = int32(interval.(int))
 	}
 	if percentage, ok := config["percentage"]; ok {
-		linear.LinearPercentage = aws.Int64(int64(percentage.(int)))
+		linear.LinearPercentage = int32(percentage.(int))
 	}
 	return &linear
 }
 
-func flattenMinimumHealthHostsConfig(hosts *codedeploy.MinimumHealthyHosts) []map[string]interface{} {
+func expandZonalConfig(d *schema.ResourceData) *types.ZonalConfig {
+	block, ok := d.GetOk("zonal_config")
+	if !ok || block.([]interface{})[0] == nil {
+		return nil
+	}
+	config := block.([]interface{})[0].(map[string]interface{})
+	zonalConfig := types.ZonalConfig{}
+
+	if v, ok := config["first_zone_monitor_duration_in_seconds"].(int); ok && v != 0 {
+		zonalConfig.FirstZoneMonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+	if v, ok := config["minimum_healthy_hosts_per_zone"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		hosts := v[0].(map[string]interface{})
+		zonalConfig.MinimumHealthyHostsPerZone = &types.MinimumHealthyHostsPerZone{
+			Type:  types.MinimumHealthyHostsPerZoneType(hosts["type"].(string)),
+			Value: int32(hosts["value"].(int)),
+		}
+	}
+	if v, ok := config["monitor_duration_in_seconds"].(int); ok && v != 0 {
+		zonalConfig.MonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+
+	return &zonalConfig
+}
+
+func flattenMinimumHealthHostsConfig(hosts *types.MinimumHealthyHosts) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	if hosts == nil {
 		return result
@@ -276,13 +372,13 @@ func flattenMinimumHealthHostsConfig(hosts *codedeploy.MinimumHealthyHosts) []ma
 
 	item := make(map[string]interface{})
 
-	item["type"] = aws.StringValue(hosts.Type)
-	item["value"] = aws.Int64Value(hosts.Value)
+	item["type"] = string(hosts.Type)
+	item["value"] = hosts.Value
 
 	return append(result, item)
 }
 
-func flattenTrafficRoutingConfig(config *codedeploy.TrafficRoutingConfig) []map[string]interface{} {
+func flattenTrafficRoutingConfig(config *types.TrafficRoutingConfig) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	if config == nil {
 		return result
@@ -290,35 +386,56 @@ func flattenTrafficRoutingConfig(config *codedeploy.TrafficRoutingConfig) []map[
 
 	item := make(map[string]interface{})
 
-	item["type"] = aws.StringValue(config.Type)
+	item["type"] = string(config.Type)
 	item["time_based_canary"] = flattenTrafficRoutingCanaryConfig(config.TimeBasedCanary)
 	item["time_based_linear"] = flattenTrafficRoutingLinearConfig(config.TimeBasedLinear)
 
 	return append(result, item)
 }
 
-func flattenTrafficRoutingCanaryConfig(canary *codedeploy.TimeBasedCanary) []map[string]interface{} {
+func flattenTrafficRoutingCanaryConfig(canary *types.TimeBasedCanary) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	if canary == nil {
 		return result
 	}
 
 	item := make(map[string]interface{})
-	item["interval"] = aws.Int64Value(canary.CanaryInterval)
-	item["percentage"] = aws.Int64Value(canary.CanaryPercentage)
+	item["interval"] = canary.CanaryInterval
+	item["percentage"] = canary.CanaryPercentage
 
 	return append(result, item)
 }
 
-func flattenTrafficRoutingLinearConfig(linear *codedeploy.TimeBasedLinear) []map[string]interface{} {
+func flattenTrafficRoutingLinearConfig(linear *types.TimeBasedLinear) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	if linear == nil {
 		return result
 	}
 
 	item := make(map[string]interface{})
-	item["interval"] = aws.Int64Value(linear.LinearInterval)
-	item["percentage"] = aws.Int64Value(linear.LinearPercentage)
+	item["interval"] = linear.LinearInterval
+	item["percentage"] = linear.LinearPercentage
+
+	return append(result, item)
+}
+
+func flattenZonalConfig(config *types.ZonalConfig) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	// Deployment configurations created without zonal settings may return an empty object.
+	if config == nil || (config.FirstZoneMonitorDurationInSeconds == nil && config.MinimumHealthyHostsPerZone == nil && config.MonitorDurationInSeconds == nil) {
+		return result
+	}
+
+	item := make(map[string]interface{})
+	item["first_zone_monitor_duration_in_seconds"] = aws.ToInt64(config.FirstZoneMonitorDurationInSeconds)
+	item["monitor_duration_in_seconds"] = aws.ToInt64(config.MonitorDurationInSeconds)
+
+	if hosts := config.MinimumHealthyHostsPerZone; hosts != nil {
+		item["minimum_healthy_hosts_per_zone"] = []map[string]interface{}{{
+			"type":  string(hosts.Type),
+			"value": hosts.Value,
+		}}
+	}
 
 	return append(result, item)
 }
@@ -1,22 +1,25 @@
 package deploy_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdeploy "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccDeployDeploymentConfig_basic(t *testing.T) {
-	var config1 codedeploy.DeploymentConfigInfo
+	var config1 types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -45,7 +48,7 @@ func TestAccDeployDeploymentConfig_basic(t *testing.T) {
 }
 
 func TestAccDeployDeploymentConfig_fleetPercent(t *testing.T) {
-	var config1, config2 codedeploy.DeploymentConfigInfo
+	var config1, config2 types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -88,7 +91,7 @@ func TestAccDeployDeploymentConfig_fleetPercent(t *testing.T) {
 }
 
 func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
-	var config1, config2 codedeploy.DeploymentConfigInfo
+	var config1, config2 types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -131,7 +134,7 @@ func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
 }
 
 func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
-	var config1, config2 codedeploy.DeploymentConfigInfo
+	var config1, config2 types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -180,7 +183,7 @@ func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
 }
 
 func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
-	var config1, config2 codedeploy.DeploymentConfigInfo
+	var config1, config2 types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -228,60 +231,87 @@ func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
+	var config1 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_zonalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentConfigDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeployConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_deployment_config" {
 			continue
 		}
 
-		resp, err := conn.GetDeploymentConfig(&codedeploy.GetDeploymentConfigInput{
-			DeploymentConfigName: aws.String(rs.Primary.ID),
-		})
+		_, err := tfdeploy.FindDeploymentConfigByName(context.Background(), conn, rs.Primary.ID)
 
-		if tfawserr.ErrCodeEquals(err, codedeploy.ErrCodeDeploymentConfigDoesNotExistException) {
+		if tfresource.NotFound(err) {
 			continue
 		}
 
-		if err == nil {
-			if resp.DeploymentConfigInfo != nil {
-				return fmt.Errorf("CodeDeploy deployment config still exists:\n%#v", *resp.DeploymentConfigInfo.DeploymentConfigName)
-			}
+		if err != nil {
+			return err
 		}
 
-		return err
+		return fmt.Errorf("CodeDeploy Deployment Config %s still exists", rs.Primary.ID)
 	}
 
 	return nil
 }
 
-func testAccCheckDeploymentConfigExists(name string, config *codedeploy.DeploymentConfigInfo) resource.TestCheckFunc {
+func testAccCheckDeploymentConfigExists(name string, config *types.DeploymentConfigInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient()
 
-		resp, err := conn.GetDeploymentConfig(&codedeploy.GetDeploymentConfigInput{
-			DeploymentConfigName: aws.String(rs.Primary.ID),
-		})
+		output, err := tfdeploy.FindDeploymentConfigByName(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		*config = *resp.DeploymentConfigInfo
+		*config = *output
 
 		return nil
 	}
 }
 
-func testAccCheckDeploymentConfigRecreated(i, j *codedeploy.DeploymentConfigInfo) resource.TestCheckFunc {
+func testAccCheckDeploymentConfigRecreated(i, j *types.DeploymentConfigInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if aws.TimeValue(i.CreateTime).Equal(aws.TimeValue(j.CreateTime)) {
+		if aws.ToTime(i.CreateTime).Equal(aws.ToTime(j.CreateTime)) {
 			return errors.New("CodeDeploy Deployment Config was not recreated")
 		}
 
@@ -350,3 +380,26 @@ resource "aws_codedeploy_deployment_config" "test" {
 }
 `, rName, interval, percentage)
 }
+
+func testAccDeploymentConfigConfig_zonalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+
+  zonal_config {
+    first_zone_monitor_duration_in_seconds = 60
+    monitor_duration_in_seconds            = 30
+
+    minimum_healthy_hosts_per_zone {
+      type  = "FLEET_PERCENT"
+      value = 50
+    }
+  }
+}
+`, rName)
+}
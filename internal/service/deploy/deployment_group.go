@@ -168,7 +168,7 @@ func ResourceDeploymentGroup() *schema.Resource {
 									"termination_wait_time_in_minutes": {
 										Type:         schema.TypeInt,
 										Optional:     true,
-										ValidateFunc: validation.IntAtMost(2880),
+										ValidateFunc: validation.IntBetween(0, 2880),
 									},
 								},
 							},
@@ -323,7 +323,10 @@ func ResourceDeploymentGroup() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Set:      schema.HashString,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(codedeploy.AutoRollbackEvent_Values(), false),
+							},
 						},
 					},
 				},
@@ -343,6 +346,9 @@ func ResourceDeploymentGroup() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 100),
 			},
 
+			"last_attempted_deployment":  lastDeploymentInfoSchema(),
+			"last_successful_deployment": lastDeploymentInfoSchema(),
+
 			"ec2_tag_set": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -447,6 +453,13 @@ func ResourceDeploymentGroup() *schema.Resource {
 				Set: resourceTagFilterHash,
 			},
 
+			"outdated_instances_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codedeploy.OutdatedInstancesStrategyUpdate,
+				ValidateFunc: validation.StringInSlice(codedeploy.OutdatedInstancesStrategy_Values(), false),
+			},
+
 			"trigger_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -484,6 +497,33 @@ func ResourceDeploymentGroup() *schema.Resource {
 	}
 }
 
+func lastDeploymentInfoSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"deployment_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DeployConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -521,6 +561,10 @@ func resourceDeploymentGroupCreate(d *schema.ResourceData, meta interface{}) err
 		input.Ec2TagSet = buildEC2TagSet(attr.(*schema.Set).List())
 	}
 
+	if attr, ok := d.GetOk("outdated_instances_strategy"); ok {
+		input.OutdatedInstancesStrategy = aws.String(attr.(string))
+	}
+
 	if attr, ok := d.GetOk("ec2_tag_filter"); ok {
 		input.Ec2TagFilters = buildEC2TagFilters(attr.(*schema.Set).List())
 	}
@@ -624,8 +668,17 @@ func resourceDeploymentGroupRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("deployment_group_name", group.DeploymentGroupName)
 	d.Set("deployment_group_id", group.DeploymentGroupId)
 	d.Set("compute_platform", group.ComputePlatform)
+	d.Set("outdated_instances_strategy", group.OutdatedInstancesStrategy)
 	d.Set("service_role_arn", group.ServiceRoleArn)
 
+	if err := d.Set("last_attempted_deployment", flattenLastDeploymentInfo(group.LastAttemptedDeployment)); err != nil {
+		return fmt.Errorf("error setting last_attempted_deployment: %w", err)
+	}
+
+	if err := d.Set("last_successful_deployment", flattenLastDeploymentInfo(group.LastSuccessfulDeployment)); err != nil {
+		return fmt.Errorf("error setting last_successful_deployment: %w", err)
+	}
+
 	autoScalingGroups := make([]string, len(group.AutoScalingGroups))
 	for i, autoScalingGroup := range group.AutoScalingGroups {
 		autoScalingGroups[i] = aws.StringValue(autoScalingGroup.Name)
@@ -715,7 +768,9 @@ func resourceDeploymentGroupUpdate(d *schema.ResourceData, meta interface{}) err
 			input.CurrentDeploymentGroupName = aws.String(d.Get("deployment_group_name").(string))
 		}
 
-		if d.HasChange("deployment_style") {
+		// Blue/green settings are validated against the deployment style and load balancer
+		// of the group, so resend those alongside any blue/green change.
+		if d.HasChanges("deployment_style", "blue_green_deployment_config") {
 			_, n := d.GetChange("deployment_style")
 			input.DeploymentStyle = ExpandDeploymentStyle(n.([]interface{}))
 		}
@@ -750,7 +805,11 @@ func resourceDeploymentGroupUpdate(d *schema.ResourceData, meta interface{}) err
 			input.Ec2TagFilters = ec2Filters
 		}
 
-		if d.HasChange("ecs_service") {
+		if d.HasChange("outdated_instances_strategy") {
+			input.OutdatedInstancesStrategy = aws.String(d.Get("outdated_instances_strategy").(string))
+		}
+
+		if d.HasChanges("ecs_service", "blue_green_deployment_config") {
 			input.EcsServices = expandECSServices(d.Get("ecs_service").([]interface{}))
 		}
 
@@ -760,17 +819,18 @@ func resourceDeploymentGroupUpdate(d *schema.ResourceData, meta interface{}) err
 			input.TriggerConfigurations = triggerConfigs
 		}
 
-		if d.HasChange("auto_rollback_configuration") {
+		// Rollback on alarm requires the alarm list, so the two configurations are updated together.
+		if d.HasChanges("auto_rollback_configuration", "alarm_configuration") {
 			_, n := d.GetChange("auto_rollback_configuration")
 			input.AutoRollbackConfiguration = BuildAutoRollbackConfig(n.([]interface{}))
 		}
 
-		if d.HasChange("alarm_configuration") {
+		if d.HasChanges("alarm_configuration", "auto_rollback_configuration") {
 			_, n := d.GetChange("alarm_configuration")
 			input.AlarmConfiguration = BuildAlarmConfig(n.([]interface{}))
 		}
 
-		if d.HasChange("load_balancer_info") {
+		if d.HasChanges("load_balancer_info", "blue_green_deployment_config") {
 			_, n := d.GetChange("load_balancer_info")
 			input.LoadBalancerInfo = ExpandLoadBalancerInfo(n.([]interface{}))
 		}
@@ -1239,7 +1299,7 @@ func AutoRollbackConfigToMap(config *codedeploy.AutoRollbackConfiguration) []map
 
 	// only create configurations that are enabled or temporarily disabled (retaining events)
 	// otherwise empty configurations will be created
-	if config != nil && (*config.Enabled || len(config.Events) > 0) {
+	if config != nil && (aws.BoolValue(config.Enabled) || len(config.Events) > 0) {
 		item := make(map[string]interface{})
 		item["enabled"] = aws.BoolValue(config.Enabled)
 		item["events"] = flex.FlattenStringSet(config.Events)
@@ -1256,7 +1316,7 @@ func AlarmConfigToMap(config *codedeploy.AlarmConfiguration) []map[string]interf
 
 	// only create configurations that are enabled or temporarily disabled (retaining alarms)
 	// otherwise empty configurations will be created
-	if config != nil && (*config.Enabled || len(config.Alarms) > 0) {
+	if config != nil && (aws.BoolValue(config.Enabled) || len(config.Alarms) > 0) {
 		names := make([]*string, 0, len(config.Alarms))
 		for _, alarm := range config.Alarms {
 			names = append(names, alarm.Name)
@@ -1273,6 +1333,27 @@ func AlarmConfigToMap(config *codedeploy.AlarmConfiguration) []map[string]interf
 	return result
 }
 
+func flattenLastDeploymentInfo(apiObject *codedeploy.LastDeploymentInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"deployment_id": aws.StringValue(apiObject.DeploymentId),
+		"status":        aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.CreateTime; v != nil {
+		m["create_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		m["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
+
 func flattenECSServices(ecsServices []*codedeploy.ECSService) []interface{} {
 	l := make([]interface{}, 0)
 
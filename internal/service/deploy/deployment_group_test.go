@@ -56,6 +56,9 @@ func TestAccDeployDeploymentGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "trigger_configuration.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
 					resource.TestCheckResourceAttrSet(resourceName, "deployment_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "last_attempted_deployment.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "last_successful_deployment.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outdated_instances_strategy", "UPDATE"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -583,6 +586,86 @@ func TestAccDeployDeploymentGroup_AutoRollback_disable(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentGroup_outdatedInstancesStrategy(t *testing.T) {
+	var group codedeploy.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_outdatedInstancesStrategy(rName, "IGNORE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "outdated_instances_strategy", "IGNORE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDeploymentGroupImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentGroupConfig_outdatedInstancesStrategy(rName, "UPDATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "outdated_instances_strategy", "UPDATE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroup_AutoRollback_alarm(t *testing.T) {
+	var group codedeploy.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_autoRollbackConfigurationAlarm(rName, `"test-alarm"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.0.events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_rollback_configuration.0.events.*", "DEPLOYMENT_STOP_ON_ALARM"),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_autoRollbackConfigurationAlarm(rName, `"test-alarm", "test-alarm-2", "test-alarm-3"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarms.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "alarm_configuration.0.alarms.*", "test-alarm-3"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_rollback_configuration.0.events.*", "DEPLOYMENT_STOP_ON_ALARM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDeploymentGroupImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentGroup_Alarm_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
@@ -1755,6 +1838,46 @@ func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentGroup_ECS_blueGreenTerminationWait(t *testing.T) {
+	var group codedeploy.DeploymentGroupInfo
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	resourceName := "aws_codedeploy_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codedeploy.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_ecsBlueGreenTerminationWait(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.action", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.0.target_group_pair_info.0.target_group.#", "2"),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_ecsBlueGreenTerminationWait(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.action", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.0.target_group_pair_info.0.target_group.#", "2"),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_ecsBlueGreenTerminationWait(rName, 2880),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "2880"),
+				),
+			},
+		},
+	})
+}
+
 func TestDeploymentGroup_buildTriggerConfigs(t *testing.T) {
 	input := []interface{}{
 		map[string]interface{}{
@@ -2221,6 +2344,30 @@ func TestDeploymentGroup_alarmConfigToMap(t *testing.T) {
 	}
 }
 
+func TestDeploymentGroup_alarmConfigToMapNilEnabled(t *testing.T) {
+	input := &codedeploy.AlarmConfiguration{
+		Alarms: []*codedeploy.Alarm{
+			{
+				Name: aws.String("test-alarm"),
+			},
+		},
+	}
+
+	actual := tfcodedeploy.AlarmConfigToMap(input)
+
+	if len(actual) != 1 {
+		t.Fatalf("tfcodedeploy.AlarmConfigToMap returned %d items, expected 1", len(actual))
+	}
+
+	if actual[0]["enabled"] != false {
+		t.Fatalf("tfcodedeploy.AlarmConfigToMap enabled is %#v, expected false", actual[0]["enabled"])
+	}
+
+	if actual := tfcodedeploy.AlarmConfigToMap(&codedeploy.AlarmConfiguration{}); len(actual) != 0 {
+		t.Fatalf("tfcodedeploy.AlarmConfigToMap returned %d items for an empty configuration, expected 0", len(actual))
+	}
+}
+
 func testAccCheckDeploymentGroupTriggerEvents(group *codedeploy.DeploymentGroupInfo, triggerName string, expectedEvents []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		found := false
@@ -3650,3 +3797,82 @@ resource "aws_codedeploy_deployment_group" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccDeploymentGroupConfig_outdatedInstancesStrategy(rName, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name                    = aws_codedeploy_app.test.name
+  deployment_group_name       = "tf-acc-test-%[1]s"
+  service_role_arn            = aws_iam_role.test.arn
+  outdated_instances_strategy = %[2]q
+}
+`, rName, strategy) + testAccDeploymentGroupConfig_base(rName)
+}
+
+func testAccDeploymentGroupConfig_autoRollbackConfigurationAlarm(rName, alarms string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = "tf-acc-test-%[1]s"
+  service_role_arn      = aws_iam_role.test.arn
+
+  alarm_configuration {
+    alarms  = [%[2]s]
+    enabled = true
+  }
+
+  auto_rollback_configuration {
+    enabled = true
+    events  = ["DEPLOYMENT_STOP_ON_ALARM"]
+  }
+}
+`, rName, alarms) + testAccDeploymentGroupConfig_base(rName)
+}
+
+func testAccDeploymentGroupConfig_ecsBlueGreenTerminationWait(rName string, wait int) string {
+	return testAccDeploymentGroupConfig_ecsBase(rName) + fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_config_name = "CodeDeployDefault.ECSAllAtOnce"
+  deployment_group_name  = %[1]q
+  service_role_arn       = aws_iam_role.test.arn
+
+  blue_green_deployment_config {
+    deployment_ready_option {
+      action_on_timeout = "CONTINUE_DEPLOYMENT"
+    }
+
+    terminate_blue_instances_on_deployment_success {
+      action                           = "TERMINATE"
+      termination_wait_time_in_minutes = %[2]d
+    }
+  }
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  ecs_service {
+    cluster_name = aws_ecs_cluster.test.name
+    service_name = aws_ecs_service.test.name
+  }
+
+  load_balancer_info {
+    target_group_pair_info {
+      prod_traffic_route {
+        listener_arns = [aws_lb_listener.test.arn]
+      }
+
+      target_group {
+        name = aws_lb_target_group.blue.name
+      }
+
+      target_group {
+        name = aws_lb_target_group.green.name
+      }
+    }
+  }
+}
+`, rName, wait)
+}
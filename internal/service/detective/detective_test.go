@@ -8,9 +8,10 @@ import (
 func TestAccDetective_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Graph": {
-			"basic":      testAccGraph_basic,
-			"disappears": testAccGraph_disappears,
-			"tags":       testAccGraph_tags,
+			"basic":              testAccGraph_basic,
+			"datasourcePackages": testAccGraph_datasourcePackages,
+			"disappears":         testAccGraph_disappears,
+			"tags":               testAccGraph_tags,
 		},
 		"InvitationAccepter": {
 			"basic": testAccInvitationAccepter_basic,
@@ -20,6 +21,12 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear": testAccMember_disappears,
 			"message":   testAccMember_message,
 		},
+		"OrganizationAdminAccount": {
+			"basic": testAccOrganizationAdminAccount_basic,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccOrganizationConfiguration_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -129,3 +129,107 @@ func FindMemberByGraphARNAndAccountID(ctx context.Context, conn *detective.Detec
 
 	return result, nil
 }
+
+func FindDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (map[string]*detective.DatasourcePackageIngestDetail, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	result := make(map[string]*detective.DatasourcePackageIngestDetail)
+
+	err := conn.ListDatasourcePackagesPagesWithContext(ctx, input, func(page *detective.ListDatasourcePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for k, v := range page.DatasourcePackages {
+			if v == nil {
+				continue
+			}
+
+			result[k] = v
+		}
+
+		return !lastPage
+	})
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func FindOrganizationAdminAccountByAccountID(ctx context.Context, conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	input := &detective.ListOrganizationAdminAccountsInput{}
+
+	var result *detective.Administrator
+
+	err := conn.ListOrganizationAdminAccountsPagesWithContext(ctx, input, func(page *detective.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, administrator := range page.Administrators {
+			if administrator == nil {
+				continue
+			}
+
+			if aws.StringValue(administrator.AccountId) == accountID {
+				result = administrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("No organization admin account found with accountID %q", accountID),
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindOrganizationConfigurationByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (*detective.DescribeOrganizationConfigurationOutput, error) {
+	input := &detective.DescribeOrganizationConfigurationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("No organization configuration found with arn %q", graphARN),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
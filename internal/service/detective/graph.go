@@ -8,9 +8,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -30,6 +33,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(graphOptionalDatasourcePackages(), false),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -37,8 +49,26 @@ func ResourceGraph() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Data source packages can be started but not stopped.
+			customdiff.ForceNewIfChange("datasource_packages", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(*schema.Set).Difference(new.(*schema.Set)).Len() > 0
+			}),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// graphOptionalDatasourcePackages returns the data source packages that can be started for a behavior graph.
+// The core package is always started.
+func graphOptionalDatasourcePackages() []string {
+	var packages []string
+	for _, v := range detective.DatasourcePackage_Values() {
+		if v != detective.DatasourcePackageDetectiveCore {
+			packages = append(packages, v)
+		}
 	}
+	return packages
 }
 
 func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -78,6 +108,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.StringValue(output.GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := startGraphDatasourcePackages(ctx, conn, d.Id(), v.(*schema.Set)); err != nil {
+			return diag.Errorf("error starting detective Graph (%s) data source packages: %s", d.Id(), err)
+		}
+	}
+
 	return resourceGraphRead(ctx, d, meta)
 }
 
@@ -100,6 +136,16 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("created_time", aws.TimeValue(resp.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", resp.Arn)
 
+	packages, err := FindDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading detective Graph (%s) data source packages: %s", d.Id(), err)
+	}
+
+	if err := d.Set("datasource_packages", flattenGraphDatasourcePackages(packages)); err != nil {
+		return diag.Errorf("error setting `%s` for Detective Graph (%s): %s", "datasource_packages", d.Id(), err)
+	}
+
 	tags, err := ListTags(conn, aws.StringValue(resp.Arn))
 
 	if err != nil {
@@ -122,6 +168,15 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DetectiveConn
 
+	if d.HasChange("datasource_packages") {
+		o, n := d.GetChange("datasource_packages")
+		if v := n.(*schema.Set).Difference(o.(*schema.Set)); v.Len() > 0 {
+			if err := startGraphDatasourcePackages(ctx, conn, d.Id(), v); err != nil {
+				return diag.Errorf("error starting detective Graph (%s) data source packages: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
@@ -149,3 +204,28 @@ func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func startGraphDatasourcePackages(ctx context.Context, conn *detective.Detective, graphARN string, packages *schema.Set) error {
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: flex.ExpandStringSet(packages),
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackagesWithContext(ctx, input)
+
+	return err
+}
+
+func flattenGraphDatasourcePackages(packages map[string]*detective.DatasourcePackageIngestDetail) []string {
+	var result []string
+	for k, v := range packages {
+		if k == detective.DatasourcePackageDetectiveCore {
+			continue
+		}
+
+		if aws.StringValue(v.DatasourcePackageIngestState) == detective.DatasourcePackageIngestStateStarted {
+			result = append(result, k)
+		}
+	}
+	return result
+}
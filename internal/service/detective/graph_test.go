@@ -97,6 +97,43 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	var graph1, graph2 detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph1),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "EKS_AUDIT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"ASFF_SECURITYHUB_FINDING", "EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(resourceName, &graph2),
+					testAccCheckGraphNotRecreated(&graph1, &graph2),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "ASFF_SECURITYHUB_FINDING"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", "EKS_AUDIT"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGraph_disappears(t *testing.T) {
 	var graphOutput detective.Graph
 	resourceName := "aws_detective_graph.test"
@@ -205,3 +242,11 @@ resource "aws_detective_graph" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGraphConfig_datasourcePackages(packages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, packages)
+}
@@ -57,6 +57,10 @@ func ResourceMember() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"invitation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"invited_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -130,7 +134,7 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if _, err = MemberStatusUpdated(ctx, conn, graphArn, accountId, detective.MemberStatusInvited); err != nil {
-		return diag.Errorf("error waiting for Detective Member (%s) to be invited: %s", d.Id(), err)
+		return diag.Errorf("error waiting for Detective Member (%s) to be invited or enabled: %s", d.Id(), err)
 	}
 
 	d.SetId(EncodeMemberID(graphArn, accountId))
@@ -169,6 +173,7 @@ func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("disabled_reason", resp.DisabledReason)
 	d.Set("email_address", resp.EmailAddress)
 	d.Set("graph_arn", resp.GraphArn)
+	d.Set("invitation_type", resp.InvitationType)
 	d.Set("invited_time", aws.TimeValue(resp.InvitedTime).Format(time.RFC3339))
 	d.Set("status", resp.Status)
 	d.Set("updated_time", aws.TimeValue(resp.UpdatedTime).Format(time.RFC3339))
@@ -0,0 +1,102 @@
+package detective_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccOrganizationAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_detective_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAdminAccountDestroy,
+		ErrorCheck:               acctest.ErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAdminAccountConfig_self(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAdminAccountExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "graph_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAdminAccountDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_organization_admin_account" {
+			continue
+		}
+
+		_, err := tfdetective.FindOrganizationAdminAccountByAccountID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("detective organization admin account %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOrganizationAdminAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+		_, err := tfdetective.FindOrganizationAdminAccountByAccountID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccOrganizationAdminAccountConfig_self() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["detective.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_detective_organization_admin_account" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  account_id = data.aws_caller_identity.current.account_id
+}
+`
+}
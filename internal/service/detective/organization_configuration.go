@@ -0,0 +1,80 @@
+package detective
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationConfigurationUpdate,
+		ReadContext:   resourceOrganizationConfigurationRead,
+		UpdateContext: resourceOrganizationConfigurationUpdate,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	graphARN := d.Get("graph_arn").(string)
+
+	input := &detective.UpdateOrganizationConfigurationInput{
+		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
+		GraphArn:   aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Detective Organization Configuration (%s): %s", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return resourceOrganizationConfigurationRead(ctx, d, meta)
+}
+
+func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	output, err := FindOrganizationConfigurationByGraphARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Organization Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auto_enable", output.AutoEnable)
+	d.Set("graph_arn", d.Id())
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package detective_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccOrganizationConfiguration_basic(t *testing.T) {
+	graphResourceName := "aws_detective_organization_admin_account.test"
+	resourceName := "aws_detective_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Detective Organization Configuration cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckOrganizationAdminAccountDestroy,
+		ErrorCheck:   acctest.ErrorCheck(t, detective.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_autoEnable(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", graphResourceName, "graph_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_autoEnable(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", graphResourceName, "graph_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfigurationConfig_autoEnable(autoEnable bool) string {
+	return acctest.ConfigCompose(testAccOrganizationAdminAccountConfig_self(), fmt.Sprintf(`
+resource "aws_detective_organization_configuration" "test" {
+  auto_enable = %[1]t
+  graph_arn   = aws_detective_organization_admin_account.test.graph_arn
+}
+`, autoEnable))
+}
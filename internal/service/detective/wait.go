@@ -13,13 +13,16 @@ const (
 	GraphOperationTimeout = 4 * time.Minute
 	// MemberStatusPropagationTimeout Maximum amount of time to wait for a detective member status to return Invited
 	MemberStatusPropagationTimeout = 4 * time.Minute
+	// OrganizationAdminAccountPropagationTimeout Maximum amount of time to wait for a detective organization admin account to be enabled, disabled
+	OrganizationAdminAccountPropagationTimeout = 2 * time.Minute
 )
 
-// MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited
+// MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited,
+// or Enabled for organization accounts, which are added without an invitation
 func MemberStatusUpdated(ctx context.Context, conn *detective.Detective, graphARN, adminAccountID, expectedValue string) (*detective.MemberDetail, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{detective.MemberStatusVerificationInProgress},
-		Target:  []string{detective.MemberStatusInvited},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled},
 		Refresh: MemberStatus(ctx, conn, graphARN, adminAccountID),
 		Timeout: MemberStatusPropagationTimeout,
 	}
@@ -33,6 +33,12 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dlm.DefaultPolicyTypeValues_Values(), false),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -124,6 +130,31 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -166,6 +197,50 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 64),
+										},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"policy_language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dlm.PolicyLanguageValues_Values(), false),
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dlm.ResourceTypeValues_Values(), false),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -208,6 +283,12 @@ func ResourceLifecyclePolicy() *schema.Resource {
 							Default:      dlm.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateFunc: validation.StringInSlice(dlm.PolicyTypeValues_Values(), false),
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						"schedule": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -215,6 +296,51 @@ func ResourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		"interval": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ValidateFunc: validation.StringInSlice(
+																				dlm.RetentionIntervalUnitValues_Values(),
+																				false,
+																			),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -497,13 +623,18 @@ func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) err
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	defaultPolicy := d.Get("default_policy").(string)
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get("description").(string)),
 		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{})),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{}), defaultPolicy),
 		State:            aws.String(d.Get("state").(string)),
 	}
 
+	if defaultPolicy != "" {
+		input.DefaultPolicy = aws.String(defaultPolicy)
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -543,6 +674,11 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("arn", out.Policy.PolicyArn)
+	if aws.BoolValue(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set("description", out.Policy.Description)
 	d.Set("execution_role_arn", out.Policy.ExecutionRoleArn)
 	d.Set("state", out.Policy.State)
@@ -582,7 +718,7 @@ func resourceLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) err
 			input.State = aws.String(d.Get("state").(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}), d.Get("default_policy").(string))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -619,7 +755,7 @@ func resourceLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
+func expandPolicyDetails(cfg []interface{}, defaultPolicy string) *dlm.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -629,6 +765,35 @@ func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
 	policyDetails := &dlm.PolicyDetails{
 		PolicyType: aws.String(policyType),
 	}
+	if defaultPolicy != "" {
+		// Default policies are always written in the simplified policy language.
+		policyDetails.PolicyLanguage = aws.String(dlm.PolicyLanguageValuesSimplified)
+		policyDetails.ResourceType = aws.String(defaultPolicy)
+	}
+	if v, ok := m["copy_tags"].(bool); ok && v {
+		policyDetails.CopyTags = aws.Bool(v)
+	}
+	if v, ok := m["create_interval"].(int); ok && v > 0 {
+		policyDetails.CreateInterval = aws.Int64(int64(v))
+	}
+	if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+		policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+	}
+	if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		policyDetails.Exclusions = expandExclusions(v[0].(map[string]interface{}))
+	}
+	if v, ok := m["extend_deletion"].(bool); ok && v {
+		policyDetails.ExtendDeletion = aws.Bool(v)
+	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = aws.String(v)
+	}
+	if v, ok := m["resource_type"].(string); ok && v != "" {
+		policyDetails.ResourceType = aws.String(v)
+	}
+	if v, ok := m["retain_interval"].(int); ok && v > 0 {
+		policyDetails.RetainInterval = aws.Int64(int64(v))
+	}
 	if v, ok := m["resource_types"].([]interface{}); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringList(v)
 	}
@@ -656,6 +821,13 @@ func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
 
 func flattenPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interface{} {
 	result := make(map[string]interface{})
+	result["copy_tags"] = aws.BoolValue(policyDetails.CopyTags)
+	result["create_interval"] = aws.Int64Value(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["extend_deletion"] = aws.BoolValue(policyDetails.ExtendDeletion)
+	result["policy_language"] = aws.StringValue(policyDetails.PolicyLanguage)
+	result["resource_type"] = aws.StringValue(policyDetails.ResourceType)
+	result["retain_interval"] = aws.Int64Value(policyDetails.RetainInterval)
 	result["resource_types"] = flex.FlattenStringList(policyDetails.ResourceTypes)
 	result["resource_locations"] = flex.FlattenStringList(policyDetails.ResourceLocations)
 	result["action"] = flattenActions(policyDetails.Actions)
@@ -664,6 +836,10 @@ func flattenPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interfa
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = aws.StringValue(policyDetails.PolicyType)
 
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
+
 	if policyDetails.Parameters != nil {
 		result["parameters"] = flattenParameters(policyDetails.Parameters)
 	}
@@ -676,6 +852,9 @@ func expandSchedules(cfg []interface{}) []*dlm.Schedule {
 	for i, c := range cfg {
 		schedule := &dlm.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			schedule.ArchiveRule = expandArchiveRule(v[0].(map[string]interface{}))
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -725,6 +904,10 @@ func flattenSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
 		m["tags_to_add"] = flattenTags(s.TagsToAdd)
 		m["variable_tags"] = flattenTags(s.VariableTags)
 
+		if s.ArchiveRule != nil {
+			m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
+		}
+
 		if s.DeprecateRule != nil {
 			m["deprecate_rule"] = flattenDeprecateRule(s.DeprecateRule)
 		}
@@ -983,6 +1166,11 @@ func flattenCrossRegionCopyRules(rules []*dlm.CrossRegionCopyRule) []interface{}
 			"target":         aws.StringValue(rule.Target),
 		}
 
+		// Policies created with the deprecated TargetRegion parameter don't return Target.
+		if rule.Target == nil && rule.TargetRegion != nil {
+			m["target"] = aws.StringValue(rule.TargetRegion)
+		}
+
 		result = append(result, m)
 	}
 
@@ -1041,6 +1229,118 @@ func flattenCrossRegionCopyRuleRetainRule(rule *dlm.CrossRegionCopyRetainRule) [
 	return []interface{}{m}
 }
 
+func expandCrossRegionCopyTargets(l []interface{}) []*dlm.CrossRegionCopyTarget {
+	var targets []*dlm.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, &dlm.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []*dlm.CrossRegionCopyTarget) []interface{} {
+	var result []interface{}
+
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"target_region": aws.StringValue(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(m map[string]interface{}) *dlm.Exclusions {
+	exclusions := &dlm.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *dlm.Exclusions) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.BoolValue(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = flex.FlattenStringList(exclusions.ExcludeVolumeTypes)
+
+	return []map[string]interface{}{result}
+}
+
+func expandArchiveRule(m map[string]interface{}) *dlm.ArchiveRule {
+	rule := &dlm.ArchiveRule{}
+
+	if v, ok := m["archive_retain_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		retainRule := &dlm.ArchiveRetainRule{}
+
+		if v, ok := v[0].(map[string]interface{})["retention_archive_tier"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			tier := &dlm.RetentionArchiveTier{}
+
+			if v, ok := m["count"].(int); ok && v > 0 {
+				tier.Count = aws.Int64(int64(v))
+			}
+
+			if v, ok := m["interval"].(int); ok && v > 0 {
+				tier.Interval = aws.Int64(int64(v))
+			}
+
+			if v, ok := m["interval_unit"].(string); ok && v != "" {
+				tier.IntervalUnit = aws.String(v)
+			}
+
+			retainRule.RetentionArchiveTier = tier
+		}
+
+		rule.RetainRule = retainRule
+	}
+
+	return rule
+}
+
+func flattenArchiveRule(rule *dlm.ArchiveRule) []map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if rule.RetainRule != nil {
+		retainRule := make(map[string]interface{})
+
+		if tier := rule.RetainRule.RetentionArchiveTier; tier != nil {
+			retainRule["retention_archive_tier"] = []interface{}{map[string]interface{}{
+				"count":         aws.Int64Value(tier.Count),
+				"interval":      aws.Int64Value(tier.Interval),
+				"interval_unit": aws.StringValue(tier.IntervalUnit),
+			}}
+		}
+
+		result["archive_retain_rule"] = []interface{}{retainRule}
+	}
+
+	return []map[string]interface{}{result}
+}
+
 func expandCreateRule(cfg []interface{}) *dlm.CreateRule {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
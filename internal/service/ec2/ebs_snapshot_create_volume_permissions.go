@@ -0,0 +1,200 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSnapshotCreateVolumePermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSnapshotCreateVolumePermissionsCreate,
+		Read:   resourceSnapshotCreateVolumePermissionsRead,
+		Update: resourceSnapshotCreateVolumePermissionsUpdate,
+		Delete: resourceSnapshotCreateVolumePermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCreateVolumePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	snapshotID := d.Get("snapshot_id").(string)
+	accountIDs := flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set))
+
+	if err := putSnapshotCreateVolumePermissions(conn, snapshotID, accountIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("creating EBS Snapshot CreateVolumePermissions (%s): %w", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+
+	return resourceSnapshotCreateVolumePermissionsRead(d, meta)
+}
+
+func resourceSnapshotCreateVolumePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	accountIDs, err := FindSnapshotCreateVolumePermissionAccountIDsBySnapshotID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot CreateVolumePermissions %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EBS Snapshot CreateVolumePermissions (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("snapshot_id", d.Id())
+
+	return nil
+}
+
+func resourceSnapshotCreateVolumePermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	accountIDs := flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set))
+
+	if err := putSnapshotCreateVolumePermissions(conn, d.Id(), accountIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("updating EBS Snapshot CreateVolumePermissions (%s): %w", d.Id(), err)
+	}
+
+	return resourceSnapshotCreateVolumePermissionsRead(d, meta)
+}
+
+func resourceSnapshotCreateVolumePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EBS Snapshot CreateVolumePermissions: %s", d.Id())
+	err := putSnapshotCreateVolumePermissions(conn, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) || tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EBS Snapshot CreateVolumePermissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putSnapshotCreateVolumePermissions makes the set of accounts with createVolumePermission
+// on the snapshot exactly match accountIDs, revoking any grants made outside of Terraform.
+func putSnapshotCreateVolumePermissions(conn *ec2.EC2, snapshotID string, accountIDs []string, timeout time.Duration) error {
+	current, err := FindSnapshotCreateVolumePermissionAccountIDsBySnapshotID(conn, snapshotID)
+
+	if err != nil {
+		return err
+	}
+
+	add, remove := snapshotCreateVolumePermissionsDiff(current, accountIDs)
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	input := &ec2.ModifySnapshotAttributeInput{
+		Attribute:              aws.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{},
+		SnapshotId:             aws.String(snapshotID),
+	}
+
+	for _, v := range add {
+		input.CreateVolumePermission.Add = append(input.CreateVolumePermission.Add, &ec2.CreateVolumePermission{UserId: aws.String(v)})
+	}
+
+	for _, v := range remove {
+		input.CreateVolumePermission.Remove = append(input.CreateVolumePermission.Remove, &ec2.CreateVolumePermission{UserId: aws.String(v)})
+	}
+
+	if _, err := conn.ModifySnapshotAttribute(input); err != nil {
+		return err
+	}
+
+	err = tfresource.WaitUntil(timeout, func() (bool, error) {
+		current, err := FindSnapshotCreateVolumePermissionAccountIDsBySnapshotID(conn, snapshotID)
+
+		if err != nil {
+			return false, err
+		}
+
+		add, remove := snapshotCreateVolumePermissionsDiff(current, accountIDs)
+
+		return len(add) == 0 && len(remove) == 0, nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		Delay:                     1 * time.Second,
+		MinTimeout:                3 * time.Second,
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for propagation: %w", err)
+	}
+
+	return nil
+}
+
+func snapshotCreateVolumePermissionsDiff(current, desired []string) ([]string, []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, v := range current {
+		currentSet[v] = struct{}{}
+	}
+
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, v := range desired {
+		desiredSet[v] = struct{}{}
+	}
+
+	var add, remove []string
+
+	for _, v := range desired {
+		if _, ok := currentSet[v]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	for _, v := range current {
+		if _, ok := desiredSet[v]; !ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, remove
+}
@@ -272,6 +272,12 @@ func resourceEBSVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 		if _, err := WaitVolumeUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("waiting for EBS Volume (%s) update: %w", d.Id(), err)
 		}
+
+		// The volume state returns to "available" or "in-use" before the modification itself
+		// has progressed, so also wait for the modification to reach the "optimizing" state.
+		if _, err := WaitVolumeModificationComplete(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for EBS Volume (%s) modification: %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -2,20 +2,24 @@ package ec2
 
 import (
 	"bytes"
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,17 +32,22 @@ const (
 	amiRetryMinTimeout = 3 * time.Second
 )
 
+const (
+	imageDeregistrationProtectionEnabled             = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
 func ResourceAMI() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAMICreate,
+		CreateWithoutTimeout: resourceAMICreate,
 		// The Read, Update and Delete operations are shared with aws_ami_copy and aws_ami_from_instance,
 		// since they differ only in how the image is created.
-		Read:   resourceAMIRead,
-		Update: resourceAMIUpdate,
-		Delete: resourceAMIDelete,
+		ReadWithoutTimeout:   resourceAMIRead,
+		UpdateWithoutTimeout: resourceAMIUpdate,
+		DeleteWithoutTimeout: resourceAMIDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -73,6 +82,16 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -204,6 +223,10 @@ func ResourceAMI() *schema.Resource {
 				ForceNew:     true, // this attribute can only be set at registration time
 				ValidateFunc: validation.StringInSlice([]string{"v2.0"}, false),
 			},
+			"last_launched_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -283,7 +306,7 @@ func ResourceAMI() *schema.Resource {
 	}
 }
 
-func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -341,7 +364,7 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if snapshot != "" && encrypted {
-				return errors.New("can't set both 'snapshot_id' and 'encrypted'")
+				return diag.Errorf("can't set both 'snapshot_id' and 'encrypted'")
 			}
 		}
 
@@ -355,31 +378,37 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 	output, err := conn.RegisterImage(input)
 
 	if err != nil {
-		return fmt.Errorf("error creating EC2 AMI (%s): %w", name, err)
+		return diag.Errorf("creating EC2 AMI (%s): %s", name, err)
 	}
 
 	d.SetId(aws.StringValue(output.ImageId))
 
 	if len(tags) > 0 {
 		if err := CreateTags(conn, d.Id(), tags); err != nil {
-			return fmt.Errorf("error adding tags: %s", err)
+			return diag.Errorf("adding tags: %s", err)
 		}
 	}
 
 	if _, err := WaitImageAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for EC2 AMI (%s) create: %w", d.Id(), err)
+		return diag.Errorf("waiting for EC2 AMI (%s) create: %s", d.Id(), err)
 	}
 
 	if v, ok := d.GetOk("deprecation_time"); ok {
 		if err := enableImageDeprecation(conn, d.Id(), v.(string)); err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(), d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceAMIRead(d, meta)
+	return resourceAMIRead(ctx, d, meta)
 }
 
-func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -395,7 +424,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 AMI (%s): %w", d.Id(), err)
+		return diag.Errorf("reading EC2 AMI (%s): %s", d.Id(), err)
 	}
 
 	image := outputRaw.(*ec2.Image)
@@ -409,7 +438,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		image, err = WaitImageAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EC2 AMI (%s) create: %w", d.Id(), err)
+			return diag.Errorf("waiting for EC2 AMI (%s) create: %s", d.Id(), err)
 		}
 	}
 
@@ -444,36 +473,51 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("usage_operation", image.UsageOperation)
 	d.Set("virtualization_type", image.VirtualizationType)
 
+	// Deregistration protection and last launched time aren't available in AWS SDK for Go v1.
+	// The image has already been read, so a failure here leaves the previous values in place.
+	if imageV2, err := FindImageByIDV2(ctx, meta.(*conns.AWSClient).EC2Client(), d.Id()); err != nil {
+		log.Printf("[WARN] reading EC2 AMI (%s) deregistration protection: %s", d.Id(), err)
+	} else {
+		// e.g. "enabled-with-cooldown", "enabled-without-cooldown", "disabled" or "disabled-until 2024-06-01T00:00:00.000Z".
+		if v := aws.StringValue(imageV2.DeregistrationProtection); strings.HasPrefix(v, imageDeregistrationProtectionEnabled) {
+			d.Set("deregistration_protection", true)
+			d.Set("deregistration_protection_with_cooldown", v == imageDeregistrationProtectionEnabledWithCooldown)
+		} else {
+			d.Set("deregistration_protection", false)
+		}
+		d.Set("last_launched_time", imageV2.LastLaunchedTime)
+	}
+
 	if err := d.Set("ebs_block_device", flattenBlockDeviceMappingsForAMIEBSBlockDevice(image.BlockDeviceMappings)); err != nil {
-		return fmt.Errorf("error setting ebs_block_device: %w", err)
+		return diag.Errorf("setting ebs_block_device: %s", err)
 	}
 
 	if err := d.Set("ephemeral_block_device", flattenBlockDeviceMappingsForAMIEphemeralBlockDevice(image.BlockDeviceMappings)); err != nil {
-		return fmt.Errorf("error setting ephemeral_block_device: %w", err)
+		return diag.Errorf("setting ephemeral_block_device: %s", err)
 	}
 
 	tags := KeyValueTags(image.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating AMI (%s) tags: %s", d.Id(), err)
+			return diag.Errorf("updating AMI (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -486,22 +530,56 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if err != nil {
-			return fmt.Errorf("error updating EC2 AMI (%s) description: %w", d.Id(), err)
+			return diag.Errorf("updating EC2 AMI (%s) description: %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("deprecation_time") {
-		if err := enableImageDeprecation(conn, d.Id(), d.Get("deprecation_time").(string)); err != nil {
-			return err
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableImageDeprecation(conn, d.Id(), v); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := disableImageDeprecation(conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
-	return resourceAMIRead(d, meta)
+	if d.HasChanges("deregistration_protection", "deregistration_protection_with_cooldown") {
+		conn := meta.(*conns.AWSClient).EC2Client()
+
+		if d.Get("deregistration_protection").(bool) {
+			if err := enableImageDeregistrationProtection(ctx, conn, d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if d.HasChange("deregistration_protection") {
+			if err := disableImageDeregistrationProtection(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceAMIRead(ctx, d, meta)
 }
 
-func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	// A protected image can't be deregistered.
+	// With a cooldown period, the image remains protected for 24 hours after protection is disabled.
+	if d.Get("deregistration_protection").(bool) {
+		err := disableImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(), d.Id())
+
+		if apiErr, ok := errs.As[smithy.APIError](err); ok && (apiErr.ErrorCode() == errCodeInvalidAMIIDNotFound || apiErr.ErrorCode() == errCodeInvalidAMIIDUnavailable) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EC2 AMI: %s", d.Id())
 	_, err := conn.DeregisterImage(&ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -512,7 +590,7 @@ func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deregistering EC2 AMI (%s): %w", d.Id(), err)
+		return diag.Errorf("deregistering EC2 AMI (%s): %s", d.Id(), err)
 	}
 
 	// If we're managing the EBS snapshots then we need to delete those too.
@@ -538,12 +616,12 @@ func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
 				errParts = append(errParts, fmt.Sprintf("%s: %s", snapshotId, err))
 			}
 			errParts = append(errParts, "These are no longer managed by Terraform and must be deleted manually.")
-			return errors.New(strings.Join(errParts, "\n"))
+			return diag.Errorf("%s", strings.Join(errParts, "\n"))
 		}
 	}
 
 	if _, err := WaitImageDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for EC2 AMI (%s) delete: %w", d.Id(), err)
+		return diag.Errorf("waiting for EC2 AMI (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
@@ -565,6 +643,49 @@ func enableImageDeprecation(conn *ec2.EC2, id string, deprecateAt string) error
 	return nil
 }
 
+func disableImageDeprecation(conn *ec2.EC2, id string) error {
+	input := &ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error disabling EC2 AMI (%s) image deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
+func enableImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string, withCooldown bool) error {
+	input := &ec2_sdkv2.EnableImageDeregistrationProtectionInput{
+		ImageId:      aws.String(id),
+		WithCooldown: aws.Bool(withCooldown),
+	}
+
+	_, err := conn.EnableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling EC2 AMI (%s) deregistration protection: %w", id, err)
+	}
+
+	return nil
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string) error {
+	input := &ec2_sdkv2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws.String(id),
+	}
+
+	_, err := conn.DisableImageDeregistrationProtection(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error disabling EC2 AMI (%s) deregistration protection: %w", id, err)
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -2,11 +2,13 @@ package ec2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,12 +20,12 @@ import (
 
 func ResourceAMICopy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAMICopyCreate,
+		CreateWithoutTimeout: resourceAMICopyCreate,
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
-		Read:   resourceAMIRead,
-		Update: resourceAMIUpdate,
-		Delete: resourceAMIDelete,
+		ReadWithoutTimeout:   resourceAMIRead,
+		UpdateWithoutTimeout: resourceAMIUpdate,
+		DeleteWithoutTimeout: resourceAMIDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(amiRetryTimeout),
@@ -52,6 +54,16 @@ func ResourceAMICopy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -174,6 +186,10 @@ func ResourceAMICopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_launched_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -260,7 +276,7 @@ func ResourceAMICopy() *schema.Resource {
 	}
 }
 
-func resourceAMICopyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAMICopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -287,7 +303,7 @@ func resourceAMICopyCreate(d *schema.ResourceData, meta interface{}) error {
 	output, err := conn.CopyImage(input)
 
 	if err != nil {
-		return fmt.Errorf("creating EC2 AMI (%s) from source EC2 AMI (%s): %w", name, sourceImageID, err)
+		return diag.Errorf("creating EC2 AMI (%s) from source EC2 AMI (%s): %s", name, sourceImageID, err)
 	}
 
 	d.SetId(aws.StringValue(output.ImageId))
@@ -295,19 +311,25 @@ func resourceAMICopyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if len(tags) > 0 {
 		if err := CreateTags(conn, d.Id(), tags); err != nil {
-			return fmt.Errorf("adding tags: %w", err)
+			return diag.Errorf("adding tags: %s", err)
 		}
 	}
 
 	if _, err := WaitImageAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("waiting for EC2 AMI (%s) create: %w", d.Id(), err)
+		return diag.Errorf("waiting for EC2 AMI (%s) create: %s", d.Id(), err)
 	}
 
 	if v, ok := d.GetOk("deprecation_time"); ok {
 		if err := enableImageDeprecation(conn, d.Id(), v.(string)); err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(), d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceAMIRead(d, meta)
+	return resourceAMIRead(ctx, d, meta)
 }
@@ -2,11 +2,13 @@ package ec2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +19,12 @@ import (
 
 func ResourceAMIFromInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAMIFromInstanceCreate,
+		CreateWithoutTimeout: resourceAMIFromInstanceCreate,
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_from_instance resource only differs in how it's created.
-		Read:   resourceAMIRead,
-		Update: resourceAMIUpdate,
-		Delete: resourceAMIDelete,
+		ReadWithoutTimeout:   resourceAMIRead,
+		UpdateWithoutTimeout: resourceAMIUpdate,
+		DeleteWithoutTimeout: resourceAMIDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(amiRetryTimeout),
@@ -51,6 +53,16 @@ func ResourceAMIFromInstance() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deregistration_protection_with_cooldown": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -162,6 +174,10 @@ func ResourceAMIFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_launched_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kernel_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -241,7 +257,7 @@ func ResourceAMIFromInstance() *schema.Resource {
 	}
 }
 
-func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAMIFromInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -259,21 +275,27 @@ func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	output, err := conn.CreateImage(input)
 
 	if err != nil {
-		return fmt.Errorf("creating EC2 AMI (%s) from EC2 Instance (%s): %w", name, instanceID, err)
+		return diag.Errorf("creating EC2 AMI (%s) from EC2 Instance (%s): %s", name, instanceID, err)
 	}
 
 	d.SetId(aws.StringValue(output.ImageId))
 	d.Set("manage_ebs_snapshots", true)
 
 	if _, err := WaitImageAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("waiting for EC2 AMI (%s) create: %w", d.Id(), err)
+		return diag.Errorf("waiting for EC2 AMI (%s) create: %s", d.Id(), err)
 	}
 
 	if v, ok := d.GetOk("deprecation_time"); ok {
 		if err := enableImageDeprecation(conn, d.Id(), v.(string)); err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("deregistration_protection").(bool) {
+		if err := enableImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(), d.Id(), d.Get("deregistration_protection_with_cooldown").(bool)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceAMIRead(d, meta)
+	return resourceAMIRead(ctx, d, meta)
 }
@@ -34,6 +34,8 @@ func TestAccEC2AMI_basic(t *testing.T) {
 					testAccCheckAMIExists(resourceName, &ami),
 					resource.TestCheckResourceAttr(resourceName, "architecture", "x86_64"),
 					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "ec2", regexp.MustCompile(`image/ami-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection_with_cooldown", "false"),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
@@ -53,6 +55,7 @@ func TestAccEC2AMI_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "image_type", "machine"),
 					resource.TestCheckResourceAttr(resourceName, "imds_support", ""),
 					resource.TestCheckResourceAttr(resourceName, "kernel_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "last_launched_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
 					resource.TestCheckResourceAttr(resourceName, "platform_details", "Linux/UNIX"),
@@ -166,6 +169,52 @@ func TestAccEC2AMI_deprecateAt(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "virtualization_type", "hvm"),
 				),
 			},
+			{
+				Config: testAccAMIConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AMI_deregistrationProtection(t *testing.T) {
+	var ami ec2.Image
+	resourceName := "aws_ami.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIConfig_deregistrationProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection_with_cooldown", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"manage_ebs_snapshots",
+				},
+			},
+			{
+				// Disable deregistration protection so that the AMI can be destroyed.
+				Config: testAccAMIConfig_deregistrationProtection(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAMIExists(resourceName, &ami),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection", "false"),
+				),
+			},
 		},
 	})
 }
@@ -696,6 +745,25 @@ resource "aws_ami" "test" {
 `, rName, deprecateAt))
 }
 
+func testAccAMIConfig_deregistrationProtection(rName string, deregistrationProtection bool) string {
+	return acctest.ConfigCompose(
+		testAccAMIConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ami" "test" {
+  ena_support               = true
+  name                      = %[1]q
+  root_device_name          = "/dev/sda1"
+  virtualization_type       = "hvm"
+  deregistration_protection = %[2]t
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+`, rName, deregistrationProtection))
+}
+
 func testAccAMIConfig_desc(rName, desc string) string {
 	return acctest.ConfigCompose(
 		testAccAMIConfig_base(rName),
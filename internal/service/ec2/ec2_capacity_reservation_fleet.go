@@ -0,0 +1,377 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCapacityReservationFleet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCapacityReservationFleetCreate,
+		Read:   resourceCapacityReservationFleetRead,
+		Update: resourceCapacityReservationFleetUpdate,
+		Delete: resourceCapacityReservationFleetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocation_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "prioritized",
+				ValidateFunc: validation.StringInSlice([]string{"prioritized"}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_reservation_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_match_criteria": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetInstanceMatchCriteriaOpen,
+				ValidateFunc: validation.StringInSlice(ec2.FleetInstanceMatchCriteria_Values(), false),
+			},
+			"instance_type_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"instance_platform": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"weight": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(0.001, 99.999),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetCapacityReservationTenancyDefault,
+				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationTenancy_Values(), false),
+			},
+			"total_fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 25000),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationFleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateCapacityReservationFleetInput{
+		AllocationStrategy:         aws.String(d.Get("allocation_strategy").(string)),
+		InstanceMatchCriteria:      aws.String(d.Get("instance_match_criteria").(string)),
+		InstanceTypeSpecifications: expandReservationFleetInstanceSpecifications(d.Get("instance_type_specification").([]interface{})),
+		TagSpecifications:          tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeCapacityReservationFleet),
+		Tenancy:                    aws.String(d.Get("tenancy").(string)),
+		TotalTargetCapacity:        aws.Int64(int64(d.Get("total_target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(v)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Capacity Reservation Fleet: %s", input)
+	output, err := conn.CreateCapacityReservationFleet(input)
+
+	if err != nil {
+		return fmt.Errorf("creating EC2 Capacity Reservation Fleet: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservationFleetId))
+
+	if _, err := WaitCapacityReservationFleetActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceCapacityReservationFleetRead(d, meta)
+}
+
+func resourceCapacityReservationFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindCapacityReservationFleetByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Reservation Fleet %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+	}
+
+	d.Set("allocation_strategy", fleet.AllocationStrategy)
+	d.Set("arn", fleet.CapacityReservationFleetArn)
+	var capacityReservationIDs []string
+	for _, v := range fleet.InstanceTypeSpecifications {
+		if v != nil && v.CapacityReservationId != nil {
+			capacityReservationIDs = append(capacityReservationIDs, aws.StringValue(v.CapacityReservationId))
+		}
+	}
+	d.Set("capacity_reservation_ids", capacityReservationIDs)
+	if fleet.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(fleet.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	if fleet.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(fleet.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("instance_match_criteria", fleet.InstanceMatchCriteria)
+	if err := d.Set("instance_type_specification", flattenFleetCapacityReservations(fleet.InstanceTypeSpecifications)); err != nil {
+		return fmt.Errorf("setting instance_type_specification: %w", err)
+	}
+	d.Set("state", fleet.State)
+	d.Set("tenancy", fleet.Tenancy)
+	d.Set("total_fulfilled_capacity", fleet.TotalFulfilledCapacity)
+	d.Set("total_target_capacity", fleet.TotalTargetCapacity)
+
+	tags := KeyValueTags(fleet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityReservationFleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("end_date", "total_target_capacity") {
+		input := &ec2.ModifyCapacityReservationFleetInput{
+			CapacityReservationFleetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("end_date") {
+			if v, ok := d.GetOk("end_date"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+
+				input.EndDate = aws.Time(v)
+			} else {
+				input.RemoveEndDate = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("total_target_capacity") {
+			input.TotalTargetCapacity = aws.Int64(int64(d.Get("total_target_capacity").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating EC2 Capacity Reservation Fleet: %s", input)
+		_, err := conn.ModifyCapacityReservationFleet(input)
+
+		if err != nil {
+			return fmt.Errorf("updating EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitCapacityReservationFleetActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating EC2 Capacity Reservation Fleet (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityReservationFleetRead(d, meta)
+}
+
+func resourceCapacityReservationFleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Capacity Reservation Fleet: %s", d.Id())
+	output, err := conn.CancelCapacityReservationFleets(&ec2.CancelCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if err == nil && output != nil {
+		err = CancelCapacityReservationFleetsError(output.FailedFleetCancellations)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Capacity Reservation Fleet (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitCapacityReservationFleetDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReservationFleetInstanceSpecifications(tfList []interface{}) []*ec2.ReservationFleetInstanceSpecification {
+	var apiObjects []*ec2.ReservationFleetInstanceSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ec2.ReservationFleetInstanceSpecification{
+			InstancePlatform: aws.String(tfMap["instance_platform"].(string)),
+			InstanceType:     aws.String(tfMap["instance_type"].(string)),
+		}
+
+		if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+			apiObject.AvailabilityZone = aws.String(v)
+		}
+
+		if v, ok := tfMap["availability_zone_id"].(string); ok && v != "" {
+			apiObject.AvailabilityZoneId = aws.String(v)
+		}
+
+		if v, ok := tfMap["ebs_optimized"].(bool); ok && v {
+			apiObject.EbsOptimized = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["priority"].(int); ok && v != 0 {
+			apiObject.Priority = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["weight"].(float64); ok && v != 0 {
+			apiObject.Weight = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFleetCapacityReservations(apiObjects []*ec2.FleetCapacityReservation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone":    aws.StringValue(apiObject.AvailabilityZone),
+			"availability_zone_id": aws.StringValue(apiObject.AvailabilityZoneId),
+			"ebs_optimized":        aws.BoolValue(apiObject.EbsOptimized),
+			"instance_platform":    aws.StringValue(apiObject.InstancePlatform),
+			"instance_type":        aws.StringValue(apiObject.InstanceType),
+			"priority":             aws.Int64Value(apiObject.Priority),
+			"weight":               aws.Float64Value(apiObject.Weight),
+		})
+	}
+
+	return tfList
+}
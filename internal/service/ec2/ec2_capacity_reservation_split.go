@@ -0,0 +1,226 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ec2v1 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceCapacityReservationSplit manages a Capacity Reservation carved out of an existing one.
+// Changes to instance_count move capacity between the two reservations.
+func ResourceCapacityReservationSplit() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationSplitCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationSplitRead,
+		UpdateWithoutTimeout: resourceCapacityReservationSplitUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationSplitDelete,
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"instance_platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_capacity_reservation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCapacityReservationSplitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	sourceID := d.Get("source_capacity_reservation_id").(string)
+	input := &ec2.CreateCapacityReservationBySplittingInput{
+		InstanceCount:               aws.Int32(int32(d.Get("instance_count").(int))),
+		SourceCapacityReservationId: aws.String(sourceID),
+	}
+
+	if tags := tags.IgnoreAWS(); len(tags) > 0 {
+		input.TagSpecifications = []types.TagSpecification{{
+			ResourceType: types.ResourceTypeCapacityReservation,
+			Tags:         capacityReservationSplitTags(tags),
+		}}
+	}
+
+	output, err := conn.CreateCapacityReservationBySplitting(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("splitting EC2 Capacity Reservation (%s): %s", sourceID, err)
+	}
+
+	d.SetId(aws.ToString(output.DestinationCapacityReservation.CapacityReservationId))
+
+	if err := waitCapacityReservationSplitActive(meta.(*conns.AWSClient).EC2Conn, d.Id(), sourceID); err != nil {
+		return diag.Errorf("waiting for EC2 Capacity Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceCapacityReservationSplitRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationSplitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindCapacityReservationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Reservation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", reservation.CapacityReservationArn)
+	d.Set("availability_zone", reservation.AvailabilityZone)
+	d.Set("instance_count", reservation.TotalInstanceCount)
+	d.Set("instance_platform", reservation.InstancePlatform)
+	d.Set("instance_type", reservation.InstanceType)
+	d.Set("owner_id", reservation.OwnerId)
+
+	tags := KeyValueTags(reservation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityReservationSplitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	if d.HasChange("instance_count") {
+		sourceID := d.Get("source_capacity_reservation_id").(string)
+		o, n := d.GetChange("instance_count")
+		input := &ec2.MoveCapacityReservationInstancesInput{
+			DestinationCapacityReservationId: aws.String(d.Id()),
+			InstanceCount:                    aws.Int32(int32(n.(int) - o.(int))),
+			SourceCapacityReservationId:      aws.String(sourceID),
+		}
+
+		// Shrinking returns capacity to the source reservation.
+		if n.(int) < o.(int) {
+			input.DestinationCapacityReservationId = aws.String(sourceID)
+			input.InstanceCount = aws.Int32(int32(o.(int) - n.(int)))
+			input.SourceCapacityReservationId = aws.String(d.Id())
+		}
+
+		_, err := conn.MoveCapacityReservationInstances(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("moving EC2 Capacity Reservation (%s) instances: %s", d.Id(), err)
+		}
+
+		if err := waitCapacityReservationSplitActive(meta.(*conns.AWSClient).EC2Conn, d.Id(), sourceID); err != nil {
+			return diag.Errorf("waiting for EC2 Capacity Reservation (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(meta.(*conns.AWSClient).EC2Conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating EC2 Capacity Reservation (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityReservationSplitRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationSplitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Capacity Reservation: %s", d.Id())
+	_, err := conn.CancelCapacityReservationWithContext(ctx, &ec2v1.CancelCapacityReservationInput{
+		CapacityReservationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EC2 Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitCapacityReservationDeleted(conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for EC2 Capacity Reservation (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitCapacityReservationSplitActive waits for both sides of a split or move to settle.
+func waitCapacityReservationSplitActive(conn *ec2v1.EC2, ids ...string) error {
+	for _, id := range ids {
+		if _, err := WaitCapacityReservationActive(conn, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func capacityReservationSplitTags(tags tftags.KeyValueTags) []types.Tag {
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
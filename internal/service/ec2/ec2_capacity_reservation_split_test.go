@@ -0,0 +1,95 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityReservationSplit_basic(t *testing.T) {
+	var source, destination ec2.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation_split.test"
+	sourceResourceName := "aws_ec2_capacity_reservation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckCapacityReservation(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationSplitConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &destination),
+					testAccCheckCapacityReservationExists(sourceResourceName, &source),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", sourceResourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_platform", sourceResourceName, "instance_platform"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", sourceResourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_capacity_reservation_id", sourceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					testAccCheckCapacityReservationTotalInstanceCount(&source, 3),
+				),
+			},
+			{
+				Config: testAccCapacityReservationSplitConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &destination),
+					testAccCheckCapacityReservationExists(sourceResourceName, &source),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "3"),
+					testAccCheckCapacityReservationTotalInstanceCount(&source, 1),
+				),
+			},
+			{
+				Config: testAccCapacityReservationSplitConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &destination),
+					testAccCheckCapacityReservationExists(sourceResourceName, &source),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "2"),
+					testAccCheckCapacityReservationTotalInstanceCount(&source, 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationTotalInstanceCount(v *ec2.CapacityReservation, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := aws.Int64Value(v.TotalInstanceCount); actual != expected {
+			return fmt.Errorf("EC2 Capacity Reservation (%s) total instance count is %d, expected %d", aws.StringValue(v.CapacityReservationId), actual, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapacityReservationSplitConfig_basic(rName string, instanceCount int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_count    = 4
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t2.micro"
+
+  lifecycle {
+    ignore_changes = [instance_count]
+  }
+}
+
+resource "aws_ec2_capacity_reservation_split" "test" {
+  source_capacity_reservation_id = aws_ec2_capacity_reservation.test.id
+  instance_count                 = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceCount))
+}
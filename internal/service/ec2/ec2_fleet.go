@@ -53,6 +53,20 @@ func ResourceFleet() *schema.Resource {
 
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Instant fleets cannot be modified.
+				if diff.Id() != "" && diff.Get("type").(string) == ec2.FleetTypeInstant {
+					for _, key := range []string{"context", "excess_capacity_termination_policy", "launch_template_config", "target_capacity_specification"} {
+						if diff.HasChange(key) {
+							if err := diff.ForceNew(key); err != nil {
+								return err
+							}
+						}
+					}
+				}
+
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 
@@ -71,6 +85,31 @@ func ResourceFleet() *schema.Resource {
 				Default:      ec2.FleetExcessCapacityTerminationPolicyTermination,
 				ValidateFunc: validation.StringInSlice(ec2.FleetExcessCapacityTerminationPolicy_Values(), false),
 			},
+			"fleet_instance_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -450,6 +489,12 @@ func ResourceFleet() *schema.Resource {
 													ForceNew:     true,
 													ValidateFunc: validation.StringInSlice(ec2.FleetReplacementStrategy_Values(), false),
 												},
+												"termination_delay": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(120, 7200),
+												},
 											},
 										},
 									},
@@ -554,14 +599,11 @@ func ResourceFleet() *schema.Resource {
 				ForceNew: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  ec2.FleetTypeMaintain,
-				ValidateFunc: validation.StringInSlice([]string{
-					ec2.FleetTypeMaintain,
-					ec2.FleetTypeRequest,
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetTypeMaintain,
+				ValidateFunc: validation.StringInSlice(ec2.FleetType_Values(), false),
 			},
 		},
 	}
@@ -614,6 +656,17 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.FleetId))
 
+	// Instant fleets are fulfilled synchronously.
+	if fleetType == ec2.FleetTypeInstant {
+		if len(output.Instances) == 0 {
+			if err := CreateFleetsError(output.Errors); err != nil {
+				return fmt.Errorf("creating EC2 Fleet (%s): %w", d.Id(), err)
+			}
+		}
+
+		return resourceFleetRead(d, meta)
+	}
+
 	// If a request type is fulfilled immediately, we can miss the transition from active to deleted.
 	// Instead of an error here, allow the Read function to trigger recreation.
 	targetStates := []string{ec2.FleetStateCodeActive}
@@ -655,6 +708,9 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn)
 	d.Set("context", fleet.Context)
 	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	if err := d.Set("fleet_instance_set", flattenFleetInstances(fleet.Instances)); err != nil {
+		return fmt.Errorf("setting fleet_instance_set: %w", err)
+	}
 	if err := d.Set("launch_template_config", flattenFleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
 		return fmt.Errorf("setting launch_template_config: %w", err)
 	}
@@ -739,10 +795,13 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
+	// Instances launched by an instant fleet must be terminated when the fleet is deleted.
+	terminateInstances := d.Get("terminate_instances").(bool) || d.Get("type").(string) == ec2.FleetTypeInstant
+
 	log.Printf("[DEBUG] Deleting EC2 Fleet: %s", d.Id())
 	output, err := conn.DeleteFleets(&ec2.DeleteFleetsInput{
 		FleetIds:           aws.StringSlice([]string{d.Id()}),
-		TerminateInstances: aws.Bool(d.Get("terminate_instances").(bool)),
+		TerminateInstances: aws.Bool(terminateInstances),
 	})
 
 	if err == nil && output != nil {
@@ -760,7 +819,7 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	delay := 0 * time.Second
 	pendingStates := []string{ec2.FleetStateCodeActive}
 	targetStates := []string{ec2.FleetStateCodeDeleted}
-	if d.Get("terminate_instances").(bool) {
+	if terminateInstances {
 		pendingStates = append(pendingStates, ec2.FleetStateCodeDeletedTerminating)
 		delay = 5 * time.Minute
 	} else {
@@ -972,6 +1031,10 @@ func expandFleetSpotCapacityRebalanceRequest(tfMap map[string]interface{}) *ec2.
 		apiObject.ReplacementStrategy = aws.String(v)
 	}
 
+	if v, ok := tfMap["termination_delay"].(int); ok && v != 0 {
+		apiObject.TerminationDelay = aws.Int64(int64(v))
+	}
+
 	return apiObject
 }
 
@@ -1188,6 +1251,54 @@ func flattenFleetSpotCapacityRebalance(apiObject *ec2.FleetSpotCapacityRebalance
 		tfMap["replacement_strategy"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.TerminationDelay; v != nil {
+		tfMap["termination_delay"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenFleetInstances(apiObjects []*ec2.DescribeFleetsInstances) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenFleetInstance(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenFleetInstance(apiObject *ec2.DescribeFleetsInstances) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InstanceIds; v != nil {
+		tfMap["instance_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Lifecycle; v != nil {
+		tfMap["lifecycle"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Platform; v != nil {
+		tfMap["platform"] = aws.StringValue(v)
+	}
+
 	return tfMap
 }
 
@@ -26,6 +26,10 @@ func DataSourceHost() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"asset_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"auto_placement": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,6 +48,10 @@ func DataSourceHost() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"host_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_recovery": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -97,10 +105,12 @@ func dataSourceHostRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
+	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
 	d.Set("availability_zone", host.AvailabilityZone)
 	d.Set("cores", host.HostProperties.Cores)
 	d.Set("host_id", host.HostId)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
@@ -24,10 +24,12 @@ func TestAccEC2HostDataSource_basic(t *testing.T) {
 				Config: testAccHostDataSourceConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "asset_id", resourceName, "asset_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_maintenance", resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
@@ -56,10 +58,12 @@ func TestAccEC2HostDataSource_filter(t *testing.T) {
 				Config: testAccHostDataSourceConfig_filter(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "asset_id", resourceName, "asset_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_maintenance", resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_family", resourceName, "instance_family"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
@@ -30,7 +30,9 @@ func TestAccEC2Host_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHostExists(resourceName, &host),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`dedicated-host/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_id", ""),
 					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttrSet(resourceName, "host_maintenance"),
 					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
 					resource.TestCheckResourceAttr(resourceName, "instance_family", ""),
 					resource.TestCheckResourceAttr(resourceName, "instance_type", "a1.large"),
@@ -118,6 +120,40 @@ func TestAccEC2Host_instanceFamily(t *testing.T) {
 	})
 }
 
+func TestAccEC2Host_hostMaintenance(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostConfig_hostMaintenance(rName, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccHostConfig_hostMaintenance(rName, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "on"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Host_tags(t *testing.T) {
 	var host ec2.Host
 	resourceName := "aws_ec2_host.test"
@@ -278,6 +314,20 @@ resource "aws_ec2_host" "test" {
 `, rName))
 }
 
+func testAccHostConfig_hostMaintenance(rName, hostMaintenance string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  host_maintenance  = %[2]q
+  instance_type     = "a1.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, hostMaintenance))
+}
+
 func testAccHostConfig_tags1(tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ec2_host" "test" {
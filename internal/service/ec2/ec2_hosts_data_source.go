@@ -0,0 +1,70 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHostsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeHostsInput{}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filter = append(input.Filter, BuildTagFilterList(
+			Tags(tftags.New(tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filter = append(input.Filter, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filter) == 0 {
+		input.Filter = nil
+	}
+
+	output, err := FindHosts(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Hosts: %s", err)
+	}
+
+	var hostIDs []string
+
+	for _, v := range output {
+		hostIDs = append(hostIDs, aws.StringValue(v.HostId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", hostIDs)
+
+	return nil
+}
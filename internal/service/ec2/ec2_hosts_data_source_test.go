@@ -0,0 +1,81 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2HostsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_tags", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_hosts.by_tags", "ids.0", "aws_ec2_host.test1", "id"),
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.by_filter", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_ec2_hosts.empty", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test1" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+
+  tags = {
+    Name   = %[1]q
+    Filter = "test1"
+  }
+}
+
+resource "aws_ec2_host" "test2" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "a1.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_hosts" "by_tags" {
+  tags = {
+    Filter = "test1"
+  }
+
+  depends_on = [aws_ec2_host.test1, aws_ec2_host.test2]
+}
+
+data "aws_ec2_hosts" "by_filter" {
+  filter {
+    name   = "tag:Name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_ec2_host.test1, aws_ec2_host.test2]
+}
+
+data "aws_ec2_hosts" "empty" {
+  filter {
+    name   = "tag:Name"
+    values = ["%[1]s-does-not-exist"]
+  }
+
+  depends_on = [aws_ec2_host.test1, aws_ec2_host.test2]
+}
+`, rName))
+}
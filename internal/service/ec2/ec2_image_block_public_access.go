@@ -0,0 +1,148 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imageBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	state := d.Get("state").(string)
+
+	var err error
+
+	if state == string(types.ImageBlockPublicAccessEnabledStateBlockNewSharing) {
+		input := &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: types.ImageBlockPublicAccessEnabledState(state),
+		}
+
+		_, err = conn.EnableImageBlockPublicAccess(ctx, input)
+	} else {
+		input := &ec2.DisableImageBlockPublicAccessInput{}
+
+		_, err = conn.DisableImageBlockPublicAccess(ctx, input)
+	}
+
+	if err != nil {
+		return diag.Errorf("updating EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := waitImageBlockPublicAccessState(ctx, conn, state, timeout); err != nil {
+		return diag.Errorf("waiting for EC2 Image Block Public Access state (%s): %s", state, err)
+	}
+
+	return resourceImageBlockPublicAccessRead(ctx, d, meta)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Image Block Public Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Image Block Public Access (%s): %s", d.Id(), err)
+	}
+
+	d.Set("state", output)
+
+	return nil
+}
+
+func resourceImageBlockPublicAccessDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The account-level setting is left unchanged.
+	log.Printf("[DEBUG] Removing EC2 Image Block Public Access (%s) from state", d.Id())
+
+	return nil
+}
+
+func imageBlockPublicAccessState_Values() []string {
+	return append(
+		enum.Values[types.ImageBlockPublicAccessEnabledState](),
+		enum.Values[types.ImageBlockPublicAccessDisabledState]()...,
+	)
+}
+
+func statusImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, output, nil
+	}
+}
+
+// waitImageBlockPublicAccessState waits for the account-level setting to reach the desired state,
+// which can take up to 10 minutes.
+func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, state string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: imageBlockPublicAccessState_Values(),
+		Target:  []string{state},
+		Refresh: statusImageBlockPublicAccessState(ctx, conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
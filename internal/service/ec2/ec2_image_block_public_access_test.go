@@ -0,0 +1,61 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2ImageBlockPublicAccess_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccImageBlockPublicAccess_basic,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccImageBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic("block-new-sharing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "block-new-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic("unblocked"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "unblocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
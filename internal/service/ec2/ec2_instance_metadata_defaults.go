@@ -0,0 +1,167 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// instanceMetadataDefaultsHopLimitNoPreference resets the hop limit to "no preference".
+	instanceMetadataDefaultsHopLimitNoPreference = -1
+)
+
+func ResourceInstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceMetadataDefaultsCreate,
+		ReadWithoutTimeout:   resourceInstanceMetadataDefaultsRead,
+		UpdateWithoutTimeout: resourceInstanceMetadataDefaultsUpdate,
+		DeleteWithoutTimeout: resourceInstanceMetadataDefaultsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.DefaultInstanceMetadataEndpointStateNoPreference,
+				ValidateDiagFunc: enum.Validate[types.DefaultInstanceMetadataEndpointState](),
+			},
+			"http_put_response_hop_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      instanceMetadataDefaultsHopLimitNoPreference,
+				ValidateFunc: validation.Any(validation.IntInSlice([]int{instanceMetadataDefaultsHopLimitNoPreference}), validation.IntBetween(1, 64)),
+			},
+			"http_tokens": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.MetadataDefaultHttpTokensStateNoPreference,
+				ValidateDiagFunc: enum.Validate[types.MetadataDefaultHttpTokensState](),
+			},
+			"instance_metadata_tags": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.DefaultInstanceMetadataTagsStateNoPreference,
+				ValidateDiagFunc: enum.Validate[types.DefaultInstanceMetadataTagsState](),
+			},
+		},
+	}
+}
+
+func resourceInstanceMetadataDefaultsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            types.DefaultInstanceMetadataEndpointState(d.Get("http_endpoint").(string)),
+		HttpPutResponseHopLimit: aws.Int32(int32(d.Get("http_put_response_hop_limit").(int))),
+		HttpTokens:              types.MetadataDefaultHttpTokensState(d.Get("http_tokens").(string)),
+		InstanceMetadataTags:    types.DefaultInstanceMetadataTagsState(d.Get("instance_metadata_tags").(string)),
+	}
+
+	if _, err := conn.ModifyInstanceMetadataDefaults(ctx, input); err != nil {
+		return diag.Errorf("creating EC2 Instance Metadata Defaults: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourceInstanceMetadataDefaultsRead(ctx, d, meta)
+}
+
+func resourceInstanceMetadataDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	output, err := FindInstanceMetadataDefaults(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance Metadata Defaults (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Instance Metadata Defaults (%s): %s", d.Id(), err)
+	}
+
+	// Attributes with no account-level preference are omitted from the response.
+	if v := output.HttpEndpoint; v != "" {
+		d.Set("http_endpoint", v)
+	} else {
+		d.Set("http_endpoint", types.DefaultInstanceMetadataEndpointStateNoPreference)
+	}
+	if v := output.HttpPutResponseHopLimit; v != nil {
+		d.Set("http_put_response_hop_limit", aws.ToInt32(v))
+	} else {
+		d.Set("http_put_response_hop_limit", instanceMetadataDefaultsHopLimitNoPreference)
+	}
+	if v := output.HttpTokens; v != "" {
+		d.Set("http_tokens", v)
+	} else {
+		d.Set("http_tokens", types.MetadataDefaultHttpTokensStateNoPreference)
+	}
+	if v := output.InstanceMetadataTags; v != "" {
+		d.Set("instance_metadata_tags", v)
+	} else {
+		d.Set("instance_metadata_tags", types.DefaultInstanceMetadataTagsStateNoPreference)
+	}
+
+	return nil
+}
+
+func resourceInstanceMetadataDefaultsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{}
+
+	if d.HasChange("http_endpoint") {
+		input.HttpEndpoint = types.DefaultInstanceMetadataEndpointState(d.Get("http_endpoint").(string))
+	}
+
+	if d.HasChange("http_put_response_hop_limit") {
+		input.HttpPutResponseHopLimit = aws.Int32(int32(d.Get("http_put_response_hop_limit").(int)))
+	}
+
+	if d.HasChange("http_tokens") {
+		input.HttpTokens = types.MetadataDefaultHttpTokensState(d.Get("http_tokens").(string))
+	}
+
+	if d.HasChange("instance_metadata_tags") {
+		input.InstanceMetadataTags = types.DefaultInstanceMetadataTagsState(d.Get("instance_metadata_tags").(string))
+	}
+
+	if _, err := conn.ModifyInstanceMetadataDefaults(ctx, input); err != nil {
+		return diag.Errorf("updating EC2 Instance Metadata Defaults (%s): %s", d.Id(), err)
+	}
+
+	return resourceInstanceMetadataDefaultsRead(ctx, d, meta)
+}
+
+func resourceInstanceMetadataDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Client()
+
+	// Removing the resource resets every default to "no preference".
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            types.DefaultInstanceMetadataEndpointStateNoPreference,
+		HttpPutResponseHopLimit: aws.Int32(instanceMetadataDefaultsHopLimitNoPreference),
+		HttpTokens:              types.MetadataDefaultHttpTokensStateNoPreference,
+		InstanceMetadataTags:    types.DefaultInstanceMetadataTagsStateNoPreference,
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Instance Metadata Defaults: %s", d.Id())
+	if _, err := conn.ModifyInstanceMetadataDefaults(ctx, input); err != nil {
+		return diag.Errorf("deleting EC2 Instance Metadata Defaults (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
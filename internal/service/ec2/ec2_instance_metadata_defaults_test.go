@@ -0,0 +1,168 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2InstanceMetadataDefaults_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":  testAccInstanceMetadataDefaults_basic,
+		"update": testAccInstanceMetadataDefaults_update,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccInstanceMetadataDefaults_basic(t *testing.T) {
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "no-preference"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceMetadataDefaults_update(t *testing.T) {
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_full("enabled", 1, "optional", "disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "optional"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
+				),
+			},
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_full("enabled", 2, "required", "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceMetadataDefaultsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_instance_metadata_defaults" {
+			continue
+		}
+
+		output, err := tfec2.FindInstanceMetadataDefaults(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.HttpEndpoint != "" || output.HttpPutResponseHopLimit != nil || output.HttpTokens != "" || output.InstanceMetadataTags != "" {
+			return fmt.Errorf("EC2 Instance Metadata Defaults %s still set", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckInstanceMetadataDefaultsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Instance Metadata Defaults ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client()
+
+		output, err := tfec2.FindInstanceMetadataDefaults(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if v := rs.Primary.Attributes["http_tokens"]; v != string(types.MetadataDefaultHttpTokensStateNoPreference) && string(output.HttpTokens) != v {
+			return fmt.Errorf("EC2 Instance Metadata Defaults http_tokens is %q, expected %q", output.HttpTokens, v)
+		}
+
+		if v := rs.Primary.Attributes["http_put_response_hop_limit"]; v != "-1" && fmt.Sprint(aws.ToInt32(output.HttpPutResponseHopLimit)) != v {
+			return fmt.Errorf("EC2 Instance Metadata Defaults http_put_response_hop_limit is %d, expected %s", aws.ToInt32(output.HttpPutResponseHopLimit), v)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceMetadataDefaultsConfig_basic() string {
+	return `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_tokens = "required"
+}
+`
+}
+
+func testAccInstanceMetadataDefaultsConfig_full(httpEndpoint string, hopLimit int, httpTokens, instanceMetadataTags string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_endpoint               = %[1]q
+  http_put_response_hop_limit = %[2]d
+  http_tokens                 = %[3]q
+  instance_metadata_tags      = %[4]q
+}
+`, httpEndpoint, hopLimit, httpTokens, instanceMetadataTags)
+}
@@ -22,6 +22,7 @@ const (
 	errCodeInvalidAllocationIDNotFound                    = "InvalidAllocationID.NotFound"
 	errCodeInvalidAssociationIDNotFound                   = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                    = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound      = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound           = "InvalidCapacityReservationId.NotFound'"
 	errCodeInvalidCarrierGatewayIDNotFound                = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound      = "InvalidClientVpnActiveAssociationNotFound"
@@ -107,6 +108,26 @@ const (
 	errCodeVolumeInUse                                    = "VolumeInUse"
 )
 
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
@@ -127,6 +148,26 @@ func CancelSpotFleetRequestsError(apiObjects []*ec2.CancelSpotFleetRequestsError
 	return errors.ErrorOrNil()
 }
 
+func CreateFleetError(apiObject *ec2.CreateFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage), nil)
+}
+
+func CreateFleetsError(apiObjects []*ec2.CreateFleetError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CreateFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func DeleteFleetError(apiObject *ec2.DeleteFleetErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
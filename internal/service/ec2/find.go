@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -157,6 +159,83 @@ func FindCapacityReservationByID(conn *ec2.EC2, id string) (*ec2.CapacityReserva
 	return output, nil
 }
 
+func FindCapacityReservationFleet(conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) (*ec2.CapacityReservationFleet, error) {
+	output, err := FindCapacityReservationFleets(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindCapacityReservationFleets(conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) ([]*ec2.CapacityReservationFleet, error) {
+	var output []*ec2.CapacityReservationFleet
+
+	err := conn.DescribeCapacityReservationFleetsPages(input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindCapacityReservationFleetByID(conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCapacityReservationFleet(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.CapacityReservationFleetStateCancelled || state == ec2.CapacityReservationFleetStateExpired {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.CapacityReservationFleetId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindCarrierGateway(conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) (*ec2.CarrierGateway, error) {
 	output, err := FindCarrierGateways(conn, input)
 
@@ -869,6 +948,37 @@ func FindHost(conn *ec2.EC2, input *ec2.DescribeHostsInput) (*ec2.Host, error) {
 	return host, nil
 }
 
+func FindHosts(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeHostsInput) ([]*ec2.Host, error) {
+	var output []*ec2.Host
+
+	err := conn.DescribeHostsPagesWithContext(ctx, input, func(page *ec2.DescribeHostsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Hosts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidHostIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindImage(conn *ec2.EC2, input *ec2.DescribeImagesInput) (*ec2.Image, error) {
 	output, err := conn.DescribeImages(input)
 
@@ -6414,6 +6524,31 @@ func FindCreateSnapshotCreateVolumePermissionByTwoPartKey(conn *ec2.EC2, snapsho
 	return nil, &resource.NotFoundError{LastRequest: input}
 }
 
+func FindSnapshotCreateVolumePermissionAccountIDsBySnapshotID(conn *ec2.EC2, snapshotID string) ([]string, error) {
+	input := &ec2.DescribeSnapshotAttributeInput{
+		Attribute:  aws.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+		SnapshotId: aws.String(snapshotID),
+	}
+
+	output, err := FindSnapshotAttribute(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var accountIDs []string
+
+	for _, v := range output.CreateVolumePermissions {
+		if v == nil || v.UserId == nil {
+			continue
+		}
+
+		accountIDs = append(accountIDs, aws.StringValue(v.UserId))
+	}
+
+	return accountIDs, nil
+}
+
 func FindFindSnapshotTierStatuses(conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) ([]*ec2.SnapshotTierStatus, error) {
 	var output []*ec2.SnapshotTierStatus
 
@@ -6515,3 +6650,80 @@ func FindNetworkPerformanceMetricSubscriptionByFourPartKey(ctx context.Context,
 
 	return nil, &resource.NotFoundError{}
 }
+
+func FindInstanceMetadataDefaults(ctx context.Context, conn *ec2_sdkv2.Client) (*types.InstanceMetadataDefaultsResponse, error) {
+	input := &ec2_sdkv2.GetInstanceMetadataDefaultsInput{}
+
+	output, err := conn.GetInstanceMetadataDefaults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountLevel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountLevel, nil
+}
+
+func FindImageByIDV2(ctx context.Context, conn *ec2_sdkv2.Client, id string) (*types.Image, error) {
+	input := &ec2_sdkv2.DescribeImagesInput{
+		ImageIds: []string{id},
+	}
+
+	output, err := conn.DescribeImages(ctx, input)
+
+	if apiErr, ok := errs.As[smithy.APIError](err); ok && (apiErr.ErrorCode() == errCodeInvalidAMIIDNotFound || apiErr.ErrorCode() == errCodeInvalidAMIIDUnavailable) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Images) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Images); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	image := output.Images[0]
+
+	if state := image.State; state == types.ImageStateDeregistered {
+		return nil, &resource.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws_sdkv2.ToString(image.ImageId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return &image, nil
+}
+
+func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) (string, error) {
+	input := &ec2_sdkv2.GetImageBlockPublicAccessStateInput{}
+
+	output, err := conn.GetImageBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ImageBlockPublicAccessState == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws_sdkv2.ToString(output.ImageBlockPublicAccessState), nil
+}
@@ -32,9 +32,16 @@ func ResourceLocalGatewayRoute() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidCIDRNetworkAddress,
+				ExactlyOneOf: []string{"destination_cidr_block", "destination_prefix_list_id"},
+			},
+			"destination_prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"destination_cidr_block", "destination_prefix_list_id"},
 			},
 			"local_gateway_route_table_id": {
 				Type:     schema.TypeString,
@@ -42,9 +49,16 @@ func ResourceLocalGatewayRoute() *schema.Resource {
 				ForceNew: true,
 			},
 			"local_gateway_virtual_interface_group_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"local_gateway_virtual_interface_group_id", "network_interface_id"},
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"local_gateway_virtual_interface_group_id", "network_interface_id"},
 			},
 		},
 	}
@@ -53,13 +67,27 @@ func ResourceLocalGatewayRoute() *schema.Resource {
 func resourceLocalGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	destination := d.Get("destination_cidr_block").(string)
 	localGatewayRouteTableID := d.Get("local_gateway_route_table_id").(string)
 
 	input := &ec2.CreateLocalGatewayRouteInput{
-		DestinationCidrBlock:                aws.String(destination),
-		LocalGatewayRouteTableId:            aws.String(localGatewayRouteTableID),
-		LocalGatewayVirtualInterfaceGroupId: aws.String(d.Get("local_gateway_virtual_interface_group_id").(string)),
+		LocalGatewayRouteTableId: aws.String(localGatewayRouteTableID),
+	}
+
+	var destination string
+	if v, ok := d.GetOk("destination_cidr_block"); ok {
+		destination = v.(string)
+		input.DestinationCidrBlock = aws.String(destination)
+	} else {
+		destination = d.Get("destination_prefix_list_id").(string)
+		input.DestinationPrefixListId = aws.String(destination)
+	}
+
+	if v, ok := d.GetOk("local_gateway_virtual_interface_group_id"); ok {
+		input.LocalGatewayVirtualInterfaceGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_interface_id"); ok {
+		input.NetworkInterfaceId = aws.String(v.(string))
 	}
 
 	_, err := conn.CreateLocalGatewayRoute(input)
@@ -131,8 +159,10 @@ func resourceLocalGatewayRouteRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	d.Set("destination_cidr_block", localGatewayRoute.DestinationCidrBlock)
+	d.Set("destination_prefix_list_id", localGatewayRoute.DestinationPrefixListId)
 	d.Set("local_gateway_virtual_interface_group_id", localGatewayRoute.LocalGatewayVirtualInterfaceGroupId)
 	d.Set("local_gateway_route_table_id", localGatewayRoute.LocalGatewayRouteTableId)
+	d.Set("network_interface_id", localGatewayRoute.NetworkInterfaceId)
 
 	return nil
 }
@@ -146,10 +176,15 @@ func resourceLocalGatewayRouteDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	input := &ec2.DeleteLocalGatewayRouteInput{
-		DestinationCidrBlock:     aws.String(destination),
 		LocalGatewayRouteTableId: aws.String(localGatewayRouteTableID),
 	}
 
+	if isLocalGatewayRoutePrefixListDestination(destination) {
+		input.DestinationPrefixListId = aws.String(destination)
+	} else {
+		input.DestinationCidrBlock = aws.String(destination)
+	}
+
 	log.Printf("[DEBUG] Deleting EC2 Local Gateway Route (%s): %s", d.Id(), input)
 	_, err = conn.DeleteLocalGatewayRoute(input)
 
@@ -174,6 +209,11 @@ func DecodeLocalGatewayRouteID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// isLocalGatewayRoutePrefixListDestination returns whether a route destination is a prefix list ID rather than a CIDR block.
+func isLocalGatewayRoutePrefixListDestination(destination string) bool {
+	return strings.HasPrefix(destination, "pl-")
+}
+
 func GetLocalGatewayRoute(conn *ec2.EC2, localGatewayRouteTableID, destination string) (*ec2.LocalGatewayRoute, error) {
 	input := &ec2.SearchLocalGatewayRoutesInput{
 		Filters: []*ec2.Filter{
@@ -200,7 +240,7 @@ func GetLocalGatewayRoute(conn *ec2.EC2, localGatewayRouteTableID, destination s
 			continue
 		}
 
-		if aws.StringValue(route.DestinationCidrBlock) == destination {
+		if aws.StringValue(route.DestinationCidrBlock) == destination || aws.StringValue(route.DestinationPrefixListId) == destination {
 			return route, nil
 		}
 	}
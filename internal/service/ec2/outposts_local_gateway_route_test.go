@@ -45,6 +45,34 @@ func TestAccEC2OutpostsLocalGatewayRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2OutpostsLocalGatewayRoute_destinationPrefixListID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	prefixListResourceName := "aws_ec2_managed_prefix_list.test"
+	resourceName := "aws_ec2_local_gateway_route.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteConfig_destinationPrefixListID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_prefix_list_id", prefixListResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2OutpostsLocalGatewayRoute_disappears(t *testing.T) {
 	rInt := sdkacctest.RandIntRange(0, 255)
 	destinationCidrBlock := fmt.Sprintf("172.16.%d.0/24", rInt)
@@ -154,3 +182,33 @@ resource "aws_ec2_local_gateway_route" "test" {
 }
 `, destinationCidrBlock)
 }
+
+func testAccOutpostsLocalGatewayRouteConfig_destinationPrefixListID(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+data "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+}
+
+data "aws_ec2_local_gateway_virtual_interface_group" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  entry {
+    cidr = "172.16.0.0/24"
+  }
+}
+
+resource "aws_ec2_local_gateway_route" "test" {
+  destination_prefix_list_id               = aws_ec2_managed_prefix_list.test.id
+  local_gateway_route_table_id             = data.aws_ec2_local_gateway_route_table.test.id
+  local_gateway_virtual_interface_group_id = data.aws_ec2_local_gateway_virtual_interface_group.test.id
+}
+`, rName)
+}
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"log"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,6 +43,22 @@ func StatusCapacityReservationState(conn *ec2.EC2, id string) resource.StateRefr
 	}
 }
 
+func StatusCapacityReservationFleetState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCapacityReservationFleetByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusCarrierGatewayState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindCarrierGatewayByID(conn, id)
@@ -887,6 +904,8 @@ func StatusVolumeModificationState(conn *ec2.EC2, id string) resource.StateRefre
 			return nil, "", err
 		}
 
+		log.Printf("[DEBUG] EBS Volume (%s) modification state: %s (%d%% complete)", id, aws.StringValue(output.ModificationState), aws.Int64Value(output.Progress))
+
 		return output, aws.StringValue(output.ModificationState), nil
 	}
 }
@@ -108,6 +108,41 @@ func WaitCapacityReservationDeleted(conn *ec2.EC2, id string) (*ec2.CapacityRese
 	return nil, err
 }
 
+func WaitCapacityReservationFleetActive(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted, ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled, ec2.CapacityReservationFleetStateCancelling},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -3,21 +3,22 @@ package ecr
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.ECR, repositoryPrefix string) (*ecr.PullThroughCacheRule, error) {
-	input := ecr.DescribePullThroughCacheRulesInput{
-		EcrRepositoryPrefixes: aws.StringSlice([]string{repositoryPrefix}),
+func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.Client, repositoryPrefix string) (*types.PullThroughCacheRule, error) {
+	input := &ecr.DescribePullThroughCacheRulesInput{
+		EcrRepositoryPrefixes: []string{repositoryPrefix},
 	}
 
-	output, err := conn.DescribePullThroughCacheRulesWithContext(ctx, &input)
+	output, err := conn.DescribePullThroughCacheRules(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, ecr.ErrCodePullThroughCacheRuleNotFoundException) {
+	if errs.IsA[*types.PullThroughCacheRuleNotFoundException](err) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -28,7 +29,7 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 		return nil, err
 	}
 
-	if output == nil || len(output.PullThroughCacheRules) == 0 || output.PullThroughCacheRules[0] == nil {
+	if output == nil || len(output.PullThroughCacheRules) == 0 {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
@@ -36,5 +37,38 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 		return nil, tfresource.NewTooManyResultsError(count, input)
 	}
 
-	return output.PullThroughCacheRules[0], nil
+	return &output.PullThroughCacheRules[0], nil
+}
+
+func FindRepositoryCreationTemplateByPrefix(ctx context.Context, conn *ecr.Client, prefix string) (*types.RepositoryCreationTemplate, error) {
+	input := &ecr.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: []string{prefix},
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplates(ctx, input)
+
+	if errs.IsA[*types.TemplateNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.RepositoryCreationTemplates {
+		if aws.ToString(v.Prefix) == prefix {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
 }
@@ -5,27 +5,45 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourcePullThroughCacheRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePullThroughCacheRuleCreate,
 		ReadContext:   resourcePullThroughCacheRuleRead,
+		UpdateContext: resourcePullThroughCacheRuleUpdate,
 		DeleteContext: resourcePullThroughCacheRuleDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		// Credentials can be rotated in place, but a rule cannot be switched
+		// between authenticated and unauthenticated upstream registries.
+		CustomizeDiff: customdiff.ForceNewIfChange("credential_arn", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(string) == "" || new.(string) == ""
+		}),
+
 		Schema: map[string]*schema.Schema{
+			"credential_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					verify.ValidARN,
+					validation.StringMatch(regexp.MustCompile(`:secret:ecr-pullthroughcache/`), "must be the ARN of a Secrets Manager secret whose name begins with ecr-pullthroughcache/"),
+				),
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -51,7 +69,7 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 }
 
 func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.ecr-in-func-name
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRClient()
 
 	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
 	input := &ecr.CreatePullThroughCacheRuleInput{
@@ -59,8 +77,12 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		UpstreamRegistryUrl: aws.String(d.Get("upstream_registry_url").(string)),
 	}
 
-	log.Printf("[DEBUG] Creating ECR Pull Through Cache Rule: %s", input)
-	_, err := conn.CreatePullThroughCacheRuleWithContext(ctx, input)
+	if v, ok := d.GetOk("credential_arn"); ok {
+		input.CredentialArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating ECR Pull Through Cache Rule: %s", repositoryPrefix)
+	_, err := conn.CreatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
 		return diag.Errorf("error creating ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
@@ -72,7 +94,7 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRClient()
 
 	rule, err := FindPullThroughCacheRuleByRepositoryPrefix(ctx, conn, d.Id())
 
@@ -86,6 +108,7 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("error reading ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
 	}
 
+	d.Set("credential_arn", rule.CredentialArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
@@ -93,16 +116,34 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRClient()
+
+	input := &ecr.UpdatePullThroughCacheRuleInput{
+		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
+		EcrRepositoryPrefix: aws.String(d.Id()),
+		RegistryId:          aws.String(d.Get("registry_id").(string)),
+	}
+
+	_, err := conn.UpdatePullThroughCacheRule(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourcePullThroughCacheRuleRead(ctx, d, meta)
+}
+
 func resourcePullThroughCacheRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRClient()
 
 	log.Printf("[DEBUG] Deleting ECR Pull Through Cache Rule: (%s)", d.Id())
-	_, err := conn.DeletePullThroughCacheRuleWithContext(ctx, &ecr.DeletePullThroughCacheRuleInput{
+	_, err := conn.DeletePullThroughCacheRule(ctx, &ecr.DeletePullThroughCacheRuleInput{
 		EcrRepositoryPrefix: aws.String(d.Id()),
 		RegistryId:          aws.String(d.Get("registry_id").(string)),
 	})
 
-	if tfawserr.ErrCodeEquals(err, ecr.ErrCodePullThroughCacheRuleNotFoundException) {
+	if errs.IsA[*types.PullThroughCacheRuleNotFoundException](err) {
 		return nil
 	}
 
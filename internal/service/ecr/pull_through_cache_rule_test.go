@@ -66,6 +66,41 @@ func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "upstream_registry_url", "registry-1.docker.io"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
 	resourceName := "aws_ecr_pull_through_cache_rule.test"
@@ -92,7 +127,7 @@ func TestAccECRPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
 }
 
 func testAccCheckPullThroughCacheRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_pull_through_cache_rule" {
@@ -126,7 +161,7 @@ func testAccCheckPullThroughCacheRuleExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ECR Pull Through Cache Rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient()
 
 		_, err := tfecr.FindPullThroughCacheRuleByRepositoryPrefix(context.Background(), conn, rs.Primary.ID)
 
@@ -164,3 +199,33 @@ resource "aws_ecr_pull_through_cache_rule" "duplicate" {
 }
 `, repositoryPrefix)
 }
+
+func testAccPullThroughCacheRuleConfig_credentialARN(repositoryPrefix, rName, secret string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test1" {
+  name                    = "ecr-pullthroughcache/%[2]s-1"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id     = aws_secretsmanager_secret.test1.id
+  secret_string = jsonencode({ username = "tf-test", accessToken = "test1" })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "ecr-pullthroughcache/%[2]s-2"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = jsonencode({ username = "tf-test", accessToken = "test2" })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "registry-1.docker.io"
+  credential_arn        = aws_secretsmanager_secret_version.%[3]s.arn
+}
+`, repositoryPrefix, rName, secret)
+}
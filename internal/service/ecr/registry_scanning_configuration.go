@@ -101,9 +101,15 @@ func resourceRegistryScanningConfigurationRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): %w", d.Id(), err)
 	}
 
+	if out == nil || out.ScanningConfiguration == nil {
+		return fmt.Errorf("error reading ECR Registry Scanning Configuration (%s): empty response", d.Id())
+	}
+
 	d.Set("registry_id", out.RegistryId)
 	d.Set("scan_type", out.ScanningConfiguration.ScanType)
-	d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules))
+	if err := d.Set("rule", flattenScanningConfigurationRules(out.ScanningConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
 
 	return nil
 }
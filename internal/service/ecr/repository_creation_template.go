@@ -0,0 +1,353 @@
+package ecr
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameRepositoryCreationTemplate = "Repository Creation Template"
+)
+
+func ResourceRepositoryCreationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryCreationTemplateCreate,
+		ReadWithoutTimeout:   resourceRepositoryCreationTemplateRead,
+		UpdateWithoutTimeout: resourceRepositoryCreationTemplateUpdate,
+		DeleteWithoutTimeout: resourceRepositoryCreationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"applied_for": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.RCTAppliedFor](),
+				},
+			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.EncryptionTypeAes256),
+							ValidateDiagFunc: enum.Validate[types.EncryptionType](),
+						},
+						"kms_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
+			"image_tag_mutability": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.ImageTagMutabilityMutable),
+				ValidateDiagFunc: enum.Validate[types.ImageTagMutability](),
+			},
+			"lifecycle_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalentLifecyclePolicyJSON(old, new)
+
+					return equal
+				},
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(
+						regexp.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*/?|ROOT)$`),
+						"must be ROOT or only include lowercase alphanumeric, underscore, period, hyphen or slash characters"),
+				),
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func resourceRepositoryCreationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRClient()
+
+	prefix := d.Get("prefix").(string)
+	input := &ecr.CreateRepositoryCreationTemplateInput{
+		AppliedFor:         expandRCTAppliedFor(d.Get("applied_for").(*schema.Set)),
+		ImageTagMutability: types.ImageTagMutability(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(prefix),
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandEncryptionConfigurationForRepositoryCreationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lifecycle_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return create.DiagError(names.ECR, create.ErrActionCreating, ResNameRepositoryCreationTemplate, prefix, err)
+		}
+
+		input.LifecyclePolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("repository_policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return create.DiagError(names.ECR, create.ErrActionCreating, ResNameRepositoryCreationTemplate, prefix, err)
+		}
+
+		input.RepositoryPolicy = aws.String(policy)
+	}
+
+	if v, ok := d.GetOk("resource_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ResourceTags = expandResourceTags(tftags.New(v.(map[string]interface{})))
+	}
+
+	_, err := conn.CreateRepositoryCreationTemplate(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionCreating, ResNameRepositoryCreationTemplate, prefix, err)
+	}
+
+	d.SetId(prefix)
+
+	return resourceRepositoryCreationTemplateRead(ctx, d, meta)
+}
+
+func resourceRepositoryCreationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRClient()
+
+	template, err := FindRepositoryCreationTemplateByPrefix(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.ECR, create.ErrActionReading, ResNameRepositoryCreationTemplate, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionReading, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	d.Set("applied_for", enum.Slice(template.AppliedFor...))
+	d.Set("custom_role_arn", template.CustomRoleArn)
+	d.Set("description", template.Description)
+	if err := d.Set("encryption_configuration", flattenEncryptionConfigurationForRepositoryCreationTemplate(template.EncryptionConfiguration)); err != nil {
+		return create.DiagError(names.ECR, create.ErrActionSetting, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+	d.Set("image_tag_mutability", template.ImageTagMutability)
+
+	d.Set("lifecycle_policy", template.LifecyclePolicy)
+	d.Set("prefix", template.Prefix)
+	d.Set("registry_id", meta.(*conns.AWSClient).AccountID)
+
+	policy, err := verify.PolicyToSet(d.Get("repository_policy").(string), aws.ToString(template.RepositoryPolicy))
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionReading, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	d.Set("repository_policy", policy)
+
+	if err := d.Set("resource_tags", flattenResourceTags(template.ResourceTags)); err != nil {
+		return create.DiagError(names.ECR, create.ErrActionSetting, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceRepositoryCreationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRClient()
+
+	input := &ecr.UpdateRepositoryCreationTemplateInput{
+		AppliedFor:         expandRCTAppliedFor(d.Get("applied_for").(*schema.Set)),
+		CustomRoleArn:      aws.String(d.Get("custom_role_arn").(string)),
+		Description:        aws.String(d.Get("description").(string)),
+		ImageTagMutability: types.ImageTagMutability(d.Get("image_tag_mutability").(string)),
+		Prefix:             aws.String(d.Id()),
+		ResourceTags:       expandResourceTags(tftags.New(d.Get("resource_tags").(map[string]interface{}))),
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandEncryptionConfigurationForRepositoryCreationTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("lifecycle_policy").(string))
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionUpdating, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	input.LifecyclePolicy = aws.String(policy)
+
+	policy, err = structure.NormalizeJsonString(d.Get("repository_policy").(string))
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionUpdating, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	input.RepositoryPolicy = aws.String(policy)
+
+	_, err = conn.UpdateRepositoryCreationTemplate(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionUpdating, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	return resourceRepositoryCreationTemplateRead(ctx, d, meta)
+}
+
+func resourceRepositoryCreationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRClient()
+
+	log.Printf("[DEBUG] Deleting ECR Repository Creation Template: %s", d.Id())
+	_, err := conn.DeleteRepositoryCreationTemplate(ctx, &ecr.DeleteRepositoryCreationTemplateInput{
+		Prefix: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.TemplateNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ECR, create.ErrActionDeleting, ResNameRepositoryCreationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRCTAppliedFor(s *schema.Set) []types.RCTAppliedFor {
+	var apiObjects []types.RCTAppliedFor
+
+	for _, v := range flex.ExpandStringValueSet(s) {
+		apiObjects = append(apiObjects, types.RCTAppliedFor(v))
+	}
+
+	return apiObjects
+}
+
+func expandEncryptionConfigurationForRepositoryCreationTemplate(tfMap map[string]interface{}) *types.EncryptionConfigurationForRepositoryCreationTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EncryptionConfigurationForRepositoryCreationTemplate{}
+
+	if v, ok := tfMap["encryption_type"].(string); ok && v != "" {
+		apiObject.EncryptionType = types.EncryptionType(v)
+	}
+
+	if v, ok := tfMap["kms_key"].(string); ok && v != "" {
+		apiObject.KmsKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryptionConfigurationForRepositoryCreationTemplate(apiObject *types.EncryptionConfigurationForRepositoryCreationTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encryption_type": string(apiObject.EncryptionType),
+		"kms_key":         aws.ToString(apiObject.KmsKey),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandResourceTags(tags tftags.KeyValueTags) []types.Tag {
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenResourceTags(apiObjects []types.Tag) map[string]string {
+	tfMap := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.ToString(apiObject.Key)] = aws.ToString(apiObject.Value)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,254 @@
+package ecr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccECRRepositoryCreationTemplate_basic(t *testing.T) {
+	prefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_repository_creation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryCreationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateConfig_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryCreationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "applied_for.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_for.*", "PULL_THROUGH_CACHE"),
+					resource.TestCheckResourceAttr(resourceName, "custom_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.encryption_type", "AES256"),
+					resource.TestCheckResourceAttr(resourceName, "image_tag_mutability", "MUTABLE"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "prefix", prefix),
+					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, "repository_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECRRepositoryCreationTemplate_disappears(t *testing.T) {
+	prefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_repository_creation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryCreationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateConfig_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryCreationTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecr.ResourceRepositoryCreationTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECRRepositoryCreationTemplate_update(t *testing.T) {
+	prefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_repository_creation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryCreationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateConfig_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryCreationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_tag_mutability", "MUTABLE"),
+				),
+			},
+			{
+				Config: testAccRepositoryCreationTemplateConfig_updated(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryCreationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "applied_for.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_for.*", "PULL_THROUGH_CACHE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_for.*", "REPLICATION"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test description"),
+					resource.TestCheckResourceAttr(resourceName, "image_tag_mutability", "IMMUTABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "lifecycle_policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "repository_policy"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Foo", "Bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECRRepositoryCreationTemplate_root(t *testing.T) {
+	resourceName := "aws_ecr_repository_creation_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryCreationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateConfig_basic("ROOT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryCreationTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "ROOT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryCreationTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_repository_creation_template" {
+			continue
+		}
+
+		_, err := tfecr.FindRepositoryCreationTemplateByPrefix(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECR Repository Creation Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRepositoryCreationTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Repository Creation Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient()
+
+		_, err := tfecr.FindRepositoryCreationTemplateByPrefix(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRepositoryCreationTemplateConfig_basic(prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository_creation_template" "test" {
+  prefix = %[1]q
+
+  applied_for = [
+    "PULL_THROUGH_CACHE",
+  ]
+}
+`, prefix)
+}
+
+func testAccRepositoryCreationTemplateConfig_updated(prefix string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid    = "AllowPull"
+    effect = "Allow"
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+
+    actions = [
+      "ecr:BatchGetImage",
+      "ecr:GetDownloadUrlForLayer",
+    ]
+  }
+}
+
+resource "aws_ecr_repository_creation_template" "test" {
+  prefix               = %[1]q
+  description          = "Test description"
+  image_tag_mutability = "IMMUTABLE"
+
+  applied_for = [
+    "PULL_THROUGH_CACHE",
+    "REPLICATION",
+  ]
+
+  repository_policy = data.aws_iam_policy_document.test.json
+
+  lifecycle_policy = <<EOT
+{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire images older than 14 days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}
+EOT
+
+  resource_tags = {
+    Foo = "Bar"
+  }
+}
+`, prefix)
+}
@@ -1,17 +1,24 @@
 package ecs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ecs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -19,15 +26,18 @@ import (
 
 func ResourceCapacityProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCapacityProviderCreate,
-		Read:   resourceCapacityProviderRead,
-		Update: resourceCapacityProviderUpdate,
-		Delete: resourceCapacityProviderDelete,
+		CreateWithoutTimeout: resourceCapacityProviderCreate,
+		ReadWithoutTimeout:   resourceCapacityProviderRead,
+		UpdateWithoutTimeout: resourceCapacityProviderUpdate,
+		DeleteWithoutTimeout: resourceCapacityProviderDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceCapacityProviderImport,
+			StateContext: resourceCapacityProviderImport,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCapacityProviderCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -47,6 +57,12 @@ func ResourceCapacityProvider() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"managed_draining": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.ManagedDraining](),
+						},
 						"managed_scaling": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -106,7 +122,7 @@ func ResourceCapacityProvider() *schema.Resource {
 	}
 }
 
-func resourceCapacityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCapacityProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -123,46 +139,59 @@ func resourceCapacityProviderCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Creating ECS Capacity Provider: %s", input)
-	output, err := conn.CreateCapacityProvider(&input)
+	output, err := conn.CreateCapacityProviderWithContext(ctx, &input)
 
 	// Some partitions (i.e., ISO) may not support tag-on-create
 	if input.Tags != nil && verify.ErrorISOUnsupported(conn.PartitionID, err) {
 		log.Printf("[WARN] ECS tagging failed creating Capacity Provider (%s) with tags: %s. Trying create without tags.", name, err)
 		input.Tags = nil
 
-		output, err = conn.CreateCapacityProvider(&input)
+		output, err = conn.CreateCapacityProviderWithContext(ctx, &input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed creating ECS Capacity Provider (%s): %w", name, err)
+		return diag.Errorf("creating ECS Capacity Provider (%s): %s", name, err)
 	}
 
 	d.SetId(aws.StringValue(output.CapacityProvider.CapacityProviderArn))
 
+	// Managed draining is not supported by the AWS SDK for Go v1 create operation.
+	if v, ok := d.GetOk("auto_scaling_group_provider.0.managed_draining"); ok {
+		input := &ecs_sdkv2.UpdateCapacityProviderInput{
+			AutoScalingGroupProvider: &types.AutoScalingGroupProviderUpdate{
+				ManagedDraining: types.ManagedDraining(v.(string)),
+			},
+			Name: aws.String(name),
+		}
+
+		if err := updateCapacityProvider(ctx, meta.(*conns.AWSClient).ECSClient(), conn, d.Id(), input); err != nil {
+			return diag.Errorf("setting ECS Capacity Provider (%s) managed draining: %s", d.Id(), err)
+		}
+	}
+
 	// Some partitions (i.e., ISO) may not support tag-on-create, attempt tag after create
 	if input.Tags == nil && len(tags) > 0 {
-		err := UpdateTags(conn, d.Id(), nil, tags)
+		err := UpdateTagsWithContext(ctx, conn, d.Id(), nil, tags)
 
 		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			// If default tags only, log and continue. Otherwise, error.
 			log.Printf("[WARN] ECS tagging failed adding tags after create for Capacity Provider (%s): %s", d.Id(), err)
-			return resourceCapacityProviderRead(d, meta)
+			return resourceCapacityProviderRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("ECS tagging failed adding tags after create for Capacity Provider (%s): %w", d.Id(), err)
+			return diag.Errorf("ECS tagging failed adding tags after create for Capacity Provider (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceCapacityProviderRead(d, meta)
+	return resourceCapacityProviderRead(ctx, d, meta)
 }
 
-func resourceCapacityProviderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+func resourceCapacityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	output, err := FindCapacityProviderByARN(conn, d.Id())
+	output, err := findCapacityProviderByARNSDKv2(ctx, meta.(*conns.AWSClient).ECSClient(), meta.(*conns.AWSClient).Partition, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] ECS Capacity Provider (%s) not found, removing from state", d.Id())
@@ -171,92 +200,69 @@ func resourceCapacityProviderRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS Capacity Provider (%s): %w", d.Id(), err)
+		return diag.Errorf("reading ECS Capacity Provider (%s): %s", d.Id(), err)
 	}
 
 	d.Set("arn", output.CapacityProviderArn)
 
 	if err := d.Set("auto_scaling_group_provider", flattenAutoScalingGroupProvider(output.AutoScalingGroupProvider)); err != nil {
-		return fmt.Errorf("error setting auto_scaling_group_provider: %w", err)
+		return diag.Errorf("setting auto_scaling_group_provider: %s", err)
 	}
 
 	d.Set("name", output.Name)
 
-	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := keyValueTagsSDKv2(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCapacityProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
-		input := &ecs.UpdateCapacityProviderInput{
+		input := &ecs_sdkv2.UpdateCapacityProviderInput{
 			AutoScalingGroupProvider: expandAutoScalingGroupProviderUpdate(d.Get("auto_scaling_group_provider")),
 			Name:                     aws.String(d.Get("name").(string)),
 		}
 
-		log.Printf("[DEBUG] Updating ECS Capacity Provider: %s", input)
-		err := resource.Retry(capacityProviderUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateCapacityProvider(input)
-
-			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeUpdateInProgressException) {
-				return resource.RetryableError(err)
-			}
-
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateCapacityProvider(input)
-		}
-
-		if err != nil {
-			return fmt.Errorf("error updating ECS Capacity Provider (%s): %w", d.Id(), err)
-		}
-
-		if _, err = waitCapacityProviderUpdated(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for ECS Capacity Provider (%s) to update: %w", d.Id(), err)
+		if err := updateCapacityProvider(ctx, meta.(*conns.AWSClient).ECSClient(), conn, d.Id(), input); err != nil {
+			return diag.Errorf("updating ECS Capacity Provider (%s): %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		err := UpdateTags(conn, d.Id(), o, n)
+		err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n)
 
 		// Some partitions (i.e., ISO) may not support tagging, giving error
 		if verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] ECS tagging failed updating tags for Capacity Provider (%s): %s", d.Id(), err)
-			return resourceCapacityProviderRead(d, meta)
+			return resourceCapacityProviderRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("ECS tagging failed updating tags for Capacity Provider (%s): %w", d.Id(), err)
+			return diag.Errorf("ECS tagging failed updating tags for Capacity Provider (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceCapacityProviderRead(d, meta)
+	return resourceCapacityProviderRead(ctx, d, meta)
 }
 
-func resourceCapacityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCapacityProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 
 	log.Printf("[DEBUG] Deleting ECS Capacity Provider (%s)", d.Id())
-	_, err := conn.DeleteCapacityProvider(&ecs.DeleteCapacityProviderInput{
+	_, err := conn.DeleteCapacityProviderWithContext(ctx, &ecs.DeleteCapacityProviderInput{
 		CapacityProvider: aws.String(d.Id()),
 	})
 
@@ -266,17 +272,55 @@ func resourceCapacityProviderDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting ECS Capacity Provider (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting ECS Capacity Provider (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCapacityProviderDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for ECS Capacity Provider (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCapacityProviderCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Managed termination protection requires managed scaling to be turned on.
+	if diff.Get("auto_scaling_group_provider.0.managed_termination_protection").(string) == ecs.ManagedTerminationProtectionEnabled &&
+		diff.Get("auto_scaling_group_provider.0.managed_scaling.0.status").(string) == ecs.ManagedScalingStatusDisabled {
+		return fmt.Errorf("auto_scaling_group_provider.0.managed_termination_protection cannot be %s when managed scaling is %s", ecs.ManagedTerminationProtectionEnabled, ecs.ManagedScalingStatusDisabled)
+	}
+
+	return nil
+}
+
+// updateCapacityProvider updates the capacity provider using the AWS SDK for Go v2, which is required for managed draining,
+// and waits for the update to complete.
+func updateCapacityProvider(ctx context.Context, client *ecs_sdkv2.Client, conn *ecs.ECS, arn string, input *ecs_sdkv2.UpdateCapacityProviderInput) error {
+	log.Printf("[DEBUG] Updating ECS Capacity Provider: %s", arn)
+	_, err := tfresource.RetryWhenContext(ctx, capacityProviderUpdateTimeout,
+		func() (interface{}, error) {
+			return client.UpdateCapacityProvider(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*types.UpdateInProgressException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return err
 	}
 
-	if _, err := waitCapacityProviderDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for ECS Capacity Provider (%s) to delete: %w", d.Id(), err)
+	if _, err = waitCapacityProviderUpdated(ctx, conn, arn); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
 	}
 
 	return nil
 }
 
-func resourceCapacityProviderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceCapacityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -311,7 +355,7 @@ func expandAutoScalingGroupProviderCreate(configured interface{}) *ecs.AutoScali
 	return &prov
 }
 
-func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScalingGroupProviderUpdate {
+func expandAutoScalingGroupProviderUpdate(configured interface{}) *types.AutoScalingGroupProviderUpdate {
 	if configured == nil {
 		return nil
 	}
@@ -320,14 +364,18 @@ func expandAutoScalingGroupProviderUpdate(configured interface{}) *ecs.AutoScali
 		return nil
 	}
 
-	prov := ecs.AutoScalingGroupProviderUpdate{}
+	prov := types.AutoScalingGroupProviderUpdate{}
 	p := configured.([]interface{})[0].(map[string]interface{})
 
+	if v := p["managed_draining"].(string); len(v) > 0 {
+		prov.ManagedDraining = types.ManagedDraining(v)
+	}
+
 	if mtp := p["managed_termination_protection"].(string); len(mtp) > 0 {
-		prov.ManagedTerminationProtection = aws.String(mtp)
+		prov.ManagedTerminationProtection = types.ManagedTerminationProtection(mtp)
 	}
 
-	prov.ManagedScaling = expandManagedScaling(p["managed_scaling"])
+	prov.ManagedScaling = expandManagedScalingV2(p["managed_scaling"])
 
 	return &prov
 }
@@ -364,24 +412,57 @@ func expandManagedScaling(configured interface{}) *ecs.ManagedScaling {
 	return &managedScaling
 }
 
-func flattenAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []map[string]interface{} {
+func expandManagedScalingV2(configured interface{}) *types.ManagedScaling {
+	if configured == nil {
+		return nil
+	}
+
+	if configured.([]interface{}) == nil || len(configured.([]interface{})) == 0 {
+		return nil
+	}
+
+	p := configured.([]interface{})[0].(map[string]interface{})
+
+	managedScaling := types.ManagedScaling{}
+
+	if val, ok := p["instance_warmup_period"].(int); ok && val != 0 {
+		managedScaling.InstanceWarmupPeriod = aws_sdkv2.Int32(int32(val))
+	}
+	if val, ok := p["maximum_scaling_step_size"].(int); ok && val != 0 {
+		managedScaling.MaximumScalingStepSize = aws_sdkv2.Int32(int32(val))
+	}
+	if val, ok := p["minimum_scaling_step_size"].(int); ok && val != 0 {
+		managedScaling.MinimumScalingStepSize = aws_sdkv2.Int32(int32(val))
+	}
+	if val, ok := p["status"].(string); ok && len(val) > 0 {
+		managedScaling.Status = types.ManagedScalingStatus(val)
+	}
+	if val, ok := p["target_capacity"].(int); ok && val != 0 {
+		managedScaling.TargetCapacity = aws_sdkv2.Int32(int32(val))
+	}
+
+	return &managedScaling
+}
+
+func flattenAutoScalingGroupProvider(provider *types.AutoScalingGroupProvider) []map[string]interface{} {
 	if provider == nil {
 		return nil
 	}
 
 	p := map[string]interface{}{
-		"auto_scaling_group_arn":         aws.StringValue(provider.AutoScalingGroupArn),
-		"managed_termination_protection": aws.StringValue(provider.ManagedTerminationProtection),
+		"auto_scaling_group_arn":         aws_sdkv2.ToString(provider.AutoScalingGroupArn),
+		"managed_draining":               provider.ManagedDraining,
+		"managed_termination_protection": provider.ManagedTerminationProtection,
 		"managed_scaling":                []map[string]interface{}{},
 	}
 
 	if provider.ManagedScaling != nil {
 		m := map[string]interface{}{
-			"instance_warmup_period":    aws.Int64Value(provider.ManagedScaling.InstanceWarmupPeriod),
-			"maximum_scaling_step_size": aws.Int64Value(provider.ManagedScaling.MaximumScalingStepSize),
-			"minimum_scaling_step_size": aws.Int64Value(provider.ManagedScaling.MinimumScalingStepSize),
-			"status":                    aws.StringValue(provider.ManagedScaling.Status),
-			"target_capacity":           aws.Int64Value(provider.ManagedScaling.TargetCapacity),
+			"instance_warmup_period":    aws_sdkv2.ToInt32(provider.ManagedScaling.InstanceWarmupPeriod),
+			"maximum_scaling_step_size": aws_sdkv2.ToInt32(provider.ManagedScaling.MaximumScalingStepSize),
+			"minimum_scaling_step_size": aws_sdkv2.ToInt32(provider.ManagedScaling.MinimumScalingStepSize),
+			"status":                    provider.ManagedScaling.Status,
+			"target_capacity":           aws_sdkv2.ToInt32(provider.ManagedScaling.TargetCapacity),
 		}
 
 		p["managed_scaling"] = []map[string]interface{}{m}
@@ -1,7 +1,9 @@
 package ecs_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -159,6 +161,58 @@ func TestAccECSCapacityProvider_managedScalingPartial(t *testing.T) {
 	})
 }
 
+func TestAccECSCapacityProvider_managedDraining(t *testing.T) {
+	var provider ecs.CapacityProvider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityProviderConfig_managedDraining(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_draining", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSCapacityProvider_managedTerminationProtectionWithoutManagedScaling(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCapacityProviderConfig_managedTerminationProtectionWithoutManagedScaling(rName),
+				ExpectError: regexp.MustCompile(`managed_termination_protection cannot be ENABLED when managed scaling is DISABLED`),
+			},
+		},
+	})
+}
+
 func TestAccECSCapacityProvider_tags(t *testing.T) {
 	var provider ecs.CapacityProvider
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -213,7 +267,7 @@ func testAccCheckCapacityProviderDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := tfecs.FindCapacityProviderByARN(conn, rs.Primary.ID)
+		_, err := tfecs.FindCapacityProviderByARN(context.Background(), conn, rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
@@ -242,7 +296,7 @@ func testAccCheckCapacityProviderExists(resourceName string, provider *ecs.Capac
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
 
-		output, err := tfecs.FindCapacityProviderByARN(conn, rs.Primary.ID)
+		output, err := tfecs.FindCapacityProviderByARN(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -352,6 +406,36 @@ resource "aws_ecs_capacity_provider" "test" {
 `, rName)
 }
 
+func testAccCapacityProviderConfig_managedDraining(rName, managedDraining string) string {
+	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn = aws_autoscaling_group.test.arn
+    managed_draining       = %[2]q
+  }
+}
+`, rName, managedDraining)
+}
+
+func testAccCapacityProviderConfig_managedTerminationProtectionWithoutManagedScaling(rName string) string {
+	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn         = aws_autoscaling_group.test.arn
+    managed_termination_protection = "ENABLED"
+
+    managed_scaling {
+      status = "DISABLED"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccCapacityProviderConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return testAccCapacityProviderBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_ecs_capacity_provider" "test" {
@@ -0,0 +1,128 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceClusterCapacityProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterCapacityProvidersRead,
+
+		Schema: map[string]*schema.Schema{
+			"capacity_providers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateClusterName,
+			},
+			"default_capacity_provider_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"effective_weight_percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterCapacityProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	clusterName := d.Get("cluster_name").(string)
+	cluster, err := FindClusterByNameOrARN(ctx, conn, clusterName)
+
+	if err != nil {
+		return diag.Errorf("reading ECS Cluster (%s): %s", clusterName, err)
+	}
+
+	d.SetId(aws.StringValue(cluster.ClusterArn))
+
+	if err := d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders)); err != nil {
+		return diag.Errorf("setting capacity_providers: %s", err)
+	}
+
+	d.Set("cluster_arn", cluster.ClusterArn)
+
+	if err := d.Set("default_capacity_provider_strategy", flattenCapacityProviderStrategyEffectiveWeights(cluster.DefaultCapacityProviderStrategy)); err != nil {
+		return diag.Errorf("setting default_capacity_provider_strategy: %s", err)
+	}
+
+	return nil
+}
+
+// flattenCapacityProviderStrategyEffectiveWeights flattens the strategy, adding each item's share of the total weight as a percentage.
+func flattenCapacityProviderStrategyEffectiveWeights(apiObjects []*ecs.CapacityProviderStrategyItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var totalWeight int64
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		totalWeight += aws.Int64Value(apiObject.Weight)
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		weight := aws.Int64Value(apiObject.Weight)
+		tfMap := map[string]interface{}{
+			"base":                        aws.Int64Value(apiObject.Base),
+			"capacity_provider":           aws.StringValue(apiObject.CapacityProvider),
+			"effective_weight_percentage": effectiveWeightPercentage(weight, totalWeight),
+			"weight":                      weight,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func effectiveWeightPercentage(weight, totalWeight int64) float64 {
+	if totalWeight == 0 {
+		return 0
+	}
+
+	return float64(weight) / float64(totalWeight) * 100
+}
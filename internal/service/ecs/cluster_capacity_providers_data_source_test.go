@@ -0,0 +1,76 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSClusterCapacityProvidersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecs_cluster_capacity_providers.test"
+	resourceName := "aws_ecs_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "capacity_providers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "capacity_providers.*", "FARGATE_SPOT"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_capacity_provider_strategy.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"base":                        "1",
+						"capacity_provider":           "FARGATE",
+						"effective_weight_percentage": "25",
+						"weight":                      "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"base":                        "0",
+						"capacity_provider":           "FARGATE_SPOT",
+						"effective_weight_percentage": "75",
+						"weight":                      "3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterCapacityProvidersDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 1
+    capacity_provider = "FARGATE"
+  }
+
+  default_capacity_provider_strategy {
+    weight            = 3
+    capacity_provider = "FARGATE_SPOT"
+  }
+}
+
+data "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster_capacity_providers.test.cluster_name
+}
+`, rName)
+}
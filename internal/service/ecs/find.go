@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 
+	ecs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -13,20 +15,20 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-func FindCapacityProviderByARN(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
+func FindCapacityProviderByARN(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
 	input := &ecs.DescribeCapacityProvidersInput{
 		CapacityProviders: aws.StringSlice([]string{arn}),
 		Include:           aws.StringSlice([]string{ecs.CapacityProviderFieldTags}),
 	}
 
-	output, err := conn.DescribeCapacityProviders(input)
+	output, err := conn.DescribeCapacityProvidersWithContext(ctx, input)
 
 	// Some partitions (i.e., ISO) may not support tagging, giving error
 	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
 		log.Printf("[WARN] ECS tagging failed describing Capacity Provider (%s) with tags: %s; retrying without tags", arn, err)
 
 		input.Include = nil
-		output, err = conn.DescribeCapacityProviders(input)
+		output, err = conn.DescribeCapacityProvidersWithContext(ctx, input)
 	}
 
 	if err != nil {
@@ -52,6 +54,44 @@ func FindCapacityProviderByARN(conn *ecs.ECS, arn string) (*ecs.CapacityProvider
 	return capacityProvider, nil
 }
 
+// findCapacityProviderByARNSDKv2 returns the capacity provider using the AWS SDK for Go v2,
+// which is required to read managed draining.
+func findCapacityProviderByARNSDKv2(ctx context.Context, conn *ecs_sdkv2.Client, partition, arn string) (*types.CapacityProvider, error) {
+	input := &ecs_sdkv2.DescribeCapacityProvidersInput{
+		CapacityProviders: []string{arn},
+		Include:           []types.CapacityProviderField{types.CapacityProviderFieldTags},
+	}
+
+	output, err := conn.DescribeCapacityProviders(ctx, input)
+
+	// Some partitions (i.e., ISO) may not support tagging, giving error
+	if errorISOUnsupportedSDKv2(partition, err) {
+		log.Printf("[WARN] ECS tagging failed describing Capacity Provider (%s) with tags: %s; retrying without tags", arn, err)
+
+		input.Include = nil
+		output, err = conn.DescribeCapacityProviders(ctx, input)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CapacityProviders) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	capacityProvider := &output.CapacityProviders[0]
+
+	if status := capacityProvider.Status; status == types.CapacityProviderStatusInactive {
+		return nil, &resource.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return capacityProvider, nil
+}
+
 func FindClusterByNameOrARN(ctx context.Context, conn *ecs.ECS, nameOrARN string) (*ecs.Cluster, error) {
 	input := &ecs.DescribeClustersInput{
 		Clusters: aws.StringSlice([]string{nameOrARN}),
@@ -25,9 +25,9 @@ const (
 	taskSetStatusPrimary  = "PRIMARY"
 )
 
-func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
+func statusCapacityProvider(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindCapacityProviderByARN(conn, arn)
+		output, err := FindCapacityProviderByARN(ctx, conn, arn)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -41,9 +41,9 @@ func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc
 	}
 }
 
-func statusCapacityProviderUpdate(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
+func statusCapacityProviderUpdate(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindCapacityProviderByARN(conn, arn)
+		output, err := FindCapacityProviderByARN(ctx, conn, arn)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -0,0 +1,65 @@
+package ecs
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/smithy-go"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// keyValueTagsSDKv2 creates tftags.KeyValueTags from ecs service tags returned by the AWS SDK for Go v2.
+func keyValueTagsSDKv2(tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
+
+// errorISOUnsupportedSDKv2 is verify.ErrorISOUnsupported for errors returned by the AWS SDK for Go v2.
+func errorISOUnsupportedSDKv2(partition string, err error) bool {
+	if partition == endpoints.AwsPartitionID {
+		return false
+	}
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	code := apiErr.ErrorCode()
+
+	if code == verify.ErrCodeValidationError {
+		return strings.Contains(apiErr.ErrorMessage(), "not support tagging")
+	}
+
+	for _, v := range []string{
+		verify.ErrCodeAccessDenied,
+		verify.ErrCodeAuthorizationError,
+		verify.ErrCodeInternalException,
+		verify.ErrCodeInternalServiceError,
+		verify.ErrCodeInvalidAction,
+		verify.ErrCodeInvalidParameterException,
+		verify.ErrCodeInvalidParameterValue,
+		verify.ErrCodeInvalidRequest,
+		verify.ErrCodeOperationDisabledException,
+		verify.ErrCodeOperationNotPermitted,
+		verify.ErrCodeUnknownOperationException,
+		verify.ErrCodeUnsupportedFeatureException,
+		verify.ErrCodeUnsupportedOperation,
+		verify.ErrCodeValidationException,
+	} {
+		if strings.Contains(code, v) {
+			return true
+		}
+	}
+
+	return false
+}
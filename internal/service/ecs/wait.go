@@ -28,15 +28,15 @@ const (
 	taskSetDeleteTimeout = 10 * time.Minute
 )
 
-func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
+func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ecs.CapacityProviderStatusActive},
 		Target:  []string{},
-		Refresh: statusCapacityProvider(conn, arn),
+		Refresh: statusCapacityProvider(ctx, conn, arn),
 		Timeout: capacityProviderDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -45,15 +45,15 @@ func waitCapacityProviderDeleted(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
+func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ecs.CapacityProviderUpdateStatusUpdateInProgress},
 		Target:  []string{ecs.CapacityProviderUpdateStatusUpdateComplete},
-		Refresh: statusCapacityProviderUpdate(conn, arn),
+		Refresh: statusCapacityProviderUpdate(ctx, conn, arn),
 		Timeout: capacityProviderUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.CapacityProvider); ok {
 		return v, err
@@ -1,6 +1,7 @@
 package efs
 
 import (
+	"errors"
 	"fmt"
 	"log"
 
@@ -8,13 +9,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	// Maximum number of secondary POSIX group IDs for an access point user.
+	accessPointPOSIXUserSecondaryGIDsMaxItems = 16
+)
+
 func ResourceAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccessPointCreate,
@@ -69,6 +77,7 @@ func ResourceAccessPoint() *schema.Resource {
 							Set:      schema.HashInt,
 							Optional: true,
 							ForceNew: true,
+							MaxItems: accessPointPOSIXUserSecondaryGIDsMaxItems,
 						},
 					},
 				},
@@ -129,7 +138,9 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 
 	fsId := d.Get("file_system_id").(string)
 
+	// A fixed client token makes retried requests idempotent.
 	input := efs.CreateAccessPointInput{
+		ClientToken:  aws.String(resource.UniqueId()),
 		FileSystemId: aws.String(fsId),
 		Tags:         Tags(tags.IgnoreAWS()),
 	}
@@ -144,12 +155,24 @@ func resourceAccessPointCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating EFS Access Point: %#v", input)
 
-	ap, err := conn.CreateAccessPoint(&input)
-	if err != nil {
+	// The file system may not yet be available, e.g. when it is created in the same apply.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(accessPointCreatedTimeout, func() (interface{}, error) {
+		return conn.CreateAccessPoint(&input)
+	}, efs.ErrCodeIncorrectFileSystemLifeCycleState)
+
+	var accessPointID string
+	var alreadyExists *efs.AccessPointAlreadyExists
+
+	// An earlier attempt with the same client token created the access point.
+	if errors.As(err, &alreadyExists) {
+		accessPointID = aws.StringValue(alreadyExists.AccessPointId)
+	} else if err != nil {
 		return fmt.Errorf("error creating EFS Access Point for File System (%s): %w", fsId, err)
+	} else {
+		accessPointID = aws.StringValue(outputRaw.(*efs.AccessPointDescription).AccessPointId)
 	}
 
-	d.SetId(aws.StringValue(ap.AccessPointId))
+	d.SetId(accessPointID)
 
 	if _, err := waitAccessPointCreated(conn, d.Id()); err != nil {
 		return fmt.Errorf("error waiting for EFS access point (%s) to be available: %w", d.Id(), err)
@@ -165,7 +188,7 @@ func resourceAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating EFS file system (%s) tags: %w", d.Id(), err)
+			return fmt.Errorf("error updating EFS access point (%s) tags: %w", d.Id(), err)
 		}
 	}
 
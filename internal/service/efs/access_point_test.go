@@ -171,8 +171,25 @@ func TestAccEFSAccessPoint_POSIXUserSecondary_gids(t *testing.T) {
 	})
 }
 
+func TestAccEFSAccessPoint_POSIXUserSecondaryGIDs_tooMany(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, efs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccessPointConfig_posixUserSecondaryGidsCount(rName, 17),
+				ExpectError: regexp.MustCompile(`supports 16 item maximum`),
+			},
+		},
+	})
+}
+
 func TestAccEFSAccessPoint_tags(t *testing.T) {
-	var ap efs.AccessPointDescription
+	var ap, ap2 efs.AccessPointDescription
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
@@ -198,7 +215,8 @@ func TestAccEFSAccessPoint_tags(t *testing.T) {
 			{
 				Config: testAccAccessPointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAccessPointExists(resourceName, &ap),
+					testAccCheckAccessPointExists(resourceName, &ap2),
+					testAccCheckAccessPointNotRecreated(&ap, &ap2),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -298,6 +316,16 @@ func testAccCheckAccessPointExists(resourceID string, mount *efs.AccessPointDesc
 	}
 }
 
+func testAccCheckAccessPointNotRecreated(before, after *efs.AccessPointDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.AccessPointId), aws.StringValue(after.AccessPointId); before != after {
+			return fmt.Errorf("EFS Access Point (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccAccessPointConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_efs_file_system" "test" {
@@ -378,6 +406,23 @@ resource "aws_efs_access_point" "test" {
 `, rName)
 }
 
+func testAccAccessPointConfig_posixUserSecondaryGidsCount(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = aws_efs_file_system.test.id
+  posix_user {
+    gid            = 1001
+    uid            = 1001
+    secondary_gids = range(2000, 2000 + %[2]d)
+  }
+}
+`, rName, count)
+}
+
 func testAccAccessPointConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_efs_file_system" "test" {
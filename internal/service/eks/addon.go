@@ -7,14 +7,17 @@ import (
 	"regexp"
 	"time"
 
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,7 +34,10 @@ func ResourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAddonCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -65,6 +71,11 @@ func ResourceAddon() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validClusterName,
 			},
+			"configuration_values": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,6 +84,23 @@ func ResourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -95,6 +123,7 @@ func ResourceAddon() *schema.Resource {
 
 func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
+	client := meta.(*conns.AWSClient).EKSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -102,7 +131,8 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	clusterName := d.Get("cluster_name").(string)
 	id := AddonCreateResourceID(clusterName, addonName)
 
-	input := &eks.CreateAddonInput{
+	// Pod Identity associations are not supported by the AWS SDK for Go v1.
+	input := &eks_sdkv2.CreateAddonInput{
 		AddonName:          aws.String(addonName),
 		ClientRequestToken: aws.String(resource.UniqueId()),
 		ClusterName:        aws.String(clusterName),
@@ -112,8 +142,16 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.AddonVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("configuration_values"); ok {
+		input.ConfigurationValues = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
-		input.ResolveConflicts = aws.String(v.(string))
+		input.ResolveConflicts = types.ResolveConflicts(v.(string))
 	}
 
 	if v, ok := d.GetOk("service_account_role_arn"); ok {
@@ -121,17 +159,17 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
+		input.Tags = tags.IgnoreAWS().Map()
 	}
 
 	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateAddonWithContext(ctx, input)
+		_, err := client.CreateAddon(ctx, input)
 
-		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "CREATE_FAILED") {
+		if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "CREATE_FAILED") {
 			return resource.RetryableError(err)
 		}
 
-		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
+		if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
 			return resource.RetryableError(err)
 		}
 
@@ -143,7 +181,7 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateAddonWithContext(ctx, input)
+		_, err = client.CreateAddon(ctx, input)
 	}
 
 	if err != nil {
@@ -198,10 +236,21 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("addon_version", addon.AddonVersion)
 	d.Set("arn", addon.AddonArn)
 	d.Set("cluster_name", addon.ClusterName)
+	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set("created_at", aws.TimeValue(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.TimeValue(addon.ModifiedAt).Format(time.RFC3339))
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
+	podIdentityAssociations, err := findAddonPodIdentityAssociations(ctx, meta.(*conns.AWSClient).EKSClient(), clusterName, addonName)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Add-On (%s) Pod Identity associations: %w", d.Id(), err))
+	}
+
+	if err := d.Set("pod_identity_association", flattenAddonPodIdentityAssociations(podIdentityAssociations)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting pod_identity_association: %w", err))
+	}
+
 	tags := KeyValueTags(addon.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -225,8 +274,8 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn") {
-		input := &eks.UpdateAddonInput{
+	if d.HasChanges("addon_version", "configuration_values", "pod_identity_association", "service_account_role_arn") {
+		input := &eks_sdkv2.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(resource.UniqueId()),
 			ClusterName:        aws.String(clusterName),
@@ -236,8 +285,21 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.AddonVersion = aws.String(d.Get("addon_version").(string))
 		}
 
+		if d.HasChange("configuration_values") {
+			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
+		}
+
+		// An empty list removes all Pod Identity associations from the add-on.
+		if d.HasChange("pod_identity_association") {
+			input.PodIdentityAssociations = []types.AddonPodIdentityAssociations{}
+
+			if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+				input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+			}
+		}
+
 		if v, ok := d.GetOk("resolve_conflicts"); ok {
-			input.ResolveConflicts = aws.String(v.(string))
+			input.ResolveConflicts = types.ResolveConflicts(v.(string))
 		}
 
 		// If service account role ARN is already provided, use it. Otherwise, the add-on uses
@@ -246,7 +308,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.ServiceAccountRoleArn = aws.String(d.Get("service_account_role_arn").(string))
 		}
 
-		output, err := conn.UpdateAddonWithContext(ctx, input)
+		output, err := meta.(*conns.AWSClient).EKSClient().UpdateAddon(ctx, input)
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error updating EKS Add-On (%s): %w", d.Id(), err))
@@ -312,3 +374,83 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func resourceAddonCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("addon_version") || !diff.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonVersion := diff.Get("addon_version").(string)
+	configurationValues := diff.Get("configuration_values").(string)
+
+	// Without an explicit version the add-on's default version is only known once it is created.
+	if addonVersion == "" || configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSConn
+	addonName := diff.Get("addon_name").(string)
+
+	output, err := conn.DescribeAddonConfigurationWithContext(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if err := validAddonConfigurationValues(aws.StringValue(output.ConfigurationSchema), configurationValues); err != nil {
+		return fmt.Errorf("configuration_values do not match the EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
+func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.AddonPodIdentityAssociations
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.AddonPodIdentityAssociations{
+			RoleArn:        aws.String(tfMap["role_arn"].(string)),
+			ServiceAccount: aws.String(tfMap["service_account"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAddonPodIdentityAssociations(apiObjects []*types.PodIdentityAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"role_arn":        aws.StringValue(apiObject.RoleArn),
+			"service_account": aws.StringValue(apiObject.ServiceAccount),
+		})
+	}
+
+	return tfList
+}
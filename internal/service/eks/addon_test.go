@@ -246,6 +246,88 @@ func TestAccEKSAddon_serviceAccountRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccEKSAddon_configurationValues(t *testing.T) {
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.Background()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_configurationValues(rName, addonName, `{"env": {"WARM_ENI_TARGET": "2"}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "configuration_values", `{"env": {"WARM_ENI_TARGET": "2"}}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
+			},
+			{
+				Config: testAccAddonConfig_configurationValues(rName, addonName, `{"env": {"WARM_ENI_TARGET": "3"}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "configuration_values", `{"env": {"WARM_ENI_TARGET": "3"}}`),
+				),
+			},
+			{
+				Config:      testAccAddonConfig_configurationValues(rName, addonName, `{"unknownProperty": true}`),
+				ExpectError: regexp.MustCompile(`configuration_values do not match the EKS Add-On`),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_podIdentityAssociation(t *testing.T) {
+	var addon eks.Addon
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+	ctx := context.Background()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_podIdentityAssociation(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_association.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "pod_identity_association.*", map[string]string{
+						"service_account": "aws-node",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pod_identity_association.*.role_arn", "aws_iam_role.pod_identity", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
+			},
+			{
+				Config: testAccAddonConfig_version(rName, addonName, "v1.18.1-eksbuild.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "pod_identity_association.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSAddon_tags(t *testing.T) {
 	var addon1, addon2, addon3 eks.Addon
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -886,6 +968,55 @@ resource "aws_eks_addon" "test" {
 `, rName, addonName))
 }
 
+func testAccAddonConfig_configurationValues(rName, addonName, configurationValues string) string {
+	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+  most_recent        = true
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name         = aws_eks_cluster.test.name
+  addon_name           = %[2]q
+  addon_version        = data.aws_eks_addon_version.test.version
+  configuration_values = %[3]q
+  resolve_conflicts    = "OVERWRITE"
+}
+`, rName, addonName, configurationValues))
+}
+
+func testAccAddonConfig_podIdentityAssociation(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iam_role" "pod_identity" {
+  name = "%[1]s-pod-identity"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "pods.eks.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name      = aws_eks_cluster.test.name
+  addon_name        = %[2]q
+  addon_version     = "v1.18.1-eksbuild.1"
+  resolve_conflicts = "OVERWRITE"
+
+  pod_identity_association {
+    role_arn        = aws_iam_role.pod_identity.arn
+    service_account = "aws-node"
+  }
+}
+`, rName, addonName))
+}
+
 func testAccAddonConfig_tags1(rName, addonName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
 resource "aws_eks_addon" "test" {
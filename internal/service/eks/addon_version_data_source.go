@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +22,10 @@ func DataSourceAddonVersion() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"kubernetes_version": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,9 +56,19 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("error reading EKS Add-On version info (%s, %s): %w", id, kubernetesVersion, err))
 	}
 
+	configuration, err := conn.DescribeAddonConfigurationWithContext(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: versionInfo.AddonVersion,
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading EKS Add-On (%s) version (%s) configuration schema: %w", id, aws.StringValue(versionInfo.AddonVersion), err))
+	}
+
 	d.SetId(id)
 
 	d.Set("addon_name", addonName)
+	d.Set("configuration_schema", configuration.ConfigurationSchema)
 	d.Set("kubernetes_version", kubernetesVersion)
 	d.Set("most_recent", mostRecent)
 	d.Set("version", versionInfo.AddonVersion)
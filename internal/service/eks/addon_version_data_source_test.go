@@ -32,6 +32,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
 					resource.TestCheckResourceAttr(versionDataSourceName, "most_recent", "true"),
+					resource.TestCheckResourceAttrSet(versionDataSourceName, "configuration_schema"),
 				),
 			},
 			{
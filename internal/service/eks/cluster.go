@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"time"
 
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -15,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -38,6 +42,7 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			resourceClusterVersionCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -177,7 +182,7 @@ func ResourceCluster() *schema.Resource {
 				Type:          schema.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"encryption_config", "kubernetes_network_config"},
+				ConflictsWith: []string{"encryption_config", "kubernetes_network_config", "remote_network_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"control_plane_instance_type": {
@@ -214,6 +219,57 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"remote_network_config": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"outpost_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remote_node_networks": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidrs": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidCIDRNetworkAddress,
+										},
+									},
+								},
+							},
+						},
+						"remote_pod_networks": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidrs": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidCIDRNetworkAddress,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -226,6 +282,22 @@ func ResourceCluster() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"upgrade_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"support_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.SupportType](),
+						},
+					},
+				},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -281,17 +353,34 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"zonal_shift_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
+	client := meta.(*conns.AWSClient).EKSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
 
-	input := &eks.CreateClusterInput{
+	// Hybrid nodes, upgrade policy and zonal shift configuration are not supported by the AWS SDK for Go v1.
+	input := &eks_sdkv2.CreateClusterInput{
 		EncryptionConfig:   expandEncryptionConfig(d.Get("encryption_config").([]interface{})),
 		Logging:            expandLogging(d.Get("enabled_cluster_log_types").(*schema.Set)),
 		Name:               aws.String(name),
@@ -307,40 +396,52 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.OutpostConfig = expandOutpostConfigRequest(d.Get("outpost_config").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("remote_network_config"); ok && len(v.([]interface{})) > 0 {
+		input.RemoteNetworkConfig = expandRemoteNetworkConfigRequest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("upgrade_policy"); ok && len(v.([]interface{})) > 0 {
+		input.UpgradePolicy = expandUpgradePolicyRequest(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("version"); ok {
 		input.Version = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("zonal_shift_config"); ok && len(v.([]interface{})) > 0 {
+		input.ZonalShiftConfig = expandZonalShiftConfigRequest(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
+		input.Tags = tags.IgnoreAWS().Map()
 	}
 
 	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			return conn.CreateClusterWithContext(ctx, input)
+			return client.CreateCluster(ctx, input)
 		},
 		func(err error) (bool, error) {
 			// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
+			if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
 				return true, err
 			}
 
 			// InvalidParameterException: Error in role params
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Error in role params") {
+			if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "Error in role params") {
 				return true, err
 			}
 
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed because the trusted entity is not correct") {
+			if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed because the trusted entity is not correct") {
 				return true, err
 			}
 
 			// InvalidParameterException: The provided role doesn't have the Amazon EKS Managed Policies associated with it. Please ensure the following policy is attached: arn:aws:iam::aws:policy/AmazonEKSClusterPolicy
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
+			if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "The provided role doesn't have the Amazon EKS Managed Policies associated with it") {
 				return true, err
 			}
 
 			// InvalidParameterException: IAM role's policy must include the `ec2:DescribeSubnets` action
-			if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "IAM role's policy must include") {
+			if errs.MessageContains(err, eks.ErrCodeInvalidParameterException, "IAM role's policy must include") {
 				return true, err
 			}
 
@@ -352,7 +453,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("creating EKS Cluster (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
+	d.SetId(aws.StringValue(outputRaw.(*eks_sdkv2.CreateClusterOutput).Cluster.Name))
 
 	if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for EKS Cluster (%s) create: %s", d.Id(), err)
@@ -412,6 +513,22 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("setting vpc_config: %s", err)
 	}
 
+	clusterSDKv2, err := findClusterByNameSDKv2(ctx, meta.(*conns.AWSClient).EKSClient(), d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("remote_network_config", flattenRemoteNetworkConfigResponse(clusterSDKv2.RemoteNetworkConfig)); err != nil {
+		return diag.Errorf("setting remote_network_config: %s", err)
+	}
+	if err := d.Set("upgrade_policy", flattenUpgradePolicyResponse(clusterSDKv2.UpgradePolicy)); err != nil {
+		return diag.Errorf("setting upgrade_policy: %s", err)
+	}
+	if err := d.Set("zonal_shift_config", flattenZonalShiftConfigResponse(clusterSDKv2.ZonalShiftConfig)); err != nil {
+		return diag.Errorf("setting zonal_shift_config: %s", err)
+	}
+
 	tags := KeyValueTags(cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -428,6 +545,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
+	client := meta.(*conns.AWSClient).EKSClient()
 
 	// Do any version update first.
 	if d.HasChange("version") {
@@ -455,12 +573,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		o, n := d.GetChange("encryption_config")
 
 		if len(o.([]interface{})) == 0 && len(n.([]interface{})) == 1 {
-			input := &eks.AssociateEncryptionConfigInput{
+			input := &eks_sdkv2.AssociateEncryptionConfigInput{
 				ClusterName:      aws.String(d.Id()),
 				EncryptionConfig: expandEncryptionConfig(d.Get("encryption_config").([]interface{})),
 			}
 
-			output, err := conn.AssociateEncryptionConfigWithContext(ctx, input)
+			output, err := client.AssociateEncryptionConfig(ctx, input)
 
 			if err != nil {
 				return diag.Errorf("associating EKS Cluster (%s) encryption config: %s", d.Id(), err)
@@ -477,12 +595,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if d.HasChange("enabled_cluster_log_types") {
-		input := &eks.UpdateClusterConfigInput{
+		input := &eks_sdkv2.UpdateClusterConfigInput{
 			Logging: expandLogging(d.Get("enabled_cluster_log_types").(*schema.Set)),
 			Name:    aws.String(d.Id()),
 		}
 
-		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+		output, err := client.UpdateClusterConfig(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating EKS Cluster (%s) logging: %s", d.Id(), err)
@@ -498,12 +616,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if d.HasChanges("vpc_config.0.endpoint_private_access", "vpc_config.0.endpoint_public_access", "vpc_config.0.public_access_cidrs") {
-		input := &eks.UpdateClusterConfigInput{
+		input := &eks_sdkv2.UpdateClusterConfigInput{
 			Name:               aws.String(d.Id()),
 			ResourcesVpcConfig: expandVPCConfigRequestForUpdate(d.Get("vpc_config").([]interface{})),
 		}
 
-		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+		output, err := client.UpdateClusterConfig(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating EKS Cluster (%s) VPC config: %s", d.Id(), err)
@@ -518,6 +636,48 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("upgrade_policy") {
+		input := &eks_sdkv2.UpdateClusterConfigInput{
+			Name:          aws.String(d.Id()),
+			UpgradePolicy: expandUpgradePolicyRequest(d.Get("upgrade_policy").([]interface{})),
+		}
+
+		output, err := client.UpdateClusterConfig(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) upgrade policy: %s", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) upgrade policy update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
+	if d.HasChange("zonal_shift_config") {
+		input := &eks_sdkv2.UpdateClusterConfigInput{
+			Name:             aws.String(d.Id()),
+			ZonalShiftConfig: expandZonalShiftConfigRequest(d.Get("zonal_shift_config").([]interface{})),
+		}
+
+		output, err := client.UpdateClusterConfig(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EKS Cluster (%s) zonal shift config: %s", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EKS Cluster (%s) zonal shift config update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -608,6 +768,31 @@ func FindClusterByName(ctx context.Context, conn *eks.EKS, name string) (*eks.Cl
 	return output.Cluster, nil
 }
 
+func findClusterByNameSDKv2(ctx context.Context, conn *eks_sdkv2.Client, name string) (*types.Cluster, error) {
+	input := &eks_sdkv2.DescribeClusterInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeCluster(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
 func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.EKS, name, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		Name:     aws.String(name),
@@ -721,12 +906,12 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id st
 	return nil, err
 }
 
-func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
+func expandEncryptionConfig(tfList []interface{}) []types.EncryptionConfig {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*eks.EncryptionConfig
+	var apiObjects []types.EncryptionConfig
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -735,12 +920,12 @@ func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 			continue
 		}
 
-		apiObject := &eks.EncryptionConfig{
+		apiObject := types.EncryptionConfig{
 			Provider: expandProvider(tfMap["provider"].([]interface{})),
 		}
 
 		if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
-			apiObject.Resources = flex.ExpandStringSet(v)
+			apiObject.Resources = flex.ExpandStringValueSet(v)
 		}
 
 		apiObjects = append(apiObjects, apiObject)
@@ -749,14 +934,14 @@ func expandEncryptionConfig(tfList []interface{}) []*eks.EncryptionConfig {
 	return apiObjects
 }
 
-func expandProvider(tfList []interface{}) *eks.Provider {
+func expandProvider(tfList []interface{}) *types.Provider {
 	tfMap, ok := tfList[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	apiObject := &eks.Provider{}
+	apiObject := &types.Provider{}
 
 	if v, ok := tfMap["key_arn"].(string); ok && v != "" {
 		apiObject.KeyArn = aws.String(v)
@@ -765,14 +950,14 @@ func expandProvider(tfList []interface{}) *eks.Provider {
 	return apiObject
 }
 
-func expandOutpostConfigRequest(l []interface{}) *eks.OutpostConfigRequest {
+func expandOutpostConfigRequest(l []interface{}) *types.OutpostConfigRequest {
 	tfMap, ok := l[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	outpostConfigRequest := &eks.OutpostConfigRequest{}
+	outpostConfigRequest := &types.OutpostConfigRequest{}
 
 	if v, ok := tfMap["control_plane_instance_type"].(string); ok && v != "" {
 		outpostConfigRequest.ControlPlaneInstanceType = aws.String(v)
@@ -783,13 +968,13 @@ func expandOutpostConfigRequest(l []interface{}) *eks.OutpostConfigRequest {
 	}
 
 	if v, ok := tfMap["outpost_arns"].(*schema.Set); ok && v.Len() > 0 {
-		outpostConfigRequest.OutpostArns = flex.ExpandStringSet(v)
+		outpostConfigRequest.OutpostArns = flex.ExpandStringValueSet(v)
 	}
 
 	return outpostConfigRequest
 }
 
-func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacementRequest {
+func expandControlPlanePlacement(tfList []interface{}) *types.ControlPlanePlacementRequest {
 	if len(tfList) == 0 {
 		return nil
 	}
@@ -800,7 +985,7 @@ func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacemen
 		return nil
 	}
 
-	apiObject := &eks.ControlPlanePlacementRequest{}
+	apiObject := &types.ControlPlanePlacementRequest{}
 
 	if v, ok := tfMap["group_name"].(string); ok && v != "" {
 		apiObject.GroupName = aws.String(v)
@@ -809,87 +994,147 @@ func expandControlPlanePlacement(tfList []interface{}) *eks.ControlPlanePlacemen
 	return apiObject
 }
 
-func expandVPCConfigRequestForCreate(l []interface{}) *eks.VpcConfigRequest {
+func expandVPCConfigRequestForCreate(l []interface{}) *types.VpcConfigRequest {
 	if len(l) == 0 {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	vpcConfigRequest := &eks.VpcConfigRequest{
+	vpcConfigRequest := &types.VpcConfigRequest{
 		EndpointPrivateAccess: aws.Bool(m["endpoint_private_access"].(bool)),
 		EndpointPublicAccess:  aws.Bool(m["endpoint_public_access"].(bool)),
-		SecurityGroupIds:      flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
-		SubnetIds:             flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+		SecurityGroupIds:      flex.ExpandStringValueSet(m["security_group_ids"].(*schema.Set)),
+		SubnetIds:             flex.ExpandStringValueSet(m["subnet_ids"].(*schema.Set)),
 	}
 
 	if v, ok := m["public_access_cidrs"].(*schema.Set); ok && v.Len() > 0 {
-		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringSet(v)
+		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringValueSet(v)
 	}
 
 	return vpcConfigRequest
 }
 
-func expandVPCConfigRequestForUpdate(l []interface{}) *eks.VpcConfigRequest {
+func expandVPCConfigRequestForUpdate(l []interface{}) *types.VpcConfigRequest {
 	if len(l) == 0 {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	vpcConfigRequest := &eks.VpcConfigRequest{
+	vpcConfigRequest := &types.VpcConfigRequest{
 		EndpointPrivateAccess: aws.Bool(m["endpoint_private_access"].(bool)),
 		EndpointPublicAccess:  aws.Bool(m["endpoint_public_access"].(bool)),
 	}
 
 	if v, ok := m["public_access_cidrs"].(*schema.Set); ok && v.Len() > 0 {
-		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringSet(v)
+		vpcConfigRequest.PublicAccessCidrs = flex.ExpandStringValueSet(v)
 	}
 
 	return vpcConfigRequest
 }
 
-func expandKubernetesNetworkConfigRequest(tfList []interface{}) *eks.KubernetesNetworkConfigRequest {
+func expandKubernetesNetworkConfigRequest(tfList []interface{}) *types.KubernetesNetworkConfigRequest {
 	tfMap, ok := tfList[0].(map[string]interface{})
 
 	if !ok {
 		return nil
 	}
 
-	apiObject := &eks.KubernetesNetworkConfigRequest{}
+	apiObject := &types.KubernetesNetworkConfigRequest{}
 
 	if v, ok := tfMap["service_ipv4_cidr"].(string); ok && v != "" {
 		apiObject.ServiceIpv4Cidr = aws.String(v)
 	}
 
 	if v, ok := tfMap["ip_family"].(string); ok && v != "" {
-		apiObject.IpFamily = aws.String(v)
+		apiObject.IpFamily = types.IpFamily(v)
 	}
 
 	return apiObject
 }
 
-func expandLogging(vEnabledLogTypes *schema.Set) *eks.Logging {
+func expandLogging(vEnabledLogTypes *schema.Set) *types.Logging {
 	vEksLogTypes := []interface{}{}
 	for _, eksLogType := range eks.LogType_Values() {
 		vEksLogTypes = append(vEksLogTypes, eksLogType)
 	}
 	vAllLogTypes := schema.NewSet(schema.HashString, vEksLogTypes)
 
-	return &eks.Logging{
-		ClusterLogging: []*eks.LogSetup{
+	return &types.Logging{
+		ClusterLogging: []types.LogSetup{
 			{
 				Enabled: aws.Bool(true),
-				Types:   flex.ExpandStringSet(vEnabledLogTypes),
+				Types:   expandLogTypes(vEnabledLogTypes),
 			},
 			{
 				Enabled: aws.Bool(false),
-				Types:   flex.ExpandStringSet(vAllLogTypes.Difference(vEnabledLogTypes)),
+				Types:   expandLogTypes(vAllLogTypes.Difference(vEnabledLogTypes)),
 			},
 		},
 	}
 }
 
+func expandLogTypes(tfSet *schema.Set) []types.LogType {
+	var apiObjects []types.LogType
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, types.LogType(v))
+	}
+
+	return apiObjects
+}
+
+func expandRemoteNetworkConfigRequest(tfList []interface{}) *types.RemoteNetworkConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.RemoteNetworkConfigRequest{}
+
+	if v, ok := tfMap["remote_node_networks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RemoteNodeNetworks = []types.RemoteNodeNetwork{{
+			Cidrs: flex.ExpandStringValueSet(v[0].(map[string]interface{})["cidrs"].(*schema.Set)),
+		}}
+	}
+
+	if v, ok := tfMap["remote_pod_networks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RemotePodNetworks = []types.RemotePodNetwork{{
+			Cidrs: flex.ExpandStringValueSet(v[0].(map[string]interface{})["cidrs"].(*schema.Set)),
+		}}
+	}
+
+	return apiObject
+}
+
+func expandUpgradePolicyRequest(tfList []interface{}) *types.UpgradePolicyRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.UpgradePolicyRequest{}
+
+	if v, ok := tfMap["support_type"].(string); ok && v != "" {
+		apiObject.SupportType = types.SupportType(v)
+	}
+
+	return apiObject
+}
+
+func expandZonalShiftConfigRequest(tfList []interface{}) *types.ZonalShiftConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.ZonalShiftConfigRequest{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+}
+
 func flattenCertificate(certificate *eks.Certificate) []map[string]interface{} {
 	if certificate == nil {
 		return []map[string]interface{}{}
@@ -1031,3 +1276,70 @@ func flattenControlPlanePlacementResponse(apiObject *eks.ControlPlanePlacementRe
 
 	return []interface{}{tfMap}
 }
+
+func flattenRemoteNetworkConfigResponse(apiObject *types.RemoteNetworkConfigResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if len(apiObject.RemoteNodeNetworks) > 0 {
+		tfMap["remote_node_networks"] = []interface{}{
+			map[string]interface{}{
+				"cidrs": apiObject.RemoteNodeNetworks[0].Cidrs,
+			},
+		}
+	}
+
+	if len(apiObject.RemotePodNetworks) > 0 {
+		tfMap["remote_pod_networks"] = []interface{}{
+			map[string]interface{}{
+				"cidrs": apiObject.RemotePodNetworks[0].Cidrs,
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUpgradePolicyResponse(apiObject *types.UpgradePolicyResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"support_type": apiObject.SupportType,
+		},
+	}
+}
+
+func flattenZonalShiftConfigResponse(apiObject *types.ZonalShiftConfigResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": aws.BoolValue(apiObject.Enabled),
+		},
+	}
+}
+
+// resourceClusterVersionCustomizeDiff rejects version changes that EKS does not support:
+// downgrades and upgrades of more than one minor version at a time.
+func resourceClusterVersionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+		return nil
+	}
+
+	o, n := diff.GetChange("version")
+	oldVersion, newVersion := o.(string), n.(string)
+
+	if oldVersion == "" || newVersion == "" {
+		return nil
+	}
+
+	return validClusterVersionUpgrade(oldVersion, newVersion)
+}
@@ -2,11 +2,16 @@ package eks
 
 import (
 	"context"
+	"strings"
 
+	eks_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
@@ -38,6 +43,75 @@ func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clus
 	return output.Addon, nil
 }
 
+// findAddonPodIdentityAssociations returns the Pod Identity associations managed by the add-on.
+// The add-on only reports association ARNs, so each association is described individually.
+func findAddonPodIdentityAssociations(ctx context.Context, conn *eks_sdkv2.Client, clusterName, addonName string) ([]*types.PodIdentityAssociation, error) {
+	input := &eks_sdkv2.DescribeAddonInput{
+		AddonName:   aws.String(addonName),
+		ClusterName: aws.String(clusterName),
+	}
+
+	output, err := conn.DescribeAddon(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Addon == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var associations []*types.PodIdentityAssociation
+
+	for _, associationARN := range output.Addon.PodIdentityAssociations {
+		// Association ARN format: arn:${Partition}:eks:${Region}:${Account}:podidentityassociation/${ClusterName}/${AssociationID}.
+		associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+
+		association, err := findPodIdentityAssociationByClusterNameAndID(ctx, conn, clusterName, associationID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		associations = append(associations, association)
+	}
+
+	return associations, nil
+}
+
+func findPodIdentityAssociationByClusterNameAndID(ctx context.Context, conn *eks_sdkv2.Client, clusterName, id string) (*types.PodIdentityAssociation, error) {
+	input := &eks_sdkv2.DescribePodIdentityAssociationInput{
+		AssociationId: aws.String(id),
+		ClusterName:   aws.String(clusterName),
+	}
+
+	output, err := conn.DescribePodIdentityAssociation(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Association == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Association, nil
+}
+
 func FindAddonUpdateByClusterNameAddonNameAndID(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
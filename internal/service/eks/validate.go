@@ -1,8 +1,13 @@
 package eks
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +27,75 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validAddonConfigurationValues validates add-on configuration values against the add-on version's JSON schema.
+// Configuration values that are not JSON (i.e. YAML) are left for the EKS API to validate.
+func validAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	if configurationSchema == "" || !json.Valid([]byte(configurationValues)) {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewStringLoader(configurationValues))
+
+	if err != nil {
+		return err
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var messages []string
+
+	for _, resultErr := range result.Errors() {
+		messages = append(messages, resultErr.String())
+	}
+
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+func validClusterVersionUpgrade(oldVersion, newVersion string) error {
+	oldMajor, oldMinor, err := parseClusterVersion(oldVersion)
+
+	if err != nil {
+		return err
+	}
+
+	newMajor, newMinor, err := parseClusterVersion(newVersion)
+
+	if err != nil {
+		return err
+	}
+
+	if newMajor < oldMajor || (newMajor == oldMajor && newMinor < oldMinor) {
+		return fmt.Errorf("EKS Cluster version cannot be changed from %s to %s: downgrades are not supported", oldVersion, newVersion)
+	}
+
+	if newMajor != oldMajor || newMinor > oldMinor+1 {
+		return fmt.Errorf("EKS Cluster version cannot be changed from %s to %s: upgrades must be done one minor version at a time", oldVersion, newVersion)
+	}
+
+	return nil
+}
+
+func parseClusterVersion(version string) (int, int, error) {
+	parts := strings.Split(version, ".")
+
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected format for EKS Cluster version (%s), expected MAJOR.MINOR", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing EKS Cluster version (%s): %w", version, err)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing EKS Cluster version (%s): %w", version, err)
+	}
+
+	return major, minor, nil
+}
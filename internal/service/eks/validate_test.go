@@ -57,3 +57,108 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAddonConfigurationValues(t *testing.T) {
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "replicaCount": {
+      "type": "integer",
+      "minimum": 1
+    }
+  },
+  "additionalProperties": false
+}`
+
+	cases := []struct {
+		Name                string
+		ConfigurationValues string
+		ExpectError         bool
+	}{
+		{
+			Name:                "valid",
+			ConfigurationValues: `{"replicaCount": 2}`,
+		},
+		{
+			Name:                "wrong type",
+			ConfigurationValues: `{"replicaCount": "two"}`,
+			ExpectError:         true,
+		},
+		{
+			Name:                "below minimum",
+			ConfigurationValues: `{"replicaCount": 0}`,
+			ExpectError:         true,
+		},
+		{
+			Name:                "unknown property",
+			ConfigurationValues: `{"replicas": 2}`,
+			ExpectError:         true,
+		},
+		{
+			Name:                "YAML",
+			ConfigurationValues: "replicaCount: 2",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validAddonConfigurationValues(configurationSchema, tc.ConfigurationValues)
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected error, got none", tc.Name)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.Name, err)
+		}
+	}
+}
+
+func TestValidClusterVersionUpgrade(t *testing.T) {
+	cases := []struct {
+		OldVersion  string
+		NewVersion  string
+		ExpectError bool
+	}{
+		{
+			OldVersion: "1.29",
+			NewVersion: "1.30",
+		},
+		{
+			OldVersion: "1.29",
+			NewVersion: "1.29",
+		},
+		{
+			OldVersion:  "1.29",
+			NewVersion:  "1.31",
+			ExpectError: true,
+		},
+		{
+			OldVersion:  "1.30",
+			NewVersion:  "1.29",
+			ExpectError: true,
+		},
+		{
+			OldVersion:  "1.30",
+			NewVersion:  "2.0",
+			ExpectError: true,
+		},
+		{
+			OldVersion:  "1.30",
+			NewVersion:  "latest",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validClusterVersionUpgrade(tc.OldVersion, tc.NewVersion)
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s -> %s: expected error, got none", tc.OldVersion, tc.NewVersion)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s -> %s: unexpected error: %s", tc.OldVersion, tc.NewVersion, err)
+		}
+	}
+}
@@ -168,7 +168,6 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -186,6 +185,7 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customizeDiffGlobalReplicationGroupEngineVersionErrorOnDowngrade,
 			customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrade,
+			customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID,
 			customdiff.ComputedIf("global_node_groups", diffHasChange("num_node_groups")),
 		),
 	}
@@ -266,6 +266,35 @@ func paramGroupNameRequiresMajorVersionUpgrade(diff changeDiffer) error {
 	return nil
 }
 
+// customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID forces replacement when
+// primary_replication_group_id is changed to a Replication Group that is not a secondary member,
+// otherwise the change is applied as a failover.
+func customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChange("primary_replication_group_id") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("primary_replication_group_id") {
+		return diff.ForceNew("primary_replication_group_id")
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	id := diff.Get("primary_replication_group_id").(string)
+
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(ctx, conn, diff.Id())
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache Global Replication Group (%s): %w", diff.Id(), err)
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupId) == id && aws.StringValue(member.Role) == GlobalReplicationGroupMemberRoleSecondary {
+			return nil
+		}
+	}
+
+	return diff.ForceNew("primary_replication_group_id")
+}
+
 func resourceGlobalReplicationGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
@@ -428,6 +457,13 @@ type globalReplicationGroupUpdater func(input *elasticache.ModifyGlobalReplicati
 func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
+	// Fail over before any other change so that it is applied from the new primary Region.
+	if d.HasChange("primary_replication_group_id") {
+		if err := failoverGlobalReplicationGroup(ctx, conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating ElastiCache Global Replication Group (%s) primary replication group: %s", d.Id(), err)
+		}
+	}
+
 	// Only one field can be changed per request
 	if d.HasChange("cache_node_type") {
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupNodeTypeUpdater(d.Get("cache_node_type").(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -549,6 +585,43 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	return nil
 }
 
+// failoverGlobalReplicationGroup promotes the secondary Replication Group member to primary.
+func failoverGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiCache, id, primaryReplicationGroupID string, timeout time.Duration) error {
+	member, err := FindGlobalReplicationGroupMemberByID(conn, id, primaryReplicationGroupID)
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+		return nil
+	}
+
+	// The Global Replication Group must be available before it can fail over.
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for availability: %w", err)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             member.ReplicationGroupRegion,
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	if _, err := conn.FailoverGlobalReplicationGroupWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitGlobalReplicationGroupMemberPrimary(ctx, conn, id, primaryReplicationGroupID, timeout); err != nil {
+		return fmt.Errorf("waiting for failover: %w", err)
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn
 
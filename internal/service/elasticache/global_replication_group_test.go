@@ -2,6 +2,7 @@ package elasticache_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
@@ -503,6 +504,43 @@ func TestAccElastiCacheGlobalReplicationGroup_ReplaceSecondary_differentRegion(t
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_failover(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalReplcationGroup1, globalReplcationGroup2 elasticache.GlobalReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(t, 2),
+		CheckDestroy:             testAccCheckGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, "aws_elasticache_replication_group.primary.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplcationGroup1),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_replication_group_id", "aws_elasticache_replication_group.primary", "id"),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, fmt.Sprintf("%q", rName+"-a")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplcationGroup2),
+					testAccCheckGlobalReplicationGroupNotRecreated(&globalReplcationGroup1, &globalReplcationGroup2),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-a"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheGlobalReplicationGroup_clusterMode_basic(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1427,6 +1465,16 @@ func testAccCheckGlobalReplicationGroupExists(resourceName string, v *elasticach
 	}
 }
 
+func testAccCheckGlobalReplicationGroupNotRecreated(i, j *elasticache.GlobalReplicationGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.ARN) != aws.StringValue(j.ARN) {
+			return errors.New("ElastiCache Global Replication Group was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckGlobalReplicationGroupDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
 	ctx := context.Background()
@@ -1757,6 +1805,52 @@ resource "aws_elasticache_replication_group" "third" {
 `, rName))
 }
 
+func testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		testAccVPCBaseWithProvider(rName, "primary", acctest.ProviderName, 1),
+		testAccVPCBaseWithProvider(rName, "alternate", acctest.ProviderNameAlternate, 1),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  provider = aws
+
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = %[2]s
+}
+
+resource "aws_elasticache_replication_group" "primary" {
+  provider = aws
+
+  replication_group_id          = "%[1]s-p"
+  replication_group_description = "primary"
+
+  subnet_group_name = aws_elasticache_subnet_group.primary.name
+
+  node_type = "cache.m5.large"
+
+  engine                = "redis"
+  engine_version        = "5.0.6"
+  number_cache_clusters = 1
+
+  lifecycle {
+    ignore_changes = [global_replication_group_id]
+  }
+}
+
+resource "aws_elasticache_replication_group" "alternate" {
+  provider = awsalternate
+
+  replication_group_id          = "%[1]s-a"
+  replication_group_description = "alternate"
+  global_replication_group_id   = aws_elasticache_global_replication_group.test.global_replication_group_id
+
+  subnet_group_name = aws_elasticache_subnet_group.alternate.name
+
+  number_cache_clusters = 1
+}
+`, rName, primaryReplicationGroupID))
+}
+
 func testAccGlobalReplicationGroupConfig_replaceSecondaryDifferentRegionSetup(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -131,6 +131,21 @@ func statusGlobalReplicationGroupMember(conn *elasticache.ElastiCache, globalRep
 	}
 }
 
+// statusGlobalReplicationGroupMemberRole fetches a Global Replication Group Member and its Role
+func statusGlobalReplicationGroupMemberRole(conn *elasticache.ElastiCache, globalReplicationGroupID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := FindGlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Role), nil
+	}
+}
+
 // StatusUser fetches the ElastiCache user and its Status
 func StatusUser(conn *elasticache.ElastiCache, userId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
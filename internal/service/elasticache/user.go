@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,6 +19,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	// userIAMAuthenticationMinimumEngineVersion is the minimum Redis engine version supporting IAM authentication.
+	userIAMAuthenticationMinimumEngineVersion = "7.0"
+)
+
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserCreate,
@@ -26,7 +33,10 @@ func ResourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffUserAuthenticationMode,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"access_string": {
@@ -38,6 +48,37 @@ func ResourceUser() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"authentication_mode": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"no_password_required", "passwords"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passwords": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+							Sensitive: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(elasticache.InputAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
 			"engine": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -48,14 +89,16 @@ func ResourceUser() *schema.Resource {
 				},
 			},
 			"no_password_required": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"authentication_mode"},
 			},
 			"passwords": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 2,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      2,
+				ConflictsWith: []string{"authentication_mode"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
@@ -83,15 +126,20 @@ func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &elasticache.CreateUserInput{
-		AccessString:       aws.String(d.Get("access_string").(string)),
-		Engine:             aws.String(d.Get("engine").(string)),
-		NoPasswordRequired: aws.Bool(d.Get("no_password_required").(bool)),
-		UserId:             aws.String(d.Get("user_id").(string)),
-		UserName:           aws.String(d.Get("user_name").(string)),
+		AccessString: aws.String(d.Get("access_string").(string)),
+		Engine:       aws.String(d.Get("engine").(string)),
+		UserId:       aws.String(d.Get("user_id").(string)),
+		UserName:     aws.String(d.Get("user_name").(string)),
 	}
 
-	if v, ok := d.GetOk("passwords"); ok {
-		input.Passwords = flex.ExpandStringSet(v.(*schema.Set))
+	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		input.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
+
+		if v, ok := d.GetOk("passwords"); ok {
+			input.Passwords = flex.ExpandStringSet(v.(*schema.Set))
+		}
 	}
 
 	if len(tags) > 0 {
@@ -152,6 +200,10 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("user_name", resp.UserName)
 	d.Set("arn", resp.ARN)
 
+	if err := d.Set("authentication_mode", flattenAuthenticationMode(resp.Authentication, d.Get("authentication_mode.0.passwords").(*schema.Set))); err != nil {
+		return fmt.Errorf("error setting authentication_mode: %w", err)
+	}
+
 	tags, err := ListTags(conn, aws.StringValue(resp.ARN))
 
 	if err != nil && !verify.ErrorISOUnsupported(conn.PartitionID, err) {
@@ -193,18 +245,33 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 			hasChange = true
 		}
 
-		if d.HasChange("no_password_required") {
-			req.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
-			hasChange = true
+		if d.HasChange("authentication_mode") {
+			if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				req.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
+				hasChange = true
+
+				if aws.StringValue(req.AuthenticationMode.Type) == elasticache.InputAuthenticationTypeIam {
+					if err := validateUserIAMAuthenticationEngineVersion(conn, d.Id()); err != nil {
+						return fmt.Errorf("error updating ElastiCache User (%s): %w", d.Id(), err)
+					}
+				}
+			}
 		}
 
-		if d.HasChange("passwords") {
-			req.Passwords = flex.ExpandStringSet(d.Get("passwords").(*schema.Set))
-			hasChange = true
+		if req.AuthenticationMode == nil {
+			if d.HasChange("no_password_required") {
+				req.NoPasswordRequired = aws.Bool(d.Get("no_password_required").(bool))
+				hasChange = true
+			}
+
+			if d.HasChange("passwords") {
+				req.Passwords = flex.ExpandStringSet(d.Get("passwords").(*schema.Set))
+				hasChange = true
+			}
 		}
 
 		if hasChange {
-			_, err := conn.ModifyUser(req)
+			resp, err := conn.ModifyUser(req)
 			if err != nil {
 				return fmt.Errorf("error updating ElastiCache User (%s): %w", d.Id(), err)
 			}
@@ -212,6 +279,13 @@ func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
 			if err := WaitUserActive(conn, d.Id()); err != nil {
 				return fmt.Errorf("error waiting for ElastiCache User (%s) to be modified: %w", d.Id(), err)
 			}
+
+			// Changes to a user are propagated to every user group it belongs to.
+			for _, groupID := range aws.StringValueSlice(resp.UserGroupIds) {
+				if err := waitUserGroupActive(conn, groupID, UserGroupActiveTimeout); err != nil {
+					return fmt.Errorf("error waiting for ElastiCache User Group (%s) to be available: %w", groupID, err)
+				}
+			}
 		}
 	}
 
@@ -259,3 +333,121 @@ func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func customizeDiffUserAuthenticationMode(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	v, ok := diff.GetOk("authentication_mode")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	authenticationType := tfMap["type"].(string)
+	passwords := tfMap["passwords"].(*schema.Set)
+
+	switch authenticationType {
+	case elasticache.InputAuthenticationTypePassword:
+		if passwords.Len() == 0 && diff.NewValueKnown("authentication_mode.0.passwords") {
+			return fmt.Errorf(`authentication_mode.0.passwords must be specified when authentication_mode.0.type is %q`, authenticationType)
+		}
+	case elasticache.InputAuthenticationTypeIam, elasticache.InputAuthenticationTypeNoPasswordRequired:
+		if passwords.Len() > 0 {
+			return fmt.Errorf(`authentication_mode.0.passwords cannot be specified when authentication_mode.0.type is %q`, authenticationType)
+		}
+	}
+
+	// IAM authentication requires the user ID and user name to be identical.
+	if authenticationType == elasticache.InputAuthenticationTypeIam && diff.NewValueKnown("user_id") && diff.NewValueKnown("user_name") {
+		if userID, userName := diff.Get("user_id").(string), diff.Get("user_name").(string); userID != userName {
+			return fmt.Errorf(`user_id (%s) and user_name (%s) must be identical when authentication_mode.0.type is %q`, userID, userName, authenticationType)
+		}
+	}
+
+	return nil
+}
+
+// validateUserIAMAuthenticationEngineVersion checks that every replication group the user
+// can access through its user groups runs an engine version that supports IAM authentication.
+func validateUserIAMAuthenticationEngineVersion(conn *elasticache.ElastiCache, userID string) error {
+	user, err := FindUserByID(conn, userID)
+
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache User: %w", err)
+	}
+
+	minVersion, err := normalizeEngineVersion(userIAMAuthenticationMinimumEngineVersion)
+
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range aws.StringValueSlice(user.UserGroupIds) {
+		group, err := FindUserGroupByID(conn, groupID)
+
+		if err != nil {
+			return fmt.Errorf("reading ElastiCache User Group (%s): %w", groupID, err)
+		}
+
+		for _, replicationGroupID := range aws.StringValueSlice(group.ReplicationGroups) {
+			clusters, err := FindReplicationGroupMemberClustersByID(conn, replicationGroupID)
+
+			if err != nil {
+				return fmt.Errorf("reading ElastiCache Replication Group (%s) member clusters: %w", replicationGroupID, err)
+			}
+
+			for _, cluster := range clusters {
+				version, err := normalizeEngineVersion(aws.StringValue(cluster.EngineVersion))
+
+				if err != nil {
+					return fmt.Errorf("parsing ElastiCache Cluster (%s) engine version: %w", aws.StringValue(cluster.CacheClusterId), err)
+				}
+
+				if version.LessThan(minVersion) {
+					return fmt.Errorf("IAM authentication requires engine version %s or higher, ElastiCache Replication Group (%s) in User Group (%s) uses %s", userIAMAuthenticationMinimumEngineVersion, replicationGroupID, groupID, aws.StringValue(cluster.EngineVersion))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandAuthenticationMode(tfMap map[string]interface{}) *elasticache.AuthenticationMode {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &elasticache.AuthenticationMode{}
+
+	if v, ok := tfMap["passwords"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Passwords = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// flattenAuthenticationMode maps the user's authentication to the authentication_mode block.
+// Passwords are never returned by the API so the configured values are retained.
+func flattenAuthenticationMode(apiObject *elasticache.Authentication, passwords *schema.Set) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"password_count": aws.Int64Value(apiObject.PasswordCount),
+		"passwords":      passwords,
+	}
+
+	switch v := aws.StringValue(apiObject.Type); v {
+	case elasticache.AuthenticationTypeNoPassword:
+		tfMap["type"] = elasticache.InputAuthenticationTypeNoPasswordRequired
+	default:
+		tfMap["type"] = v
+	}
+
+	return []interface{}{tfMap}
+}
@@ -203,7 +203,11 @@ func resourceUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if hasChange {
-			_, err := conn.ModifyUserGroup(req)
+			// Users being added and removed are reconciled in a single request.
+			// Retry while member users are still applying authentication changes.
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+				return conn.ModifyUserGroup(req)
+			}, elasticache.ErrCodeInvalidUserStateFault, elasticache.ErrCodeInvalidUserGroupStateFault)
 			if err != nil {
 				return fmt.Errorf("error updating ElastiCache User Group (%q): %w", d.Id(), err)
 			}
@@ -2,6 +2,7 @@ package elasticache_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
@@ -87,6 +88,79 @@ func TestAccElastiCacheUser_update(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheUser_authenticationMode(t *testing.T) {
+	var user elasticache.User
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_elasticache_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_authenticationModePassword(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.type", "password"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.passwords.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"authentication_mode.0.passwords",
+					"no_password_required",
+				},
+			},
+			{
+				Config: testAccUserConfig_authenticationModeType(rName, "iam"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.type", "iam"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.passwords.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.password_count", "0"),
+				),
+			},
+			{
+				Config: testAccUserConfig_authenticationModeType(rName, "no-password-required"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_mode.0.type", "no-password-required"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheUser_authenticationModeValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfig_authenticationModeType(rName, "password"),
+				ExpectError: regexp.MustCompile(`authentication_mode.0.passwords must be specified`),
+			},
+			{
+				Config:      testAccUserConfig_authenticationModeIAMUserName(rName),
+				ExpectError: regexp.MustCompile(`must be identical`),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheUser_tags(t *testing.T) {
 	var user elasticache.User
 	rName := sdkacctest.RandomWithPrefix("tf-acc")
@@ -257,3 +331,49 @@ resource "aws_elasticache_user" "test" {
 }
 `, rName, tagKey, tagValue))
 }
+
+func testAccUserConfig_authenticationModePassword(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = %[1]q
+  access_string = "on ~* +@all"
+  engine        = "REDIS"
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["password123456789", "password987654321"]
+  }
+}
+`, rName)
+}
+
+func testAccUserConfig_authenticationModeType(rName, authenticationType string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = %[1]q
+  access_string = "on ~* +@all"
+  engine        = "REDIS"
+
+  authentication_mode {
+    type = %[2]q
+  }
+}
+`, rName, authenticationType)
+}
+
+func testAccUserConfig_authenticationModeIAMUserName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_user" "test" {
+  user_id       = %[1]q
+  user_name     = "username1"
+  access_string = "on ~* +@all"
+  engine        = "REDIS"
+
+  authentication_mode {
+    type = "iam"
+  }
+}
+`, rName)
+}
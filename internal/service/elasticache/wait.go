@@ -21,6 +21,8 @@ const (
 
 	UserActiveTimeout  = 5 * time.Minute
 	UserDeletedTimeout = 5 * time.Minute
+
+	UserGroupActiveTimeout = 10 * time.Minute
 )
 
 // WaitReplicationGroupAvailable waits for a ReplicationGroup to return Available
@@ -204,6 +206,24 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.El
 	return nil, err
 }
 
+// waitGlobalReplicationGroupMemberPrimary waits for a Global Replication Group Member to become the primary after a failover
+func waitGlobalReplicationGroupMemberPrimary(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, id string, timeout time.Duration) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupMemberRoleSecondary},
+		Target:     []string{GlobalReplicationGroupMemberRolePrimary},
+		Refresh:    statusGlobalReplicationGroupMemberRole(conn, globalReplicationGroupID, id),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+	return nil, err
+}
+
 const (
 	// GlobalReplicationGroupDisassociationReadyTimeout specifies how long to wait for a global replication group
 	// to be in a valid state before disassociating
@@ -262,3 +282,18 @@ func WaitUserDeleted(conn *elasticache.ElastiCache, userId string) error {
 
 	return err
 }
+
+// waitUserGroupActive waits for an ElastiCache user group to reach an active state after modifications
+func waitUserGroupActive(conn *elasticache.ElastiCache, groupID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    resourceUserGroupPendingStates,
+		Target:     []string{UserStatusActive},
+		Refresh:    resourceUserGroupStateRefreshFunc(groupID, conn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
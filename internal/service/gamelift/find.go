@@ -68,6 +68,56 @@ func FindFleetByID(conn *gamelift.GameLift, id string) (*gamelift.FleetAttribute
 	return fleet, nil
 }
 
+func FindFleetLocationAttributes(conn *gamelift.GameLift, fleetID string) ([]*gamelift.LocationAttributes, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(fleetID),
+	}
+	var output []*gamelift.LocationAttributes
+
+	err := conn.DescribeFleetLocationAttributesPages(input, func(page *gamelift.DescribeFleetLocationAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v != nil && v.LocationState != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFleetLocationByTwoPartKey(conn *gamelift.GameLift, fleetID, location string) (*gamelift.LocationState, error) {
+	output, err := FindFleetLocationAttributes(conn, fleetID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.LocationState.Location) == location {
+			return v.LocationState, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(location)
+}
+
 func FindGameServerGroupByName(conn *gamelift.GameLift, name string) (*gamelift.GameServerGroup, error) {
 	input := &gamelift.DescribeGameServerGroupInput{
 		GameServerGroupName: aws.String(name),
@@ -117,3 +167,50 @@ func FindScriptByID(conn *gamelift.GameLift, id string) (*gamelift.Script, error
 
 	return output.Script, nil
 }
+
+func FindScalingPolicyByTwoPartKey(conn *gamelift.GameLift, fleetID, name string) (*gamelift.ScalingPolicy, error) {
+	input := &gamelift.DescribeScalingPoliciesInput{
+		FleetId: aws.String(fleetID),
+	}
+	var output *gamelift.ScalingPolicy
+
+	err := conn.DescribeScalingPoliciesPages(input, func(page *gamelift.DescribeScalingPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScalingPolicies {
+			if v != nil && aws.StringValue(v.Name) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == gamelift.ScalingStatusTypeDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -21,6 +21,7 @@ import (
 
 const (
 	fleetCreatedDefaultTimeout = 70 * time.Minute
+	fleetUpdatedDefaultTimeout = 70 * time.Minute
 	FleetDeletedDefaultTimeout = 20 * time.Minute
 )
 
@@ -36,6 +37,7 @@ func ResourceFleet() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(fleetCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(fleetUpdatedDefaultTimeout),
 			Delete: schema.DefaultTimeout(FleetDeletedDefaultTimeout),
 		},
 
@@ -125,6 +127,19 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -267,6 +282,10 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 		input.InstanceRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("location"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("metric_groups"); ok {
 		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
 	}
@@ -315,6 +334,14 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for GameLift Fleet (%s) to active: %w", d.Id(), err)
 	}
 
+	for _, apiObject := range input.Locations {
+		location := aws.StringValue(apiObject.Location)
+
+		if _, err := waitFleetLocationActive(conn, d.Id(), location, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for GameLift Fleet (%s) location (%s) to active: %w", d.Id(), location, err)
+		}
+	}
+
 	return resourceFleetRead(d, meta)
 }
 
@@ -359,6 +386,16 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting resource_creation_limit_policy: %w", err)
 	}
 
+	locations, err := FindFleetLocationAttributes(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Fleet (%s) locations: %w", d.Id(), err)
+	}
+
+	if err := d.Set("location", flattenLocationAttributes(locations, meta.(*conns.AWSClient).Region)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -429,6 +466,55 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("location") {
+		o, n := d.GetChange("location")
+		os, ns := locationNames(o.(*schema.Set).List()), locationNames(n.(*schema.Set).List())
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			locations := flex.ExpandStringSet(del)
+
+			_, err := conn.DeleteFleetLocations(&gamelift.DeleteFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: locations,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error deleting GameLift Fleet (%s) locations: %w", d.Id(), err)
+			}
+
+			for _, location := range aws.StringValueSlice(locations) {
+				if _, err := waitFleetLocationDeleted(conn, d.Id(), location, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for GameLift Fleet (%s) location (%s) delete: %w", d.Id(), location, err)
+				}
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			var locations []*gamelift.LocationConfiguration
+
+			for _, location := range add.List() {
+				locations = append(locations, &gamelift.LocationConfiguration{
+					Location: aws.String(location.(string)),
+				})
+			}
+
+			_, err := conn.CreateFleetLocations(&gamelift.CreateFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: locations,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error creating GameLift Fleet (%s) locations: %w", d.Id(), err)
+			}
+
+			for _, location := range add.List() {
+				if _, err := waitFleetLocationActive(conn, d.Id(), location.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for GameLift Fleet (%s) location (%s) to active: %w", d.Id(), location, err)
+				}
+			}
+		}
+	}
+
 	if d.HasChange("runtime_configuration") {
 		_, err := conn.UpdateRuntimeConfiguration(&gamelift.UpdateRuntimeConfigurationInput{
 			FleetId:              aws.String(d.Id()),
@@ -544,6 +630,61 @@ func flattenIPPermission(apiObject *gamelift.IpPermission) map[string]interface{
 	return tfMap
 }
 
+func expandLocationConfigurations(tfList []interface{}) []*gamelift.LocationConfiguration {
+	var apiObjects []*gamelift.LocationConfiguration
+
+	for _, location := range locationNames(tfList).List() {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(location.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// locationNames returns the names of the configured fleet locations.
+func locationNames(tfList []interface{}) *schema.Set {
+	names := schema.NewSet(schema.HashString, nil)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["location"].(string); ok && v != "" {
+			names.Add(v)
+		}
+	}
+
+	return names
+}
+
+// flattenLocationAttributes flattens a fleet's remote locations.
+// The fleet's home Region and terminated locations are not included.
+func flattenLocationAttributes(apiObjects []*gamelift.LocationAttributes, homeRegion string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.LocationState == nil {
+			continue
+		}
+
+		location := aws.StringValue(apiObject.LocationState.Location)
+
+		if location == homeRegion || aws.StringValue(apiObject.LocationState.Status) == gamelift.FleetStatusTerminated {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"location": location,
+		})
+	}
+
+	return tfList
+}
+
 func expandResourceCreationLimitPolicy(cfg []interface{}) *gamelift.ResourceCreationLimitPolicy {
 	if len(cfg) < 1 {
 		return nil
@@ -573,6 +573,62 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftFleet_locations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.FleetAttributes
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_locations(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						"location": acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"runtime_configuration"},
+			},
+			{
+				Config: testAccFleetConfig_script(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_locations(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -987,3 +1043,29 @@ resource "aws_gamelift_fleet" "test" {
 }
 `, rName)
 }
+
+func testAccFleetConfig_locations(rName, location string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_script" "test" {
+  name     = %[1]q
+  zip_file = "test-fixtures/script.zip"
+}
+
+resource "aws_gamelift_fleet" "test" {
+  script_id         = aws_gamelift_script.test.id
+  ec2_instance_type = "t2.micro"
+  name              = %[1]q
+
+  location {
+    location = %[2]q
+  }
+
+  runtime_configuration {
+    server_process {
+      concurrent_executions = 1
+      launch_path           = "/local/game/lol"
+    }
+  }
+}
+`, rName, location)
+}
@@ -0,0 +1,249 @@
+package gamelift
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	scalingPolicyActiveTimeout  = 10 * time.Minute
+	scalingPolicyDeletedTimeout = 10 * time.Minute
+)
+
+func ResourceScalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScalingPolicyPut,
+		Read:   resourceScalingPolicyRead,
+		Update: resourceScalingPolicyPut,
+		Delete: resourceScalingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comparison_operator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ComparisonOperatorType_Values(), false),
+			},
+			"evaluation_periods": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.MetricName_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      gamelift.PolicyTypeRuleBased,
+				ValidateFunc: validation.StringInSlice(gamelift.PolicyType_Values(), false),
+			},
+			"scaling_adjustment": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"scaling_adjustment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ScalingAdjustmentType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceScalingPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID := d.Get("fleet_id").(string)
+	name := d.Get("name").(string)
+	id := ScalingPolicyCreateResourceID(fleetID, name)
+	input := &gamelift.PutScalingPolicyInput{
+		FleetId:    aws.String(fleetID),
+		MetricName: aws.String(d.Get("metric_name").(string)),
+		Name:       aws.String(name),
+		PolicyType: aws.String(d.Get("policy_type").(string)),
+	}
+
+	if v, ok := d.GetOk("comparison_operator"); ok {
+		input.ComparisonOperator = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_periods"); ok {
+		input.EvaluationPeriods = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("scaling_adjustment"); ok {
+		input.ScalingAdjustment = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("scaling_adjustment_type"); ok {
+		input.ScalingAdjustmentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetConfiguration = &gamelift.TargetConfiguration{
+			TargetValue: aws.Float64(v.([]interface{})[0].(map[string]interface{})["target_value"].(float64)),
+		}
+	}
+
+	if v, ok := d.GetOk("threshold"); ok {
+		input.Threshold = aws.Float64(v.(float64))
+	}
+
+	log.Printf("[INFO] Putting GameLift Scaling Policy: %s", input)
+	_, err := conn.PutScalingPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting GameLift Scaling Policy (%s): %w", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	if _, err := waitScalingPolicyActive(conn, fleetID, name, scalingPolicyActiveTimeout); err != nil {
+		return fmt.Errorf("error waiting for GameLift Scaling Policy (%s) to active: %w", d.Id(), err)
+	}
+
+	return resourceScalingPolicyRead(d, meta)
+}
+
+func resourceScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID, name, err := ScalingPolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := FindScalingPolicyByTwoPartKey(conn, fleetID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Scaling Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Scaling Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("comparison_operator", policy.ComparisonOperator)
+	d.Set("evaluation_periods", policy.EvaluationPeriods)
+	d.Set("fleet_id", policy.FleetId)
+	d.Set("metric_name", policy.MetricName)
+	d.Set("name", policy.Name)
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("scaling_adjustment", policy.ScalingAdjustment)
+	d.Set("scaling_adjustment_type", policy.ScalingAdjustmentType)
+	d.Set("status", policy.Status)
+	if v := policy.TargetConfiguration; v != nil {
+		if err := d.Set("target_configuration", []interface{}{map[string]interface{}{
+			"target_value": aws.Float64Value(v.TargetValue),
+		}}); err != nil {
+			return fmt.Errorf("error setting target_configuration: %w", err)
+		}
+	} else {
+		d.Set("target_configuration", nil)
+	}
+	d.Set("threshold", policy.Threshold)
+
+	return nil
+}
+
+func resourceScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID, name, err := ScalingPolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting GameLift Scaling Policy: %s", d.Id())
+	_, err = conn.DeleteScalingPolicy(&gamelift.DeleteScalingPolicyInput{
+		FleetId: aws.String(fleetID),
+		Name:    aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GameLift Scaling Policy (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitScalingPolicyDeleted(conn, fleetID, name, scalingPolicyDeletedTimeout); err != nil {
+		return fmt.Errorf("error waiting for GameLift Scaling Policy (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const scalingPolicyResourceIDSeparator = ","
+
+func ScalingPolicyCreateResourceID(fleetID, name string) string {
+	parts := []string{fleetID, name}
+	id := strings.Join(parts, scalingPolicyResourceIDSeparator)
+
+	return id
+}
+
+func ScalingPolicyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, scalingPolicyResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected fleet-id%[2]sname", id, scalingPolicyResourceIDSeparator)
+}
@@ -0,0 +1,235 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftScalingPolicy_ruleBased(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.ScalingPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_scaling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_ruleBased(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", "LessThanThreshold"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_periods", "5"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_gamelift_fleet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "AvailableGameSessions"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "RuleBased"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_adjustment", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_adjustment_type", "ChangeInCapacity"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScalingPolicyConfig_ruleBased(rName, 2, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "scaling_adjustment", "2"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftScalingPolicy_targetBased(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.ScalingPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_scaling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_targetBased(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "PercentAvailableGameSessions"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "TargetBased"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.target_value", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScalingPolicyConfig_targetBased(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.target_value", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftScalingPolicy_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.ScalingPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_scaling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalingPolicyConfig_ruleBased(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgamelift.ResourceScalingPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalingPolicyExists(n string, v *gamelift.ScalingPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GameLift Scaling Policy ID is set")
+		}
+
+		fleetID, name, err := tfgamelift.ScalingPolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+		output, err := tfgamelift.FindScalingPolicyByTwoPartKey(conn, fleetID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScalingPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_scaling_policy" {
+			continue
+		}
+
+		fleetID, name, err := tfgamelift.ScalingPolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgamelift.FindScalingPolicyByTwoPartKey(conn, fleetID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GameLift Scaling Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccScalingPolicyConfig_ruleBased(rName string, adjustment, threshold int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_script(rName), fmt.Sprintf(`
+resource "aws_gamelift_scaling_policy" "test" {
+  fleet_id                = aws_gamelift_fleet.test.id
+  name                    = %[1]q
+  metric_name             = "AvailableGameSessions"
+  comparison_operator     = "LessThanThreshold"
+  evaluation_periods      = 5
+  scaling_adjustment      = %[2]d
+  scaling_adjustment_type = "ChangeInCapacity"
+  threshold               = %[3]d
+}
+`, rName, adjustment, threshold))
+}
+
+func testAccScalingPolicyConfig_targetBased(rName string, targetValue int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_script(rName), fmt.Sprintf(`
+resource "aws_gamelift_scaling_policy" "test" {
+  fleet_id    = aws_gamelift_fleet.test.id
+  name        = %[1]q
+  metric_name = "PercentAvailableGameSessions"
+  policy_type = "TargetBased"
+
+  target_configuration {
+    target_value = %[2]d
+  }
+}
+`, rName, targetValue))
+}
@@ -39,6 +39,22 @@ func statusFleet(conn *gamelift.GameLift, id string) resource.StateRefreshFunc {
 	}
 }
 
+func statusFleetLocation(conn *gamelift.GameLift, fleetID, location string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFleetLocationByTwoPartKey(conn, fleetID, location)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusGameServerGroup(conn *gamelift.GameLift, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindGameServerGroupByName(conn, name)
@@ -54,3 +70,19 @@ func statusGameServerGroup(conn *gamelift.GameLift, name string) resource.StateR
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusScalingPolicy(conn *gamelift.GameLift, fleetID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindScalingPolicyByTwoPartKey(conn, fleetID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
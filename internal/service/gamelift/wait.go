@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -87,6 +88,55 @@ func waitFleetTerminated(conn *gamelift.GameLift, id string, timeout time.Durati
 	return nil, err
 }
 
+func waitFleetLocationActive(conn *gamelift.GameLift, fleetID, location string, timeout time.Duration) (*gamelift.LocationState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActivating,
+			gamelift.FleetStatusBuilding,
+			gamelift.FleetStatusDownloading,
+			gamelift.FleetStatusNew,
+			gamelift.FleetStatusValidating,
+		},
+		Target:  []string{gamelift.FleetStatusActive},
+		Refresh: statusFleetLocation(conn, fleetID, location),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*gamelift.LocationState); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetLocationDeleted(conn *gamelift.GameLift, fleetID, location string, timeout time.Duration) (*gamelift.LocationState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.FleetStatusActive,
+			gamelift.FleetStatusDeleting,
+			gamelift.FleetStatusError,
+		},
+		Target:  []string{gamelift.FleetStatusTerminated},
+		Refresh: statusFleetLocation(conn, fleetID, location),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	// The location may be removed from the fleet once terminated.
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*gamelift.LocationState); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func getFleetFailures(conn *gamelift.GameLift, id string) ([]*gamelift.Event, error) {
 	var events []*gamelift.Event
 	err := _getFleetFailures(conn, id, nil, &events)
@@ -189,3 +239,44 @@ func waitGameServerGroupTerminated(conn *gamelift.GameLift, name string, timeout
 
 	return nil
 }
+
+func waitScalingPolicyActive(conn *gamelift.GameLift, fleetID, name string, timeout time.Duration) (*gamelift.ScalingPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.ScalingStatusTypeUpdateRequested,
+			gamelift.ScalingStatusTypeUpdating,
+		},
+		Target:  []string{gamelift.ScalingStatusTypeActive},
+		Refresh: statusScalingPolicy(conn, fleetID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*gamelift.ScalingPolicy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitScalingPolicyDeleted(conn *gamelift.GameLift, fleetID, name string, timeout time.Duration) (*gamelift.ScalingPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			gamelift.ScalingStatusTypeActive,
+			gamelift.ScalingStatusTypeDeleteRequested,
+			gamelift.ScalingStatusTypeDeleting,
+		},
+		Target:  []string{},
+		Refresh: statusScalingPolicy(conn, fleetID, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*gamelift.ScalingPolicy); ok {
+		return output, err
+	}
+
+	return nil, err
+}
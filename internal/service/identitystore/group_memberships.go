@@ -0,0 +1,261 @@
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+)
+
+// ResourceGroupMemberships manages the complete member set of a group.
+// Memberships that are not declared in configuration are removed.
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupMembershipsCreate,
+		ReadContext:   resourceGroupMembershipsRead,
+		UpdateContext: resourceGroupMembershipsUpdate,
+		DeleteContext: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	identityStoreId := d.Get("identity_store_id").(string)
+	groupId := d.Get("group_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", identityStoreId, groupId))
+
+	if err := syncGroupMemberships(ctx, meta.(*conns.AWSClient).IdentityStoreClient, identityStoreId, groupId, d.Get("member_ids").(*schema.Set)); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId, groupId, err := resourceGroupMembershipsParseID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	if _, err := findGroupByID(ctx, conn, identityStoreId, groupId); err != nil {
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberIds := make([]string, 0, len(memberships))
+
+	for _, membership := range memberships {
+		memberId, err := getMemberIdMemberUserId(membership.MemberId)
+
+		if err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+		}
+
+		memberIds = append(memberIds, aws.ToString(memberId))
+	}
+
+	d.Set("group_id", groupId)
+	d.Set("identity_store_id", identityStoreId)
+	d.Set("member_ids", memberIds)
+
+	return nil
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("member_ids") {
+		identityStoreId, groupId, err := resourceGroupMembershipsParseID(d.Id())
+
+		if err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+		}
+
+		if err := syncGroupMemberships(ctx, meta.(*conns.AWSClient).IdentityStoreClient, identityStoreId, groupId, d.Get("member_ids").(*schema.Set)); err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+		}
+	}
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	identityStoreId, groupId, err := resourceGroupMembershipsParseID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+
+	err = syncGroupMemberships(ctx, meta.(*conns.AWSClient).IdentityStoreClient, identityStoreId, groupId, schema.NewSet(schema.HashString, nil))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncGroupMemberships reconciles the group's memberships with the desired
+// set of member (user) IDs, creating missing and deleting unlisted memberships.
+func syncGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string, want *schema.Set) error {
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreId, groupId)
+
+	if err != nil {
+		return err
+	}
+
+	have := make(map[string]string, len(memberships))
+
+	for _, membership := range memberships {
+		memberId, err := getMemberIdMemberUserId(membership.MemberId)
+
+		if err != nil {
+			return err
+		}
+
+		have[aws.ToString(memberId)] = aws.ToString(membership.MembershipId)
+	}
+
+	for memberId, membershipId := range have {
+		if want.Contains(memberId) {
+			continue
+		}
+
+		_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreId),
+			MembershipId:    aws.String(membershipId),
+		})
+
+		if err != nil {
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				continue
+			}
+
+			return fmt.Errorf("removing member (%s): %w", memberId, err)
+		}
+	}
+
+	for _, v := range want.List() {
+		memberId := v.(string)
+
+		if _, ok := have[memberId]; ok {
+			continue
+		}
+
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupId),
+			IdentityStoreId: aws.String(identityStoreId),
+			MemberId:        &types.MemberIdMemberUserId{Value: memberId},
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding member (%s): %w", memberId, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGroupMembershipsParseID(id string) (identityStoreId, groupId string, err error) {
+	identityStoreId, groupId, err = resourceGroupParseID(id)
+
+	if err != nil {
+		err = errors.New("expected a resource id in the form: identity-store-id/group-id")
+	}
+
+	return
+}
+
+func findGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreId, groupId string) ([]types.GroupMembership, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupId),
+		IdentityStoreId: aws.String(identityStoreId),
+	}
+	var out []types.GroupMembership
+
+	paginator := identitystore.NewListGroupMembershipsPaginator(conn, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			var e *types.ResourceNotFoundException
+			if errors.As(err, &e) {
+				return nil, &resource.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		out = append(out, page.GroupMemberships...)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,217 @@
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMemberships_basic(t *testing.T) {
+	groupResourceName := "aws_identitystore_group.test"
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IdentityStoreEndpointID, t)
+			testAccPreCheckSSOAdminInstances(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_exclusive(t *testing.T) {
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IdentityStoreEndpointID, t)
+			testAccPreCheckSSOAdminInstances(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(resourceName, 2),
+				),
+			},
+			{
+				// A membership added outside of Terraform is removed on the next apply.
+				Config: testAccGroupMembershipsConfig_outOfBand(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(resourceName, 3),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_identitystore_group_memberships" {
+			continue
+		}
+
+		out, err := conn.ListGroupMemberships(ctx, &identitystore.ListGroupMembershipsInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+		})
+
+		if err != nil {
+			// The group is normally destroyed alongside its memberships.
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				continue
+			}
+			return err
+		}
+
+		if len(out.GroupMemberships) == 0 {
+			continue
+		}
+
+		return create.Error(names.IdentityStore, create.ErrActionCheckingDestroyed, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckGroupMembershipsCount(name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient
+		ctx := context.Background()
+		out, err := conn.ListGroupMemberships(ctx, &identitystore.ListGroupMembershipsInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+		})
+
+		if err != nil {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, err)
+		}
+
+		if got := len(out.GroupMemberships); got != want {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, fmt.Errorf("expected %d memberships, got %d", want, got))
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupMembershipsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  count = 3
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+`, rName)
+}
+
+func testAccGroupMembershipsConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccGroupMembershipsConfig_base(rName), fmt.Sprintf(`
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id   = aws_identitystore_group.test.group_id
+  member_ids = slice(aws_identitystore_user.test[*].user_id, 0, %[1]d)
+}
+`, count))
+}
+
+func testAccGroupMembershipsConfig_outOfBand(rName string, count int) string {
+	return acctest.ConfigCompose(testAccGroupMembershipsConfig_basic(rName, count), `
+resource "aws_identitystore_group_membership" "out_of_band" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id  = aws_identitystore_group.test.group_id
+  member_id = aws_identitystore_user.test[2].user_id
+}
+`)
+}
@@ -0,0 +1,130 @@
+package identitystore
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"external_id_issuer": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"issuer": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+		},
+	}
+}
+
+const (
+	DSNameGroups = "Groups Data Source"
+)
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient
+
+	identityStoreId := d.Get("identity_store_id").(string)
+	issuer := d.Get("external_id_issuer").(string)
+
+	input := &identitystore.ListGroupsInput{
+		IdentityStoreId: aws.String(identityStoreId),
+	}
+	var groups []interface{}
+
+	paginator := identitystore.NewListGroupsPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionReading, DSNameGroups, identityStoreId, err)
+		}
+
+		for _, group := range page.Groups {
+			if issuer != "" && !hasExternalIdIssuer(group.ExternalIds, issuer) {
+				continue
+			}
+
+			groups = append(groups, map[string]interface{}{
+				"description":  aws.ToString(group.Description),
+				"display_name": aws.ToString(group.DisplayName),
+				"external_ids": flattenExternalIds(group.ExternalIds),
+				"group_id":     aws.ToString(group.GroupId),
+			})
+		}
+	}
+
+	d.SetId(identityStoreId)
+
+	if err := d.Set("groups", groups); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionSetting, DSNameGroups, d.Id(), err)
+	}
+
+	return nil
+}
+
+func hasExternalIdIssuer(apiObjects []types.ExternalId, issuer string) bool {
+	for _, apiObject := range apiObjects {
+		if aws.ToString(apiObject.Issuer) == issuer {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package identitystore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIdentityStoreGroupsDataSource_basic(t *testing.T) {
+	resourceName := "aws_identitystore_group.test"
+	dataSourceName := "data.aws_identitystore_groups.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSSOAdminInstances(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "groups.*.group_id", resourceName, "group_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "groups.*.display_name", resourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupsDataSource_externalIdIssuer(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_groups.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSSOAdminInstances(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Groups created through the API have no external IDs.
+				Config: testAccGroupsDataSourceConfig_externalIdIssuer(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+data "aws_identitystore_groups" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  depends_on = [aws_identitystore_group.test]
+}
+`, name)
+}
+
+func testAccGroupsDataSourceConfig_externalIdIssuer(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+data "aws_identitystore_groups" "test" {
+  identity_store_id  = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  external_id_issuer = %[1]q
+
+  depends_on = [aws_identitystore_group.test]
+}
+`, name)
+}
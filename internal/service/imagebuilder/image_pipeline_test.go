@@ -322,6 +322,41 @@ func TestAccImageBuilderImagePipeline_ImageTests_timeoutMinutes(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImagePipeline_ImageScanning_imageScanningEnabled(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_imageScanningConfigurationEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImagePipelineConfig_imageScanningConfigurationEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderImagePipeline_infrastructureARN(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	infrastructureConfigurationResourceName := "aws_imagebuilder_infrastructure_configuration.test"
@@ -839,6 +874,22 @@ resource "aws_imagebuilder_image_pipeline" "test" {
 `, rName))
 }
 
+func testAccImagePipelineConfig_imageScanningConfigurationEnabled(rName string, imageScanningEnabled bool) string {
+	return acctest.ConfigCompose(
+		testAccImagePipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+
+  image_scanning_configuration {
+    image_scanning_enabled = %[2]t
+  }
+}
+`, rName, imageScanningEnabled))
+}
+
 func testAccImagePipelineConfig_testsConfigurationTestsEnabled(rName string, imageTestsEnabled bool) string {
 	return acctest.ConfigCompose(
 		testAccImagePipelineBaseConfig(rName),
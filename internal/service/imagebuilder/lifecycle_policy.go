@@ -0,0 +1,638 @@
+package imagebuilder
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
+		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
+		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_detail": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include_resources": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"amis": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"containers": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"snapshots": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"exclusion_rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amis": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"is_public": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"last_launched": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"unit": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+															},
+															"value": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 365),
+															},
+														},
+													},
+												},
+												"regions": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidRegionName,
+													},
+												},
+												"shared_accounts": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidAccountID,
+													},
+												},
+												"tag_map": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"tag_map": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retain_at_least": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailFilterType_Values(), false),
+									},
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_selection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipe": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"semantic_version": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"tag_map": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyResourceType_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      imagebuilder.LifecyclePolicyStatusEnabled,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &imagebuilder.CreateLifecyclePolicyInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		ExecutionRole:     aws.String(d.Get("execution_role").(string)),
+		Name:              aws.String(name),
+		PolicyDetails:     expandLifecyclePolicyDetails(d.Get("policy_detail").([]interface{})),
+		ResourceSelection: expandLifecyclePolicyResourceSelection(d.Get("resource_selection").([]interface{})),
+		ResourceType:      aws.String(d.Get("resource_type").(string)),
+		Status:            aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateLifecyclePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Image Builder Lifecycle Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.LifecyclePolicyArn))
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	policy, err := FindLifecyclePolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Image Builder Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", policy.Arn)
+	d.Set("description", policy.Description)
+	d.Set("execution_role", policy.ExecutionRole)
+	d.Set("name", policy.Name)
+	if err := d.Set("policy_detail", flattenLifecyclePolicyDetails(policy.PolicyDetails)); err != nil {
+		return diag.Errorf("setting policy_detail: %s", err)
+	}
+	if err := d.Set("resource_selection", flattenLifecyclePolicyResourceSelection(policy.ResourceSelection)); err != nil {
+		return diag.Errorf("setting resource_selection: %s", err)
+	}
+	d.Set("resource_type", policy.ResourceType)
+	d.Set("status", policy.Status)
+
+	tags := KeyValueTags(policy.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &imagebuilder.UpdateLifecyclePolicyInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			ExecutionRole:      aws.String(d.Get("execution_role").(string)),
+			LifecyclePolicyArn: aws.String(d.Id()),
+			PolicyDetails:      expandLifecyclePolicyDetails(d.Get("policy_detail").([]interface{})),
+			ResourceSelection:  expandLifecyclePolicyResourceSelection(d.Get("resource_selection").([]interface{})),
+			ResourceType:       aws.String(d.Get("resource_type").(string)),
+			Status:             aws.String(d.Get("status").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateLifecyclePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Image Builder Lifecycle Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderConn
+
+	log.Printf("[INFO] Deleting Image Builder Lifecycle Policy: %s", d.Id())
+	_, err := conn.DeleteLifecyclePolicyWithContext(ctx, &imagebuilder.DeleteLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindLifecyclePolicyByARN(ctx context.Context, conn *imagebuilder.Imagebuilder, arn string) (*imagebuilder.LifecyclePolicy, error) {
+	input := &imagebuilder.GetLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLifecyclePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LifecyclePolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LifecyclePolicy, nil
+}
+
+func expandLifecyclePolicyDetails(tfList []interface{}) []*imagebuilder.LifecyclePolicyDetail {
+	var apiObjects []*imagebuilder.LifecyclePolicyDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &imagebuilder.LifecyclePolicyDetail{}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Action = expandLifecyclePolicyDetailAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["exclusion_rules"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ExclusionRules = expandLifecyclePolicyDetailExclusionRules(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Filter = expandLifecyclePolicyDetailFilter(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyDetailAction(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailAction {
+	apiObject := &imagebuilder.LifecyclePolicyDetailAction{}
+
+	if v, ok := tfMap["include_resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.IncludeResources = &imagebuilder.LifecyclePolicyDetailActionIncludeResources{
+			Amis:       aws.Bool(tfMap["amis"].(bool)),
+			Containers: aws.Bool(tfMap["containers"].(bool)),
+			Snapshots:  aws.Bool(tfMap["snapshots"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRules(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRules {
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRules{}
+
+	if v, ok := tfMap["amis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		amis := &imagebuilder.LifecyclePolicyDetailExclusionRulesAmis{}
+
+		if v, ok := tfMap["is_public"].(bool); ok {
+			amis.IsPublic = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["last_launched"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			amis.LastLaunched = &imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched{
+				Unit:  aws.String(tfMap["unit"].(string)),
+				Value: aws.Int64(int64(tfMap["value"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
+			amis.Regions = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["shared_accounts"].([]interface{}); ok && len(v) > 0 {
+			amis.SharedAccounts = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+			amis.TagMap = flex.ExpandStringMap(v)
+		}
+
+		apiObject.Amis = amis
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailFilter(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailFilter {
+	apiObject := &imagebuilder.LifecyclePolicyDetailFilter{}
+
+	if v, ok := tfMap["retain_at_least"].(int); ok && v != 0 {
+		apiObject.RetainAtLeast = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyResourceSelection(tfList []interface{}) *imagebuilder.LifecyclePolicyResourceSelection {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &imagebuilder.LifecyclePolicyResourceSelection{}
+
+	if v, ok := tfMap["recipe"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			apiObject.Recipes = append(apiObject.Recipes, &imagebuilder.LifecyclePolicyResourceSelectionRecipe{
+				Name:            aws.String(tfMap["name"].(string)),
+				SemanticVersion: aws.String(tfMap["semantic_version"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenLifecyclePolicyDetails(apiObjects []*imagebuilder.LifecyclePolicyDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Action; v != nil {
+			action := map[string]interface{}{
+				"type": aws.StringValue(v.Type),
+			}
+
+			if v := v.IncludeResources; v != nil {
+				action["include_resources"] = []interface{}{map[string]interface{}{
+					"amis":       aws.BoolValue(v.Amis),
+					"containers": aws.BoolValue(v.Containers),
+					"snapshots":  aws.BoolValue(v.Snapshots),
+				}}
+			}
+
+			tfMap["action"] = []interface{}{action}
+		}
+
+		if v := apiObject.ExclusionRules; v != nil {
+			exclusionRules := map[string]interface{}{
+				"tag_map": aws.StringValueMap(v.TagMap),
+			}
+
+			if v := v.Amis; v != nil {
+				amis := map[string]interface{}{
+					"is_public":       aws.BoolValue(v.IsPublic),
+					"regions":         aws.StringValueSlice(v.Regions),
+					"shared_accounts": aws.StringValueSlice(v.SharedAccounts),
+					"tag_map":         aws.StringValueMap(v.TagMap),
+				}
+
+				if v := v.LastLaunched; v != nil {
+					amis["last_launched"] = []interface{}{map[string]interface{}{
+						"unit":  aws.StringValue(v.Unit),
+						"value": aws.Int64Value(v.Value),
+					}}
+				}
+
+				exclusionRules["amis"] = []interface{}{amis}
+			}
+
+			tfMap["exclusion_rules"] = []interface{}{exclusionRules}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{map[string]interface{}{
+				"retain_at_least": aws.Int64Value(v.RetainAtLeast),
+				"type":            aws.StringValue(v.Type),
+				"unit":            aws.StringValue(v.Unit),
+				"value":           aws.Int64Value(v.Value),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenLifecyclePolicyResourceSelection(apiObject *imagebuilder.LifecyclePolicyResourceSelection) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var recipes []interface{}
+
+	for _, v := range apiObject.Recipes {
+		if v == nil {
+			continue
+		}
+
+		recipes = append(recipes, map[string]interface{}{
+			"name":             aws.StringValue(v.Name),
+			"semantic_version": aws.StringValue(v.SemanticVersion),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"recipe":  recipes,
+		"tag_map": aws.StringValueMap(apiObject.TagMap),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,268 @@
+package imagebuilder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfimagebuilder "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccImageBuilderLifecyclePolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "DELETE", 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", fmt.Sprintf("lifecycle-policy/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.type", "DELETE"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.type", "AGE"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.unit", "MONTHS"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.value", "6"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.tag_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_selection.0.tag_map.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AMI_IMAGE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "DEPRECATE", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.type", "DEPRECATE"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.value", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderLifecyclePolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "DELETE", 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfimagebuilder.ResourceLifecyclePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderLifecyclePolicy_exclusionRules(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_exclusionRules(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.include_resources.0.amis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.action.0.include_resources.0.snapshots", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.exclusion_rules.0.amis.0.is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.exclusion_rules.0.amis.0.last_launched.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.exclusion_rules.0.amis.0.last_launched.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.exclusion_rules.0.tag_map.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.retain_at_least", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_detail.0.filter.0.type", "COUNT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLifecyclePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_lifecycle_policy" {
+			continue
+		}
+
+		_, err := tfimagebuilder.FindLifecyclePolicyByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Lifecycle Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLifecyclePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Image Builder Lifecycle Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn
+
+		_, err := tfimagebuilder.FindLifecyclePolicyByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLifecyclePolicyBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "imagebuilder.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/EC2ImageBuilderLifecycleExecutionPolicy"
+  role       = aws_iam_role.test.name
+}
+`, rName)
+}
+
+func testAccLifecyclePolicyConfig_basic(rName, actionType string, value int) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+
+  policy_detail {
+    action {
+      type = %[2]q
+    }
+
+    filter {
+      type  = "AGE"
+      unit  = "MONTHS"
+      value = %[3]d
+    }
+  }
+
+  resource_selection {
+    tag_map = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, actionType, value))
+}
+
+func testAccLifecyclePolicyConfig_exclusionRules(rName string) string {
+	return acctest.ConfigCompose(testAccLifecyclePolicyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_imagebuilder_lifecycle_policy" "test" {
+  name           = %[1]q
+  execution_role = aws_iam_role.test.arn
+  resource_type  = "AMI_IMAGE"
+
+  policy_detail {
+    action {
+      type = "DELETE"
+
+      include_resources {
+        amis      = true
+        snapshots = true
+      }
+    }
+
+    exclusion_rules {
+      amis {
+        is_public = true
+
+        last_launched {
+          unit  = "DAYS"
+          value = 30
+        }
+      }
+
+      tag_map = {
+        Retain = "true"
+      }
+    }
+
+    filter {
+      type            = "COUNT"
+      value           = 10
+      retain_at_least = 2
+    }
+  }
+
+  resource_selection {
+    tag_map = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
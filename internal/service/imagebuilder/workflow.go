@@ -0,0 +1,250 @@
+package imagebuilder
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowCreate,
+		ReadWithoutTimeout:   resourceWorkflowRead,
+		UpdateWithoutTimeout: resourceWorkflowUpdate,
+		DeleteWithoutTimeout: resourceWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"data", "uri"},
+				ValidateFunc: validation.StringLenBetween(1, 16000),
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.WorkflowType](),
+			},
+			"uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"data", "uri"},
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &imagebuilder.CreateWorkflowInput{
+		ClientToken:     aws.String(resource.UniqueId()),
+		Name:            aws.String(name),
+		SemanticVersion: aws.String(d.Get("version").(string)),
+		Type:            types.WorkflowType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("change_description"); ok {
+		input.ChangeDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data"); ok {
+		input.Data = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAWS().Map()
+	}
+
+	if v, ok := d.GetOk("uri"); ok {
+		input.Uri = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWorkflow(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Image Builder Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.WorkflowBuildVersionArn))
+
+	return resourceWorkflowRead(ctx, d, meta)
+}
+
+func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workflow, err := FindWorkflowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Image Builder Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Image Builder Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", workflow.Arn)
+	d.Set("change_description", workflow.ChangeDescription)
+	d.Set("data", workflow.Data)
+	d.Set("date_created", workflow.DateCreated)
+	d.Set("description", workflow.Description)
+	d.Set("kms_key_id", workflow.KmsKeyId)
+	d.Set("name", workflow.Name)
+	d.Set("owner", workflow.Owner)
+	d.Set("type", workflow.Type)
+	d.Set("version", workflow.Version)
+
+	tags := tftags.New(workflow.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Image Builder Workflow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceWorkflowRead(ctx, d, meta)
+}
+
+func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ImageBuilderClient()
+
+	log.Printf("[INFO] Deleting Image Builder Workflow: %s", d.Id())
+	_, err := conn.DeleteWorkflow(ctx, &imagebuilder.DeleteWorkflowInput{
+		WorkflowBuildVersionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Image Builder Workflow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindWorkflowByARN(ctx context.Context, conn *imagebuilder.Client, arn string) (*types.Workflow, error) {
+	input := &imagebuilder.GetWorkflowInput{
+		WorkflowBuildVersionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetWorkflow(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workflow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workflow, nil
+}
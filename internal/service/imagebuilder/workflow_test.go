@@ -0,0 +1,232 @@
+package imagebuilder_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfimagebuilder "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccImageBuilderWorkflow_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", regexp.MustCompile(fmt.Sprintf("workflow/test/%s/1.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttr(resourceName, "change_description", ""),
+					resource.TestMatchResourceAttr(resourceName, "data", regexp.MustCompile(`schemaVersion`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "TEST"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderWorkflow_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfimagebuilder.ResourceWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderWorkflow_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkflowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccWorkflowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_workflow" {
+			continue
+		}
+
+		_, err := tfimagebuilder.FindWorkflowByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Image Builder Workflow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Image Builder Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderClient()
+
+		_, err := tfimagebuilder.FindWorkflowByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+const testAccWorkflowData = `
+name: test-image
+description: Workflow to test an image
+schemaVersion: 1.0
+
+steps:
+  - name: LaunchTestInstance
+    action: LaunchInstance
+    onFailure: Abort
+    inputs:
+      waitFor: "ssmAgent"
+
+  - name: TerminateTestInstance
+    action: TerminateInstance
+    onFailure: Continue
+    inputs:
+      instanceId.$: "$.stepOutputs.LaunchTestInstance.instanceId"
+`
+
+func testAccWorkflowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  name    = %[1]q
+  version = "1.0.0"
+  type    = "TEST"
+
+  data = <<-EOT
+%[2]s
+  EOT
+}
+`, rName, testAccWorkflowData)
+}
+
+func testAccWorkflowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  name    = %[1]q
+  version = "1.0.0"
+  type    = "TEST"
+
+  data = <<-EOT
+%[2]s
+  EOT
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccWorkflowData, tagKey1, tagValue1)
+}
+
+func testAccWorkflowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  name    = %[1]q
+  version = "1.0.0"
+  type    = "TEST"
+
+  data = <<-EOT
+%[2]s
+  EOT
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccWorkflowData, tagKey1, tagValue1, tagKey2, tagValue2)
+}
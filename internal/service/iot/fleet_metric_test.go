@@ -0,0 +1,321 @@
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Fleet metrics depend on the Region's fleet indexing configuration so the tests are run serially.
+func TestAccIoTFleetMetric_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccFleetMetric_basic,
+		"disappears": testAccFleetMetric_disappears,
+		"tags":       testAccFleetMetric_tags,
+		"update":     testAccFleetMetric_update,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccFleetMetric_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_field", "registry.version"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Statistics"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aggregation_type.0.values.*", "sum"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iot", fmt.Sprintf("fleetmetric/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "thingName:*"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourceFleetMetric(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetMetricConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFleetMetricConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccFleetMetricConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_field", "registry.version"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Percentiles"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aggregation_type.0.values.*", "50"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aggregation_type.0.values.*", "90"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "thingName:test*"),
+					resource.TestCheckResourceAttr(resourceName, "unit", "None"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFleetMetricExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Fleet Metric ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		_, err := tfiot.FindFleetMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFleetMetricDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_fleet_metric" {
+			continue
+		}
+
+		_, err := tfiot.FindFleetMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Fleet Metric %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccFleetMetricBaseConfig = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+
+    custom_field {
+      name = "registry.version"
+      type = "Number"
+    }
+  }
+}
+`
+
+func testAccFleetMetricConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricBaseConfig, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 60
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["sum"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricBaseConfig, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  description       = "updated"
+  query_string      = "thingName:test*"
+  aggregation_field = "registry.version"
+  period            = 300
+  unit              = "None"
+
+  aggregation_type {
+    name   = "Percentiles"
+    values = ["50", "90"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFleetMetricBaseConfig, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 60
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["sum"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFleetMetricConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFleetMetricBaseConfig, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 60
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["sum"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 func ResourceIndexingConfiguration() *schema.Resource {
@@ -105,6 +106,41 @@ func ResourceIndexingConfiguration() *schema.Resource {
 							Default:      iot.DeviceDefenderIndexingModeOff,
 							ValidateFunc: validation.StringInSlice(iot.DeviceDefenderIndexingMode_Values(), false),
 						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"geo_location": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"order": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      iot.TargetFieldOrderLatLon,
+													ValidateFunc: validation.StringInSlice(iot.TargetFieldOrder_Values(), false),
+												},
+											},
+										},
+									},
+									"named_shadow_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 64),
+										},
+									},
+								},
+							},
+						},
 						"managed_field": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -237,6 +273,10 @@ func flattenThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration
 		tfMap["device_defender_indexing_mode"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenIndexingFilter(v)}
+	}
+
 	if v := apiObject.ManagedFields; v != nil {
 		tfMap["managed_field"] = flattenFields(v)
 	}
@@ -256,6 +296,60 @@ func flattenThingIndexingConfiguration(apiObject *iot.ThingIndexingConfiguration
 	return tfMap
 }
 
+func flattenIndexingFilter(apiObject *iot.IndexingFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GeoLocations; v != nil {
+		tfMap["geo_location"] = flattenGeoLocationTargets(v)
+	}
+
+	if v := apiObject.NamedShadowNames; v != nil {
+		tfMap["named_shadow_names"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenGeoLocationTarget(apiObject *iot.GeoLocationTarget) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Order; v != nil {
+		tfMap["order"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGeoLocationTargets(apiObjects []*iot.GeoLocationTarget) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenGeoLocationTarget(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenField(apiObject *iot.Field) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -329,6 +423,10 @@ func expandThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIn
 		apiObject.DeviceDefenderIndexingMode = aws.String(v)
 	}
 
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandIndexingFilter(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["managed_field"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.ManagedFields = expandFields(v.List())
 	}
@@ -348,6 +446,68 @@ func expandThingIndexingConfiguration(tfMap map[string]interface{}) *iot.ThingIn
 	return apiObject
 }
 
+func expandIndexingFilter(tfMap map[string]interface{}) *iot.IndexingFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.IndexingFilter{}
+
+	if v, ok := tfMap["geo_location"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GeoLocations = expandGeoLocationTargets(v.List())
+	}
+
+	if v, ok := tfMap["named_shadow_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NamedShadowNames = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandGeoLocationTarget(tfMap map[string]interface{}) *iot.GeoLocationTarget {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.GeoLocationTarget{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["order"].(string); ok && v != "" {
+		apiObject.Order = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGeoLocationTargets(tfList []interface{}) []*iot.GeoLocationTarget {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iot.GeoLocationTarget
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandGeoLocationTarget(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandField(tfMap map[string]interface{}) *iot.Field {
 	if tfMap == nil {
 		return nil
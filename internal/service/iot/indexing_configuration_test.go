@@ -41,6 +41,7 @@ func testAccIndexingConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.custom_field.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.device_defender_indexing_mode", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.managed_field.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.named_shadow_indexing_mode", "OFF"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "OFF"),
@@ -87,6 +88,9 @@ func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 						"type": "Number",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.device_defender_indexing_mode", "VIOLATIONS"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
 					acctest.CheckResourceAttrGreaterThanValue(resourceName, "thing_group_indexing_configuration.0.managed_field.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.named_shadow_indexing_mode", "ON"),
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.thing_connectivity_indexing_mode", "STATUS"),
@@ -126,6 +130,10 @@ resource "aws_iot_indexing_configuration" "test" {
     device_defender_indexing_mode    = "VIOLATIONS"
     named_shadow_indexing_mode       = "ON"
 
+    filter {
+      named_shadow_names = ["thing1shadow"]
+    }
+
     custom_field {
       name = "attributes.version"
       type = "Number"
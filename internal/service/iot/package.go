@@ -0,0 +1,208 @@
+package iot
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var validPackageName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters and/or the following: _.-"),
+)
+
+func ResourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageCreate,
+		ReadWithoutTimeout:   resourcePackageRead,
+		UpdateWithoutTimeout: resourcePackageUpdate,
+		DeleteWithoutTimeout: resourcePackageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPackageName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("package_name").(string)
+	input := &iot.CreatePackageInput{
+		PackageName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = aws.StringMap(tags.IgnoreAWS().Map())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Package: %s", name)
+	_, err := conn.CreatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Package (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPackageByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Package %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PackageArn)
+	d.Set("default_version_name", output.DefaultVersionName)
+	d.Set("description", output.Description)
+	d.Set("package_name", output.PackageName)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Package (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChange("description") {
+		input := &iot.UpdatePackageInput{
+			Description: aws.String(d.Get("description").(string)),
+			PackageName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Package: %s", d.Id())
+		_, err := conn.UpdatePackageWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	log.Printf("[INFO] Deleting IoT Package: %s", d.Id())
+	_, err := conn.DeletePackageWithContext(ctx, &iot.DeletePackageInput{
+		PackageName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Package (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPackageByName(ctx context.Context, conn *iot.IoT, name string) (*iot.GetPackageOutput, error) {
+	input := &iot.GetPackageInput{
+		PackageName: aws.String(name),
+	}
+
+	output, err := conn.GetPackageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,406 @@
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageVersionCreate,
+		ReadWithoutTimeout:   resourcePackageVersionRead,
+		UpdateWithoutTimeout: resourcePackageVersionUpdate,
+		DeleteWithoutTimeout: resourcePackageVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPackageName,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iot.PackageVersionStatusDraft,
+				ValidateFunc: validation.StringInSlice(iot.PackageVersionStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters and/or the following: _.-"),
+				),
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A package version cannot be returned to draft once it has been published.
+			customdiff.ForceNewIfChange("status", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) == iot.PackageVersionStatusDraft
+			}),
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("default_version").(bool) && d.Get("status").(string) != iot.PackageVersionStatusPublished {
+					return fmt.Errorf("default_version requires status to be %s", iot.PackageVersionStatusPublished)
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+func resourcePackageVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	packageName := d.Get("package_name").(string)
+	versionName := d.Get("version_name").(string)
+	id := PackageVersionCreateResourceID(packageName, versionName)
+	input := &iot.CreatePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = aws.StringMap(tags.IgnoreAWS().Map())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Package Version: %s", id)
+	_, err := conn.CreatePackageVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Package Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, iot.PackageVersionStatusDraft, d.Get("status").(string)); err != nil {
+		return diag.Errorf("error creating IoT Package Version (%s): %s", id, err)
+	}
+
+	if d.Get("default_version").(bool) {
+		if err := updatePackageDefaultVersion(ctx, conn, packageName, versionName, true); err != nil {
+			return diag.Errorf("error creating IoT Package Version (%s): %s", id, err)
+		}
+	}
+
+	return resourcePackageVersionRead(ctx, d, meta)
+}
+
+func resourcePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindPackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Package Version %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	pkg, err := FindPackageByName(ctx, conn, packageName)
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Package (%s): %s", packageName, err)
+	}
+
+	d.Set("arn", output.PackageVersionArn)
+	d.Set("attributes", aws.StringValueMap(output.Attributes))
+	d.Set("default_version", aws.StringValue(pkg.DefaultVersionName) == versionName)
+	d.Set("description", output.Description)
+	d.Set("error_reason", output.ErrorReason)
+	d.Set("package_name", output.PackageName)
+	d.Set("status", output.Status)
+	d.Set("version_name", output.VersionName)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePackageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The default version designation must be removed before the version can be deprecated.
+	if d.HasChange("default_version") && !d.Get("default_version").(bool) {
+		if err := updatePackageDefaultVersion(ctx, conn, packageName, versionName, false); err != nil {
+			return diag.Errorf("error updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("attributes", "description") {
+		input := &iot.UpdatePackageVersionInput{
+			Attributes:  flex.ExpandStringMap(d.Get("attributes").(map[string]interface{})),
+			Description: aws.String(d.Get("description").(string)),
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Package Version: %s", d.Id())
+		_, err := conn.UpdatePackageVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		o, n := d.GetChange("status")
+
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, o.(string), n.(string)); err != nil {
+			return diag.Errorf("error updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("default_version") && d.Get("default_version").(bool) {
+		if err := updatePackageDefaultVersion(ctx, conn, packageName, versionName, true); err != nil {
+			return diag.Errorf("error updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourcePackageVersionRead(ctx, d, meta)
+}
+
+func resourcePackageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updatePackageDefaultVersion(ctx, conn, packageName, versionName, false); err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("error deleting IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting IoT Package Version: %s", d.Id())
+	_, err = conn.DeletePackageVersionWithContext(ctx, &iot.DeletePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const packageVersionResourceIDSeparator = "/"
+
+func PackageVersionCreateResourceID(packageName, versionName string) string {
+	parts := []string{packageName, versionName}
+	id := strings.Join(parts, packageVersionResourceIDSeparator)
+
+	return id
+}
+
+func PackageVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected package-name%[2]sversion-name", id, packageVersionResourceIDSeparator)
+}
+
+// updatePackageVersionStatus moves a package version from one status to another.
+// A draft version is published before it is deprecated.
+func updatePackageVersionStatus(ctx context.Context, conn *iot.IoT, packageName, versionName, from, to string) error {
+	var actions []string
+
+	switch to {
+	case iot.PackageVersionStatusPublished:
+		if from != iot.PackageVersionStatusPublished {
+			actions = append(actions, iot.PackageVersionActionPublish)
+		}
+	case iot.PackageVersionStatusDeprecated:
+		if from == iot.PackageVersionStatusDraft {
+			actions = append(actions, iot.PackageVersionActionPublish)
+		}
+		if from != iot.PackageVersionStatusDeprecated {
+			actions = append(actions, iot.PackageVersionActionDeprecate)
+		}
+	}
+
+	for _, action := range actions {
+		input := &iot.UpdatePackageVersionInput{
+			Action:      aws.String(action),
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Package Version (%s/%s) status: %s", packageName, versionName, action)
+		if _, err := conn.UpdatePackageVersionWithContext(ctx, input); err != nil {
+			return fmt.Errorf("%s: %w", strings.ToLower(action), err)
+		}
+	}
+
+	return nil
+}
+
+// updatePackageDefaultVersion sets, or removes, the package's default version designation.
+// The designation is only removed if it is held by the specified version.
+func updatePackageDefaultVersion(ctx context.Context, conn *iot.IoT, packageName, versionName string, isDefault bool) error {
+	input := &iot.UpdatePackageInput{
+		PackageName: aws.String(packageName),
+	}
+
+	if isDefault {
+		input.DefaultVersionName = aws.String(versionName)
+	} else {
+		pkg, err := FindPackageByName(ctx, conn, packageName)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(pkg.DefaultVersionName) != versionName {
+			return nil
+		}
+
+		input.UnsetDefaultVersion = aws.Bool(true)
+	}
+
+	_, err := conn.UpdatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating IoT Package (%s) default version: %w", packageName, err)
+	}
+
+	return nil
+}
+
+func FindPackageVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, packageName, versionName string) (*iot.GetPackageVersionOutput, error) {
+	input := &iot.GetPackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	output, err := conn.GetPackageVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
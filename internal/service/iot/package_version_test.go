@@ -0,0 +1,204 @@
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTPackageVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageVersionExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iot", fmt.Sprintf("package/%s/version/1.0.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourcePackageVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+	packageResourceName := "aws_iot_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_status(rName, "PUBLISHED", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first release"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Refresh the package to pick up the default version.
+				Config: testAccPackageVersionConfig_status(rName, "PUBLISHED", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(packageResourceName, "default_version_name", "1.0.0"),
+				),
+			},
+			{
+				Config: testAccPackageVersionConfig_status(rName, "DEPRECATED", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPRECATED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Package Version ID is set")
+		}
+
+		packageName, versionName, err := tfiot.PackageVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		_, err = tfiot.FindPackageVersionByTwoPartKey(context.Background(), conn, packageName, versionName)
+
+		return err
+	}
+}
+
+func testAccCheckPackageVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_package_version" {
+			continue
+		}
+
+		packageName, versionName, err := tfiot.PackageVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfiot.FindPackageVersionByTwoPartKey(context.Background(), conn, packageName, versionName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Package Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_package" "test" {
+  package_name = %[1]q
+}
+
+resource "aws_iot_package_version" "test" {
+  package_name = aws_iot_package.test.package_name
+  version_name = "1.0.0"
+}
+`, rName)
+}
+
+func testAccPackageVersionConfig_status(rName, status string, defaultVersion bool) string {
+	return fmt.Sprintf(`
+resource "aws_iot_package" "test" {
+  package_name = %[1]q
+}
+
+resource "aws_iot_package_version" "test" {
+  package_name    = aws_iot_package.test.package_name
+  version_name    = "1.0.0"
+  description     = "first release"
+  status          = %[2]q
+  default_version = %[3]t
+
+  attributes = {
+    key1 = "value1"
+  }
+}
+`, rName, status, defaultVersion)
+}
@@ -0,0 +1,346 @@
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_documents": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.FeaturedResultsSetStatusActive),
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(resource.UniqueId()),
+		FeaturedResultsSetName: aws.String(d.Get("name").(string)),
+		IndexId:                aws.String(d.Get("index_id").(string)),
+		Status:                 types.FeaturedResultsSetStatus(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_documents"); ok && v.(*schema.Set).Len() > 0 {
+		input.FeaturedDocuments = expandFeaturedDocuments(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		input.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	outputRaw, err := tfresource.RetryWhen(
+		propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFeaturedResultsSet(ctx, input)
+		},
+		func(err error) (bool, error) {
+			var validationException *types.ValidationException
+
+			if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return diag.Errorf("creating Amazon Kendra Featured Results Set (%s): %s", d.Get("name").(string), err)
+	}
+
+	if outputRaw == nil || outputRaw.(*kendra.CreateFeaturedResultsSetOutput).FeaturedResultsSet == nil {
+		return diag.Errorf("creating Amazon Kendra Featured Results Set (%s): empty output", d.Get("name").(string))
+	}
+
+	output := outputRaw.(*kendra.CreateFeaturedResultsSetOutput)
+
+	id := aws.ToString(output.FeaturedResultsSet.FeaturedResultsSetId)
+	indexId := d.Get("index_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", id, indexId))
+
+	return resourceFeaturedResultsSetRead(ctx, d, meta)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("description", out.Description)
+	d.Set("featured_results_set_id", out.FeaturedResultsSetId)
+	d.Set("index_id", indexId)
+	d.Set("name", out.FeaturedResultsSetName)
+	d.Set("status", out.Status)
+
+	if err := d.Set("featured_documents", flattenFeaturedDocuments(out.FeaturedDocumentsWithMetadata, out.FeaturedDocumentsMissing)); err != nil {
+		return diag.Errorf("setting featured_documents: %s", err)
+	}
+
+	if err := d.Set("query_texts", flex.FlattenStringValueSet(out.QueryTexts)); err != nil {
+		return diag.Errorf("setting query_texts: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+	if err != nil {
+		return diag.Errorf("listing tags for Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			FeaturedResultsSetId: aws.String(id),
+			IndexId:              aws.String(indexId),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("featured_documents") {
+			// An empty list clears the featured documents.
+			input.FeaturedDocuments = expandFeaturedDocuments(d.Get("featured_documents").(*schema.Set))
+		}
+
+		if d.HasChange("name") {
+			input.FeaturedResultsSetName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("query_texts") {
+			input.QueryTexts = flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set))
+		}
+
+		if d.HasChange("status") {
+			input.Status = types.FeaturedResultsSetStatus(d.Get("status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Featured Results Set (%s): %#v", d.Id(), input)
+
+		_, err = tfresource.RetryWhen(
+			propagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateFeaturedResultsSet(ctx, input)
+			},
+			func(err error) (bool, error) {
+				var validationException *types.ValidationException
+
+				if errors.As(err, &validationException) && strings.Contains(validationException.ErrorMessage(), validationExceptionMessage) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return diag.Errorf("updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Kendra Featured Results Set (%s) tags: %s", d.Id(), err))
+		}
+	}
+
+	return resourceFeaturedResultsSetRead(ctx, d, meta)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	// Failures for individual sets are reported in the output rather than as an error.
+	if output != nil && len(output.Errors) > 0 {
+		return diag.Errorf("deleting Kendra Featured Results Set (%s): %s: %s", d.Id(), output.Errors[0].ErrorCode, aws.ToString(output.Errors[0].ErrorMessage))
+	}
+
+	return nil
+}
+
+func expandFeaturedDocuments(tfSet *schema.Set) []types.FeaturedDocument {
+	apiObjects := []types.FeaturedDocument{}
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenFeaturedDocuments returns the IDs of all featured documents, including those
+// that are no longer in the index.
+func flattenFeaturedDocuments(withMetadata []types.FeaturedDocumentWithMetadata, missing []types.FeaturedDocumentMissing) *schema.Set {
+	var ids []string
+
+	for _, v := range withMetadata {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	for _, v := range missing {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	return flex.FlattenStringValueSet(ids)
+}
@@ -0,0 +1,305 @@
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraFeaturedResultsSet_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.KendraEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/featured-results-set/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "featured_results_set_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.KendraEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceFeaturedResultsSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_update(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.KendraEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_full(rName, "description1", "ACTIVE", "doc1", "query1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "featured_documents.*", "doc1"),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "query_texts.*", "query1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_full(rName, "description2", "INACTIVE", "doc2", "query2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "featured_documents.*", "doc2"),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "query_texts.*", "query2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.KendraEndpointID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFeaturedResultsSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_featured_results_set" {
+			continue
+		}
+
+		id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfkendra.FindFeaturedResultsSetByID(context.Background(), conn, id, indexId)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Featured Results Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFeaturedResultsSetExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Featured Results Set is set")
+		}
+
+		id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient
+
+		_, err = tfkendra.FindFeaturedResultsSetByID(context.Background(), conn, id, indexId)
+
+		if err != nil {
+			return fmt.Errorf("Error describing Kendra Featured Results Set: %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccFeaturedResultsSetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccThesaurusBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+}
+`, rName))
+}
+
+func testAccFeaturedResultsSetConfig_full(rName, description, status, document, queryText string) string {
+	return acctest.ConfigCompose(
+		testAccThesaurusBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  description        = %[2]q
+  featured_documents = [%[4]q]
+  index_id           = aws_kendra_index.test.id
+  name               = %[1]q
+  query_texts        = [%[5]q]
+  status             = %[3]q
+}
+`, rName, description, status, document, queryText))
+}
+
+func testAccFeaturedResultsSetConfig_tags1(rName, tag, value string) string {
+	return acctest.ConfigCompose(
+		testAccThesaurusBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tag, value))
+}
+
+func testAccFeaturedResultsSetConfig_tags2(rName, tag1, value1, tag2, value2 string) string {
+	return acctest.ConfigCompose(
+		testAccThesaurusBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tag1, value1, tag2, value2))
+}
@@ -65,6 +65,33 @@ func FindFaqByID(ctx context.Context, conn *kendra.Client, id, indexId string) (
 	return out, nil
 }
 
+func FindFeaturedResultsSetByID(ctx context.Context, conn *kendra.Client, id, indexId string) (*kendra.DescribeFeaturedResultsSetOutput, error) {
+	in := &kendra.DescribeFeaturedResultsSetInput{
+		FeaturedResultsSetId: aws.String(id),
+		IndexId:              aws.String(indexId),
+	}
+
+	out, err := conn.DescribeFeaturedResultsSet(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
 func FindQuerySuggestionsBlockListByID(ctx context.Context, conn *kendra.Client, id, indexId string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
 	in := &kendra.DescribeQuerySuggestionsBlockListInput{
 		Id:      aws.String(id),
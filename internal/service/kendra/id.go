@@ -35,6 +35,16 @@ func FaqParseResourceID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+func FeaturedResultsSetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("please make sure ID is in format FEATURED_RESULTS_SET_ID/INDEX_ID")
+	}
+
+	return parts[0], parts[1], nil
+}
+
 func QuerySuggestionsBlockListParseResourceID(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 
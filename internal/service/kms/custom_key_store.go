@@ -3,12 +3,14 @@ package kms
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,24 +40,136 @@ func ResourceCustomKeyStore() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"cloud_hsm_cluster_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+			"connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"custom_key_store_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"custom_key_store_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kms.CustomKeyStoreTypeAwsCloudhsm,
+				ValidateFunc: validation.StringInSlice(kms.CustomKeyStoreType_Values(), false),
+			},
 			"key_store_password": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Sensitive:        true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
 			},
 			"trust_anchor_certificate": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"xks_proxy_authentication_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(20, 30),
+						},
+						"raw_secret_access_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(43, 64),
+						},
+					},
+				},
+			},
+			"xks_proxy_connectivity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kms.XksProxyConnectivityType_Values(), false),
+			},
+			"xks_proxy_uri_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(10, 128),
+			},
+			"xks_proxy_uri_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(10, 128),
+			},
+			"xks_proxy_vpc_endpoint_service_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(20, 64),
 			},
 		},
+
+		CustomizeDiff: resourceCustomKeyStoreCustomizeDiff,
+	}
+}
+
+func resourceCustomKeyStoreCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	var required, conflicting []string
+	switch diff.Get("custom_key_store_type").(string) {
+	case kms.CustomKeyStoreTypeAwsCloudhsm:
+		required = []string{"cloud_hsm_cluster_id", "key_store_password", "trust_anchor_certificate"}
+		conflicting = []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path", "xks_proxy_vpc_endpoint_service_name"}
+	case kms.CustomKeyStoreTypeExternalKeyStore:
+		required = []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path"}
+		conflicting = []string{"cloud_hsm_cluster_id", "key_store_password", "trust_anchor_certificate"}
+
+		if v := config.GetAttr("xks_proxy_connectivity"); v.IsKnown() && !v.IsNull() && v.AsString() == kms.XksProxyConnectivityTypeVpcEndpointService {
+			required = append(required, "xks_proxy_vpc_endpoint_service_name")
+		}
+	default:
+		return nil
+	}
+
+	for _, k := range required {
+		if !customKeyStoreAttributeConfigured(config.GetAttr(k)) {
+			return fmt.Errorf("%q is required for custom key stores of type %s", k, diff.Get("custom_key_store_type").(string))
+		}
+	}
+
+	for _, k := range conflicting {
+		if customKeyStoreAttributeConfigured(config.GetAttr(k)) {
+			return fmt.Errorf("%q cannot be set for custom key stores of type %s", k, diff.Get("custom_key_store_type").(string))
+		}
+	}
+
+	return nil
+}
+
+// customKeyStoreAttributeConfigured returns whether the raw configuration value
+// is set. Unknown values are treated as set; absent blocks are empty lists.
+func customKeyStoreAttributeConfigured(v cty.Value) bool {
+	if v.IsNull() {
+		return false
+	}
+
+	if !v.IsKnown() {
+		return true
+	}
+
+	if v.Type().IsListType() {
+		return v.LengthInt() > 0
 	}
+
+	return true
 }
 
 const (
@@ -66,10 +180,40 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).KMSConn
 
 	in := &kms.CreateCustomKeyStoreInput{
-		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
-		CustomKeyStoreName:     aws.String(d.Get("custom_key_store_name").(string)),
-		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
-		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+		CustomKeyStoreName: aws.String(d.Get("custom_key_store_name").(string)),
+		CustomKeyStoreType: aws.String(d.Get("custom_key_store_type").(string)),
+	}
+
+	if v, ok := d.GetOk("cloud_hsm_cluster_id"); ok {
+		in.CloudHsmClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_store_password"); ok {
+		in.KeyStorePassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("trust_anchor_certificate"); ok {
+		in.TrustAnchorCertificate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_connectivity"); ok {
+		in.XksProxyConnectivity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_endpoint"); ok {
+		in.XksProxyUriEndpoint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_path"); ok {
+		in.XksProxyUriPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_vpc_endpoint_service_name"); ok {
+		in.XksProxyVpcEndpointServiceName = aws.String(v.(string))
 	}
 
 	out, err := conn.CreateCustomKeyStoreWithContext(ctx, in)
@@ -83,6 +227,12 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(out.CustomKeyStoreId))
 
+	if v, ok := d.GetOk("connected"); ok && v.(bool) {
+		if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.KMS, create.ErrActionCreating, ResNameCustomKeyStore, d.Id(), err)
+		}
+	}
+
 	return resourceCustomKeyStoreRead(ctx, d, meta)
 }
 
@@ -105,8 +255,22 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set("cloud_hsm_cluster_id", out.CloudHsmClusterId)
+	d.Set("connected", aws.StringValue(out.ConnectionState) == kms.ConnectionStateTypeConnected)
+	d.Set("connection_state", out.ConnectionState)
 	d.Set("custom_key_store_name", out.CustomKeyStoreName)
+	d.Set("custom_key_store_type", out.CustomKeyStoreType)
 	d.Set("trust_anchor_certificate", out.TrustAnchorCertificate)
+	if v := out.XksProxyConfiguration; v != nil {
+		d.Set("xks_proxy_connectivity", v.Connectivity)
+		d.Set("xks_proxy_uri_endpoint", v.UriEndpoint)
+		d.Set("xks_proxy_uri_path", v.UriPath)
+		d.Set("xks_proxy_vpc_endpoint_service_name", v.VpcEndpointServiceName)
+	} else {
+		d.Set("xks_proxy_connectivity", nil)
+		d.Set("xks_proxy_uri_endpoint", nil)
+		d.Set("xks_proxy_uri_path", nil)
+		d.Set("xks_proxy_vpc_endpoint_service_name", nil)
+	}
 
 	return nil
 }
@@ -114,47 +278,158 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KMSConn
 
-	update := false
-
-	in := &kms.UpdateCustomKeyStoreInput{
-		CustomKeyStoreId:  aws.String(d.Id()),
-		CloudHsmClusterId: aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+	o, n := d.GetChange("connected")
+	connected, wantConnected := o.(bool), n.(bool)
+
+	if d.HasChangesExcept("connected", "trust_anchor_certificate") {
+		in := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
+
+		// Some properties can only be changed while the key store is disconnected.
+		disconnect := false
+
+		if d.HasChange("custom_key_store_name") {
+			in.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		}
+
+		if d.HasChange("key_store_password") {
+			in.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+			disconnect = true
+		}
+
+		if d.HasChange("xks_proxy_authentication_credential") {
+			if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("xks_proxy_connectivity") {
+			in.XksProxyConnectivity = aws.String(d.Get("xks_proxy_connectivity").(string))
+			disconnect = true
+		}
+
+		if d.HasChange("xks_proxy_uri_endpoint") {
+			in.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
+			disconnect = true
+		}
+
+		if d.HasChange("xks_proxy_uri_path") {
+			in.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
+		}
+
+		if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
+			in.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+			disconnect = true
+		}
+
+		if disconnect && connected {
+			if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+			}
+
+			connected = false
+		}
+
+		_, err := conn.UpdateCustomKeyStoreWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		}
 	}
 
-	if d.HasChange("key_store_password") {
-		in.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
-		update = true
+	if connected != wantConnected {
+		var err error
+		if wantConnected {
+			err = connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
+			return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		}
 	}
 
-	if d.HasChange("custom_key_store_name") {
-		in.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
-		update = true
+	return resourceCustomKeyStoreRead(ctx, d, meta)
+}
+
+func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	// A custom key store must be disconnected before it can be deleted.
+	if state := d.Get("connection_state").(string); state != "" && state != kms.ConnectionStateTypeDisconnected {
+		if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+				return nil
+			}
+
+			return create.DiagError(names.KMS, create.ErrActionDeleting, ResNameCustomKeyStore, d.Id(), err)
+		}
 	}
 
-	if !update {
+	log.Printf("[INFO] Deleting KMS CustomKeyStore %s", d.Id())
+
+	_, err := conn.DeleteCustomKeyStoreWithContext(ctx, &kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException, kms.ErrCodeCustomKeyStoreNotFoundException) {
 		return nil
 	}
 
-	_, err := conn.UpdateCustomKeyStoreWithContext(ctx, in)
 	if err != nil {
-		return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		return create.DiagError(names.KMS, create.ErrActionDeleting, ResNameCustomKeyStore, d.Id(), err)
 	}
 
-	return resourceCustomKeyStoreRead(ctx, d, meta)
+	return nil
 }
 
-func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).KMSConn
+func connectCustomKeyStore(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) error {
+	_, err := conn.ConnectCustomKeyStoreWithContext(ctx, &kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
 
-	log.Printf("[INFO] Deleting KMS CustomKeyStore %s", d.Id())
+	if err != nil {
+		return fmt.Errorf("connecting: %w", err)
+	}
 
-	_, err := conn.DeleteCustomKeyStoreWithContext(ctx, &kms.DeleteCustomKeyStoreInput{
-		CustomKeyStoreId: aws.String(d.Id()),
+	if _, err := WaitCustomKeyStoreConnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for connection: %w", err)
+	}
+
+	return nil
+}
+
+func disconnectCustomKeyStore(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) error {
+	_, err := conn.DisconnectCustomKeyStoreWithContext(ctx, &kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
 	})
 
-	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
-		return nil
+	if err != nil {
+		return fmt.Errorf("disconnecting: %w", err)
+	}
+
+	if _, err := WaitCustomKeyStoreDisconnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for disconnection: %w", err)
 	}
 
 	return nil
 }
+
+func expandXksProxyAuthenticationCredential(tfMap map[string]interface{}) *kms.XksProxyAuthenticationCredentialType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kms.XksProxyAuthenticationCredentialType{}
+
+	if v, ok := tfMap["access_key_id"].(string); ok && v != "" {
+		apiObject.AccessKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_secret_access_key"].(string); ok && v != "" {
+		apiObject.RawSecretAccessKey = aws.String(v)
+	}
+
+	return apiObject
+}
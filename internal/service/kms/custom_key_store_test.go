@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -151,6 +152,83 @@ func testAccCustomKeyStore_disappears(t *testing.T) {
 	})
 }
 
+func testAccCustomKeyStore_externalKeyStore(t *testing.T) {
+	for _, k := range []string{"XKS_PROXY_URI_ENDPOINT", "XKS_PROXY_URI_PATH", "XKS_PROXY_ACCESS_KEY_ID", "XKS_PROXY_SECRET_ACCESS_KEY"} {
+		if os.Getenv(k) == "" {
+			t.Skipf("%s environment variable not set", k)
+		}
+	}
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var customkeystore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	uriEndpoint := os.Getenv("XKS_PROXY_URI_ENDPOINT")
+	uriPath := os.Getenv("XKS_PROXY_URI_PATH")
+	accessKeyID := os.Getenv("XKS_PROXY_ACCESS_KEY_ID")
+	secretAccessKey := os.Getenv("XKS_PROXY_SECRET_ACCESS_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(kms.EndpointsID, t)
+			testAccCustomKeyStoresPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", kms.CustomKeyStoreTypeExternalKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", kms.XksProxyConnectivityTypePublicEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", uriEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", uriPath),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"xks_proxy_authentication_credential"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomKeyStore_externalKeyStoreMissingProxy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomKeyStoreConfig_externalKeyStoreMissingProxy(rName),
+				ExpectError: regexp.MustCompile(`"xks_proxy_authentication_credential" is required`),
+			},
+		},
+	})
+}
+
 func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
 	ctx := context.Background()
@@ -231,3 +309,35 @@ resource "aws_kms_custom_key_store" "test" {
 }
 `, rName, clusterId, anchorCertificate)
 }
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+  connected             = %[6]t
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey, connected)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreMissingProxy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = "https://example.com"
+  xks_proxy_uri_path     = "/example/kms/xks/v1"
+}
+`, rName)
+}
@@ -1,6 +1,8 @@
 package kms
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,3 +24,21 @@ func StatusKeyState(conn *kms.KMS, id string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.KeyState), nil
 	}
 }
+
+func StatusCustomKeyStoreConnectionState(ctx context.Context, conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomKeyStoreByID(ctx, conn, &kms.DescribeCustomKeyStoresInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
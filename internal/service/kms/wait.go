@@ -1,6 +1,8 @@
 package kms
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -246,3 +248,41 @@ func WaitReplicaKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 
 	return nil, err
 }
+
+func WaitCustomKeyStoreConnected(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnecting},
+		Target:  []string{kms.ConnectionStateTypeConnected},
+		Refresh: StatusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		if state := aws.StringValue(output.ConnectionState); state == kms.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomKeyStoreDisconnected(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeDisconnecting},
+		Target:  []string{kms.ConnectionStateTypeDisconnected},
+		Refresh: StatusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
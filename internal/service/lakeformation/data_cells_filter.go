@@ -0,0 +1,361 @@
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameDataCellsFilter = "Data Cells Filter"
+)
+
+func ResourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataCellsFilterCreate,
+		ReadWithoutTimeout:   resourceDataCellsFilterRead,
+		UpdateWithoutTimeout: resourceDataCellsFilterUpdate,
+		DeleteWithoutTimeout: resourceDataCellsFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"column_wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"row_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_rows_wildcard": {
+										Type:         schema.TypeBool,
+										Optional:     true,
+										ExactlyOneOf: []string{"table_data.0.row_filter.0.all_rows_wildcard", "table_data.0.row_filter.0.filter_expression"},
+									},
+									"filter_expression": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ExactlyOneOf: []string{"table_data.0.row_filter.0.all_rows_wildcard", "table_data.0.row_filter.0.filter_expression"},
+									},
+								},
+							},
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataCellsFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	tableData := expandDataCellsFilter(d.Get("table_data").([]interface{})[0].(map[string]interface{}))
+
+	if tableData.TableCatalogId == nil {
+		tableData.TableCatalogId = aws.String(meta.(*conns.AWSClient).AccountID)
+	}
+
+	input := &lakeformation.CreateDataCellsFilterInput{
+		TableData: tableData,
+	}
+
+	_, err := conn.CreateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionCreating, ResNameDataCellsFilter, aws.StringValue(tableData.Name), err)
+	}
+
+	d.SetId(DataCellsFilterCreateResourceID(aws.StringValue(tableData.TableCatalogId), aws.StringValue(tableData.DatabaseName), aws.StringValue(tableData.TableName), aws.StringValue(tableData.Name)))
+
+	return resourceDataCellsFilterRead(ctx, d, meta)
+}
+
+func resourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, databaseName, tableName, name, err := DataCellsFilterParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	filter, err := FindDataCellsFilterByID(ctx, conn, catalogID, databaseName, tableName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.LakeFormation, create.ErrActionReading, ResNameDataCellsFilter, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	if err := d.Set("table_data", []interface{}{flattenDataCellsFilter(filter)}); err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionSetting, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDataCellsFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	tableData := expandDataCellsFilter(d.Get("table_data").([]interface{})[0].(map[string]interface{}))
+	tableData.VersionId = aws.String(d.Get("table_data.0.version_id").(string))
+
+	input := &lakeformation.UpdateDataCellsFilterInput{
+		TableData: tableData,
+	}
+
+	_, err := conn.UpdateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionUpdating, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	return resourceDataCellsFilterRead(ctx, d, meta)
+}
+
+func resourceDataCellsFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, databaseName, tableName, name, err := DataCellsFilterParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionDeleting, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation Data Cells Filter: %s", d.Id())
+	_, err = conn.DeleteDataCellsFilterWithContext(ctx, &lakeformation.DeleteDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(catalogID),
+		TableName:      aws.String(tableName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionDeleting, ResNameDataCellsFilter, d.Id(), err)
+	}
+
+	return nil
+}
+
+const dataCellsFilterResourceIDSeparator = ","
+
+func DataCellsFilterCreateResourceID(catalogID, databaseName, tableName, name string) string {
+	parts := []string{catalogID, databaseName, tableName, name}
+	id := strings.Join(parts, dataCellsFilterResourceIDSeparator)
+
+	return id
+}
+
+func DataCellsFilterParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, dataCellsFilterResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TABLE-CATALOG-ID%[2]sDATABASE-NAME%[2]sTABLE-NAME%[2]sNAME", id, dataCellsFilterResourceIDSeparator)
+}
+
+func expandDataCellsFilter(tfMap map[string]interface{}) *lakeformation.DataCellsFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataCellsFilter{}
+
+	if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ColumnNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["column_wildcard"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.ColumnWildcard.ExcludedColumnNames = flex.ExpandStringSet(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["row_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RowFilter = expandRowFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table_catalog_id"].(string); ok && v != "" {
+		apiObject.TableCatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRowFilter(tfMap map[string]interface{}) *lakeformation.RowFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.RowFilter{}
+
+	if v, ok := tfMap["all_rows_wildcard"].(bool); ok && v {
+		apiObject.AllRowsWildcard = &lakeformation.AllRowsWildcard{}
+	}
+
+	if v, ok := tfMap["filter_expression"].(string); ok && v != "" {
+		apiObject.FilterExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataCellsFilter(apiObject *lakeformation.DataCellsFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ColumnNames; v != nil {
+		tfMap["column_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ColumnWildcard; v != nil {
+		tfMap["column_wildcard"] = []interface{}{
+			map[string]interface{}{
+				"excluded_column_names": aws.StringValueSlice(v.ExcludedColumnNames),
+			},
+		}
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RowFilter; v != nil {
+		tfMap["row_filter"] = []interface{}{flattenRowFilter(v)}
+	}
+
+	if v := apiObject.TableCatalogId; v != nil {
+		tfMap["table_catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VersionId; v != nil {
+		tfMap["version_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRowFilter(apiObject *lakeformation.RowFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllRowsWildcard; v != nil {
+		tfMap["all_rows_wildcard"] = true
+	}
+
+	if v := apiObject.FilterExpression; v != nil {
+		tfMap["filter_expression"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,210 @@
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDataCellsFilter_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "event = 'start'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "event = 'start'"),
+					acctest.CheckResourceAttrAccountID(resourceName, "table_data.0.table_catalog_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "table_data.0.version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "event = 'start'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "event = 'start'"),
+				),
+			},
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "event = 'stop'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "event = 'stop'"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "event = 'start'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tflakeformation.ResourceDataCellsFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataCellsFilterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_data_cells_filter" {
+			continue
+		}
+
+		catalogID, databaseName, tableName, name, err := tflakeformation.DataCellsFilterParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflakeformation.FindDataCellsFilterByID(context.Background(), conn, catalogID, databaseName, tableName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lake Formation Data Cells Filter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataCellsFilterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Data Cells Filter ID is set")
+		}
+
+		catalogID, databaseName, tableName, name, err := tflakeformation.DataCellsFilterParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+		_, err = tflakeformation.FindDataCellsFilterByID(context.Background(), conn, catalogID, databaseName, tableName, name)
+
+		return err
+	}
+}
+
+func testAccDataCellsFilterConfig_basic(rName, filterExpression string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+
+resource "aws_lakeformation_data_cells_filter" "test" {
+  table_data {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = %[1]q
+    table_name    = aws_glue_catalog_table.test.name
+    column_names  = ["event", "value"]
+
+    row_filter {
+      filter_expression = %[2]q
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, filterExpression)
+}
@@ -0,0 +1,193 @@
+package lakeformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameEffectivePermissions = "Effective Permissions Data Source"
+)
+
+func DataSourceEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEffectivePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_location": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"catalog_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"database": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &lakeformation.GetEffectivePermissionsForPathInput{
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.GetEffectivePermissionsForPathPagesWithContext(ctx, input, func(page *lakeformation.GetEffectivePermissionsForPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil {
+				permissions = append(permissions, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, DSNameEffectivePermissions, resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	if err := d.Set("permissions", flattenEffectivePermissions(permissions)); err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionSetting, DSNameEffectivePermissions, d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenEffectivePermissions(apiObjects []*lakeformation.PrincipalResourcePermissions) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"permissions":                   aws.StringValueSlice(apiObject.Permissions),
+			"permissions_with_grant_option": aws.StringValueSlice(apiObject.PermissionsWithGrantOption),
+		}
+
+		if v := apiObject.Principal; v != nil {
+			tfMap["principal"] = aws.StringValue(v.DataLakePrincipalIdentifier)
+		}
+
+		if v := apiObject.Resource; v != nil {
+			if v.DataLocation != nil {
+				tfMap["data_location"] = []interface{}{flattenDataLocationResource(v.DataLocation)}
+			}
+
+			if v.Database != nil {
+				tfMap["database"] = []interface{}{flattenDatabaseResource(v.Database)}
+			}
+
+			if v.Table != nil {
+				tfMap["table"] = []interface{}{flattenTableResource(v.Table)}
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
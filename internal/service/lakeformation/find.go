@@ -0,0 +1,84 @@
+package lakeformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDataCellsFilterByID(ctx context.Context, conn *lakeformation.LakeFormation, catalogID, databaseName, tableName, name string) (*lakeformation.DataCellsFilter, error) {
+	input := &lakeformation.GetDataCellsFilterInput{
+		DatabaseName:   aws.String(databaseName),
+		Name:           aws.String(name),
+		TableCatalogId: aws.String(catalogID),
+		TableName:      aws.String(tableName),
+	}
+
+	output, err := conn.GetDataCellsFilterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataCellsFilter == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataCellsFilter, nil
+}
+
+func FindOptIn(ctx context.Context, conn *lakeformation.LakeFormation, principal *lakeformation.DataLakePrincipal, res *lakeformation.Resource) (*lakeformation.LakeFormationOptInsInfo, error) {
+	input := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: principal,
+		Resource:  res,
+	}
+	var output *lakeformation.LakeFormationOptInsInfo
+
+	err := conn.ListLakeFormationOptInsPagesWithContext(ctx, input, func(page *lakeformation.ListLakeFormationOptInsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v == nil || v.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Principal.DataLakePrincipalIdentifier) == aws.StringValue(principal.DataLakePrincipalIdentifier) {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -6,12 +6,24 @@ import (
 
 func TestAccLakeFormation_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":      testAccDataCellsFilter_basic,
+			"disappears": testAccDataCellsFilter_disappears,
+			"update":     testAccDataCellsFilter_update,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"dataSource":       testAccDataLakeSettingsDataSource_basic,
 			"disappears":       testAccDataLakeSettings_disappears,
 			"withoutCatalogId": testAccDataLakeSettings_withoutCatalogID,
 		},
+		"EffectivePermissionsDataSource": {
+			"basic": testAccEffectivePermissionsDataSource_basic,
+		},
+		"OptIn": {
+			"basic":      testAccOptIn_basic,
+			"disappears": testAccOptIn_disappears,
+		},
 		"PermissionsBasic": {
 			"basic":              testAccPermissions_basic,
 			"database":           testAccPermissions_database,
@@ -20,6 +32,7 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"dataLocation":       testAccPermissions_dataLocation,
 			"disappears":         testAccPermissions_disappears,
 			"lfTag":              testAccPermissions_lfTag,
+			"lfTagExpression":    testAccPermissions_lfTagExpression,
 			"lfTagPolicy":        testAccPermissions_lfTagPolicy,
 		},
 		"PermissionsDataSource": {
@@ -54,6 +67,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"disappears": testAccLFTag_disappears,
 			"values":     testAccLFTag_values,
 		},
+		"LFTagExpression": {
+			"basic":      testAccLFTagExpression_basic,
+			"disappears": testAccLFTagExpression_disappears,
+			"update":     testAccLFTagExpression_update,
+		},
 		"ResourceLFTags": {
 			"basic":            testAccResourceLFTags_basic,
 			"database":         testAccResourceLFTags_database,
@@ -0,0 +1,274 @@
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameLFTagExpression = "LF-Tag Expression"
+)
+
+func ResourceLFTagExpression() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLFTagExpressionCreate,
+		ReadWithoutTimeout:   resourceLFTagExpressionRead,
+		UpdateWithoutTimeout: resourceLFTagExpressionUpdate,
+		DeleteWithoutTimeout: resourceLFTagExpressionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"expression": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateLFTagValues(),
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceLFTagExpressionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+
+	catalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	name := d.Get("name").(string)
+	input := &lakeformation_sdkv2.CreateLFTagExpressionInput{
+		CatalogId:  aws.String(catalogID),
+		Expression: expandLFTagExpressionSDKv2(d.Get("expression").(*schema.Set)),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateLFTagExpression(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionCreating, ResNameLFTagExpression, name, err)
+	}
+
+	d.SetId(LFTagExpressionCreateResourceID(catalogID, name))
+
+	return resourceLFTagExpressionRead(ctx, d, meta)
+}
+
+func resourceLFTagExpressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+
+	catalogID, name, err := LFTagExpressionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	output, err := FindLFTagExpressionByID(ctx, conn, catalogID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.LakeFormation, create.ErrActionReading, ResNameLFTagExpression, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	if err := d.Set("expression", flattenLFTagExpressionSDKv2(output.Expression)); err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionSetting, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceLFTagExpressionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+
+	catalogID, name, err := LFTagExpressionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionUpdating, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	// The expression is always required, the description is replaced with the configured value.
+	input := &lakeformation_sdkv2.UpdateLFTagExpressionInput{
+		CatalogId:   aws.String(catalogID),
+		Description: aws.String(d.Get("description").(string)),
+		Expression:  expandLFTagExpressionSDKv2(d.Get("expression").(*schema.Set)),
+		Name:        aws.String(name),
+	}
+
+	_, err = conn.UpdateLFTagExpression(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionUpdating, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	return resourceLFTagExpressionRead(ctx, d, meta)
+}
+
+func resourceLFTagExpressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+
+	catalogID, name, err := LFTagExpressionParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionDeleting, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation LF-Tag Expression: %s", d.Id())
+	_, err = conn.DeleteLFTagExpression(ctx, &lakeformation_sdkv2.DeleteLFTagExpressionInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	})
+
+	if errs.IsA[*types.EntityNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionDeleting, ResNameLFTagExpression, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindLFTagExpressionByID(ctx context.Context, conn *lakeformation_sdkv2.Client, catalogID, name string) (*lakeformation_sdkv2.GetLFTagExpressionOutput, error) {
+	input := &lakeformation_sdkv2.GetLFTagExpressionInput{
+		CatalogId: aws.String(catalogID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetLFTagExpression(ctx, input)
+
+	if errs.IsA[*types.EntityNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const lfTagExpressionResourceIDSeparator = ","
+
+func LFTagExpressionCreateResourceID(catalogID, name string) string {
+	parts := []string{catalogID, name}
+	id := strings.Join(parts, lfTagExpressionResourceIDSeparator)
+
+	return id
+}
+
+func LFTagExpressionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, lfTagExpressionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CATALOG-ID%[2]sNAME", id, lfTagExpressionResourceIDSeparator)
+}
+
+func expandLFTagExpressionSDKv2(tfSet *schema.Set) []types.LFTag {
+	var apiObjects []types.LFTag
+
+	for _, tfMapRaw := range tfSet.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.LFTag{
+			TagKey:    aws.String(tfMap["key"].(string)),
+			TagValues: flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLFTagExpressionSDKv2(apiObjects []types.LFTag) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"key":    aws.ToString(apiObject.TagKey),
+			"values": flex.FlattenStringValueSet(apiObject.TagValues),
+		})
+	}
+
+	return tfList
+}
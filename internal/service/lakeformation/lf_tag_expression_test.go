@@ -0,0 +1,204 @@
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccLFTagExpression_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "expression.*", map[string]string{
+						"key":      rName,
+						"values.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLFTagExpression_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "expression.*.values.*", "value1"),
+				),
+			},
+			{
+				Config: testAccLFTagExpressionConfig_description(rName, "value2", "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "expression.*.values.*", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLFTagExpression_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tflakeformation.ResourceLFTagExpression(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLFTagExpressionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_lf_tag_expression" {
+			continue
+		}
+
+		catalogID, name, err := tflakeformation.LFTagExpressionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflakeformation.FindLFTagExpressionByID(context.Background(), conn, catalogID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lake Formation LF-Tag Expression %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLFTagExpressionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation LF-Tag Expression ID is set")
+		}
+
+		catalogID, name, err := tflakeformation.LFTagExpressionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient()
+
+		_, err = tflakeformation.FindLFTagExpressionByID(context.Background(), conn, catalogID, name)
+
+		return err
+	}
+}
+
+func testAccLFTagExpressionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccLFTagExpressionConfig_basic(rName, value string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_lf_tag_expression" "test" {
+  name = %[1]q
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = [%[2]q]
+  }
+}
+`, rName, value))
+}
+
+func testAccLFTagExpressionConfig_description(rName, value, description string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_lf_tag_expression" "test" {
+  name        = %[1]q
+  description = %[3]q
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = [%[2]q]
+  }
+}
+`, rName, value, description))
+}
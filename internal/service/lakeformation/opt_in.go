@@ -0,0 +1,311 @@
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameOptIn = "Opt In"
+)
+
+func ResourceOptIn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptInCreate,
+		ReadWithoutTimeout:   resourceOptInRead,
+		DeleteWithoutTimeout: resourceOptInDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_location": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"data_location", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+						"wildcard": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							Default:      false,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"table.0.name", "table.0.wildcard"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOptInCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandOptInResource(d),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateLakeFormationOptInWithContext(ctx, input)
+	}, lakeformation.ErrCodeInvalidInputException, "Invalid principal")
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionCreating, ResNameOptIn, d.Get("principal").(string), err)
+	}
+
+	d.SetId(OptInCreateResourceID(d.Get("principal").(string), input.Resource))
+
+	return resourceOptInRead(ctx, d, meta)
+}
+
+func resourceOptInRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	principalID, res, err := OptInParseResourceID(d.Id())
+
+	// Opt-ins created before the resource could be imported are identified by a hash.
+	if err != nil && d.Get("principal").(string) != "" {
+		principalID, res = d.Get("principal").(string), expandOptInResource(d)
+		d.SetId(OptInCreateResourceID(principalID, res))
+	} else if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameOptIn, d.Id(), err)
+	}
+
+	principal := &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(principalID),
+	}
+
+	output, err := FindOptIn(ctx, conn, principal, res)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.LakeFormation, create.ErrActionReading, ResNameOptIn, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionReading, ResNameOptIn, d.Id(), err)
+	}
+
+	d.Set("principal", principalID)
+	d.Set("data_location", nil)
+	d.Set("database", nil)
+	d.Set("table", nil)
+	switch {
+	case res.DataLocation != nil:
+		if err := d.Set("data_location", []interface{}{flattenDataLocationResource(res.DataLocation)}); err != nil {
+			return create.DiagSettingError(names.LakeFormation, ResNameOptIn, d.Id(), "data_location", err)
+		}
+	case res.Database != nil:
+		if err := d.Set("database", []interface{}{flattenDatabaseResource(res.Database)}); err != nil {
+			return create.DiagSettingError(names.LakeFormation, ResNameOptIn, d.Id(), "database", err)
+		}
+	case res.Table != nil:
+		if err := d.Set("table", []interface{}{flattenTableResource(res.Table)}); err != nil {
+			return create.DiagSettingError(names.LakeFormation, ResNameOptIn, d.Id(), "table", err)
+		}
+	}
+	if output.LastModified != nil {
+		d.Set("last_modified", output.LastModified.Format(time.RFC3339))
+	}
+	d.Set("last_updated_by", output.LastUpdatedBy)
+
+	return nil
+}
+
+func resourceOptInDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	log.Printf("[INFO] Deleting Lake Formation Opt In: %s", d.Id())
+	_, err := conn.DeleteLakeFormationOptInWithContext(ctx, &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandOptInResource(d),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.LakeFormation, create.ErrActionDeleting, ResNameOptIn, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOptInResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+const optInResourceIDSeparator = ","
+
+// OptInCreateResourceID returns the ID of an opt-in, built from its principal and resource:
+// PRINCIPAL,DATA_LOCATION,CATALOG-ID,ARN, PRINCIPAL,DATABASE,CATALOG-ID,NAME or PRINCIPAL,TABLE,CATALOG-ID,DATABASE-NAME,NAME.
+// The catalog ID may be empty; a table wildcard is written as ALL_TABLES.
+func OptInCreateResourceID(principal string, res *lakeformation.Resource) string {
+	var parts []string
+
+	switch {
+	case res.DataLocation != nil:
+		parts = []string{principal, lakeformation.DataLakeResourceTypeDataLocation, aws.StringValue(res.DataLocation.CatalogId), aws.StringValue(res.DataLocation.ResourceArn)}
+	case res.Database != nil:
+		parts = []string{principal, lakeformation.DataLakeResourceTypeDatabase, aws.StringValue(res.Database.CatalogId), aws.StringValue(res.Database.Name)}
+	case res.Table != nil:
+		name := aws.StringValue(res.Table.Name)
+		if res.Table.TableWildcard != nil {
+			name = TableNameAllTables
+		}
+		parts = []string{principal, lakeformation.DataLakeResourceTypeTable, aws.StringValue(res.Table.CatalogId), aws.StringValue(res.Table.DatabaseName), name}
+	}
+
+	return strings.Join(parts, optInResourceIDSeparator)
+}
+
+func OptInParseResourceID(id string) (string, *lakeformation.Resource, error) {
+	parts := strings.Split(id, optInResourceIDSeparator)
+	res := &lakeformation.Resource{}
+
+	var catalogID *string
+	if len(parts) > 2 && parts[2] != "" {
+		catalogID = aws.String(parts[2])
+	}
+
+	switch {
+	case len(parts) == 4 && parts[0] != "" && parts[1] == lakeformation.DataLakeResourceTypeDataLocation && parts[3] != "":
+		res.DataLocation = &lakeformation.DataLocationResource{
+			CatalogId:   catalogID,
+			ResourceArn: aws.String(parts[3]),
+		}
+	case len(parts) == 4 && parts[0] != "" && parts[1] == lakeformation.DataLakeResourceTypeDatabase && parts[3] != "":
+		res.Database = &lakeformation.DatabaseResource{
+			CatalogId: catalogID,
+			Name:      aws.String(parts[3]),
+		}
+	case len(parts) == 5 && parts[0] != "" && parts[1] == lakeformation.DataLakeResourceTypeTable && parts[3] != "" && parts[4] != "":
+		res.Table = &lakeformation.TableResource{
+			CatalogId:    catalogID,
+			DatabaseName: aws.String(parts[3]),
+		}
+		if parts[4] == TableNameAllTables {
+			res.Table.TableWildcard = &lakeformation.TableWildcard{}
+		} else {
+			res.Table.Name = aws.String(parts[4])
+		}
+	default:
+		return "", nil, fmt.Errorf("unexpected format for ID (%[1]s), expected PRINCIPAL%[2]sDATA_LOCATION%[2]sCATALOG-ID%[2]sARN, PRINCIPAL%[2]sDATABASE%[2]sCATALOG-ID%[2]sNAME or PRINCIPAL%[2]sTABLE%[2]sCATALOG-ID%[2]sDATABASE-NAME%[2]sNAME", id, optInResourceIDSeparator)
+	}
+
+	return parts[0], res, nil
+}
@@ -177,10 +177,11 @@ func ResourcePermissions() *schema.Resource {
 							ValidateFunc: verify.ValidAccountID,
 						},
 						"expression": {
-							Type:     schema.TypeList,
-							Required: true,
-							MinItems: 1,
-							MaxItems: 5,
+							Type:         schema.TypeList,
+							Optional:     true,
+							MinItems:     1,
+							MaxItems:     5,
+							ExactlyOneOf: []string{"lf_tag_policy.0.expression", "lf_tag_policy.0.expression_name"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"key": {
@@ -192,7 +193,7 @@ func ResourcePermissions() *schema.Resource {
 										Type:     schema.TypeSet,
 										Required: true,
 										MinItems: 1,
-										MaxItems: 15,
+										MaxItems: 50,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
 											ValidateFunc: validateLFTagValues(),
@@ -202,6 +203,12 @@ func ResourcePermissions() *schema.Resource {
 								},
 							},
 						},
+						"expression_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+							ExactlyOneOf: []string{"lf_tag_policy.0.expression", "lf_tag_policy.0.expression_name"},
+						},
 						"resource_type": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -375,6 +382,10 @@ func ResourcePermissions() *schema.Resource {
 // returns.
 
 func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("lf_tag_policy.0.expression_name"); ok {
+		return resourcePermissionsCreateLFTagExpression(d, meta)
+	}
+
 	conn := meta.(*conns.AWSClient).LakeFormationConn
 
 	input := &lakeformation.GrantPermissionsInput{
@@ -465,6 +476,10 @@ func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("lf_tag_policy.0.expression_name"); ok {
+		return resourcePermissionsReadLFTagExpression(d, meta)
+	}
+
 	conn := meta.(*conns.AWSClient).LakeFormationConn
 
 	input := &lakeformation.ListPermissionsInput{
@@ -676,6 +691,10 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("lf_tag_policy.0.expression_name"); ok {
+		return resourcePermissionsDeleteLFTagExpression(d, meta)
+	}
+
 	conn := meta.(*conns.AWSClient).LakeFormationConn
 
 	input := &lakeformation.RevokePermissionsInput{
@@ -0,0 +1,304 @@
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Permissions granted on a named LF-Tag expression (lf_tag_policy.0.expression_name) are only
+// supported by the AWS SDK for Go v2 Lake Formation client, so they are handled separately from
+// the other resource types.
+
+func resourcePermissionsCreateLFTagExpression(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+	ctx := context.TODO()
+
+	input := &lakeformation_sdkv2.GrantPermissionsInput{
+		Permissions: expandPermissionsSDKv2(d.Get("permissions").([]interface{})),
+		Principal: &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &types.Resource{
+			LFTagPolicy: expandLFTagExpressionPolicyResource(d.Get("lf_tag_policy").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandPermissionsSDKv2(v.([]interface{}))
+	}
+
+	_, err := tfresource.RetryWhenContext(ctx, IAMPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.GrantPermissions(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.MessageContains(err, "InvalidInputException", "Invalid principal") ||
+				errs.MessageContains(err, "InvalidInputException", "Grantee has no permissions") ||
+				errs.MessageContains(err, "AccessDeniedException", "is not authorized to access requested permissions") ||
+				errs.IsA[*types.ConcurrentModificationException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation Permissions (LF-Tag expression %s): %w", aws.ToString(input.Resource.LFTagPolicy.ExpressionName), err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(permissionsLFTagExpressionHashInput(input))))
+
+	return resourcePermissionsReadLFTagExpression(d, meta)
+}
+
+func resourcePermissionsReadLFTagExpression(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+	ctx := context.TODO()
+
+	input := &lakeformation_sdkv2.ListPermissionsInput{
+		Principal: &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &types.Resource{
+			LFTagPolicy: expandLFTagExpressionPolicyResource(d.Get("lf_tag_policy").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, permissionsReadyTimeout, func() (interface{}, error) {
+		return FindPermissionsByLFTagExpression(ctx, conn, input)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation permissions (%s): %w", d.Id(), err)
+	}
+
+	permissions := outputRaw.([]types.PrincipalResourcePermissions)
+
+	d.Set("catalog_resource", false)
+	d.Set("data_location", nil)
+	d.Set("database", nil)
+	d.Set("lf_tag", nil)
+	d.Set("principal", permissions[0].Principal.DataLakePrincipalIdentifier)
+	d.Set("permissions", flattenPermissionsSDKv2(permissions, false))
+	d.Set("permissions_with_grant_option", flattenPermissionsSDKv2(permissions, true))
+	d.Set("table", nil)
+	d.Set("table_with_columns", nil)
+
+	if err := d.Set("lf_tag_policy", []interface{}{flattenLFTagExpressionPolicyResource(permissions[0].Resource.LFTagPolicy)}); err != nil {
+		return fmt.Errorf("error setting lf_tag_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePermissionsDeleteLFTagExpression(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationClient()
+	ctx := context.TODO()
+
+	input := &lakeformation_sdkv2.RevokePermissionsInput{
+		Permissions:                expandPermissionsSDKv2(d.Get("permissions").([]interface{})),
+		PermissionsWithGrantOption: expandPermissionsSDKv2(d.Get("permissions_with_grant_option").([]interface{})),
+		Principal: &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &types.Resource{
+			LFTagPolicy: expandLFTagExpressionPolicyResource(d.Get("lf_tag_policy").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	_, err := tfresource.RetryWhenContext(ctx, permissionsDeleteRetryTimeout,
+		func() (interface{}, error) {
+			return conn.RevokePermissions(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.MessageContains(err, "AccessDeniedException", "is not authorized to access requested permissions") ||
+				errs.IsA[*types.ConcurrentModificationException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if errs.MessageContains(err, "InvalidInputException", "No permissions revoked. Grantee") || errs.IsA[*types.EntityNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to revoke Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPermissionsByLFTagExpression(ctx context.Context, conn *lakeformation_sdkv2.Client, input *lakeformation_sdkv2.ListPermissionsInput) ([]types.PrincipalResourcePermissions, error) {
+	var permissions []types.PrincipalResourcePermissions
+
+	pages := lakeformation_sdkv2.NewListPermissionsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.EntityNotFoundException](err) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v.Principal == nil || aws.ToString(v.Principal.DataLakePrincipalIdentifier) != aws.ToString(input.Principal.DataLakePrincipalIdentifier) {
+				continue
+			}
+
+			if v.Resource == nil || v.Resource.LFTagPolicy == nil {
+				continue
+			}
+
+			if aws.ToString(v.Resource.LFTagPolicy.ExpressionName) != aws.ToString(input.Resource.LFTagPolicy.ExpressionName) {
+				continue
+			}
+
+			if v.Resource.LFTagPolicy.ResourceType != input.Resource.LFTagPolicy.ResourceType {
+				continue
+			}
+
+			permissions = append(permissions, v)
+		}
+	}
+
+	if len(permissions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return permissions, nil
+}
+
+func permissionsLFTagExpressionHashInput(input *lakeformation_sdkv2.GrantPermissionsInput) string {
+	parts := []string{
+		aws.ToString(input.CatalogId),
+		aws.ToString(input.Principal.DataLakePrincipalIdentifier),
+		aws.ToString(input.Resource.LFTagPolicy.CatalogId),
+		aws.ToString(input.Resource.LFTagPolicy.ExpressionName),
+		string(input.Resource.LFTagPolicy.ResourceType),
+	}
+
+	for _, v := range input.Permissions {
+		parts = append(parts, string(v))
+	}
+
+	for _, v := range input.PermissionsWithGrantOption {
+		parts = append(parts, string(v))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func expandLFTagExpressionPolicyResource(tfMap map[string]interface{}) *types.LFTagPolicyResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LFTagPolicyResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["expression_name"].(string); ok && v != "" {
+		apiObject.ExpressionName = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+		apiObject.ResourceType = types.ResourceType(v)
+	}
+
+	return apiObject
+}
+
+func flattenLFTagExpressionPolicyResource(apiObject *types.LFTagPolicyResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resource_type": string(apiObject.ResourceType),
+	}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ExpressionName; v != nil {
+		tfMap["expression_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandPermissionsSDKv2(tfList []interface{}) []types.Permission {
+	var apiObjects []types.Permission
+
+	for _, v := range flex.ExpandStringValueList(tfList) {
+		apiObjects = append(apiObjects, types.Permission(v))
+	}
+
+	return apiObjects
+}
+
+func flattenPermissionsSDKv2(apiObjects []types.PrincipalResourcePermissions, grantOption bool) []string {
+	tfList := make([]string, 0)
+
+	for _, apiObject := range apiObjects {
+		permissions := apiObject.Permissions
+
+		if grantOption {
+			permissions = apiObject.PermissionsWithGrantOption
+		}
+
+		for _, permission := range permissions {
+			tfList = append(tfList, string(permission))
+		}
+	}
+
+	sort.Strings(tfList)
+
+	return tfList
+}
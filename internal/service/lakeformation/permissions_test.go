@@ -1,11 +1,14 @@
 package lakeformation_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"testing"
 
+	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lakeformation"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -270,6 +273,40 @@ func testAccPermissions_lfTagPolicy(t *testing.T) {
 	})
 }
 
+func testAccPermissions_lfTagExpression(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	expressionName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_lfTagExpression(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.resource_type", "DATABASE"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.expression.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag_policy.0.expression_name", expressionName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0", lakeformation.PermissionAlter),
+					resource.TestCheckResourceAttr(resourceName, "permissions.1", lakeformation.PermissionCreateTable),
+					resource.TestCheckResourceAttr(resourceName, "permissions.2", lakeformation.PermissionDrop),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.0", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissions_tableBasic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lakeformation_permissions.test"
@@ -830,6 +867,10 @@ func permissionCountForResource(conn *lakeformation.LakeFormation, rs *terraform
 		noResource = false
 	}
 
+	if v := rs.Primary.Attributes["lf_tag_policy.0.expression_name"]; v != "" {
+		return permissionCountForLFTagExpression(rs)
+	}
+
 	if v, ok := rs.Primary.Attributes["lf_tag_policy.#"]; ok && v != "" && v != "0" {
 		tfMap := map[string]interface{}{}
 
@@ -1016,6 +1057,42 @@ func permissionCountForResource(conn *lakeformation.LakeFormation, rs *terraform
 	return len(cleanPermissions), nil
 }
 
+func permissionCountForLFTagExpression(rs *terraform.ResourceState) (int, error) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient()
+
+	input := &lakeformation_sdkv2.ListPermissionsInput{
+		Principal: &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal"]),
+		},
+		Resource: &types.Resource{
+			LFTagPolicy: &types.LFTagPolicyResource{
+				ExpressionName: aws.String(rs.Primary.Attributes["lf_tag_policy.0.expression_name"]),
+				ResourceType:   types.ResourceType(rs.Primary.Attributes["lf_tag_policy.0.resource_type"]),
+			},
+		},
+	}
+
+	if v := rs.Primary.Attributes["catalog_id"]; v != "" {
+		input.CatalogId = aws.String(v)
+	}
+
+	if v := rs.Primary.Attributes["lf_tag_policy.0.catalog_id"]; v != "" {
+		input.Resource.LFTagPolicy.CatalogId = aws.String(v)
+	}
+
+	permissions, err := tflakeformation.FindPermissionsByLFTagExpression(context.Background(), conn, input)
+
+	if tfresource.NotFound(err) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("acceptance test: error listing Lake Formation Permissions getting permission count: %w", err)
+	}
+
+	return len(permissions), nil
+}
+
 func testAccPermissionsConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -1423,6 +1500,72 @@ resource "aws_lakeformation_permissions" "test" {
 `, rName)
 }
 
+func testAccPermissionsConfig_lfTagExpression(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_lf_tag_expression" "test" {
+  name = %[1]q
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+  principal                     = aws_iam_role.test.arn
+
+  lf_tag_policy {
+    resource_type   = "DATABASE"
+    expression_name = aws_lakeformation_lf_tag_expression.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
 func testAccPermissionsConfig_tableBasic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
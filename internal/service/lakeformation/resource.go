@@ -17,6 +17,7 @@ func ResourceResource() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceCreate,
 		Read:   resourceResourceRead,
+		Update: resourceResourceUpdate,
 		Delete: resourceResourceDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -26,6 +27,11 @@ func ResourceResource() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"hybrid_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"last_modified": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,6 +61,10 @@ func resourceResourceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.UseServiceLinkedRole = aws.Bool(true)
 	}
 
+	if v, ok := d.GetOk("hybrid_access_enabled"); ok {
+		input.HybridAccessEnabled = aws.Bool(v.(bool))
+	}
+
 	_, err := conn.RegisterResource(input)
 
 	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeAlreadyExistsException) {
@@ -92,6 +102,7 @@ func resourceResourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// d.Set("arn", output.ResourceInfo.ResourceArn) // output not including resource arn currently
+	d.Set("hybrid_access_enabled", output.ResourceInfo.HybridAccessEnabled)
 	d.Set("role_arn", output.ResourceInfo.RoleArn)
 	if output.ResourceInfo.LastModified != nil { // output not including last modified currently
 		d.Set("last_modified", output.ResourceInfo.LastModified.Format(time.RFC3339))
@@ -100,6 +111,24 @@ func resourceResourceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.UpdateResourceInput{
+		HybridAccessEnabled: aws.Bool(d.Get("hybrid_access_enabled").(bool)),
+		ResourceArn:         aws.String(d.Get("arn").(string)),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+	}
+
+	_, err := conn.UpdateResource(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation Resource (%s): %w", d.Id(), err)
+	}
+
+	return resourceResourceRead(d, meta)
+}
+
 func resourceResourceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LakeFormationConn
 	resourceArn := d.Get("arn").(string)
@@ -23,6 +23,10 @@ func DataSourceResource() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"hybrid_access_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"last_modified": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,6 +66,7 @@ func dataSourceResourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(input.ResourceArn))
 	// d.Set("arn", output.ResourceInfo.ResourceArn) // output not including resource arn currently
+	d.Set("hybrid_access_enabled", output.ResourceInfo.HybridAccessEnabled)
 	d.Set("role_arn", output.ResourceInfo.RoleArn)
 	if output.ResourceInfo.LastModified != nil { // output not including last modified currently
 		d.Set("last_modified", output.ResourceInfo.LastModified.Format(time.RFC3339))
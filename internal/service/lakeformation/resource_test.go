@@ -160,6 +160,37 @@ func TestAccLakeFormationResource_updateSLRToRole(t *testing.T) {
 	})
 }
 
+func TestAccLakeFormationResource_hybridAccessEnabled(t *testing.T) {
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceAddr := "aws_lakeformation_resource.test"
+	roleAddr := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_hybridAccessEnabled(bucketName, roleName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceAddr),
+					resource.TestCheckResourceAttrPair(resourceAddr, "role_arn", roleAddr, "arn"),
+					resource.TestCheckResourceAttr(resourceAddr, "hybrid_access_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceConfig_hybridAccessEnabled(bucketName, roleName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceAddr),
+					resource.TestCheckResourceAttr(resourceAddr, "hybrid_access_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 // AWS does not support changing from an IAM role to an SLR. No error is thrown
 // but the registration is not changed (the IAM role continues in the registration).
 //
@@ -221,7 +252,7 @@ func isResourceNotFoundErr(err error) bool {
 		"Entity not found")
 }
 
-func testAccResourceConfig_basic(bucket, role string) string {
+func testAccResourceConfig_base(bucket, role string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
@@ -279,12 +310,26 @@ resource "aws_iam_role_policy" "test" {
 }
 EOF
 }
+`, bucket, role)
+}
 
+func testAccResourceConfig_basic(bucket, role string) string {
+	return acctest.ConfigCompose(testAccResourceConfig_base(bucket, role), `
 resource "aws_lakeformation_resource" "test" {
   arn      = aws_s3_bucket.test.arn
   role_arn = aws_iam_role.test.arn
 }
-`, bucket, role)
+`)
+}
+
+func testAccResourceConfig_hybridAccessEnabled(bucket, role string, hybridAccessEnabled bool) string {
+	return acctest.ConfigCompose(testAccResourceConfig_base(bucket, role), fmt.Sprintf(`
+resource "aws_lakeformation_resource" "test" {
+  arn                   = aws_s3_bucket.test.arn
+  role_arn              = aws_iam_role.test.arn
+  hybrid_access_enabled = %[1]t
+}
+`, hybridAccessEnabled))
 }
 
 func testAccResourceConfig_serviceLinkedRole(rName string) string {
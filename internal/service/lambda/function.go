@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"time"
 
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -232,6 +235,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(lambdatypes.RecursiveLoopTerminate),
+				ValidateDiagFunc: enum.Validate[lambdatypes.RecursiveLoop](),
+			},
 			"reserved_concurrent_executions": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -690,6 +699,13 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Recursive loops are terminated by default.
+	if v := lambdatypes.RecursiveLoop(d.Get("recursive_loop").(string)); v != lambdatypes.RecursiveLoopTerminate {
+		if err := putFunctionRecursionConfig(context.Background(), meta.(*conns.AWSClient).LambdaClient(), functionName, v); err != nil {
+			return fmt.Errorf("error setting Lambda Function (%s) recursive loop detection: %w", functionName, err)
+		}
+	}
+
 	return resourceFunctionRead(d, meta)
 }
 
@@ -728,6 +744,16 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("reserved_concurrent_executions", -1)
 	}
 
+	recursionConfig, err := meta.(*conns.AWSClient).LambdaClient().GetFunctionRecursionConfig(context.Background(), &lambda_sdkv2.GetFunctionRecursionConfigInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function (%s) recursive loop detection: %w", d.Id(), err)
+	}
+
+	d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+
 	// Tagging operations are permitted on Lambda functions only.
 	// Tags on aliases and versions are not supported.
 	if !qualifierExistance {
@@ -1292,6 +1318,12 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		if err := putFunctionRecursionConfig(context.Background(), meta.(*conns.AWSClient).LambdaClient(), d.Id(), lambdatypes.RecursiveLoop(d.Get("recursive_loop").(string))); err != nil {
+			return fmt.Errorf("error setting Lambda Function (%s) recursive loop detection: %w", d.Id(), err)
+		}
+	}
+
 	publish := d.Get("publish").(bool)
 	if publish && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		versionReq := &lambda.PublishVersionInput{
@@ -1366,6 +1398,18 @@ func findFunction(conn *lambda.Lambda, input *lambda.GetFunctionInput) (*lambda.
 }
 
 // loadFileContent returns contents of a file in a given path
+func putFunctionRecursionConfig(ctx context.Context, conn *lambda_sdkv2.Client, name string, recursiveLoop lambdatypes.RecursiveLoop) error {
+	input := &lambda_sdkv2.PutFunctionRecursionConfigInput{
+		FunctionName:  aws.String(name),
+		RecursiveLoop: recursiveLoop,
+	}
+
+	log.Printf("[DEBUG] Setting Lambda Function (%s) recursive loop detection: %s", name, recursiveLoop)
+	_, err := conn.PutFunctionRecursionConfig(ctx, input)
+
+	return err
+}
+
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
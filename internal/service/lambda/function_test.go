@@ -298,6 +298,41 @@ func TestAccLambdaFunction_concurrency(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_recursiveLoop(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_recursiveLoop(rName, "Allow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", "Allow"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionConfig_recursiveLoop(rName, "Terminate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", "Terminate"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_concurrencyCycle(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2398,6 +2433,21 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_recursiveLoop(rName, recursiveLoop string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename       = "test-fixtures/lambdatest.zip"
+  function_name  = %[1]q
+  role           = aws_iam_role.iam_for_lambda.arn
+  handler        = "exports.example"
+  runtime        = "nodejs16.x"
+  recursive_loop = %[2]q
+}
+`, rName, recursiveLoop))
+}
+
 func testAccFunctionConfig_concurrencyUpdate(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
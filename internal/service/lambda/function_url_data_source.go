@@ -72,6 +72,10 @@ func DataSourceFunctionURL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"invoke_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"last_modified_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -115,6 +119,7 @@ func dataSourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("function_arn", output.FunctionArn)
 	d.Set("function_name", name)
 	d.Set("function_url", functionURL)
+	d.Set("invoke_mode", output.InvokeMode)
 	d.Set("last_modified_time", output.LastModifiedTime)
 	d.Set("qualifier", qualifier)
 
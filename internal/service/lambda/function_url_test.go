@@ -43,6 +43,7 @@ func TestAccLambdaFunctionURL_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "function_arn"),
 					resource.TestCheckResourceAttr(resourceName, "function_name", funcName),
 					resource.TestCheckResourceAttrSet(resourceName, "function_url"),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeBuffered),
 					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
 				),
@@ -116,6 +117,51 @@ func TestAccLambdaFunctionURL_Cors(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "72000"),
 				),
 			},
+			{
+				Config: testAccFunctionURLConfig_basic(funcName, policyName, roleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, lambda.InvokeModeResponseStream),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeResponseStream),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, lambda.InvokeModeBuffered),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeBuffered),
+				),
+			},
 		},
 	})
 }
@@ -413,6 +459,24 @@ resource "aws_lambda_function_url" "test" {
 `, funcName))
 }
 
+func testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, invokeMode string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+  invoke_mode        = %[2]q
+}
+`, funcName, invokeMode))
+}
+
 func testAccFunctionURLConfig_alias(funcName, aliasName, policyName, roleName string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
@@ -47,6 +50,67 @@ func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"scaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"scheduled_action": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"max_capacity": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"min_capacity": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"schedule": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "UTC",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -74,6 +138,12 @@ func resourceProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta int
 		return fmt.Errorf("error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("scaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putProvisionedConcurrencyScaling(meta.(*conns.AWSClient).AppAutoScalingConn, functionName, qualifier, v.([]interface{})[0].(map[string]interface{}), nil); err != nil {
+			return fmt.Errorf("error configuring Lambda Provisioned Concurrency Config (%s) scaling: %w", d.Id(), err)
+		}
+	}
+
 	return resourceProvisionedConcurrencyConfigRead(d, meta)
 }
 
@@ -104,9 +174,42 @@ func resourceProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta inter
 	}
 
 	d.Set("function_name", functionName)
-	d.Set("provisioned_concurrent_executions", output.AllocatedProvisionedConcurrentExecutions)
 	d.Set("qualifier", qualifier)
 
+	// Scaling registered outside of this resource, e.g. with aws_appautoscaling_target, is left alone.
+	if v, ok := d.GetOk("scaling"); !ok || len(v.([]interface{})) == 0 {
+		d.Set("provisioned_concurrent_executions", output.AllocatedProvisionedConcurrentExecutions)
+
+		return nil
+	}
+
+	appAutoScalingConn := meta.(*conns.AWSClient).AppAutoScalingConn
+	resourceID := provisionedConcurrencyScalingResourceID(functionName, qualifier)
+
+	target, err := tfappautoscaling.FindTargetByThreePartKey(appAutoScalingConn, resourceID, applicationautoscaling.ServiceNamespaceLambda, applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency)
+
+	if tfresource.NotFound(err) {
+		d.Set("provisioned_concurrent_executions", output.AllocatedProvisionedConcurrentExecutions)
+		d.Set("scaling", nil)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Provisioned Concurrency Config (%s) scaling: %w", d.Id(), err)
+	}
+
+	// provisioned_concurrent_executions is not refreshed as Application Auto Scaling adjusts it.
+	scheduledActions, err := findProvisionedConcurrencyScheduledActions(appAutoScalingConn, resourceID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Provisioned Concurrency Config (%s) scheduled actions: %w", d.Id(), err)
+	}
+
+	if err := d.Set("scaling", []interface{}{flattenProvisionedConcurrencyScaling(target, scheduledActions)}); err != nil {
+		return fmt.Errorf("error setting scaling: %w", err)
+	}
+
 	return nil
 }
 
@@ -119,20 +222,44 @@ func resourceProvisionedConcurrencyConfigUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 
-	input := &lambda.PutProvisionedConcurrencyConfigInput{
-		FunctionName:                    aws.String(functionName),
-		ProvisionedConcurrentExecutions: aws.Int64(int64(d.Get("provisioned_concurrent_executions").(int))),
-		Qualifier:                       aws.String(qualifier),
-	}
+	if d.HasChange("provisioned_concurrent_executions") {
+		input := &lambda.PutProvisionedConcurrencyConfigInput{
+			FunctionName:                    aws.String(functionName),
+			ProvisionedConcurrentExecutions: aws.Int64(int64(d.Get("provisioned_concurrent_executions").(int))),
+			Qualifier:                       aws.String(qualifier),
+		}
 
-	_, err = conn.PutProvisionedConcurrencyConfig(input)
+		_, err = conn.PutProvisionedConcurrencyConfig(input)
 
-	if err != nil {
-		return fmt.Errorf("error putting Lambda Provisioned Concurrency Config (%s:%s): %s", functionName, qualifier, err)
+		if err != nil {
+			return fmt.Errorf("error putting Lambda Provisioned Concurrency Config (%s:%s): %s", functionName, qualifier, err)
+		}
+
+		if err := waitForProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
+		}
 	}
 
-	if err := waitForProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return fmt.Errorf("error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
+	if d.HasChange("scaling") {
+		appAutoScalingConn := meta.(*conns.AWSClient).AppAutoScalingConn
+
+		if v, ok := d.GetOk("scaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			var old []interface{}
+
+			if o, _ := d.GetChange("scaling"); len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+				if v, ok := o.([]interface{})[0].(map[string]interface{})["scheduled_action"].(*schema.Set); ok {
+					old = v.List()
+				}
+			}
+
+			if err := putProvisionedConcurrencyScaling(appAutoScalingConn, functionName, qualifier, v.([]interface{})[0].(map[string]interface{}), old); err != nil {
+				return fmt.Errorf("error configuring Lambda Provisioned Concurrency Config (%s) scaling: %w", d.Id(), err)
+			}
+		} else {
+			if err := deleteProvisionedConcurrencyScaling(appAutoScalingConn, functionName, qualifier); err != nil {
+				return fmt.Errorf("error removing Lambda Provisioned Concurrency Config (%s) scaling: %w", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceProvisionedConcurrencyConfigRead(d, meta)
@@ -147,6 +274,12 @@ func resourceProvisionedConcurrencyConfigDelete(d *schema.ResourceData, meta int
 		return err
 	}
 
+	if v, ok := d.GetOk("scaling"); ok && len(v.([]interface{})) > 0 {
+		if err := deleteProvisionedConcurrencyScaling(meta.(*conns.AWSClient).AppAutoScalingConn, functionName, qualifier); err != nil {
+			return fmt.Errorf("error removing Lambda Provisioned Concurrency Config (%s) scaling: %w", d.Id(), err)
+		}
+	}
+
 	input := &lambda.DeleteProvisionedConcurrencyConfigInput{
 		FunctionName: aws.String(functionName),
 		Qualifier:    aws.String(qualifier),
@@ -211,3 +344,209 @@ func waitForProvisionedConcurrencyConfigStatusReady(conn *lambda.Lambda, functio
 
 	return err
 }
+
+// provisionedConcurrencyScalingResourceID returns the Application Auto Scaling resource ID for the function alias or version.
+func provisionedConcurrencyScalingResourceID(functionName, qualifier string) string {
+	return fmt.Sprintf("function:%s:%s", functionName, qualifier)
+}
+
+// putProvisionedConcurrencyScaling registers the function alias or version as a scalable target
+// and reconciles its scheduled actions. Scheduled actions in old that are no longer configured are deleted.
+func putProvisionedConcurrencyScaling(conn *applicationautoscaling.ApplicationAutoScaling, functionName, qualifier string, tfMap map[string]interface{}, old []interface{}) error {
+	resourceID := provisionedConcurrencyScalingResourceID(functionName, qualifier)
+
+	input := &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws.Int64(int64(tfMap["max_capacity"].(int))),
+		MinCapacity:       aws.Int64(int64(tfMap["min_capacity"].(int))),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	}
+
+	log.Printf("[DEBUG] Registering Application Auto Scaling Target: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.RegisterScalableTarget(input)
+	}, applicationautoscaling.ErrCodeObjectNotFoundException)
+
+	if err != nil {
+		return fmt.Errorf("registering Application Auto Scaling Target (%s): %w", resourceID, err)
+	}
+
+	names := make(map[string]struct{})
+	var scheduledActions []interface{}
+
+	if v, ok := tfMap["scheduled_action"].(*schema.Set); ok {
+		scheduledActions = v.List()
+	}
+
+	for _, tfMapRaw := range scheduledActions {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		input := expandProvisionedConcurrencyScheduledAction(tfMap)
+		input.ResourceId = aws.String(resourceID)
+		input.ScalableDimension = aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency)
+		input.ServiceNamespace = aws.String(applicationautoscaling.ServiceNamespaceLambda)
+
+		name := aws.StringValue(input.ScheduledActionName)
+		names[name] = struct{}{}
+
+		log.Printf("[DEBUG] Putting Application Auto Scaling Scheduled Action: %s", input)
+		if _, err := conn.PutScheduledAction(input); err != nil {
+			return fmt.Errorf("putting Application Auto Scaling Scheduled Action (%s): %w", name, err)
+		}
+	}
+
+	for _, tfMapRaw := range old {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := names[name]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Application Auto Scaling Scheduled Action: %s", name)
+		_, err := conn.DeleteScheduledAction(&applicationautoscaling.DeleteScheduledActionInput{
+			ResourceId:          aws.String(resourceID),
+			ScalableDimension:   aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+			ScheduledActionName: aws.String(name),
+			ServiceNamespace:    aws.String(applicationautoscaling.ServiceNamespaceLambda),
+		})
+
+		if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting Application Auto Scaling Scheduled Action (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteProvisionedConcurrencyScaling deregisters the scalable target, which also removes its scheduled actions.
+func deleteProvisionedConcurrencyScaling(conn *applicationautoscaling.ApplicationAutoScaling, functionName, qualifier string) error {
+	resourceID := provisionedConcurrencyScalingResourceID(functionName, qualifier)
+
+	log.Printf("[DEBUG] Deregistering Application Auto Scaling Target: %s", resourceID)
+	_, err := conn.DeregisterScalableTarget(&applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering Application Auto Scaling Target (%s): %w", resourceID, err)
+	}
+
+	return nil
+}
+
+func findProvisionedConcurrencyScheduledActions(conn *applicationautoscaling.ApplicationAutoScaling, resourceID string) ([]*applicationautoscaling.ScheduledAction, error) {
+	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	}
+	var output []*applicationautoscaling.ScheduledAction
+
+	err := conn.DescribeScheduledActionsPages(input, func(page *applicationautoscaling.DescribeScheduledActionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScheduledActions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandProvisionedConcurrencyScheduledAction(tfMap map[string]interface{}) *applicationautoscaling.PutScheduledActionInput {
+	apiObject := &applicationautoscaling.PutScheduledActionInput{
+		ScalableTargetAction: &applicationautoscaling.ScalableTargetAction{
+			MaxCapacity: aws.Int64(int64(tfMap["max_capacity"].(int))),
+			MinCapacity: aws.Int64(int64(tfMap["min_capacity"].(int))),
+		},
+		Schedule:            aws.String(tfMap["schedule"].(string)),
+		ScheduledActionName: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.EndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenProvisionedConcurrencyScaling(target *applicationautoscaling.ScalableTarget, scheduledActions []*applicationautoscaling.ScheduledAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"max_capacity": aws.Int64Value(target.MaxCapacity),
+		"min_capacity": aws.Int64Value(target.MinCapacity),
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range scheduledActions {
+		tfList = append(tfList, flattenProvisionedConcurrencyScheduledAction(apiObject))
+	}
+
+	tfMap["scheduled_action"] = tfList
+
+	return tfMap
+}
+
+func flattenProvisionedConcurrencyScheduledAction(apiObject *applicationautoscaling.ScheduledAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":     aws.StringValue(apiObject.ScheduledActionName),
+		"schedule": aws.StringValue(apiObject.Schedule),
+		"timezone": aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScalableTargetAction; v != nil {
+		tfMap["max_capacity"] = aws.Int64Value(v.MaxCapacity)
+		tfMap["min_capacity"] = aws.Int64Value(v.MinCapacity)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -132,6 +132,73 @@ func TestAccLambdaProvisionedConcurrencyConfig_provisionedConcurrentExecutions(t
 	})
 }
 
+func TestAccLambdaProvisionedConcurrencyConfig_scaling(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedConcurrencyConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyConfigConfig_scaling(rName, "cron(0 8 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyExistsConfig(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrent_executions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling.0.max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName, "scaling.0.min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling.0.scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scaling.0.scheduled_action.*", map[string]string{
+						"max_capacity": "5",
+						"min_capacity": "3",
+						"name":         rName + "-up",
+						"schedule":     "cron(0 8 * * ? *)",
+						"timezone":     "UTC",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scaling.0.scheduled_action.*", map[string]string{
+						"max_capacity": "1",
+						"min_capacity": "1",
+						"name":         rName + "-down",
+						"schedule":     "cron(0 20 * * ? *)",
+						"timezone":     "UTC",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scaling"},
+			},
+			{
+				Config: testAccProvisionedConcurrencyConfigConfig_scaling(rName, "cron(0 9 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyExistsConfig(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling.0.scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scaling.0.scheduled_action.*", map[string]string{
+						"name":     rName + "-up",
+						"schedule": "cron(0 9 * * ? *)",
+					}),
+				),
+			},
+			{
+				Config: testAccProvisionedConcurrencyConfigConfig_concurrentExecutions(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyExistsConfig(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaProvisionedConcurrencyConfig_Qualifier_aliasName(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	lambdaAliasResourceName := "aws_lambda_alias.test"
@@ -316,6 +383,35 @@ resource "aws_lambda_provisioned_concurrency_config" "test" {
 `, provisionedConcurrentExecutions)
 }
 
+func testAccProvisionedConcurrencyConfigConfig_scaling(rName, scaleUpSchedule string) string {
+	return testAccProvisionedConcurrencyConfig_base(rName) + fmt.Sprintf(`
+resource "aws_lambda_provisioned_concurrency_config" "test" {
+  function_name                     = aws_lambda_function.test.function_name
+  provisioned_concurrent_executions = 1
+  qualifier                         = aws_lambda_function.test.version
+
+  scaling {
+    max_capacity = 5
+    min_capacity = 1
+
+    scheduled_action {
+      name         = "%[1]s-up"
+      schedule     = %[2]q
+      max_capacity = 5
+      min_capacity = 3
+    }
+
+    scheduled_action {
+      name         = "%[1]s-down"
+      schedule     = "cron(0 20 * * ? *)"
+      max_capacity = 1
+      min_capacity = 1
+    }
+  }
+}
+`, rName, scaleUpSchedule)
+}
+
 func testAccProvisionedConcurrencyConfigConfig_qualifierAliasName(rName string) string {
 	return testAccProvisionedConcurrencyConfig_base(rName) + `
 resource "aws_lambda_alias" "test" {
@@ -0,0 +1,377 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPIKeyCreate,
+		ReadContext:   resourceAPIKeyRead,
+		UpdateContext: resourceAPIKeyUpdate,
+		DeleteContext: resourceAPIKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"no_expiry": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(5, 200),
+							},
+						},
+						"allow_referers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 253),
+							},
+						},
+						"allow_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAPIKey = "API Key"
+)
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	name := d.Get("key_name").(string)
+	in := &locationservice.CreateKeyInput{
+		KeyName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		in.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Restrictions = expandAPIKeyRestrictions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateKeyWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameAPIKey, name, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameAPIKey, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.KeyName))
+
+	return resourceAPIKeyRead(ctx, d, meta)
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	out, err := findAPIKeyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameAPIKey, d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	if !d.Get("no_expiry").(bool) {
+		d.Set("expire_time", aws.TimeValue(out.ExpireTime).Format(time.RFC3339))
+	}
+	d.Set("key", out.Key)
+	d.Set("key_arn", out.KeyArn)
+	d.Set("key_name", out.KeyName)
+	if out.Restrictions != nil {
+		if err := d.Set("restrictions", []interface{}{flattenAPIKeyRestrictions(out.Restrictions)}); err != nil {
+			return create.DiagError(names.Location, create.ErrActionSetting, ResNameAPIKey, d.Id(), err)
+		}
+	} else {
+		d.Set("restrictions", nil)
+	}
+	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Location, create.ErrActionSetting, ResNameAPIKey, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Location, create.ErrActionSetting, ResNameAPIKey, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("description", "expire_time", "no_expiry", "restrictions") {
+		in := &locationservice.UpdateKeyInput{
+			// The key may be in use, e.g. it was used in the last 7 days.
+			ForceUpdate: aws.Bool(true),
+			KeyName:     aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			in.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if d.Get("no_expiry").(bool) {
+				in.NoExpiry = aws.Bool(true)
+			} else {
+				v, _ := time.Parse(time.RFC3339, d.Get("expire_time").(string))
+				in.ExpireTime = aws.Time(v)
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			if v, ok := d.GetOk("restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.Restrictions = expandAPIKeyRestrictions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Location API Key (%s): %s", d.Id(), in)
+		_, err := conn.UpdateKeyWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameAPIKey, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("key_arn").(string), o, n); err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameAPIKey, d.Id(), err)
+		}
+	}
+
+	return resourceAPIKeyRead(ctx, d, meta)
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[INFO] Deleting Location API Key %s", d.Id())
+	_, err := conn.DeleteKeyWithContext(ctx, &locationservice.DeleteKeyInput{
+		KeyName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	// An API key can only be deleted once it has been expired for 90 days.
+	// Expire the key so that it can no longer be used and will be deletable later.
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeValidationException) {
+		log.Printf("[WARN] Location API Key (%s) cannot yet be deleted, expiring: %s", d.Id(), err)
+		_, err = conn.UpdateKeyWithContext(ctx, &locationservice.UpdateKeyInput{
+			ExpireTime:  aws.Time(time.Now().Add(1 * time.Minute)),
+			ForceUpdate: aws.Bool(true),
+			KeyName:     aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionDeleting, ResNameAPIKey, d.Id(), err)
+		}
+
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Location API Key (%s) expired but not deleted, removing from state", d.Id()),
+				Detail:   "Amazon Location Service only allows an API key to be deleted 90 days after it has expired. The API key must be deleted outside of Terraform once that period has passed.",
+			},
+		}
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionDeleting, ResNameAPIKey, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAPIKeyByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeKeyOutput, error) {
+	in := &locationservice.DescribeKeyInput{
+		KeyName: aws.String(name),
+	}
+
+	out, err := conn.DescribeKeyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAPIKeyRestrictions(tfMap map[string]interface{}) *locationservice.ApiKeyRestrictions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.ApiKeyRestrictions{}
+
+	if v, ok := tfMap["allow_actions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowActions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_referers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowReferers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowResources = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *locationservice.ApiKeyRestrictions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllowActions; v != nil {
+		tfMap["allow_actions"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AllowReferers; v != nil {
+		tfMap["allow_referers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AllowResources; v != nil {
+		tfMap["allow_resources"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
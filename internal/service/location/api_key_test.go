@@ -0,0 +1,273 @@
+package location_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationAPIKey_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "key_arn", "geo", fmt.Sprintf("api-key/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "key_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "no_expiry", "true"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_actions.*", "geo:GetMap*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "restrictions.0.allow_resources.*", "aws_location_map.test", "map_arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expire_time", "no_expiry"},
+			},
+		},
+	})
+}
+
+func TestAccLocationAPIKey_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+	expireTime := time.Now().UTC().AddDate(0, 0, 30).Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "no_expiry", "true"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_updated(rName, "description1", expireTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "expire_time", expireTime),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_referers.*", "https://example.com/*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationAPIKey_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expire_time", "no_expiry"},
+			},
+			{
+				Config: testAccAPIKeyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAPIKeyDestroy treats expired API keys as destroyed,
+// as an API key can only be deleted 90 days after it has expired.
+func testAccCheckAPIKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_location_api_key" {
+			continue
+		}
+
+		output, err := conn.DescribeKey(&locationservice.DescribeKeyInput{
+			KeyName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.TimeValue(output.ExpireTime).Before(time.Now().Add(5 * time.Minute)) {
+			continue
+		}
+
+		return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameAPIKey, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckAPIKeyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn
+		_, err := conn.DescribeKey(&locationservice.DescribeKeyInput{
+			KeyName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAPIKeyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_map" "test" {
+  configuration {
+    style = "VectorHereBerlin"
+  }
+
+  map_name = %[1]q
+}
+`, rName)
+}
+
+func testAccAPIKeyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName))
+}
+
+func testAccAPIKeyConfig_updated(rName, description, expireTime string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name    = %[1]q
+  description = %[2]q
+  expire_time = %[3]q
+
+  restrictions {
+    allow_actions   = ["geo:GetMapTile", "geo:GetMapStyleDescriptor"]
+    allow_referers  = ["https://example.com/*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName, description, expireTime))
+}
+
+func testAccAPIKeyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAPIKeyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name  = %[1]q
+  no_expiry = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -34,6 +34,11 @@ func ResourceTracker() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1000),
 			},
+			"kms_key_enable_geospatial_queries": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"kms_key_id"},
+			},
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -77,6 +82,10 @@ func resourceTrackerCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("kms_key_enable_geospatial_queries"); ok {
+		input.KmsKeyEnableGeospatialQueries = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("kms_key_id"); ok {
 		input.KmsKeyId = aws.String(v.(string))
 	}
@@ -135,6 +144,7 @@ func resourceTrackerRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
 	d.Set("description", output.Description)
+	d.Set("kms_key_enable_geospatial_queries", output.KmsKeyEnableGeospatialQueries)
 	d.Set("kms_key_id", output.KmsKeyId)
 	d.Set("position_filtering", output.PositionFiltering)
 
@@ -158,17 +168,21 @@ func resourceTrackerRead(d *schema.ResourceData, meta interface{}) error {
 func resourceTrackerUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LocationConn
 
-	if d.HasChanges("description", "position_filtering") {
+	if d.HasChanges("description", "kms_key_enable_geospatial_queries", "position_filtering") {
 		input := &locationservice.UpdateTrackerInput{
 			TrackerName: aws.String(d.Id()),
 		}
 
-		if v, ok := d.GetOk("description"); ok {
-			input.Description = aws.String(v.(string))
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("kms_key_enable_geospatial_queries") {
+			input.KmsKeyEnableGeospatialQueries = aws.Bool(d.Get("kms_key_enable_geospatial_queries").(bool))
 		}
 
-		if v, ok := d.GetOk("position_filtering"); ok {
-			input.PositionFiltering = aws.String(v.(string))
+		if d.HasChange("position_filtering") {
+			input.PositionFiltering = aws.String(d.Get("position_filtering").(string))
 		}
 
 		_, err := conn.UpdateTracker(input)
@@ -118,6 +118,7 @@ func TestAccLocationTracker_kmsKeyID(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTrackerExists(resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_enable_geospatial_queries", "false"),
 				),
 			},
 			{
@@ -125,6 +126,14 @@ func TestAccLocationTracker_kmsKeyID(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTrackerConfig_kmsKeyEnableGeospatialQueries(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrackerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_enable_geospatial_queries", "true"),
+				),
+			},
 		},
 	})
 }
@@ -289,6 +298,20 @@ resource "aws_location_tracker" "test" {
 `, rName)
 }
 
+func testAccTrackerConfig_kmsKeyEnableGeospatialQueries(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_location_tracker" "test" {
+  tracker_name                      = %[1]q
+  kms_key_id                        = aws_kms_key.test.arn
+  kms_key_enable_geospatial_queries = %[2]t
+}
+`, rName, enabled)
+}
+
 func testAccTrackerConfig_positionFiltering(rName, positionFiltering string) string {
 	return fmt.Sprintf(`
 resource "aws_location_tracker" "test" {
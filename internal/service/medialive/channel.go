@@ -886,10 +886,15 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 		}
 
+		// A running channel must be stopped before it can be updated.
+		// Restart it afterwards unless it is also being stopped by this update.
+		restart := false
 		if channel.State == types.ChannelStateRunning {
 			if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
 				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 			}
+
+			restart = !d.HasChange("start_channel") || d.Get("start_channel").(bool)
 		}
 
 		out, err := conn.UpdateChannel(ctx, in)
@@ -900,11 +905,11 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return create.DiagError(names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
 		}
-	}
 
-	if d.Get("start_channel").(bool) {
-		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
-			return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Get("name").(string), err)
+		if restart {
+			if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+				return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+			}
 		}
 	}
 
@@ -163,6 +163,53 @@ func TestAccMediaLiveChannel_status(t *testing.T) {
 	})
 }
 
+func TestAccMediaLiveChannel_updateRunning(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+			testAccChannelsPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_startLogLevel(rName, true, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					testAccCheckChannelStatus(resourceName, types.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_startLogLevel(rName, true, "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					testAccCheckChannelStatus(resourceName, types.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "INFO"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_startLogLevel(rName, false, "DEBUG"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					testAccCheckChannelStatus(resourceName, types.ChannelStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "DEBUG"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMediaLiveChannel_update(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -670,6 +717,10 @@ resource "aws_medialive_channel" "test" {
 }
 
 func testAccChannelConfig_start(rName string, start bool) string {
+	return testAccChannelConfig_startLogLevel(rName, start, "DISABLED")
+}
+
+func testAccChannelConfig_startLogLevel(rName string, start bool, logLevel string) string {
 	return acctest.ConfigCompose(
 		testAccChannelBaseConfig(rName),
 		testAccChannelBaseS3Config(rName),
@@ -680,6 +731,7 @@ resource "aws_medialive_channel" "test" {
   channel_class = "STANDARD"
   role_arn      = aws_iam_role.test.arn
   start_channel = %[2]t
+  log_level     = %[3]q
 
   input_specification {
     codec            = "AVC"
@@ -748,7 +800,7 @@ resource "aws_medialive_channel" "test" {
     }
   }
 }
-`, rName, start))
+`, rName, start, logLevel))
 }
 
 func testAccChannelConfig_update(rName, codec, inputResolution string) string {
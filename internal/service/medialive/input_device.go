@@ -0,0 +1,355 @@
+package medialive
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceInputDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputDeviceCreate,
+		ReadWithoutTimeout:   resourceInputDeviceRead,
+		UpdateWithoutTimeout: resourceInputDeviceUpdate,
+		DeleteWithoutTimeout: resourceInputDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInputDeviceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_settings_sync_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hd_device_settings": inputDeviceSettingsSchema(),
+			"input_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uhd_device_settings": inputDeviceSettingsSchema(),
+		},
+	}
+}
+
+func inputDeviceSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"active_input": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"configured_input": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[types.InputDeviceConfiguredInput](),
+				},
+				"device_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"framerate": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"height": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"max_bitrate": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				},
+				"scan_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"width": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameInputDevice = "Input Device"
+)
+
+func resourceInputDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	id := d.Get("input_device_id").(string)
+
+	// Input devices are physical hardware registered with MediaLive, so they cannot be created.
+	// Creating the resource brings the existing device's settings under management.
+	if _, err := FindInputDeviceByID(ctx, conn, id); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInputDevice, id, err)
+	}
+
+	d.SetId(id)
+
+	if err := updateInputDevice(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionCreating, ResNameInputDevice, d.Id(), err)
+	}
+
+	return resourceInputDeviceRead(ctx, d, meta)
+}
+
+func resourceInputDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	out, err := FindInputDeviceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive InputDevice (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionReading, ResNameInputDevice, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("connection_state", out.ConnectionState)
+	d.Set("device_settings_sync_state", out.DeviceSettingsSyncState)
+	if err := d.Set("hd_device_settings", flattenInputDeviceHdSettings(out.HdDeviceSettings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameInputDevice, d.Id(), err)
+	}
+	d.Set("input_device_id", out.Id)
+	d.Set("mac_address", out.MacAddress)
+	d.Set("name", out.Name)
+	d.Set("serial_number", out.SerialNumber)
+	d.Set("type", out.Type)
+	if err := d.Set("uhd_device_settings", flattenInputDeviceUhdSettings(out.UhdDeviceSettings)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionSetting, ResNameInputDevice, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceInputDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveClient
+
+	if err := updateInputDevice(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.DiagError(names.MediaLive, create.ErrActionUpdating, ResNameInputDevice, d.Id(), err)
+	}
+
+	return resourceInputDeviceRead(ctx, d, meta)
+}
+
+func resourceInputDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] MediaLive InputDevice (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func resourceInputDeviceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("input_device_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateInputDevice(ctx context.Context, conn *medialive.Client, d *schema.ResourceData, timeout time.Duration) error {
+	if !d.HasChanges("hd_device_settings", "name", "uhd_device_settings") {
+		return nil
+	}
+
+	in := &medialive.UpdateInputDeviceInput{
+		InputDeviceId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("hd_device_settings"); ok && d.HasChange("hd_device_settings") {
+		in.HdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok && d.HasChange("name") {
+		in.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("uhd_device_settings"); ok && d.HasChange("uhd_device_settings") {
+		in.UhdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+	}
+
+	if in.HdDeviceSettings == nil && in.Name == nil && in.UhdDeviceSettings == nil {
+		return nil
+	}
+
+	if _, err := conn.UpdateInputDevice(ctx, in); err != nil {
+		return err
+	}
+
+	if _, err := waitInputDeviceSettingsSynced(ctx, conn, d.Id(), timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func waitInputDeviceSettingsSynced(ctx context.Context, conn *medialive.Client, id string, timeout time.Duration) (*medialive.DescribeInputDeviceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.DeviceSettingsSyncStateSyncing),
+		Target:                    enum.Slice(types.DeviceSettingsSyncStateSynced),
+		Refresh:                   statusInputDeviceSettingsSync(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*medialive.DescribeInputDeviceOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusInputDeviceSettingsSync(ctx context.Context, conn *medialive.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindInputDeviceByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.DeviceSettingsSyncState), nil
+	}
+}
+
+func FindInputDeviceByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.DescribeInputDeviceOutput, error) {
+	in := &medialive.DescribeInputDeviceInput{
+		InputDeviceId: aws.String(id),
+	}
+	out, err := conn.DescribeInputDevice(ctx, in)
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandInputDeviceConfigurableSettings(tfList []interface{}) *types.InputDeviceConfigurableSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	out := &types.InputDeviceConfigurableSettings{}
+	if v, ok := m["configured_input"].(string); ok && v != "" {
+		out.ConfiguredInput = types.InputDeviceConfiguredInput(v)
+	}
+	if v, ok := m["max_bitrate"].(int); ok && v != 0 {
+		out.MaxBitrate = int32(v)
+	}
+
+	return out
+}
+
+func flattenInputDeviceHdSettings(apiObject *types.InputDeviceHdSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"active_input":     string(apiObject.ActiveInput),
+		"configured_input": string(apiObject.ConfiguredInput),
+		"device_state":     string(apiObject.DeviceState),
+		"framerate":        apiObject.Framerate,
+		"height":           int(apiObject.Height),
+		"max_bitrate":      int(apiObject.MaxBitrate),
+		"scan_type":        string(apiObject.ScanType),
+		"width":            int(apiObject.Width),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenInputDeviceUhdSettings(apiObject *types.InputDeviceUhdSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"active_input":     string(apiObject.ActiveInput),
+		"configured_input": string(apiObject.ConfiguredInput),
+		"device_state":     string(apiObject.DeviceState),
+		"framerate":        apiObject.Framerate,
+		"height":           int(apiObject.Height),
+		"max_bitrate":      int(apiObject.MaxBitrate),
+		"scan_type":        string(apiObject.ScanType),
+		"width":            int(apiObject.Width),
+	}
+
+	return []interface{}{m}
+}
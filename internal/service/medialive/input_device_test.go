@@ -0,0 +1,113 @@
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveInputDevice_uhd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	// Input devices are physical hardware, so an existing UHD device is required.
+	deviceID := os.Getenv("MEDIALIVE_INPUT_DEVICE_ID")
+	if deviceID == "" {
+		t.Skip("Environment variable MEDIALIVE_INPUT_DEVICE_ID is not set")
+	}
+
+	var inputDevice medialive.DescribeInputDeviceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_input_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.MediaLiveEndpointID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputDeviceConfig_uhd(deviceID, rName, "AUTO", 10000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputDeviceExists(resourceName, &inputDevice),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "device_settings_sync_state", "SYNCED"),
+					resource.TestCheckResourceAttr(resourceName, "input_device_id", deviceID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "uhd_device_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uhd_device_settings.0.configured_input", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "uhd_device_settings.0.max_bitrate", "10000000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputDeviceConfig_uhd(deviceID, rName, "SDI", 20000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputDeviceExists(resourceName, &inputDevice),
+					resource.TestCheckResourceAttr(resourceName, "uhd_device_settings.0.configured_input", "SDI"),
+					resource.TestCheckResourceAttr(resourceName, "uhd_device_settings.0.max_bitrate", "20000000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInputDeviceExists(name string, inputDevice *medialive.DescribeInputDeviceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameInputDevice, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameInputDevice, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient
+		ctx := context.Background()
+
+		resp, err := tfmedialive.FindInputDeviceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameInputDevice, rs.Primary.ID, err)
+		}
+
+		*inputDevice = *resp
+
+		return nil
+	}
+}
+
+func testAccInputDeviceConfig_uhd(deviceID, rName, configuredInput string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_device" "test" {
+  input_device_id = %[1]q
+  name            = %[2]q
+
+  uhd_device_settings {
+    configured_input = %[3]q
+    max_bitrate      = %[4]d
+  }
+}
+`, deviceID, rName, configuredInput, maxBitrate)
+}
@@ -65,6 +65,17 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"database_vpc_endpoint_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mwaa.EndpointManagement_Values(), false),
+			},
 			"environment_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -245,6 +256,10 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"webserver_vpc_endpoint_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"weekly_maintenance_window_start": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -278,6 +293,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.AirflowVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("endpoint_management"); ok {
+		input.EndpointManagement = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("environment_class"); ok {
 		input.EnvironmentClass = aws.String(v.(string))
 	}
@@ -375,6 +394,8 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", environment.Arn)
 	d.Set("created_at", aws.TimeValue(environment.CreatedAt).String())
 	d.Set("dag_s3_path", environment.DagS3Path)
+	d.Set("database_vpc_endpoint_service", environment.DatabaseVpcEndpointService)
+	d.Set("endpoint_management", environment.EndpointManagement)
 	d.Set("environment_class", environment.EnvironmentClass)
 	d.Set("execution_role_arn", environment.ExecutionRoleArn)
 	d.Set("kms_key", environment.KmsKey)
@@ -400,6 +421,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("status", environment.Status)
 	d.Set("webserver_access_mode", environment.WebserverAccessMode)
 	d.Set("webserver_url", environment.WebserverUrl)
+	d.Set("webserver_vpc_endpoint_service", environment.WebserverVpcEndpointService)
 	d.Set("weekly_maintenance_window_start", environment.WeeklyMaintenanceWindowStart)
 
 	tags := KeyValueTags(environment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -628,8 +650,9 @@ func waitEnvironmentCreated(ctx context.Context, conn *mwaa.MWAA, name string, t
 
 func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, timeout time.Duration) (*mwaa.Environment, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{mwaa.EnvironmentStatusUpdating},
-		Target:  []string{mwaa.EnvironmentStatusAvailable},
+		// Airflow version upgrades snapshot the environment first, and failed updates are rolled back.
+		Pending: []string{mwaa.EnvironmentStatusUpdating, mwaa.EnvironmentStatusCreatingSnapshot, mwaa.EnvironmentStatusRollingBack},
+		Target:  []string{mwaa.EnvironmentStatusAvailable, mwaa.EnvironmentStatusUpdateFailed},
 		Refresh: statusEnvironment(ctx, conn, name),
 		Timeout: timeout,
 	}
@@ -641,6 +664,15 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage)))
 		}
 
+		// A rolled back update leaves the environment AVAILABLE with the previous configuration.
+		if err == nil && v.LastUpdate != nil && aws.StringValue(v.LastUpdate.Status) == mwaa.UpdateStatusFailed {
+			err = fmt.Errorf("update failed (environment status: %s)", aws.StringValue(v.Status))
+
+			if v.LastUpdate.Error != nil {
+				err = fmt.Errorf("%w: %s: %s", err, aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage))
+			}
+		}
+
 		return v, err
 	}
 
@@ -34,6 +34,8 @@ func TestAccMWAAEnvironment_basic(t *testing.T) {
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "airflow", "environment/"+rName),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
 					resource.TestCheckResourceAttr(resourceName, "dag_s3_path", "dags/"),
+					resource.TestCheckResourceAttrSet(resourceName, "database_vpc_endpoint_service"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_management", mwaa.EndpointManagementService),
 					resource.TestCheckResourceAttr(resourceName, "environment_class", "mw1.small"),
 					acctest.CheckResourceAttrGlobalARN(resourceName, "execution_role_arn", "iam", "role/service-role/"+rName),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
@@ -65,6 +67,7 @@ func TestAccMWAAEnvironment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
 					resource.TestCheckResourceAttr(resourceName, "webserver_access_mode", mwaa.WebserverAccessModePrivateOnly),
 					resource.TestCheckResourceAttrSet(resourceName, "webserver_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "webserver_vpc_endpoint_service"),
 					resource.TestCheckResourceAttrSet(resourceName, "weekly_maintenance_window_start"),
 				),
 			},
@@ -0,0 +1,99 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceCollections() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCollectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 32),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.CollectionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	DSNameCollections = "Collections Data Source"
+)
+
+func dataSourceCollectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.ListCollectionsInput{
+		CollectionFilters: &opensearchserverless.CollectionFilters{},
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.CollectionFilters.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.CollectionFilters.Status = aws.String(v.(string))
+	}
+
+	var arns, ids, collectionNames []string
+
+	err := conn.ListCollectionsPagesWithContext(ctx, input, func(page *opensearchserverless.ListCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CollectionSummaries {
+			if v == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(v.Arn))
+			ids = append(ids, aws.StringValue(v.Id))
+			collectionNames = append(collectionNames, aws.StringValue(v.Name))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, DSNameCollections, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", collectionNames)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLifecyclePolicyByNameAndType(ctx context.Context, conn *opensearchserverless.OpenSearchServerless, name, policyType string) (*opensearchserverless.LifecyclePolicyDetail, error) {
+	input := &opensearchserverless.BatchGetLifecyclePolicyInput{
+		Identifiers: []*opensearchserverless.LifecyclePolicyIdentifier{
+			{
+				Name: aws.String(name),
+				Type: aws.String(policyType),
+			},
+		},
+	}
+
+	output, err := conn.BatchGetLifecyclePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LifecyclePolicyDetails) == 0 || output.LifecyclePolicyDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LifecyclePolicyDetails[0], nil
+}
+
+func FindSecurityConfigByID(ctx context.Context, conn *opensearchserverless.OpenSearchServerless, id string) (*opensearchserverless.SecurityConfigDetail, error) {
+	input := &opensearchserverless.GetSecurityConfigInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSecurityConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityConfigDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityConfigDetail, nil
+}
@@ -0,0 +1,217 @@
+package opensearchserverless
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
+		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
+		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      opensearchserverless.LifecyclePolicyTypeRetention,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.LifecyclePolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	ResNameLifecyclePolicy = "Lifecycle Policy"
+)
+
+func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name := d.Get("name").(string)
+	policyType := d.Get("type").(string)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameLifecyclePolicy, name, err)
+	}
+
+	input := &opensearchserverless.CreateLifecyclePolicyInput{
+		Name:   aws.String(name),
+		Policy: aws.String(policy),
+		Type:   aws.String(policyType),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateLifecyclePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameLifecyclePolicy, name, err)
+	}
+
+	d.SetId(LifecyclePolicyCreateResourceID(name, policyType))
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name, policyType, err := LifecyclePolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	output, err := FindLifecyclePolicyByNameAndType(ctx, conn, name, policyType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	// The policy document is not returned by the API, so the configured value is kept as-is.
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("policy_version", output.PolicyVersion)
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.UpdateLifecyclePolicyInput{
+		Name:          aws.String(d.Get("name").(string)),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+		if err != nil {
+			return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameLifecyclePolicy, d.Id(), err)
+		}
+
+		input.Policy = aws.String(policy)
+	}
+
+	_, err := conn.UpdateLifecyclePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name, policyType, err := LifecyclePolicyParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Lifecycle Policy (%s)", d.Id())
+	_, err = conn.DeleteLifecyclePolicyWithContext(ctx, &opensearchserverless.DeleteLifecyclePolicyInput{
+		Name: aws.String(name),
+		Type: aws.String(policyType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+const lifecyclePolicyResourceIDSeparator = ","
+
+func LifecyclePolicyCreateResourceID(name, policyType string) string {
+	parts := []string{name, policyType}
+	id := strings.Join(parts, lifecyclePolicyResourceIDSeparator)
+
+	return id
+}
+
+func LifecyclePolicyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, lifecyclePolicyResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NAME%[2]sTYPE", id, lifecyclePolicyResourceIDSeparator)
+}
@@ -0,0 +1,179 @@
+package opensearchserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchServerlessLifecyclePolicy_basic(t *testing.T) {
+	var v opensearchserverless.LifecyclePolicyDetail
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "test", "81d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_version"),
+					resource.TestCheckResourceAttr(resourceName, "type", "retention"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessLifecyclePolicy_update(t *testing.T) {
+	var v opensearchserverless.LifecyclePolicyDetail
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "test", "81d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "updated", "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessLifecyclePolicy_disappears(t *testing.T) {
+	var v opensearchserverless.LifecyclePolicyDetail
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_opensearchserverless_lifecycle_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyConfig_basic(rName, "test", "81d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecyclePolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearchserverless.ResourceLifecyclePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLifecyclePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearchserverless_lifecycle_policy" {
+			continue
+		}
+
+		name, policyType, err := tfopensearchserverless.LifecyclePolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfopensearchserverless.FindLifecyclePolicyByNameAndType(context.Background(), conn, name, policyType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Serverless Lifecycle Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLifecyclePolicyExists(n string, v *opensearchserverless.LifecyclePolicyDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Serverless Lifecycle Policy ID is set")
+		}
+
+		name, policyType, err := tfopensearchserverless.LifecyclePolicyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		output, err := tfopensearchserverless.FindLifecyclePolicyByNameAndType(context.Background(), conn, name, policyType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLifecyclePolicyConfig_basic(rName, description, retention string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_lifecycle_policy" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  policy = jsonencode({
+    Rules = [
+      {
+        ResourceType      = "index"
+        Resource          = ["index/%[1]s/*"]
+        MinIndexRetention = %[3]q
+      }
+    ]
+  })
+}
+`, rName, description, retention)
+}
@@ -0,0 +1,255 @@
+package opensearchserverless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceSecurityConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityConfigCreate,
+		ReadWithoutTimeout:   resourceSecurityConfigRead,
+		UpdateWithoutTimeout: resourceSecurityConfigUpdate,
+		DeleteWithoutTimeout: resourceSecurityConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"saml_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_attribute": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"metadata": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 20480),
+						},
+						"session_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(5, 720),
+						},
+						"user_attribute": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      opensearchserverless.SecurityConfigTypeSaml,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.SecurityConfigType_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	ResNameSecurityConfig = "Security Config"
+)
+
+func resourceSecurityConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateSecurityConfigInput{
+		Name:        aws.String(name),
+		SamlOptions: expandSAMLConfigOptions(d.Get("saml_options").([]interface{})),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSecurityConfigWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityConfig, name, err)
+	}
+
+	if output == nil || output.SecurityConfigDetail == nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityConfig, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.SecurityConfigDetail.Id))
+
+	return resourceSecurityConfigRead(ctx, d, meta)
+}
+
+func resourceSecurityConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	output, err := FindSecurityConfigByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Security Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameSecurityConfig, d.Id(), err)
+	}
+
+	name, err := securityConfigNameFromID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameSecurityConfig, d.Id(), err)
+	}
+
+	d.Set("config_version", output.ConfigVersion)
+	d.Set("description", output.Description)
+	d.Set("name", name)
+	if err := d.Set("saml_options", flattenSAMLConfigOptions(output.SamlOptions)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionSetting, ResNameSecurityConfig, d.Id(), err)
+	}
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceSecurityConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.UpdateSecurityConfigInput{
+		ConfigVersion: aws.String(d.Get("config_version").(string)),
+		Id:            aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("saml_options") {
+		input.SamlOptions = expandSAMLConfigOptions(d.Get("saml_options").([]interface{}))
+	}
+
+	_, err := conn.UpdateSecurityConfigWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameSecurityConfig, d.Id(), err)
+	}
+
+	return resourceSecurityConfigRead(ctx, d, meta)
+}
+
+func resourceSecurityConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Security Config (%s)", d.Id())
+	_, err := conn.DeleteSecurityConfigWithContext(ctx, &opensearchserverless.DeleteSecurityConfigInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameSecurityConfig, d.Id(), err)
+	}
+
+	return nil
+}
+
+// securityConfigNameFromID extracts the configuration name from IDs of the form TYPE/ACCOUNT_ID/NAME.
+func securityConfigNameFromID(id string) (string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 3 || parts[2] == "" {
+		return "", fmt.Errorf("unexpected format for ID (%s), expected TYPE/ACCOUNT_ID/NAME", id)
+	}
+
+	return parts[2], nil
+}
+
+func expandSAMLConfigOptions(tfList []interface{}) *opensearchserverless.SamlConfigOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &opensearchserverless.SamlConfigOptions{}
+
+	if v, ok := tfMap["group_attribute"].(string); ok && v != "" {
+		apiObject.GroupAttribute = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata"].(string); ok && v != "" {
+		apiObject.Metadata = aws.String(v)
+	}
+
+	if v, ok := tfMap["session_timeout"].(int); ok && v != 0 {
+		apiObject.SessionTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["user_attribute"].(string); ok && v != "" {
+		apiObject.UserAttribute = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSAMLConfigOptions(apiObject *opensearchserverless.SamlConfigOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"group_attribute": aws.StringValue(apiObject.GroupAttribute),
+		"metadata":        aws.StringValue(apiObject.Metadata),
+		"session_timeout": aws.Int64Value(apiObject.SessionTimeout),
+		"user_attribute":  aws.StringValue(apiObject.UserAttribute),
+	}
+
+	return []interface{}{tfMap}
+}
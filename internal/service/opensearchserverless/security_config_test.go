@@ -0,0 +1,142 @@
+package opensearchserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchServerlessSecurityConfig_basic(t *testing.T) {
+	var v opensearchserverless.SecurityConfigDetail
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_opensearchserverless_security_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfigConfig_basic(rName, "test", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "config_version"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "type", "saml"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityConfigConfig_basic(rName, "updated", 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityConfig_disappears(t *testing.T) {
+	var v opensearchserverless.SecurityConfigDetail
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_opensearchserverless_security_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfigConfig_basic(rName, "test", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearchserverless.ResourceSecurityConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityConfigDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearchserverless_security_config" {
+			continue
+		}
+
+		_, err := tfopensearchserverless.FindSecurityConfigByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Serverless Security Config %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSecurityConfigExists(n string, v *opensearchserverless.SecurityConfigDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Serverless Security Config ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessConn
+
+		output, err := tfopensearchserverless.FindSecurityConfigByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSecurityConfigConfig_basic(rName, description string, sessionTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_security_config" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  saml_options {
+    metadata        = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = "https://example.com/%[1]s" })
+    session_timeout = %[3]d
+  }
+}
+`, rName, description, sessionTimeout)
+}
@@ -0,0 +1,83 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceVPCEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(opensearchserverless.VpcEndpointStatus_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	DSNameVPCEndpoints = "VPC Endpoints Data Source"
+)
+
+func dataSourceVPCEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessConn
+
+	input := &opensearchserverless.ListVpcEndpointsInput{}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.VpcEndpointFilters = &opensearchserverless.VpcEndpointFilters{
+			Status: aws.String(v.(string)),
+		}
+	}
+
+	var ids, endpointNames []string
+
+	err := conn.ListVpcEndpointsPagesWithContext(ctx, input, func(page *opensearchserverless.ListVpcEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VpcEndpointSummaries {
+			if v == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(v.Id))
+			endpointNames = append(endpointNames, aws.StringValue(v.Name))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, DSNameVPCEndpoints, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", ids)
+	d.Set("names", endpointNames)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package opensearchserverless_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOpenSearchServerlessVPCEndpointsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_opensearchserverless_vpc_endpoints.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opensearchserverless.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCEndpointsDataSourceConfig_basic = `
+data "aws_opensearchserverless_vpc_endpoints" "test" {
+  status = "ACTIVE"
+}
+`
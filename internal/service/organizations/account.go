@@ -29,6 +29,11 @@ func ResourceAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(accountCreateTimeout),
+			Delete: schema.DefaultTimeout(accountDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -81,7 +86,7 @@ func ResourceAccount() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
-				ValidateFunc: validation.StringMatch(regexp.MustCompile("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"), "see https://docs.aws.amazon.com/organizations/latest/APIReference/API_MoveAccount.html#organizations-MoveAccount-request-DestinationParentId"),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^(r-[0-9a-z]{4,32}|ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"), "see https://docs.aws.amazon.com/organizations/latest/APIReference/API_MoveAccount.html#organizations-MoveAccount-request-DestinationParentId"),
 			},
 			"role_name": {
 				ForceNew:     true,
@@ -132,7 +137,7 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating AWS Organizations Account (%s): %w", d.Get("name").(string), err)
 	}
 
-	output, err := waitAccountCreated(conn, aws.StringValue(s.Id))
+	output, err := waitAccountCreated(conn, aws.StringValue(s.Id), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for AWS Organizations Account (%s) create: %w", d.Get("name").(string), err)
@@ -149,14 +154,7 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if newParentAccountID := v.(string); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
-			}
-
-			log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
-			if _, err := conn.MoveAccount(input); err != nil {
+			if err := moveAccount(conn, d.Id(), oldParentAccountID, newParentAccountID); err != nil {
 				return fmt.Errorf("error moving AWS Organizations Account (%s): %w", d.Id(), err)
 			}
 		}
@@ -222,13 +220,7 @@ func resourceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("parent_id") {
 		o, n := d.GetChange("parent_id")
 
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
-		}
-
-		if _, err := conn.MoveAccount(input); err != nil {
+		if err := moveAccount(conn, d.Id(), o.(string), n.(string)); err != nil {
 			return fmt.Errorf("error moving AWS Organizations Account (%s): %w", d.Id(), err)
 		}
 	}
@@ -271,7 +263,7 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if close {
-		if _, err := waitAccountDeleted(conn, d.Id()); err != nil {
+		if _, err := waitAccountDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 			return fmt.Errorf("error waiting for AWS Organizations Account (%s) delete: %w", d.Id(), err)
 		}
 	}
@@ -279,6 +271,22 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// moveAccount moves an account between roots and organizational units in place.
+func moveAccount(conn *organizations.Organizations, id, sourceParentID, destinationParentID string) error {
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		DestinationParentId: aws.String(destinationParentID),
+		SourceParentId:      aws.String(sourceParentID),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(accountMoveTimeout, func() (interface{}, error) {
+		return conn.MoveAccount(input)
+	}, organizations.ErrCodeConcurrentModificationException)
+
+	return err
+}
+
 func createAccount(conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
 	if govCloud {
 		input := &organizations.CreateGovCloudAccountInput{
@@ -415,13 +423,19 @@ func statusCreateAccountState(conn *organizations.Organizations, id string) reso
 	}
 }
 
-func waitAccountCreated(conn *organizations.Organizations, id string) (*organizations.CreateAccountStatus, error) {
+const (
+	accountCreateTimeout = 5 * time.Minute
+	accountDeleteTimeout = 5 * time.Minute
+	accountMoveTimeout   = 2 * time.Minute
+)
+
+func waitAccountCreated(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.CreateAccountStatus, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{organizations.CreateAccountStateInProgress},
 		Target:       []string{organizations.CreateAccountStateSucceeded},
 		Refresh:      statusCreateAccountState(conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -453,13 +467,13 @@ func statusAccountStatus(conn *organizations.Organizations, id string) resource.
 	}
 }
 
-func waitAccountDeleted(conn *organizations.Organizations, id string) (*organizations.Account, error) {
+func waitAccountDeleted(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{organizations.AccountStatusPendingClosure},
 		Target:       []string{},
 		Refresh:      statusAccountStatus(conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -141,6 +141,13 @@ func testAccAccount_ParentID(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "parent_id", parentIdResourceName2, "id"),
 				),
 			},
+			{
+				Config: testAccAccountConfig_parentIdRoot(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_id", "aws_organizations_organization.test", "roots.0.id"),
+				),
+			},
 		},
 	})
 }
@@ -339,6 +346,29 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_parentIdRoot(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test1" {
+  name      = "test1"
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_organizational_unit" "test2" {
+  name      = "test2"
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  parent_id         = aws_organizations_organization.test.roots[0].id
+  close_on_deletion = true
+}
+`, name, email)
+}
+
 func testAccAccountConfig_tags1(name, email, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {}
@@ -0,0 +1,259 @@
+package outposts
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrderCreate,
+		ReadWithoutTimeout:   resourceOrderRead,
+		DeleteWithoutTimeout: resourceOrderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"line_item": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_item_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"line_item_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quantity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"order_fulfilled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_submission_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"payment_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(outposts.PaymentOption_Values(), false),
+			},
+			"payment_term": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(outposts.PaymentTerm_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameOrder = "Order"
+)
+
+func resourceOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	outpostID := d.Get("outpost_id").(string)
+	input := &outposts.CreateOrderInput{
+		LineItems:         expandLineItemRequests(d.Get("line_item").([]interface{})),
+		OutpostIdentifier: aws.String(outpostID),
+		PaymentOption:     aws.String(d.Get("payment_option").(string)),
+	}
+
+	if v, ok := d.GetOk("payment_term"); ok {
+		input.PaymentTerm = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateOrderWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Outposts, create.ErrActionCreating, ResNameOrder, outpostID, err)
+	}
+
+	if output == nil || output.Order == nil {
+		return create.DiagError(names.Outposts, create.ErrActionCreating, ResNameOrder, outpostID, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.Order.OrderId))
+
+	return resourceOrderRead(ctx, d, meta)
+}
+
+func resourceOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	order, err := FindOrderByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Outposts Order (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Outposts, create.ErrActionReading, ResNameOrder, d.Id(), err)
+	}
+
+	if err := d.Set("line_item", flattenLineItems(order.LineItems)); err != nil {
+		return create.DiagError(names.Outposts, create.ErrActionSetting, ResNameOrder, d.Id(), err)
+	}
+	if v := order.OrderFulfilledDate; v != nil {
+		d.Set("order_fulfilled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("order_fulfilled_date", nil)
+	}
+	if v := order.OrderSubmissionDate; v != nil {
+		d.Set("order_submission_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("order_submission_date", nil)
+	}
+	d.Set("order_type", order.OrderType)
+	d.Set("outpost_id", order.OutpostId)
+	d.Set("payment_option", order.PaymentOption)
+	d.Set("payment_term", order.PaymentTerm)
+	d.Set("status", order.Status)
+
+	return nil
+}
+
+func resourceOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OutpostsConn
+
+	log.Printf("[INFO] Cancelling Outposts Order (%s)", d.Id())
+	_, err := conn.CancelOrderWithContext(ctx, &outposts.CancelOrderInput{
+		OrderId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, outposts.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Outposts, create.ErrActionDeleting, ResNameOrder, d.Id(), err)
+	}
+
+	return nil
+}
+
+// FindOrderByID returns the order with the specified ID.
+// Cancelled orders are treated as not found.
+func FindOrderByID(ctx context.Context, conn *outposts.Outposts, id string) (*outposts.Order, error) {
+	input := &outposts.GetOrderInput{
+		OrderId: aws.String(id),
+	}
+
+	output, err := conn.GetOrderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, outposts.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Order == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Order.Status); status == outposts.OrderStatusCancelled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Order, nil
+}
+
+func expandLineItemRequests(tfList []interface{}) []*outposts.LineItemRequest {
+	var apiObjects []*outposts.LineItemRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &outposts.LineItemRequest{
+			CatalogItemId: aws.String(tfMap["catalog_item_id"].(string)),
+			Quantity:      aws.Int64(int64(tfMap["quantity"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLineItems(apiObjects []*outposts.LineItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"catalog_item_id": aws.StringValue(apiObject.CatalogItemId),
+			"line_item_id":    aws.StringValue(apiObject.LineItemId),
+			"quantity":        aws.Int64Value(apiObject.Quantity),
+			"status":          aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
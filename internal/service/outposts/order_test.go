@@ -0,0 +1,125 @@
+package outposts_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfoutposts "github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOutpostsOrder_basic(t *testing.T) {
+	// Orders are purchases of physical capacity, so only run when a catalog item is explicitly provided.
+	key := "OUTPOSTS_CATALOG_ITEM_ID"
+	catalogItemID := os.Getenv(key)
+	if catalogItemID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v outposts.Order
+	resourceName := "aws_outposts_order.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderConfig_basic(catalogItemID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "line_item.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "line_item.0.catalog_item_id", catalogItemID),
+					resource.TestCheckResourceAttr(resourceName, "line_item.0.quantity", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "outpost_id", "data.aws_outposts_outpost.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "payment_option", "NO_UPFRONT"),
+					resource.TestCheckResourceAttr(resourceName, "payment_term", "THREE_YEARS"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OutpostsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_outposts_order" {
+			continue
+		}
+
+		_, err := tfoutposts.FindOrderByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Outposts Order %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOrderExists(n string, v *outposts.Order) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Outposts Order ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OutpostsConn
+
+		output, err := tfoutposts.FindOrderByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOrderConfig_basic(catalogItemID string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_outposts_order" "test" {
+  outpost_id     = data.aws_outposts_outpost.test.id
+  payment_option = "NO_UPFRONT"
+  payment_term   = "THREE_YEARS"
+
+  line_item {
+    catalog_item_id = %[1]q
+    quantity        = 1
+  }
+}
+`, catalogItemID)
+}
@@ -209,3 +209,31 @@ func FindResourceSharePrincipalAssociationByShareARNPrincipal(conn *ram.RAM, res
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+// findResourceSharePermissionARNs returns the ARNs of the permissions associated with the specified resource share.
+func findResourceSharePermissionARNs(conn *ram.RAM, resourceShareARN string) (map[string]struct{}, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+	permissionARNs := make(map[string]struct{})
+
+	for {
+		output, err := conn.ListResourceSharePermissions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.Permissions {
+			permissionARNs[aws.StringValue(v.Arn)] = struct{}{}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return permissionARNs, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -49,7 +50,6 @@ func ResourceResourceShare() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
@@ -173,6 +173,60 @@ func resourceResourceShareUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("permission_arns") {
+		o, n := d.GetChange("permission_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Associating with Replace set replaces any permission for the same resource type
+		// and moves an already associated permission to its current default version.
+		for _, v := range ns.Difference(os).List() {
+			permissionARN := v.(string)
+			input := &ram.AssociateResourceSharePermissionInput{
+				ClientToken:      aws.String(resource.UniqueId()),
+				PermissionArn:    aws.String(permissionARN),
+				Replace:          aws.Bool(true),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Associating RAM Resource Share permission: %s", input)
+			_, err := conn.AssociateResourceSharePermission(input)
+
+			if err != nil {
+				return fmt.Errorf("associating RAM Resource Share (%s) permission (%s): %w", d.Id(), permissionARN, err)
+			}
+		}
+
+		if removed := os.Difference(ns); removed.Len() > 0 {
+			permissionARNs, err := findResourceSharePermissionARNs(conn, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("listing RAM Resource Share (%s) permissions: %w", d.Id(), err)
+			}
+
+			for _, v := range removed.List() {
+				permissionARN := v.(string)
+
+				// Replaced permissions are no longer associated.
+				if _, ok := permissionARNs[permissionARN]; !ok {
+					continue
+				}
+
+				input := &ram.DisassociateResourceSharePermissionInput{
+					ClientToken:      aws.String(resource.UniqueId()),
+					PermissionArn:    aws.String(permissionARN),
+					ResourceShareArn: aws.String(d.Id()),
+				}
+
+				log.Printf("[DEBUG] Disassociating RAM Resource Share permission: %s", input)
+				_, err := conn.DisassociateResourceSharePermission(input)
+
+				if err != nil {
+					return fmt.Errorf("disassociating RAM Resource Share (%s) permission (%s): %w", d.Id(), permissionARN, err)
+				}
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
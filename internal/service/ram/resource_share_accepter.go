@@ -92,10 +92,23 @@ func resourceResourceShareAccepterCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if invitation == nil || aws.StringValue(invitation.ResourceShareInvitationArn) == "" {
-		return fmt.Errorf(
-			"No RAM Resource Share (%s) invitation found\n\n"+
-				"NOTE: If both AWS accounts are in the same AWS Organization and RAM Sharing with AWS Organizations is enabled, this resource is not necessary",
-			shareARN)
+		// No pending invitation. The invitation may already have been accepted, or the share may not
+		// need accepting because both AWS accounts are in the same AWS Organization and RAM sharing
+		// with AWS Organizations is enabled. In either case the share is already active for this account.
+		resourceShare, err := FindResourceShareOwnerOtherAccountsByARN(conn, shareARN)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+			return fmt.Errorf("reading RAM Resource Share (%s): %w", shareARN, err)
+		}
+
+		if resourceShare == nil || aws.StringValue(resourceShare.Status) != ram.ResourceShareStatusActive {
+			return fmt.Errorf("No RAM Resource Share (%s) invitation found", shareARN)
+		}
+
+		log.Printf("[INFO] RAM Resource Share (%s) already accepted", shareARN)
+		d.SetId(shareARN)
+
+		return resourceResourceShareAccepterRead(d, meta)
 	}
 
 	input := &ram.AcceptResourceShareInvitationInput{
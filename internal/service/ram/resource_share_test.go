@@ -77,6 +77,38 @@ func TestAccRAMResourceShare_permission(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShare_permissionUpdate(t *testing.T) {
+	var resourceShare1, resourceShare2 ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_permissionName(rName, "AWSRAMBlankEndEntityCertificateAPICSRPassthroughIssuanceCertificateAuthority"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(resourceName, &resourceShare1),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMBlankEndEntityCertificateAPICSRPassthroughIssuanceCertificateAuthority", acctest.Partition())),
+				),
+			},
+			{
+				Config: testAccResourceShareConfig_permissionName(rName, "AWSRAMSubordinateCACertificatePathLen0IssuanceCertificateAuthority"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(resourceName, &resourceShare2),
+					testAccCheckResourceShareNotRecreated(&resourceShare1, &resourceShare2),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_arns.*", fmt.Sprintf("arn:%s:ram::aws:permission/AWSRAMSubordinateCACertificatePathLen0IssuanceCertificateAuthority", acctest.Partition())),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShare_allowExternalPrincipals(t *testing.T) {
 	var resourceShare1, resourceShare2 ram.ResourceShare
 	resourceName := "aws_ram_resource_share.test"
@@ -243,6 +275,16 @@ func testAccCheckResourceShareExists(resourceName string, v *ram.ResourceShare)
 	}
 }
 
+func testAccCheckResourceShareNotRecreated(i, j *ram.ResourceShare) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationTime).Equal(aws.TimeValue(j.CreationTime)) {
+			return fmt.Errorf("RAM Resource Share (%s) recreated", aws.StringValue(i.ResourceShareArn))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckResourceShareDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn
 
@@ -317,3 +359,14 @@ resource "aws_ram_resource_share" "test" {
 }
 `, rName)
 }
+
+func testAccResourceShareConfig_permissionName(rName, permissionName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ram_resource_share" "test" {
+  name            = %[1]q
+  permission_arns = ["arn:${data.aws_partition.current.partition}:ram::aws:permission/%[2]s"]
+}
+`, rName, permissionName)
+}
@@ -0,0 +1,128 @@
+package ram
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceSharedResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSharedResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_region_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ram.ResourceRegionScopeFilterAll,
+				ValidateFunc: validation.StringInSlice(ram.ResourceRegionScopeFilter_Values(), false),
+			},
+			"resource_share_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_region_scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSharedResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RAMConn
+
+	input := &ram.ListResourcesInput{
+		ResourceOwner:       aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceRegionScope: aws.String(d.Get("resource_region_scope").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_share_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceShareArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	var arns []string
+	var resources []interface{}
+
+	err := conn.ListResourcesPages(input, func(page *ram.ListResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Resources {
+			if v == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(v.Arn))
+			resources = append(resources, map[string]interface{}{
+				"arn":                   aws.StringValue(v.Arn),
+				"resource_region_scope": aws.StringValue(v.ResourceRegionScope),
+				"resource_share_arn":    aws.StringValue(v.ResourceShareArn),
+				"status":                aws.StringValue(v.Status),
+				"type":                  aws.StringValue(v.Type),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("listing RAM shared resources: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	if err := d.Set("resources", resources); err != nil {
+		return fmt.Errorf("setting resources: %w", err)
+	}
+
+	return nil
+}
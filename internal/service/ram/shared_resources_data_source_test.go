@@ -0,0 +1,48 @@
+package ram_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRAMSharedResourcesDataSource_resourceType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ram_shared_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedResourcesDataSourceConfig_resourceType(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", "aws_codebuild_project.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.resource_share_arn", "aws_ram_resource_share.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.status", ram.ResourceStatusAvailable),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "codebuild:Project"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedResourcesDataSourceConfig_resourceType(rName string) string {
+	return acctest.ConfigCompose(testAccResourceShareAccepterConfig_association(rName), `
+data "aws_ram_shared_resources" "test" {
+  resource_type       = "codebuild:Project"
+  resource_share_arns = [aws_ram_resource_share_accepter.test.share_arn]
+
+  depends_on = [aws_ram_resource_association.test]
+}
+`)
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -60,10 +64,15 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+// switchover switches over the Blue/Green Deployment.
+// switchoverTimeout is the number of seconds RDS allows for the switchover; zero uses the service default.
+func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, switchoverTimeout int32, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(switchoverTimeout)
+	}
 	_, err := tfresource.RetryWhenContext(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -94,9 +103,19 @@ func newInstanceHandler(conn *rds_sdkv2.Client) *instanceHandler {
 }
 
 func (h *instanceHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	if err := h.checkReplicas(ctx, d.Id()); err != nil {
+		return fmt.Errorf("checking pre-conditions: %s", err)
+	}
+
+	if d.Get("engine").(string) == InstanceEnginePostgres {
+		if err := h.checkLogicalReplication(ctx, d.Id()); err != nil {
+			return fmt.Errorf("checking pre-conditions: %s", err)
+		}
+	}
+
 	needsPreConditions := false
 	input := &rds_sdkv2.ModifyDBInstanceInput{
-		ApplyImmediately:     true,
+		ApplyImmediately:     aws.Bool(true),
 		DBInstanceIdentifier: aws.String(d.Id()),
 	}
 
@@ -121,28 +140,189 @@ func (h *instanceHandler) precondition(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func (h *instanceHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+// checkReplicas checks that the Blue environment's read replicas can be included in a Blue/Green Deployment.
+// RDS creates a Green replica for each same-Region read replica, but cross-Region read replicas are not supported.
+func (h *instanceHandler) checkReplicas(ctx context.Context, identifier string) error {
+	instance, err := findDBInstanceByIDSDKv2(ctx, h.conn, identifier)
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %s", identifier, err)
+	}
+
+	for _, v := range instance.ReadReplicaDBInstanceIdentifiers {
+		// Cross-Region read replicas are identified by ARN.
+		if arn.IsARN(v) {
+			return fmt.Errorf("cross-Region read replica (%s) is not supported by Blue/Green Deployments: remove the read replica before updating", v)
+		}
+	}
+
+	return nil
+}
+
+// waitForGreenReplicas waits for the Green environment's read replicas to become available.
+func (h *instanceHandler) waitForGreenReplicas(ctx context.Context, dep *types.BlueGreenDeployment, timeout time.Duration) error {
+	_, green, err := blueGreenDeploymentInstanceMembers(dep)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range green {
+		replicaARN, err := parseDBInstanceARN(v)
+		if err != nil {
+			return fmt.Errorf("Green environment read replica: %s", err)
+		}
+
+		if _, err := waitDBInstanceAvailableSDKv2(ctx, h.conn, replicaARN.Identifier, timeout); err != nil {
+			return fmt.Errorf("waiting for Green environment read replica (%s): %s", replicaARN.Identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// blueGreenDeploymentInstanceMembers returns the ARNs of the Blue and Green DB instance members of a Blue/Green Deployment,
+// other than the source and target themselves: read replicas for a DB instance, or the cluster's DB instances for a DB cluster.
+func blueGreenDeploymentInstanceMembers(dep *types.BlueGreenDeployment) ([]string, []string, error) {
+	var blue, green []string
+
+	for _, detail := range dep.SwitchoverDetails {
+		source, target := aws.StringValue(detail.SourceMember), aws.StringValue(detail.TargetMember)
+		if source == aws.StringValue(dep.Source) {
+			continue
+		}
+
+		sourceARN, err := arn.Parse(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Blue/Green Deployment source member (%s): %w", source, err)
+		}
+
+		// Other members, e.g. a DB cluster's custom endpoints, aren't DB instances.
+		if !strings.HasPrefix(sourceARN.Resource, "db:") {
+			continue
+		}
+
+		if _, err := parseDBInstanceARN(target); err != nil {
+			return nil, nil, fmt.Errorf("Blue/Green Deployment target member (%s): %w", target, err)
+		}
+
+		blue = append(blue, source)
+		green = append(green, target)
+	}
+
+	return blue, green, nil
+}
+
+// checkLogicalReplication checks that logical replication is enabled on the Blue environment.
+// RDS for PostgreSQL uses logical replication to keep the Green environment in sync.
+func (h *instanceHandler) checkLogicalReplication(ctx context.Context, identifier string) error {
+	instance, err := findDBInstanceByIDSDKv2(ctx, h.conn, identifier)
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %s", identifier, err)
+	}
+
+	if len(instance.DBParameterGroups) == 0 {
+		return fmt.Errorf(`the DB parameter group must set %q to "1" for a Blue/Green Deployment`, parameterNameLogicalReplication)
+	}
+
+	group := instance.DBParameterGroups[0]
+	groupName := aws.StringValue(group.DBParameterGroupName)
+	if status := aws.StringValue(group.ParameterApplyStatus); status != parameterApplyStatusInSync {
+		return fmt.Errorf("DB parameter group (%s) is %s: the DB instance must be rebooted before a Blue/Green Deployment", groupName, status)
+	}
+
+	parameter, err := findDBParameterByTwoPartKey(ctx, h.conn, groupName, parameterNameLogicalReplication)
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("reading DB parameter group (%s) parameter (%s): %s", groupName, parameterNameLogicalReplication, err)
+	}
+
+	if parameter == nil || aws.StringValue(parameter.ParameterValue) != "1" {
+		return fmt.Errorf(`DB parameter group (%s) must set %q to "1" for a Blue/Green Deployment`, groupName, parameterNameLogicalReplication)
+	}
+
+	return nil
+}
+
+func (h *instanceHandler) createBlueGreenInput(d *schema.ResourceData, tags tftags.KeyValueTags) *rds_sdkv2.CreateBlueGreenDeploymentInput {
 	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
 		BlueGreenDeploymentName: aws.String(d.Id()),
 		Source:                  aws.String(d.Get("arn").(string)),
+		Tags:                    tagsSDKv2(tags.IgnoreAWS()),
 	}
 
-	if d.HasChange("engine_version") {
+	if v, ok := d.GetOk("blue_green_update.0.target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	} else if d.HasChange("engine_version") {
 		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
 	}
-	if d.HasChange("parameter_group_name") {
+	if v, ok := d.GetOk("blue_green_update.0.target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	} else if d.HasChange("parameter_group_name") {
 		input.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 	}
+	if v, ok := d.GetOk("blue_green_update.0.target_db_instance_class"); ok {
+		input.TargetDBInstanceClass = aws.String(v.(string))
+	} else if d.HasChange("instance_class") {
+		input.TargetDBInstanceClass = aws.String(d.Get("instance_class").(string))
+	}
+	if v, ok := d.GetOk("blue_green_update.0.target_allocated_storage"); ok {
+		input.TargetAllocatedStorage = aws.Int32(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("blue_green_update.0.target_iops"); ok {
+		input.TargetIops = aws.Int32(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("blue_green_update.0.target_storage_throughput"); ok {
+		input.TargetStorageThroughput = aws.Int32(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("blue_green_update.0.target_storage_type"); ok {
+		input.TargetStorageType = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// targetStorageModification returns the storage modification for the Green environment, or nil if there is none.
+// Storage settings with a target_* override are applied when the Blue/Green Deployment is created, so they are left out.
+func (h *instanceHandler) targetStorageModification(d *schema.ResourceData, identifier string) *rds_sdkv2.ModifyDBInstanceInput {
+	input := dbInstanceStorageModification(d, identifier, true)
+
+	if input == nil {
+		return nil
+	}
+
+	iops := input.Iops
+
+	if _, ok := d.GetOk("blue_green_update.0.target_allocated_storage"); ok {
+		input.AllocatedStorage = nil
+	}
+	if _, ok := d.GetOk("blue_green_update.0.target_iops"); ok {
+		input.Iops = nil
+	}
+	if _, ok := d.GetOk("blue_green_update.0.target_storage_throughput"); ok {
+		input.StorageThroughput = nil
+	}
+	if _, ok := d.GetOk("blue_green_update.0.target_storage_type"); ok {
+		input.StorageType = nil
+	}
+
+	if input.AllocatedStorage == nil && input.Iops == nil && input.StorageThroughput == nil && input.StorageType == nil {
+		return nil
+	}
+
+	// Provisioned IOPS must still be specified with the remaining modifications.
+	if input.Iops == nil && (storageTypeRequiresIOPS(d.Get("storage_type").(string)) || input.StorageThroughput != nil) {
+		input.Iops = iops
+	}
 
 	return input
 }
 
 func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d *schema.ResourceData, timeout time.Duration, operation string) error {
 	modifyInput := &rds_sdkv2.ModifyDBInstanceInput{
-		ApplyImmediately:     true,
+		ApplyImmediately:     aws.Bool(true),
 		DBInstanceIdentifier: aws.String(identifier),
 	}
 
+	// The Green environment's engine version, DB parameter group and instance class are set when the
+	// Blue/Green Deployment is created, so they are not part of the modification.
 	needsModify := dbInstancePopulateModify(modifyInput, d)
 
 	if needsModify {
@@ -154,9 +334,128 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 		}
 	}
 
+	if storageInput := h.targetStorageModification(d, identifier); storageInput != nil {
+		log.Printf("[DEBUG] %s: Updating Green environment storage", operation)
+
+		err := dbInstanceModifyStorage(ctx, h.conn, storageInput, timeout)
+		if err != nil {
+			return fmt.Errorf("updating Green environment storage: %s", err)
+		}
+	}
+
 	return nil
 }
 
+// deleteBlueInstance deletes a DB instance left in the Blue environment after switchover.
+func (h *instanceHandler) deleteBlueInstance(ctx context.Context, identifier string) error {
+	input := &rds_sdkv2.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:    aws.Bool(true),
+	}
+	_, err := tfresource.RetryWhenContext(ctx, 5*time.Minute,
+		func() (any, error) {
+			return h.conn.DeleteDBInstance(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// Retry for IAM eventual consistency.
+			apiErr, ok := errs.As[smithy.APIError](err)
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
+type clusterHandler struct {
+	conn *rds_sdkv2.Client
+}
+
+func newClusterHandler(conn *rds_sdkv2.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData, tags tftags.KeyValueTags) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+		Tags:                    tagsSDKv2(tags.IgnoreAWS()),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+// waitForGreenMembers waits for the Green environment's DB instances to become available.
+func (h *clusterHandler) waitForGreenMembers(ctx context.Context, dep *types.BlueGreenDeployment, timeout time.Duration) error {
+	_, green, err := blueGreenDeploymentInstanceMembers(dep)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range green {
+		memberARN, err := parseDBInstanceARN(v)
+		if err != nil {
+			return fmt.Errorf("Green environment DB instance: %s", err)
+		}
+
+		if _, err := waitDBInstanceAvailableSDKv2(ctx, h.conn, memberARN.Identifier, timeout); err != nil {
+			return fmt.Errorf("waiting for Green environment DB instance (%s): %s", memberARN.Identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteBlueCluster deletes a DB cluster left in the Blue environment after switchover.
+// The cluster's DB instances must already be deleting.
+func (h *clusterHandler) deleteBlueCluster(ctx context.Context, identifier string, deletionProtection bool, timeout time.Duration) error {
+	if deletionProtection {
+		_, err := h.conn.ModifyDBCluster(ctx, &rds_sdkv2.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(identifier),
+			DeletionProtection:  aws.Bool(false),
+		})
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection: %s", err)
+		}
+	}
+
+	input := &rds_sdkv2.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:   aws.Bool(true),
+	}
+	_, err := tfresource.RetryWhenContext(ctx, timeout,
+		func() (any, error) {
+			return h.conn.DeleteDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			// The cluster's DB instances may still be deleting.
+			return errs.IsA[*types.InvalidDBClusterStateFault](err), err
+		},
+	)
+
+	return err
+}
+
 type deadline time.Time
 
 func NewDeadline(duration time.Duration) deadline {
@@ -0,0 +1,91 @@
+package rds
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCertificatePut,
+		ReadWithoutTimeout:   resourceCertificateRead,
+		UpdateWithoutTimeout: resourceCertificatePut,
+		DeleteWithoutTimeout: resourceCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceCertificatePut(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	certificateID := d.Get("certificate_identifier").(string)
+	input := &rds.ModifyCertificatesInput{
+		CertificateIdentifier: aws.String(certificateID),
+	}
+
+	_, err := conn.ModifyCertificatesWithContext(ctx, input)
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "setting RDS default certificate (%s): %s", certificateID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceCertificateRead(ctx, d, meta)...)
+}
+
+func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	output, err := FindDefaultCertificate(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS default certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS default certificate (%s): %s", d.Id(), err)
+	}
+
+	d.Set("certificate_identifier", output.CertificateIdentifier)
+
+	return diags
+}
+
+func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	// Removing the override reverts new DB instances to the system-default CA.
+	log.Printf("[DEBUG] Removing RDS default certificate override (%s)", d.Id())
+	_, err := conn.ModifyCertificatesWithContext(ctx, &rds.ModifyCertificatesInput{
+		RemoveCustomerOverride: aws.Bool(true),
+	})
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "removing RDS default certificate override (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -2,16 +2,23 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +28,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -85,6 +93,29 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_source": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -174,6 +205,13 @@ func ResourceCluster() *schema.Resource {
 				Default:      ClusterEngineAurora,
 				ValidateFunc: validClusterEngine(),
 			},
+			"engine_lifecycle_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(EngineLifecycleSupport_Values(), false),
+			},
 			"engine_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -235,7 +273,7 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(verify.ARNService("kms")),
 			},
 			"master_password": {
 				Type:      schema.TypeString,
@@ -307,12 +345,25 @@ func ResourceCluster() *schema.Resource {
 						},
 						"source_cluster_identifier": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 							ValidateFunc: validation.Any(
 								verify.ValidARN,
 								validIdentifier,
 							),
+							ExactlyOneOf: []string{
+								"restore_to_point_in_time.0.source_cluster_identifier",
+								"restore_to_point_in_time.0.source_cluster_resource_id",
+							},
+						},
+						"source_cluster_resource_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ExactlyOneOf: []string{
+								"restore_to_point_in_time.0.source_cluster_identifier",
+								"restore_to_point_in_time.0.source_cluster_resource_id",
+							},
 						},
 						"use_latest_restorable_time": {
 							Type:          schema.TypeBool,
@@ -457,13 +508,53 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.ARNPartitionMatchesProvider("kms_key_id"),
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get("engine").(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if engineMode := d.Get("engine_mode").(string); engineMode != EngineModeProvisioned {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine_mode" is %q.`, engineMode)
+				}
+				return nil
+			},
+		),
 	}
 }
 
+// clusterBlueGreenUpdateKeys are the arguments whose changes are made with a Blue/Green Deployment
+// when blue_green_update is enabled. Other changes are made in-place.
+var clusterBlueGreenUpdateKeys = []string{
+	"db_cluster_parameter_group_name",
+	"db_instance_parameter_group_name",
+	"engine_version",
+}
+
+// clusterModifyExceptKeys are the arguments whose changes are not made with ModifyDBCluster.
+var clusterModifyExceptKeys = []string{
+	"allow_major_version_upgrade",
+	"blue_green_update",
+	"enable_http_endpoint",
+	"final_snapshot_identifier",
+	"global_cluster_identifier",
+	"iam_roles",
+	"replication_source_identifier",
+	"skip_final_snapshot",
+	"tags", "tags_all",
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn
+	connV2 := meta.(*conns.AWSClient).RDSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -487,18 +578,18 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
-		input := &rds.RestoreDBClusterFromSnapshotInput{
+		input := &rds_sdkv2.RestoreDBClusterFromSnapshotInput{
 			CopyTagsToSnapshot:  aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBClusterIdentifier: aws.String(identifier),
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
 			Engine:              aws.String(d.Get("engine").(string)),
 			EngineMode:          aws.String(d.Get("engine_mode").(string)),
 			SnapshotIdentifier:  aws.String(v.(string)),
-			Tags:                Tags(tags.IgnoreAWS()),
+			Tags:                tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if v, ok := d.GetOk("availability_zones"); ok && v.(*schema.Set).Len() > 0 {
-			input.AvailabilityZones = flex.ExpandStringSet(v.(*schema.Set))
+			input.AvailabilityZones = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("backtrack_window"); ok {
@@ -523,13 +614,17 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("engine_version"); ok {
 			input.EngineVersion = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("kms_key_id"); ok {
 			input.KmsKeyId = aws.String(v.(string))
 		}
@@ -548,7 +643,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("preferred_backup_window"); ok {
@@ -562,7 +657,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			input.ScalingConfiguration = expandScalingConfigurationSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -571,15 +666,17 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
-		log.Printf("[DEBUG] Creating RDS Cluster: %s", input)
-		_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout,
+		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBClusterFromSnapshotWithContext(ctx, input)
+				return connV2.RestoreDBClusterFromSnapshot(ctx, input)
+			},
+			func(err error) (bool, error) {
+				return errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions"), err
 			},
-			errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions")
+		)
 
 		if err != nil {
 			return errs.AppendErrorf(diags, "creating RDS Cluster (restore from snapshot) (%s): %s", identifier, err)
@@ -596,7 +693,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		tfMap := v.([]interface{})[0].(map[string]interface{})
-		input := &rds.RestoreDBClusterFromS3Input{
+		input := &rds_sdkv2.RestoreDBClusterFromS3Input{
 			CopyTagsToSnapshot:  aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBClusterIdentifier: aws.String(identifier),
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
@@ -608,11 +705,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			S3Prefix:            aws.String(tfMap["bucket_prefix"].(string)),
 			SourceEngine:        aws.String(tfMap["source_engine"].(string)),
 			SourceEngineVersion: aws.String(tfMap["source_engine_version"].(string)),
-			Tags:                Tags(tags.IgnoreAWS()),
+			Tags:                tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if v, ok := d.GetOk("availability_zones"); ok && v.(*schema.Set).Len() > 0 {
-			input.AvailabilityZones = flex.ExpandStringSet(v.(*schema.Set))
+			input.AvailabilityZones = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("backtrack_window"); ok {
@@ -620,7 +717,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("backup_retention_period"); ok {
-			input.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+			input.BackupRetentionPeriod = aws.Int32(int32(v.(int)))
 		}
 
 		if v := d.Get("database_name"); v.(string) != "" {
@@ -636,13 +733,17 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("engine_version"); ok {
 			input.EngineVersion = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
 		}
@@ -656,7 +757,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("preferred_backup_window"); ok {
@@ -672,25 +773,25 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBClusterFromS3WithContext(ctx, input)
+				return connV2.RestoreDBClusterFromS3(ctx, input)
 			},
 			func(err error) (bool, error) {
 				// InvalidParameterValue: Files from the specified Amazon S3 bucket cannot be downloaded.
 				// Make sure that you have created an AWS Identity and Access Management (IAM) role that lets Amazon RDS access Amazon S3 for you.
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Files from the specified Amazon S3 bucket cannot be downloaded") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "Files from the specified Amazon S3 bucket cannot be downloaded") {
 					return true, err
 				}
 
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "S3_SNAPSHOT_INGESTION") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "S3_SNAPSHOT_INGESTION") {
 					return true, err
 				}
 
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "S3 bucket cannot be found") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "S3 bucket cannot be found") {
 					return true, err
 				}
 
@@ -703,11 +804,20 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
-		input := &rds.RestoreDBClusterToPointInTimeInput{
-			DBClusterIdentifier:       aws.String(identifier),
-			DeletionProtection:        aws.Bool(d.Get("deletion_protection").(bool)),
-			SourceDBClusterIdentifier: aws.String(tfMap["source_cluster_identifier"].(string)),
-			Tags:                      Tags(tags.IgnoreAWS()),
+		input := &rds_sdkv2.RestoreDBClusterToPointInTimeInput{
+			CopyTagsToSnapshot:  aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
+			DBClusterIdentifier: aws.String(identifier),
+			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
+			Tags:                tagsSDKv2(tags.IgnoreAWS()),
+		}
+
+		// The source cluster can be in another account when identified by ARN.
+		if v, ok := tfMap["source_cluster_identifier"].(string); ok && v != "" {
+			input.SourceDBClusterIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_cluster_resource_id"].(string); ok && v != "" {
+			input.SourceDbClusterResourceId = aws.String(v)
 		}
 
 		if v, ok := tfMap["restore_to_time"].(string); ok && v != "" {
@@ -741,7 +851,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
 		}
 
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
@@ -766,7 +880,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("preferred_backup_window"); ok {
@@ -794,31 +908,30 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
-		log.Printf("[DEBUG] Creating RDS Cluster: %s", input)
-		_, err := conn.RestoreDBClusterToPointInTimeWithContext(ctx, input)
+		_, err := connV2.RestoreDBClusterToPointInTime(ctx, input)
 
 		if err != nil {
 			return errs.AppendErrorf(diags, "creating RDS Cluster (restore to point-in-time) (%s): %s", identifier, err)
 		}
 	} else {
-		input := &rds.CreateDBClusterInput{
+		input := &rds_sdkv2.CreateDBClusterInput{
 			CopyTagsToSnapshot:  aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBClusterIdentifier: aws.String(identifier),
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
 			Engine:              aws.String(d.Get("engine").(string)),
 			EngineMode:          aws.String(d.Get("engine_mode").(string)),
-			Tags:                Tags(tags.IgnoreAWS()),
+			Tags:                tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if v, ok := d.GetOkExists("allocated_storage"); ok {
-			input.AllocatedStorage = aws.Int64(int64(v.(int)))
+			input.AllocatedStorage = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("availability_zones"); ok && v.(*schema.Set).Len() > 0 {
-			input.AvailabilityZones = flex.ExpandStringSet(v.(*schema.Set))
+			input.AvailabilityZones = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("backtrack_window"); ok {
@@ -826,7 +939,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("backup_retention_period"); ok {
-			input.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+			input.BackupRetentionPeriod = aws.Int32(int32(v.(int)))
 		}
 
 		if v := d.Get("database_name"); v.(string) != "" {
@@ -849,12 +962,12 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EnableGlobalWriteForwarding = aws.Bool(v.(bool))
 		}
 
-		if v, ok := d.GetOk("enable_http_endpoint"); ok {
+		if v, ok := d.GetOk("enable_http_endpoint"); ok && d.Get("engine_mode").(string) == EngineModeServerless {
 			input.EnableHttpEndpoint = aws.Bool(v.(bool))
 		}
 
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("engine_version"); ok {
@@ -865,12 +978,16 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.GlobalClusterIdentifier = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
 		}
 
 		if v, ok := d.GetOkExists("iops"); ok {
-			input.Iops = aws.Int64(int64(v.(int)))
+			input.Iops = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("kms_key_id"); ok {
@@ -894,7 +1011,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("preferred_backup_window"); ok {
@@ -910,11 +1027,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			input.ScalingConfiguration = expandScalingConfigurationSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfigurationSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("source_region"); ok {
@@ -930,14 +1047,17 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
-		_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout,
+		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.CreateDBClusterWithContext(ctx, input)
+				return connV2.CreateDBCluster(ctx, input)
 			},
-			errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions")
+			func(err error) (bool, error) {
+				return errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions"), err
+			},
+		)
 
 		if err != nil {
 			return errs.AppendErrorf(diags, "creating RDS Cluster (%s): %s", identifier, err)
@@ -946,10 +1066,33 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(identifier)
 
-	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	dbc, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return errs.AppendErrorf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	// A point-in-time restore always has the source cluster's engine version. Upgrade it afterwards.
+	if _, ok := d.GetOk("restore_to_point_in_time"); ok {
+		if v, ok := d.GetOk("engine_version"); ok && !engineVersionMatches(v.(string), aws.StringValue(dbc.EngineVersion)) {
+			modifyDbClusterInput.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
+			modifyDbClusterInput.EngineVersion = aws.String(v.(string))
+			requiresModifyDbCluster = true
+		}
+	}
+
+	if v, ok := d.GetOk("enable_http_endpoint"); ok && v.(bool) && d.Get("engine_mode").(string) != EngineModeServerless {
+		dbc, err := FindDBClusterByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return errs.AppendErrorf(diags, "reading RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := modifyClusterHTTPEndpoint(ctx, meta.(*conns.AWSClient).RDSClient(), conn, aws.StringValue(dbc.DBClusterArn), d.Id(), true, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return errs.AppendErrorf(diags, "enabling HTTP endpoint for RDS Cluster (%s): %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("iam_roles"); ok && v.(*schema.Set).Len() > 0 {
 		for _, v := range v.(*schema.Set).List() {
 			if err := addIAMRoleToCluster(ctx, conn, d.Id(), v.(string)); err != nil {
@@ -975,11 +1118,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	dbc, err := FindDBClusterByID(ctx, conn, d.Id())
+	dbc, err := findDBClusterByIDSDKv2(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
@@ -994,7 +1137,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("allocated_storage", dbc.AllocatedStorage)
 	clusterARN := aws.StringValue(dbc.DBClusterArn)
 	d.Set("arn", clusterARN)
-	d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones))
+	d.Set("availability_zones", dbc.AvailabilityZones)
 	d.Set("backtrack_window", dbc.BacktrackWindow)
 	d.Set("backup_retention_period", dbc.BackupRetentionPeriod)
 	d.Set("cluster_identifier", dbc.DBClusterIdentifier)
@@ -1016,10 +1159,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("db_cluster_parameter_group_name", dbc.DBClusterParameterGroup)
 	d.Set("db_subnet_group_name", dbc.DBSubnetGroup)
 	d.Set("deletion_protection", dbc.DeletionProtection)
-	d.Set("enabled_cloudwatch_logs_exports", aws.StringValueSlice(dbc.EnabledCloudwatchLogsExports))
+	d.Set("enabled_cloudwatch_logs_exports", dbc.EnabledCloudwatchLogsExports)
 	d.Set("enable_http_endpoint", dbc.HttpEndpointEnabled)
 	d.Set("endpoint", dbc.Endpoint)
 	d.Set("engine", dbc.Engine)
+	d.Set("engine_lifecycle_support", dbc.EngineLifecycleSupport)
 	d.Set("engine_mode", dbc.EngineMode)
 	clusterSetResourceDataEngineVersionFromCluster(d, dbc)
 	d.Set("hosted_zone_id", dbc.HostedZoneId)
@@ -1039,14 +1183,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("reader_endpoint", dbc.ReaderEndpoint)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
 	if dbc.ScalingConfigurationInfo != nil {
-		if err := d.Set("scaling_configuration", []interface{}{flattenScalingConfigurationInfo(dbc.ScalingConfigurationInfo)}); err != nil {
+		if err := d.Set("scaling_configuration", []interface{}{flattenScalingConfigurationInfoSDKv2(dbc.ScalingConfigurationInfo)}); err != nil {
 			return errs.AppendErrorf(diags, "setting scaling_configuration: %s", err)
 		}
 	} else {
 		d.Set("scaling_configuration", nil)
 	}
 	if dbc.ServerlessV2ScalingConfiguration != nil {
-		if err := d.Set("serverlessv2_scaling_configuration", []interface{}{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
+		if err := d.Set("serverlessv2_scaling_configuration", []interface{}{flattenServerlessV2ScalingConfigurationInfoSDKv2(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
 			return errs.AppendErrorf(diags, "setting serverlessv2_scaling_configuration: %s", err)
 		}
 	} else {
@@ -1060,13 +1204,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.Set("vpc_security_group_ids", securityGroupIDs)
 
-	tags, err := ListTagsWithContext(ctx, conn, clusterARN)
-
-	if err != nil {
-		return errs.AppendErrorf(diags, "listing tags for RDS Cluster (%s): %s", d.Id(), err)
-	}
-
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := keyValueTagsSDKv2(dbc.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -1081,7 +1219,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("global_cluster_identifier", "")
 
 	if aws.StringValue(dbc.EngineMode) == EngineModeGlobal || aws.StringValue(dbc.EngineMode) == EngineModeProvisioned {
-		globalCluster, err := FindGlobalClusterByDBClusterARN(ctx, conn, aws.StringValue(dbc.DBClusterArn))
+		globalCluster, err := FindGlobalClusterByDBClusterARN(ctx, meta.(*conns.AWSClient).RDSConn, clusterARN)
 
 		if err == nil {
 			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
@@ -1099,14 +1237,26 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn
 
-	if d.HasChangesExcept(
-		"allow_major_version_upgrade",
-		"final_snapshot_identifier",
-		"global_cluster_identifier",
-		"iam_roles",
-		"replication_source_identifier",
-		"skip_final_snapshot",
-		"tags", "tags_all") {
+	// Only Aurora Serverless v1 clusters toggle the Data API via ModifyDBCluster.
+	// Provisioned and Serverless v2 clusters use EnableHttpEndpoint/DisableHttpEndpoint.
+	serverlessV1 := d.Get("engine_mode").(string) == EngineModeServerless
+
+	blueGreen := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(clusterBlueGreenUpdateKeys...)
+	if blueGreen {
+		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+		diags = append(diags, clusterBlueGreenUpdate(ctx, conn, meta.(*conns.AWSClient).RDSClient(), d, tags)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	modifyExceptKeys := clusterModifyExceptKeys
+	if blueGreen {
+		modifyExceptKeys = append(slices.Clone(modifyExceptKeys), clusterBlueGreenUpdateKeys...)
+	}
+
+	if d.HasChangesExcept(modifyExceptKeys...) || (serverlessV1 && d.HasChange("enable_http_endpoint")) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1136,11 +1286,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EngineVersion = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if !blueGreen && d.HasChange("db_cluster_parameter_group_name") {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
-		if d.HasChange("db_instance_parameter_group_name") {
+		if !blueGreen && d.HasChange("db_instance_parameter_group_name") {
 			input.DBInstanceParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
 		}
 
@@ -1152,7 +1302,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
 		}
 
-		if d.HasChange("enable_http_endpoint") {
+		if serverlessV1 && d.HasChange("enable_http_endpoint") {
 			input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
 		}
 
@@ -1167,7 +1317,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if !blueGreen && d.HasChange("engine_version") {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
@@ -1249,6 +1399,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if !serverlessV1 && d.HasChange("enable_http_endpoint") {
+		if err := modifyClusterHTTPEndpoint(ctx, meta.(*conns.AWSClient).RDSClient(), conn, d.Get("arn").(string), d.Id(), d.Get("enable_http_endpoint").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errs.AppendErrorf(diags, "updating RDS Cluster (%s) HTTP endpoint: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("global_cluster_identifier") {
 		oRaw, nRaw := d.GetChange("global_cluster_identifier")
 		o := oRaw.(string)
@@ -1273,6 +1429,15 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeGlobalClusterNotFoundFault) && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
 			return errs.AppendErrorf(diags, "removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
 		}
+
+		if err := waitForGlobalClusterRemoval(conn, d.Get("arn").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errs.AppendErrorf(diags, "waiting for RDS Cluster (%s) removal from RDS Global Cluster (%s): %s", d.Id(), o, err)
+		}
+
+		// A secondary cluster, including a headless cluster with no DB instances, is promoted to a standalone cluster.
+		if _, err := waitDBClusterPromoted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errs.AppendErrorf(diags, "waiting for RDS Cluster (%s) promotion: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("iam_roles") {
@@ -1310,6 +1475,151 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterBlueGreenUpdate makes the Blue/Green changes to a DB cluster with a Blue/Green Deployment.
+// tags are applied to the Blue/Green Deployment.
+func clusterBlueGreenUpdate(ctx context.Context, conn *rds.RDS, connV2 *rds_sdkv2.Client, d *schema.ResourceData, tags tftags.KeyValueTags) (diags diag.Diagnostics) {
+	deadline := NewDeadline(d.Timeout(schema.TimeoutUpdate))
+	orchestrator := newBlueGreenOrchestrator(connV2)
+	handler := newClusterHandler(connV2)
+	var cleaupWaiters []func(optFns ...tfresource.OptionsFunc)
+	defer func() {
+		if len(cleaupWaiters) == 0 {
+			return
+		}
+
+		waiter, waiters := cleaupWaiters[0], cleaupWaiters[1:]
+		waiter()
+		for _, waiter := range waiters {
+			// Skip the delay for subsequent waiters. Since we're waiting for all of the waiters
+			// to complete, we don't need to run them concurrently, saving on network traffic.
+			waiter(tfresource.WithDelay(0))
+		}
+	}()
+
+	tflog.Info(ctx, "Updating RDS Cluster: Creating Blue/Green Deployment", map[string]interface{}{
+		"id": d.Id(),
+	})
+
+	dep, err := orchestrator.createDeployment(ctx, handler.createBlueGreenInput(d, tags))
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+	progressFields := map[string]interface{}{
+		"id":                       d.Id(),
+		"blue_green_deployment_id": aws.StringValue(deploymentIdentifier),
+	}
+
+	tflog.Info(ctx, "Updating RDS Cluster: Blue/Green Deployment created", progressFields)
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		if dep == nil {
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment: deployment disappeared", d.Id())
+			return
+		}
+
+		// Ensure that the Blue/Green Deployment is always cleaned up
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: deploymentIdentifier,
+		}
+		if aws.StringValue(dep.Status) != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+		_, err = connV2.DeleteBlueGreenDeployment(ctx, input)
+		if err != nil {
+			diags = errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+			return
+		}
+
+		cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
+			_, err = waitBlueGreenDeploymentDeleted(ctx, connV2, aws.StringValue(deploymentIdentifier), deadline.remaining(), optFns...)
+			if err != nil {
+				diags = errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+			}
+		})
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	targetARN, err := parseDBClusterARN(aws.StringValue(dep.Target))
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+	if _, err := waitDBClusterUpdated(ctx, conn, targetARN.Identifier, deadline.remaining()); err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+
+	if err := handler.waitForGreenMembers(ctx, dep, deadline.remaining()); err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: %s", d.Id(), err)
+	}
+
+	tflog.Info(ctx, "Updating RDS Cluster: Blue/Green Deployment Green environment available", progressFields)
+
+	tflog.Info(ctx, "Updating RDS Cluster: Blue/Green Deployment switchover started", progressFields)
+
+	dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), int32(d.Get("blue_green_update.0.switchover_timeout").(int)), deadline.remaining())
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	tflog.Info(ctx, "Updating RDS Cluster: Blue/Green Deployment switchover completed", progressFields)
+
+	sourceARN, err := parseDBClusterARN(aws.StringValue(dep.Source))
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	if !d.Get("blue_green_update.0.delete_source").(bool) {
+		tflog.Info(ctx, "Updating RDS Cluster: Retaining Blue/Green Deployment source", map[string]interface{}{
+			"id":                       d.Id(),
+			"blue_green_deployment_id": aws.StringValue(deploymentIdentifier),
+			"source_id":                sourceARN.Identifier,
+		})
+
+		return diags
+	}
+
+	tflog.Info(ctx, "Updating RDS Cluster: Deleting Blue/Green Deployment source", progressFields)
+
+	// The Green environment's DB instances take over the identifiers of the source cluster's DB instances.
+	// Delete the source cluster's DB instances before the source cluster.
+	blueMembers, _, err := blueGreenDeploymentInstanceMembers(dep)
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source DB instances: %s", d.Id(), err)
+	}
+
+	for _, v := range blueMembers {
+		memberARN, err := parseDBInstanceARN(v)
+		if err != nil {
+			return errs.AppendErrorf(diags, "updating RDS Cluster (%s): Blue/Green Deployment source DB instance: %s", d.Id(), err)
+		}
+
+		if err := newInstanceHandler(connV2).deleteBlueInstance(ctx, memberARN.Identifier); err != nil {
+			return errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source DB instance (%s): %s", d.Id(), memberARN.Identifier, err)
+		}
+	}
+
+	if err := handler.deleteBlueCluster(ctx, sourceARN.Identifier, d.Get("deletion_protection").(bool), deadline.remaining()); err != nil {
+		return errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
+		_, err := waitDBClusterDeleted(ctx, conn, sourceARN.Identifier, deadline.remaining())
+		if err != nil {
+			diags = errs.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+			return
+		}
+
+		tflog.Info(ctx, "Updating RDS Cluster: Blue/Green Deployment source deleted", progressFields)
+	})
+
+	return diags
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn
 
@@ -1450,7 +1760,30 @@ func removeIAMRoleFromCluster(ctx context.Context, conn *rds.RDS, clusterID, rol
 	return err
 }
 
-func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *rds.DBCluster) {
+func modifyClusterHTTPEndpoint(ctx context.Context, conn *rds_sdkv2.Client, connV1 *rds.RDS, arn, id string, enabled bool, timeout time.Duration) error {
+	var err error
+	if enabled {
+		_, err = conn.EnableHttpEndpoint(ctx, &rds_sdkv2.EnableHttpEndpointInput{
+			ResourceArn: aws.String(arn),
+		})
+	} else {
+		_, err = conn.DisableHttpEndpoint(ctx, &rds_sdkv2.DisableHttpEndpointInput{
+			ResourceArn: aws.String(arn),
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBClusterHTTPEndpointUpdated(ctx, connV1, id, enabled, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *types.DBCluster) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
 	compareActualEngineVersion(d, oldVersion, newVersion)
@@ -1486,6 +1819,38 @@ func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCl
 	return dbCluster, nil
 }
 
+func findDBClusterByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.DBCluster, error) {
+	input := &rds_sdkv2.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBClusters(ctx, input)
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBClusters) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	dbCluster := &output.DBClusters[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbCluster.DBClusterIdentifier) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return dbCluster, nil
+}
+
 func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -1513,6 +1878,29 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 	return nil, err
 }
 
+func waitDBClusterPromoted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ClusterStatusBackingUp,
+			ClusterStatusModifying,
+			ClusterStatusPromoting,
+		},
+		Target:     []string{ClusterStatusAvailable},
+		Refresh:    statusDBCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -1563,6 +1951,25 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 	return nil, err
 }
 
+func waitDBClusterHTTPEndpointUpdated(ctx context.Context, conn *rds.RDS, id string, enabled bool, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{strconv.FormatBool(!enabled)},
+		Target:                    []string{strconv.FormatBool(enabled)},
+		Refresh:                   statusDBClusterHTTPEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDBClusterByID(ctx, conn, id)
@@ -1578,3 +1985,51 @@ func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) resource.Sta
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusDBClusterHTTPEndpoint(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(aws.BoolValue(output.HttpEndpointEnabled)), nil
+	}
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexp.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
@@ -107,6 +107,42 @@ func TestAccRDSCluster_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_engineLifecycleSupport(t *testing.T) {
+	var dbCluster rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_engineLifecycleSupport(rName, tfrds.EngineLifecycleSupportExtendedSupportDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "engine_lifecycle_support", tfrds.EngineLifecycleSupportExtendedSupportDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"allow_major_version_upgrade",
+					"apply_immediately",
+					"cluster_identifier_prefix",
+					"db_instance_parameter_group_name",
+					"enable_global_write_forwarding",
+					"master_password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_tags(t *testing.T) {
 	var dbCluster1, dbCluster2, dbCluster3 rds.DBCluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -579,6 +615,92 @@ func TestAccRDSCluster_pointInTimeRestore(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_PointInTimeRestore_sourceResourceID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var sourceDBCluster, dbCluster rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceResourceName := "aws_rds_cluster.test"
+	resourceName := "aws_rds_cluster.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_pointInTimeRestoreSourceResourceID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(sourceResourceName, &sourceDBCluster),
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(resourceName, "engine", sourceResourceName, "engine"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_to_point_in_time.0.source_cluster_resource_id", sourceResourceName, "cluster_resource_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_PointInTimeRestore_copyTagsToSnapshot(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var sourceDBCluster, dbCluster rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceResourceName := "aws_rds_cluster.test"
+	resourceName := "aws_rds_cluster.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_pointInTimeRestoreSourceCopyTagsToSnapshot(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(sourceResourceName, &sourceDBCluster),
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags_to_snapshot", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_PointInTimeRestore_engineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var sourceDBCluster, dbCluster rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceResourceName := "aws_rds_cluster.test"
+	resourceName := "aws_rds_cluster.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_pointInTimeRestoreSourceEngineVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(sourceResourceName, &sourceDBCluster),
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttrPair(sourceResourceName, "engine_version", "data.aws_rds_engine_version.source", "version"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.target", "version"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_PointInTimeRestore_enabledCloudWatchLogsExports(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1173,6 +1295,87 @@ func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_engineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster1, dbCluster2 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+	instanceResourceName := "aws_rds_cluster_instance.test"
+	dataSourceName := "data.aws_rds_engine_version.test"
+	dataSourceNameUpgrade := "data.aws_rds_engine_version.upgrade"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", dataSourceName, "version"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster2),
+					testAccCheckClusterRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckResourceAttr(resourceName, "cluster_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", dataSourceNameUpgrade, "version"),
+				),
+			},
+			{
+				// Refresh the cluster instance, which has been replaced by its Green counterpart.
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceResourceName, "identifier", rName),
+					resource.TestCheckResourceAttrPair(instanceResourceName, "engine_version_actual", dataSourceNameUpgrade, "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_engineNotSupported(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_engineNotSupported(rName),
+				ExpectError: regexp.MustCompile(`"blue_green_update.enabled" cannot be set when "engine" is "mysql"`),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_engineModeNotSupported(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_engineModeNotSupported(rName),
+				ExpectError: regexp.MustCompile(`"blue_green_update.enabled" cannot be set when "engine_mode" is "serverless"`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifierEngineMode_global(t *testing.T) {
 	var dbCluster1 rds.DBCluster
 
@@ -1417,6 +1620,51 @@ func TestAccRDSCluster_GlobalClusterIdentifier_secondaryClustersWriteForwarding(
 	})
 }
 
+func TestAccRDSCluster_GlobalClusterIdentifier_secondaryHeadless(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var providers []*schema.Provider
+	var primaryDbCluster, secondaryDbCluster1, secondaryDbCluster2 rds.DBCluster
+
+	rNameGlobal := sdkacctest.RandomWithPrefix("tf-acc-test-global")
+	rNamePrimary := sdkacctest.RandomWithPrefix("tf-acc-test-primary")
+	rNameSecondary := sdkacctest.RandomWithPrefix("tf-acc-test-secondary")
+
+	resourceNamePrimary := "aws_rds_cluster.primary"
+	resourceNameSecondary := "aws_rds_cluster.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(t, &providers),
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_GlobalClusterID_secondaryHeadless(rNameGlobal, rNamePrimary, rNameSecondary, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExistsWithProvider(resourceNamePrimary, &primaryDbCluster, acctest.RegionProviderFunc(acctest.Region(), &providers)),
+					testAccCheckClusterExistsWithProvider(resourceNameSecondary, &secondaryDbCluster1, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttrPair(resourceNameSecondary, "global_cluster_identifier", "aws_rds_global_cluster.test", "id"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_GlobalClusterID_secondaryHeadless(rNameGlobal, rNamePrimary, rNameSecondary, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExistsWithProvider(resourceNameSecondary, &secondaryDbCluster2, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					testAccCheckClusterNotRecreated(&secondaryDbCluster1, &secondaryDbCluster2),
+					resource.TestCheckResourceAttr(resourceNameSecondary, "global_cluster_identifier", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_port(t *testing.T) {
 	var dbCluster1, dbCluster2 rds.DBCluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2126,6 +2374,47 @@ func TestAccRDSCluster_enableHTTPEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_enableHTTPEndpointProvisioned(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster rds.DBCluster
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_enableHTTPEndpointProvisioned(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enable_http_endpoint", "true"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_enableHTTPEndpointProvisioned(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enable_http_endpoint", "false"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_enableHTTPEndpointProvisioned(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enable_http_endpoint", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(s *terraform.State) error {
 	return testAccCheckClusterDestroyWithProvider(s, acctest.Provider)
 }
@@ -2235,6 +2524,16 @@ func testAccCheckClusterRecreated(i, j *rds.DBCluster) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckClusterNotRecreated(i, j *rds.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.ClusterCreateTime).Equal(aws.TimeValue(j.ClusterCreateTime)) {
+			return errors.New("RDS Cluster was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccClusterConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -2248,6 +2547,20 @@ resource "aws_rds_cluster" "test" {
 `, rName)
 }
 
+func testAccClusterConfig_engineLifecycleSupport(rName, engineLifecycleSupport string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier       = %[1]q
+  database_name            = "test"
+  engine                   = "aurora-postgresql"
+  engine_lifecycle_support = %[2]q
+  master_username          = "tfacctest"
+  master_password          = "avoid-plaintext-passwords"
+  skip_final_snapshot      = true
+}
+`, rName, engineLifecycleSupport)
+}
+
 func testAccClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -2582,6 +2895,81 @@ resource "aws_rds_cluster" "restore" {
 `, rName))
 }
 
+func testAccClusterConfig_pointInTimeRestoreSourceResourceID(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_baseForPITR(rName), fmt.Sprintf(`
+resource "aws_rds_cluster" "restore" {
+  cluster_identifier  = "%[1]s-restore"
+  skip_final_snapshot = true
+  engine              = aws_rds_cluster.test.engine
+
+  restore_to_point_in_time {
+    source_cluster_resource_id = aws_rds_cluster.test.cluster_resource_id
+    restore_type               = "full-copy"
+    use_latest_restorable_time = true
+  }
+}
+`, rName))
+}
+
+func testAccClusterConfig_pointInTimeRestoreSourceCopyTagsToSnapshot(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_baseForPITR(rName), fmt.Sprintf(`
+resource "aws_rds_cluster" "restore" {
+  cluster_identifier    = "%[1]s-restore"
+  copy_tags_to_snapshot = true
+  skip_final_snapshot   = true
+  engine                = aws_rds_cluster.test.engine
+
+  restore_to_point_in_time {
+    source_cluster_identifier  = aws_rds_cluster.test.cluster_identifier
+    restore_type               = "full-copy"
+    use_latest_restorable_time = true
+  }
+}
+`, rName))
+}
+
+func testAccClusterConfig_pointInTimeRestoreSourceEngineVersion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 3), fmt.Sprintf(`
+data "aws_rds_engine_version" "source" {
+  engine             = "aurora-mysql"
+  preferred_versions = ["8.0.mysql_aurora.3.03.1", "8.0.mysql_aurora.3.03.2", "8.0.mysql_aurora.3.03.3"]
+}
+
+data "aws_rds_engine_version" "target" {
+  engine             = "aurora-mysql"
+  preferred_versions = ["8.0.mysql_aurora.3.05.2", "8.0.mysql_aurora.3.05.1", "8.0.mysql_aurora.3.04.1"]
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier   = %[1]q
+  master_username      = "tfacctest"
+  master_password      = "avoid-plaintext-passwords"
+  db_subnet_group_name = aws_db_subnet_group.test.name
+  skip_final_snapshot  = true
+  engine               = data.aws_rds_engine_version.source.engine
+  engine_version       = data.aws_rds_engine_version.source.version
+}
+
+resource "aws_rds_cluster" "restore" {
+  cluster_identifier  = "%[1]s-restore"
+  skip_final_snapshot = true
+  engine              = aws_rds_cluster.test.engine
+  engine_version      = data.aws_rds_engine_version.target.version
+
+  restore_to_point_in_time {
+    source_cluster_identifier  = aws_rds_cluster.test.cluster_identifier
+    restore_type               = "full-copy"
+    use_latest_restorable_time = true
+  }
+}
+`, rName))
+}
+
 func testAccClusterConfig_pointInTimeRestoreSource_enabledCloudWatchLogsExports(rName, enabledCloudwatchLogExports string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_baseForPITR(rName), fmt.Sprintf(`
 resource "aws_rds_cluster" "restore" {
@@ -2832,6 +3220,101 @@ resource "aws_rds_cluster_instance" "test" {
 `, rName, upgrade)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_engineVersion(rName string, upgrade bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = "aurora-postgresql"
+  preferred_versions = ["15.4", "15.3", "14.9"]
+}
+
+data "aws_rds_engine_version" "upgrade" {
+  engine             = data.aws_rds_engine_version.test.engine
+  preferred_versions = data.aws_rds_engine_version.test.valid_upgrade_targets
+}
+
+locals {
+  engine_version = %[2]t ? data.aws_rds_engine_version.upgrade.version : data.aws_rds_engine_version.test.version
+}
+
+# Aurora PostgreSQL Blue/Green Deployments require logical replication.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.test.parameter_group_family
+
+  parameter {
+    apply_method = "pending-reboot"
+    name         = "rds.logical_replication"
+    value        = "1"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = local.engine_version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, rName, upgrade)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_engineNotSupported(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier        = %[1]q
+  engine                    = "mysql"
+  db_cluster_instance_class = "db.r6gd.large"
+  storage_type              = "io1"
+  allocated_storage         = 100
+  iops                      = 1000
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_engineModeNotSupported(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine              = "aurora-mysql"
+  engine_mode         = "serverless"
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -3447,6 +3930,95 @@ resource "aws_rds_cluster_instance" "secondary" {
 `, rNameGlobal, rNamePrimary, rNameSecondary))
 }
 
+func testAccClusterConfig_GlobalClusterID_secondaryHeadless(rNameGlobal, rNamePrimary, rNameSecondary string, attached bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "aurora-mysql"
+  engine_version            = "5.7.mysql_aurora.2.07.1"
+}
+
+resource "aws_rds_cluster" "primary" {
+  cluster_identifier        = %[2]q
+  database_name             = "mydb"
+  master_username           = "foo"
+  master_password           = "barbarbar"
+  skip_final_snapshot       = true
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  identifier         = %[2]q
+  cluster_identifier = aws_rds_cluster.primary.id
+  instance_class     = "db.r4.large" # only db.r4 or db.r5 are valid for Aurora global db
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[3]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+# No DB instances are added to the secondary cluster.
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  cluster_identifier        = %[3]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  skip_final_snapshot       = true
+  source_region             = data.aws_region.current.name
+  global_cluster_identifier = %[4]t ? aws_rds_global_cluster.test.id : null
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  depends_on                = [aws_rds_cluster_instance.primary]
+
+  lifecycle {
+    ignore_changes = [
+      replication_source_identifier,
+    ]
+  }
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, attached))
+}
+
 func testAccClusterConfig_GlobalClusterID_secondaryClustersWriteForwarding(rNameGlobal, rNamePrimary, rNameSecondary string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
@@ -3692,6 +4264,30 @@ resource "aws_rds_cluster" "test" {
 `, rName, maxCapacity, minCapacity)
 }
 
+func testAccClusterConfig_enableHTTPEndpointProvisioned(rName string, enableHttpEndpoint bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = "aurora-postgresql"
+  preferred_versions = ["15.4", "15.3"]
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier   = %[1]q
+  master_password      = "barbarbarbar"
+  master_username      = "foo"
+  skip_final_snapshot  = true
+  engine               = data.aws_rds_engine_version.test.engine
+  engine_version       = data.aws_rds_engine_version.test.version
+  enable_http_endpoint = %[2]t
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+`, rName, enableHttpEndpoint)
+}
+
 func testAccClusterConfig_ScalingConfiguration_defaultMinCapacity(rName string, autoPause bool, maxCapacity, secondsUntilAutoPause int, timeoutAction string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
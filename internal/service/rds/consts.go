@@ -17,6 +17,7 @@ const (
 	ClusterStatusMigrating                  = "migrating"
 	ClusterStatusModifying                  = "modifying"
 	ClusterStatusPreparingDataMigration     = "preparing-data-migration"
+	ClusterStatusPromoting                  = "promoting"
 	ClusterStatusRebooting                  = "rebooting"
 	ClusterStatusRenaming                   = "renaming"
 	ClusterStatusResettingMasterCredentials = "resetting-master-credentials"
@@ -28,6 +29,7 @@ const (
 	storageTypeGP2      = "gp2"
 	storageTypeGP3      = "gp3"
 	storageTypeIO1      = "io1"
+	storageTypeIO2      = "io2"
 )
 
 func StorageType_Values() []string {
@@ -36,6 +38,17 @@ func StorageType_Values() []string {
 		storageTypeGP2,
 		storageTypeGP3,
 		storageTypeIO1,
+		storageTypeIO2,
+	}
+}
+
+// storageTypeRequiresIOPS returns whether the storage type requires Provisioned IOPS to be specified.
+func storageTypeRequiresIOPS(storageType string) bool {
+	switch storageType {
+	case storageTypeIO1, storageTypeIO2:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -53,6 +66,13 @@ const (
 	InstanceEngineSQLServerWeb        = "sqlserver-ewb"
 )
 
+const (
+	parameterApplyStatusInSync = "in-sync"
+
+	// parameterNameLogicalReplication must be enabled on RDS for PostgreSQL DB instances that use Blue/Green Deployments.
+	parameterNameLogicalReplication = "rds.logical_replication"
+)
+
 // https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/accessing-monitoring.html#Overview.DBInstance.Status.
 const (
 	InstanceStatusAvailable                                    = "available"
@@ -92,6 +112,13 @@ const (
 	InstanceAutomatedBackupStatusRetained    = "retained"
 )
 
+const (
+	TenantDatabaseStatusAvailable = "available"
+	TenantDatabaseStatusCreating  = "creating"
+	TenantDatabaseStatusDeleting  = "deleting"
+	TenantDatabaseStatusModifying = "modifying"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
@@ -117,6 +144,22 @@ func ClusterEngine_Values() []string {
 	}
 }
 
+const (
+	EngineLifecycleSupportExtendedSupport         = "open-source-rds-extended-support"
+	EngineLifecycleSupportExtendedSupportDisabled = "open-source-rds-extended-support-disabled"
+)
+
+func EngineLifecycleSupport_Values() []string {
+	return []string{
+		EngineLifecycleSupportExtendedSupport,
+		EngineLifecycleSupportExtendedSupportDisabled,
+	}
+}
+
+// The advanced mode of CloudWatch Database Insights requires Performance Insights
+// with at least 15 months of retention.
+const databaseInsightsModeAdvancedMinPerformanceInsightsRetentionPeriod = 465
+
 const (
 	EngineModeGlobal        = "global"
 	EngineModeMultiMaster   = "multimaster"
@@ -212,6 +255,40 @@ func TimeoutAction_Values() []string {
 	}
 }
 
+// InstanceState_Values returns the DB instance statuses that can be managed by aws_rds_instance_state.
+func InstanceState_Values() []string {
+	return []string{
+		InstanceStatusAvailable,
+		InstanceStatusStopped,
+	}
+}
+
+// Pending maintenance actions that can be opted in to with aws_rds_instance_pending_maintenance_action.
+const (
+	PendingMaintenanceActionDBUpgrade    = "db-upgrade"
+	PendingMaintenanceActionSystemUpdate = "system-update"
+)
+
+func PendingMaintenanceAction_Values() []string {
+	return []string{
+		PendingMaintenanceActionDBUpgrade,
+		PendingMaintenanceActionSystemUpdate,
+	}
+}
+
+const (
+	OptInTypeImmediate       = "immediate"
+	OptInTypeNextMaintenance = "next-maintenance"
+	OptInTypeUndoOptIn       = "undo-opt-in"
+)
+
+func OptInType_Values() []string {
+	return []string{
+		OptInTypeImmediate,
+		OptInTypeNextMaintenance,
+	}
+}
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
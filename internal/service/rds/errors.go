@@ -1,7 +1,25 @@
 package rds
 
+import (
+	"strings"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
 const (
+	errCodeAccessDenied                = "AccessDenied"
 	errCodeInvalidParameterValue       = "InvalidParameterValue"
 	errCodeValidationError             = "ValidationError"
 	errCodeInvalidParameterCombination = "InvalidParameterCombination"
 )
+
+// errMessageContainsSDKv2 returns true if the AWS SDK for Go v2 error has the specified code
+// and its message contains the specified string.
+func errMessageContainsSDKv2(err error, code, message string) bool {
+	if apiErr, ok := errs.As[smithy.APIError](err); ok {
+		return apiErr.ErrorCode() == code && strings.Contains(apiErr.ErrorMessage(), message)
+	}
+
+	return false
+}
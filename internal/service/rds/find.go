@@ -2,6 +2,7 @@ package rds
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -127,6 +128,51 @@ func FindDBClusterWithActivityStream(ctx context.Context, conn *rds.RDS, dbClust
 	return dbCluster, nil
 }
 
+func FindDBInstanceWithActivityStream(ctx context.Context, conn *rds.RDS, dbInstanceArn string) (*rds.DBInstance, error) {
+	input := &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-instance-id"),
+				Values: aws.StringSlice([]string{dbInstanceArn}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeDBInstancesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBInstances) == 0 || output.DBInstances[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	dbInstance := output.DBInstances[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbInstance.DBInstanceArn) != dbInstanceArn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if status := aws.StringValue(dbInstance.ActivityStreamStatus); status == rds.ActivityStreamStatusStopped {
+		return nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return dbInstance, nil
+}
+
 func FindDBClusterSnapshotByID(conn *rds.RDS, id string) (*rds.DBClusterSnapshot, error) {
 	input := &rds.DescribeDBClusterSnapshotsInput{
 		DBClusterSnapshotIdentifier: aws.String(id),
@@ -297,6 +343,34 @@ func FindDBInstanceAutomatedBackupByARN(conn *rds.RDS, arn string) (*rds.DBInsta
 	return output, nil
 }
 
+// findDBInstanceAutomatedBackupBySourceARN returns the automated backups of the specified DB instance in another Region
+// that have been replicated to the current Region.
+func findDBInstanceAutomatedBackupBySourceARN(conn *rds.RDS, sourceARN string) (*rds.DBInstanceAutomatedBackup, error) {
+	input := &rds.DescribeDBInstanceAutomatedBackupsInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-instance-id"),
+				Values: aws.StringSlice([]string{sourceARN}),
+			},
+		},
+	}
+
+	output, err := findDBInstanceAutomatedBackup(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.DBInstanceArn) != sourceARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findDBInstanceAutomatedBackup(conn *rds.RDS, input *rds.DescribeDBInstanceAutomatedBackupsInput) (*rds.DBInstanceAutomatedBackup, error) {
 	output, err := findDBInstanceAutomatedBackups(conn, input)
 
@@ -456,3 +530,95 @@ func FindReservedDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (
 
 	return output.ReservedDBInstances[0], nil
 }
+
+// FindPendingMaintenanceActionsByResourceARN returns the pending maintenance actions for the specified resource.
+func FindPendingMaintenanceActionsByResourceARN(ctx context.Context, conn *rds.RDS, arn string) ([]*rds.PendingMaintenanceAction, error) {
+	input := &rds.DescribePendingMaintenanceActionsInput{
+		ResourceIdentifier: aws.String(arn),
+	}
+	var output []*rds.PendingMaintenanceAction
+
+	err := conn.DescribePendingMaintenanceActionsPagesWithContext(ctx, input, func(page *rds.DescribePendingMaintenanceActionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PendingMaintenanceActions {
+			if v == nil || aws.StringValue(v.ResourceIdentifier) != arn {
+				continue
+			}
+
+			for _, v := range v.PendingMaintenanceActionDetails {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindPendingMaintenanceActionByTwoPartKey returns the matching pending maintenance action for the specified resource.
+func FindPendingMaintenanceActionByTwoPartKey(ctx context.Context, conn *rds.RDS, arn, action string) (*rds.PendingMaintenanceAction, error) {
+	output, err := FindPendingMaintenanceActionsByResourceARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.Action) == action {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("no %s maintenance action pending for %s", action, arn),
+	}
+}
+
+// FindDefaultCertificate returns the certificate that overrides the system-default CA for new DB instances in the account.
+func FindDefaultCertificate(ctx context.Context, conn *rds.RDS) (*rds.Certificate, error) {
+	input := &rds.DescribeCertificatesInput{}
+	var output *rds.Certificate
+
+	err := conn.DescribeCertificatesPagesWithContext(ctx, input, func(page *rds.DescribeCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Certificates {
+			if v != nil && aws.BoolValue(v.CustomerOverride) {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -1,14 +1,22 @@
 package rds
 
 import (
+	"strconv"
 	"strings"
+	"time"
 
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
+const (
+	processorFeatureNameCoreCount      = "coreCount"
+	processorFeatureNameThreadsPerCore = "threadsPerCore"
+)
+
 func expandScalingConfiguration(tfMap map[string]interface{}) *rds.ScalingConfiguration {
 	if tfMap == nil {
 		return nil
@@ -39,6 +47,22 @@ func expandScalingConfiguration(tfMap map[string]interface{}) *rds.ScalingConfig
 	return apiObject
 }
 
+func expandScalingConfigurationSDKv2(tfMap map[string]interface{}) *rdstypes.ScalingConfiguration {
+	apiObject := expandScalingConfiguration(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &rdstypes.ScalingConfiguration{
+		AutoPause:             apiObject.AutoPause,
+		MaxCapacity:           int32PtrFromInt64Ptr(apiObject.MaxCapacity),
+		MinCapacity:           int32PtrFromInt64Ptr(apiObject.MinCapacity),
+		SecondsUntilAutoPause: int32PtrFromInt64Ptr(apiObject.SecondsUntilAutoPause),
+		TimeoutAction:         apiObject.TimeoutAction,
+	}
+}
+
 func flattenScalingConfigurationInfo(apiObject *rds.ScalingConfigurationInfo) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -73,6 +97,20 @@ func flattenScalingConfigurationInfo(apiObject *rds.ScalingConfigurationInfo) ma
 	return tfMap
 }
 
+func flattenScalingConfigurationInfoSDKv2(apiObject *rdstypes.ScalingConfigurationInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenScalingConfigurationInfo(&rds.ScalingConfigurationInfo{
+		AutoPause:             apiObject.AutoPause,
+		MaxCapacity:           int64PtrFromInt32Ptr(apiObject.MaxCapacity),
+		MinCapacity:           int64PtrFromInt32Ptr(apiObject.MinCapacity),
+		SecondsUntilAutoPause: int64PtrFromInt32Ptr(apiObject.SecondsUntilAutoPause),
+		TimeoutAction:         apiObject.TimeoutAction,
+	})
+}
+
 func expandServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *rds.ServerlessV2ScalingConfiguration {
 	if tfMap == nil {
 		return nil
@@ -91,6 +129,19 @@ func expandServerlessV2ScalingConfiguration(tfMap map[string]interface{}) *rds.S
 	return apiObject
 }
 
+func expandServerlessV2ScalingConfigurationSDKv2(tfMap map[string]interface{}) *rdstypes.ServerlessV2ScalingConfiguration {
+	apiObject := expandServerlessV2ScalingConfiguration(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &rdstypes.ServerlessV2ScalingConfiguration{
+		MaxCapacity: apiObject.MaxCapacity,
+		MinCapacity: apiObject.MinCapacity,
+	}
+}
+
 func flattenServerlessV2ScalingConfigurationInfo(apiObject *rds.ServerlessV2ScalingConfigurationInfo) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -109,6 +160,51 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *rds.ServerlessV2Scal
 	return tfMap
 }
 
+func flattenServerlessV2ScalingConfigurationInfoSDKv2(apiObject *rdstypes.ServerlessV2ScalingConfigurationInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenServerlessV2ScalingConfigurationInfo(&rds.ServerlessV2ScalingConfigurationInfo{
+		MaxCapacity: apiObject.MaxCapacity,
+		MinCapacity: apiObject.MinCapacity,
+	})
+}
+
+func flattenManagedMasterUserSecret(apiObject *rds.MasterUserSecret) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecretArn; v != nil {
+		tfMap["secret_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecretStatus; v != nil {
+		tfMap["secret_status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenManagedMasterUserSecretSDKv2(apiObject *rdstypes.MasterUserSecret) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenManagedMasterUserSecret(&rds.MasterUserSecret{
+		KmsKeyId:     apiObject.KmsKeyId,
+		SecretArn:    apiObject.SecretArn,
+		SecretStatus: apiObject.SecretStatus,
+	})
+}
+
 func expandOptionConfiguration(configured []interface{}) []*rds.OptionConfiguration {
 	var option []*rds.OptionConfiguration
 
@@ -211,6 +307,13 @@ func flattenOptions(apiOptions []*rds.Option, optionConfigurations []*rds.Option
 				optionSetting["value"] = aws.StringValue(configuredOptionSetting.Value)
 			}
 
+			// JSON documents may be sent back reformatted, with keys in a different order.
+			if configuredOptionSetting != nil {
+				if equivalent, _ := flex.JSONEquivalent(aws.StringValue(configuredOptionSetting.Value), aws.StringValue(apiOptionSetting.Value)); equivalent {
+					optionSetting["value"] = aws.StringValue(configuredOptionSetting.Value)
+				}
+			}
+
 			optionSettings = append(optionSettings, optionSetting)
 		}
 		optionSettingsResource := &schema.Resource{
@@ -318,3 +421,207 @@ func flattenParameters(list []*rds.Parameter) []map[string]interface{} {
 
 	return result
 }
+
+func expandProcessorFeatures(tfMap map[string]interface{}) []*rds.ProcessorFeature {
+	if tfMap == nil {
+		return nil
+	}
+
+	var apiObjects []*rds.ProcessorFeature
+
+	if v, ok := tfMap["core_count"].(int); ok && v != 0 {
+		apiObjects = append(apiObjects, &rds.ProcessorFeature{
+			Name:  aws.String(processorFeatureNameCoreCount),
+			Value: aws.String(strconv.Itoa(v)),
+		})
+	}
+
+	if v, ok := tfMap["threads_per_core"].(int); ok && v != 0 {
+		apiObjects = append(apiObjects, &rds.ProcessorFeature{
+			Name:  aws.String(processorFeatureNameThreadsPerCore),
+			Value: aws.String(strconv.Itoa(v)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandProcessorFeaturesSDKv2(tfMap map[string]interface{}) []rdstypes.ProcessorFeature {
+	var apiObjects []rdstypes.ProcessorFeature
+
+	for _, apiObject := range expandProcessorFeatures(tfMap) {
+		apiObjects = append(apiObjects, rdstypes.ProcessorFeature{
+			Name:  apiObject.Name,
+			Value: apiObject.Value,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenProcessorFeatures(apiObjects []*rds.ProcessorFeature) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		v, err := strconv.Atoi(aws.StringValue(apiObject.Value))
+
+		if err != nil {
+			continue
+		}
+
+		switch aws.StringValue(apiObject.Name) {
+		case processorFeatureNameCoreCount:
+			tfMap["core_count"] = v
+		case processorFeatureNameThreadsPerCore:
+			tfMap["threads_per_core"] = v
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProcessorFeaturesSDKv2(apiObjects []rdstypes.ProcessorFeature) []interface{} {
+	var processorFeatures []*rds.ProcessorFeature
+
+	for _, apiObject := range apiObjects {
+		processorFeatures = append(processorFeatures, &rds.ProcessorFeature{
+			Name:  apiObject.Name,
+			Value: apiObject.Value,
+		})
+	}
+
+	return flattenProcessorFeatures(processorFeatures)
+}
+
+func flattenPendingMaintenanceActions(apiObjects []*rds.PendingMaintenanceAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action":        aws.StringValue(apiObject.Action),
+			"description":   aws.StringValue(apiObject.Description),
+			"opt_in_status": aws.StringValue(apiObject.OptInStatus),
+		}
+
+		if v := apiObject.AutoAppliedAfterDate; v != nil {
+			tfMap["auto_applied_after_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CurrentApplyDate; v != nil {
+			tfMap["current_apply_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ForcedApplyDate; v != nil {
+			tfMap["forced_apply_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPendingModifiedValues(apiObject *rds.PendingModifiedValues) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllocatedStorage; v != nil {
+		tfMap["allocated_storage"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.BackupRetentionPeriod; v != nil {
+		tfMap["backup_retention_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.CACertificateIdentifier; v != nil {
+		tfMap["ca_cert_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EngineVersion; v != nil {
+		tfMap["engine_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DBInstanceClass; v != nil {
+		tfMap["instance_class"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Iops; v != nil {
+		tfMap["iops"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MultiAZ; v != nil {
+		tfMap["multi_az"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StorageThroughput; v != nil {
+		tfMap["storage_throughput"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.StorageType; v != nil {
+		tfMap["storage_type"] = aws.StringValue(v)
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPendingModifiedValuesSDKv2(apiObject *rdstypes.PendingModifiedValues) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenPendingModifiedValues(&rds.PendingModifiedValues{
+		AllocatedStorage:        int64PtrFromInt32Ptr(apiObject.AllocatedStorage),
+		BackupRetentionPeriod:   int64PtrFromInt32Ptr(apiObject.BackupRetentionPeriod),
+		CACertificateIdentifier: apiObject.CACertificateIdentifier,
+		DBInstanceClass:         apiObject.DBInstanceClass,
+		EngineVersion:           apiObject.EngineVersion,
+		Iops:                    int64PtrFromInt32Ptr(apiObject.Iops),
+		MultiAZ:                 apiObject.MultiAZ,
+		Port:                    int64PtrFromInt32Ptr(apiObject.Port),
+		StorageThroughput:       int64PtrFromInt32Ptr(apiObject.StorageThroughput),
+		StorageType:             apiObject.StorageType,
+	})
+}
+
+func int32PtrFromInt64Ptr(v *int64) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	return aws.Int32(int32(aws.Int64Value(v)))
+}
+
+func int64PtrFromInt32Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	return aws.Int64(int64(aws.Int32Value(v)))
+}
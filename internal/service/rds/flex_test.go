@@ -3,6 +3,7 @@ package rds
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -76,3 +77,76 @@ func TestFlattenParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenProcessorFeatures(t *testing.T) {
+	cases := []struct {
+		Input  []*rds.ProcessorFeature
+		Output []interface{}
+	}{
+		{
+			Input:  nil,
+			Output: nil,
+		},
+		{
+			Input: []*rds.ProcessorFeature{
+				{
+					Name:  aws.String("coreCount"),
+					Value: aws.String("4"),
+				},
+				{
+					Name:  aws.String("threadsPerCore"),
+					Value: aws.String("1"),
+				},
+			},
+			Output: []interface{}{
+				map[string]interface{}{
+					"core_count":       4,
+					"threads_per_core": 1,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenProcessorFeatures(tc.Input)
+		if !reflect.DeepEqual(output, tc.Output) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		}
+	}
+}
+
+func TestFlattenPendingMaintenanceActions(t *testing.T) {
+	cases := []struct {
+		Input  []*rds.PendingMaintenanceAction
+		Output []interface{}
+	}{
+		{
+			Input:  nil,
+			Output: nil,
+		},
+		{
+			Input: []*rds.PendingMaintenanceAction{
+				{
+					Action:               aws.String("system-update"),
+					AutoAppliedAfterDate: aws.Time(time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)),
+					Description:          aws.String("New Operating System update is available"),
+				},
+			},
+			Output: []interface{}{
+				map[string]interface{}{
+					"action":                  "system-update",
+					"auto_applied_after_date": "2023-06-01T00:00:00Z",
+					"description":             "New Operating System update is available",
+					"opt_in_status":           "",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenPendingMaintenanceActions(tc.Input)
+		if !reflect.DeepEqual(output, tc.Output) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		}
+	}
+}
@@ -703,6 +703,13 @@ func globalClusterUpgradeMinorEngineVersion(meta interface{}, clusterMembers *sc
 			}
 		}
 
+		// A headless secondary cluster has no DB instances and its engine version can't be modified.
+		// It is upgraded when a DB instance is added to it.
+		if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "Cannot modify engine version without a primary instance in DB cluster") && !clusterMember["is_writer"].(bool) {
+			log.Printf("[WARN] Skipping RDS Global Cluster (%s) headless secondary Cluster (%s) minor version (%s) upgrade", clusterID, dbi, engineVersion)
+			continue
+		}
+
 		if err != nil {
 			return fmt.Errorf("failed to update engine_version on RDS Global Cluster Cluster (%s): %s", dbi, err)
 		}
@@ -31,3 +31,22 @@ func ClusterRoleAssociationParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DBCLUSTERID%[2]sROLEARN", id, clusterRoleAssociationResourceIDSeparator)
 }
+
+const instancePendingMaintenanceActionResourceIDSeparator = ","
+
+func InstancePendingMaintenanceActionCreateResourceID(dbInstanceID, action string) string {
+	parts := []string{dbInstanceID, action}
+	id := strings.Join(parts, instancePendingMaintenanceActionResourceIDSeparator)
+
+	return id
+}
+
+func InstancePendingMaintenanceActionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, instancePendingMaintenanceActionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DBINSTANCEID%[2]sACTION", id, instancePendingMaintenanceActionResourceIDSeparator)
+}
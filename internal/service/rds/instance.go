@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -67,29 +69,6 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					mas := d.Get("max_allocated_storage").(int)
-
-					newInt, err := strconv.Atoi(new)
-
-					if err != nil {
-						return false
-					}
-
-					oldInt, err := strconv.Atoi(old)
-
-					if err != nil {
-						return false
-					}
-
-					// Allocated is higher than the configuration
-					// and autoscaling is enabled
-					if oldInt > newInt && mas > newInt {
-						return true
-					}
-
-					return false
-				},
 			},
 			"allow_major_version_upgrade": {
 				Type:     schema.TypeBool,
@@ -112,6 +91,10 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"autoscaled_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -129,16 +112,62 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_source_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"blue_green_update": {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"delete_source": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 						"enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+						"target_allocated_storage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"target_db_instance_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target_db_parameter_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target_engine_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target_iops": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"target_storage_throughput": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"target_storage_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(StorageType_Values(), false),
+						},
 					},
 				},
 			},
@@ -178,11 +207,33 @@ func ResourceInstance() *schema.Resource {
 					"replicate_source_db",
 				},
 			},
+			"db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ConflictsWith: []string{
+					"replicate_source_db",
+					"restore_to_point_in_time",
+					"s3_import",
+					"snapshot_identifier",
+				},
+			},
+			"database_insights_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.DatabaseInsightsMode](),
+			},
 			"db_subnet_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			"dedicated_log_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"delete_automated_backups": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -196,10 +247,37 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"domain_auth_secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARNCheck(verify.ARNService("secretsmanager")),
+				RequiredWith: []string{"domain_dns_ips", "domain_fqdn", "domain_ou"},
+			},
+			"domain_dns_ips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				RequiredWith: []string{"domain_auth_secret_arn", "domain_fqdn", "domain_ou"},
+			},
+			"domain_fqdn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"domain_auth_secret_arn", "domain_dns_ips", "domain_ou"},
+			},
 			"domain_iam_role_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"domain_ou": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"domain_auth_secret_arn", "domain_dns_ips", "domain_fqdn"},
+			},
 			"enabled_cloudwatch_logs_exports": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -223,6 +301,14 @@ func ResourceInstance() *schema.Resource {
 				},
 				ConflictsWith: []string{"replicate_source_db"},
 			},
+			"engine_lifecycle_support": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(EngineLifecycleSupport_Values(), false),
+				ConflictsWith: []string{"replicate_source_db"},
+			},
 			"engine_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -242,6 +328,18 @@ func ResourceInstance() *schema.Resource {
 					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
 					validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end in a hyphen"),
 				),
+				ConflictsWith: []string{"final_snapshot_identifier_prefix"},
+			},
+			"final_snapshot_identifier_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255-resource.UniqueIDSuffixLength),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z-]+$`), "must only contain alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+				),
+				ConflictsWith: []string{"final_snapshot_identifier"},
 			},
 			"hosted_zone_id": {
 				Type:     schema.TypeString,
@@ -255,7 +353,6 @@ func ResourceInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"identifier_prefix"},
 				ValidateFunc:  validIdentifier,
 			},
@@ -281,7 +378,7 @@ func ResourceInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(verify.ARNService("kms")),
 			},
 			"latest_restorable_time": {
 				Type:     schema.TypeString,
@@ -305,6 +402,36 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"master_user_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -330,6 +457,11 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"multi_tenant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"name": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -364,9 +496,90 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
+			},
+			"pending_maintenance_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_applied_after_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forced_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opt_in_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pending_modified_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocated_storage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_retention_period": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ca_cert_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iops": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"multi_az": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
@@ -377,7 +590,7 @@ func ResourceInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(verify.ARNService("kms")),
 			},
 			"performance_insights_retention_period": {
 				Type:     schema.TypeInt,
@@ -389,11 +602,35 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"processor_features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"core_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"threads_per_core": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 2),
+						},
+					},
+				},
+			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"rename_on_identifier_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"replica_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -419,6 +656,7 @@ func ResourceInstance() *schema.Resource {
 				MaxItems: 1,
 				ForceNew: true,
 				ConflictsWith: []string{
+					"db_cluster_snapshot_identifier",
 					"s3_import",
 					"snapshot_identifier",
 					"replicate_source_db",
@@ -456,6 +694,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				MaxItems: 1,
 				ConflictsWith: []string{
+					"db_cluster_snapshot_identifier",
 					"snapshot_identifier",
 					"replicate_source_db",
 				},
@@ -501,10 +740,11 @@ func ResourceInstance() *schema.Resource {
 				Default:  false,
 			},
 			"snapshot_identifier": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validSnapshotIdentifierOrARN,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -547,10 +787,156 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			verify.ARNPartitionMatchesProvider("domain_auth_secret_arn", "kms_key_id", "performance_insights_kms_key_id", "snapshot_identifier"),
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// A DB instance can only be restored from a shared DB snapshot in the same Region.
+				v := d.Get("snapshot_identifier").(string)
+				if !d.NewValueKnown("snapshot_identifier") || !arn.IsARN(v) {
+					return nil
+				}
+
+				snapshotARN, err := arn.Parse(v)
+				if err != nil {
+					return nil
+				}
+
+				if region := meta.(*conns.AWSClient).Region; snapshotARN.Region != region {
+					return fmt.Errorf(`"snapshot_identifier" (%s) is in Region %q, but the DB instance is being created in Region %q; copy the DB snapshot to Region %[3]q first.`, v, snapshotARN.Region, region)
+				}
+
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Automated backups of a DB instance in another Region must first be replicated to this Region.
+				v := d.Get("restore_to_point_in_time.0.source_db_instance_automated_backups_arn").(string)
+				if !d.NewValueKnown("restore_to_point_in_time.0.source_db_instance_automated_backups_arn") || !arn.IsARN(v) {
+					return nil
+				}
+
+				backupsARN, err := arn.Parse(v)
+				if err != nil {
+					return nil
+				}
+
+				if region := meta.(*conns.AWSClient).Region; backupsARN.Region != region {
+					return fmt.Errorf(`"restore_to_point_in_time.0.source_db_instance_automated_backups_arn" (%s) is in Region %q, but the DB instance is being created in Region %q; replicate the automated backups with "aws_db_instance_automated_backups_replication" first.`, v, backupsARN.Region, region)
+				}
+
+				return nil
+			},
+			// Changing the identifier replaces the DB instance unless an in-place rename is requested.
+			customdiff.ForceNewIf("identifier", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Id() != "" && d.HasChange("identifier") && !instanceIdentifierRenamedInPlace(ctx, d, meta)
+			}),
+			// Renaming the DB instance in place changes its endpoint and ARN.
+			customdiff.ComputedIf("address", instanceIdentifierRenamedInPlace),
+			customdiff.ComputedIf("arn", instanceIdentifierRenamedInPlace),
+			customdiff.ComputedIf("endpoint", instanceIdentifierRenamedInPlace),
+			// Converting a DB instance to the multi-tenant configuration is permanent.
+			customdiff.ForceNewIfChange("multi_tenant", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("database_insights_mode").(string) != string(types.DatabaseInsightsModeAdvanced) {
+					return nil
+				}
+
+				if d.NewValueKnown("performance_insights_enabled") && !d.Get("performance_insights_enabled").(bool) {
+					return fmt.Errorf(`"performance_insights_enabled" must be true when "database_insights_mode" is %q.`, types.DatabaseInsightsModeAdvanced)
+				}
+
+				if !d.NewValueKnown("performance_insights_retention_period") {
+					return nil
+				}
+
+				if v := d.Get("performance_insights_retention_period").(int); v != 0 && v < databaseInsightsModeAdvancedMinPerformanceInsightsRetentionPeriod {
+					return fmt.Errorf(`"performance_insights_retention_period" must be at least %d when "database_insights_mode" is %q.`, databaseInsightsModeAdvancedMinPerformanceInsightsRetentionPeriod, types.DatabaseInsightsModeAdvanced)
+				}
+
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// An instance can join either an AWS Managed Microsoft AD directory or a self-managed Active Directory.
+				if d.Get("domain").(string) == "" && d.Get("domain_iam_role_name").(string) == "" {
+					return nil
+				}
+
+				for _, k := range []string{"domain_auth_secret_arn", "domain_fqdn", "domain_ou"} {
+					if d.Get(k).(string) != "" {
+						return fmt.Errorf(`"%s" cannot be set when "domain" or "domain_iam_role_name" is set.`, k)
+					}
+				}
+
+				if len(d.Get("domain_dns_ips").([]interface{})) > 0 {
+					return errors.New(`"domain_dns_ips" cannot be set when "domain" or "domain_iam_role_name" is set.`)
+				}
+
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				storageType := d.Get("storage_type").(string)
+				if !d.NewValueKnown("storage_type") || !storageTypeRequiresIOPS(storageType) {
+					return nil
+				}
+
+				// Replicas and restored instances can inherit Provisioned IOPS.
+				// The source may not be known until apply.
+				for _, k := range []string{"db_cluster_snapshot_identifier", "replicate_source_db", "restore_to_point_in_time", "snapshot_identifier"} {
+					if _, ok := d.GetOk(k); ok || !d.NewValueKnown(k) {
+						return nil
+					}
+				}
+
+				rawConfig := d.GetRawConfig()
+				if !rawConfig.IsKnown() || rawConfig.IsNull() {
+					return nil
+				}
+
+				if rawConfig.GetAttr("iops").IsNull() {
+					return fmt.Errorf(`"iops" must be set when "storage_type" is %q.`, storageType)
+				}
+
+				return nil
+			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// The Green environment replaces the DB instance on switchover, so its settings must agree with the configured ones.
+				// Otherwise the next plan would show a difference and start another Blue/Green Deployment.
+				rawConfig := d.GetRawConfig()
+				if !rawConfig.IsKnown() || rawConfig.IsNull() {
+					return nil
+				}
+
+				for attr, k := range map[string]string{
+					"target_allocated_storage":       "allocated_storage",
+					"target_db_instance_class":       "instance_class",
+					"target_db_parameter_group_name": "parameter_group_name",
+					"target_engine_version":          "engine_version",
+					"target_iops":                    "iops",
+					"target_storage_throughput":      "storage_throughput",
+					"target_storage_type":            "storage_type",
+				} {
+					target := "blue_green_update.0." + attr
+					v, ok := d.GetOk(target)
+					if !ok || !d.NewValueKnown(target) || !d.NewValueKnown(k) || rawConfig.GetAttr(k).IsNull() {
+						continue
+					}
+
+					if configured := d.Get(k); configured != v && !(k == "engine_version" && engineVersionMatches(configured.(string), v.(string))) {
+						return fmt.Errorf(`"blue_green_update.%s" (%v) must match "%s" (%v).`, attr, v, k, configured)
+					}
+				}
+
+				return nil
+			},
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				if !d.Get("blue_green_update.0.enabled").(bool) {
 					return nil
@@ -577,9 +963,15 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
+// instanceIdentifierRenamedInPlace returns whether the planned identifier change renames the DB instance in place.
+func instanceIdentifierRenamedInPlace(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Id() != "" && d.HasChange("identifier") && d.Get("rename_on_identifier_change").(bool)
+}
+
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn
+	connV2 := meta.(*conns.AWSClient).RDSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -594,7 +986,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	// afterwards to prevent Terraform operators from API errors or needing
 	// to double apply.
 	var requiresModifyDbInstance bool
-	modifyDbInstanceInput := &rds.ModifyDBInstanceInput{
+	modifyDbInstanceInput := &rds_sdkv2.ModifyDBInstanceInput{
 		ApplyImmediately: aws.Bool(true),
 	}
 
@@ -607,7 +999,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("replicate_source_db"); ok {
 		sourceDBInstanceID := v.(string)
-		input := &rds.CreateDBInstanceReadReplicaInput{
+		input := &rds_sdkv2.CreateDBInstanceReadReplicaInput{
 			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
 			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
@@ -615,7 +1007,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			DeletionProtection:         aws.Bool(d.Get("deletion_protection").(bool)),
 			PubliclyAccessible:         aws.Bool(d.Get("publicly_accessible").(bool)),
 			SourceDBInstanceIdentifier: aws.String(sourceDBInstanceID),
-			Tags:                       Tags(tags.IgnoreAWS()),
+			Tags:                       tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if _, ok := d.GetOk("allocated_storage"); ok {
@@ -633,12 +1025,20 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.CustomIamInstanceProfile = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("database_insights_mode"); ok {
+			input.DatabaseInsightsMode = types.DatabaseInsightsMode(v.(string))
+		}
+
 		if v, ok := d.GetOk("db_subnet_group_name"); ok {
 			input.DBSubnetGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("dedicated_log_volume"); ok {
+			input.DedicatedLogVolume = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
@@ -646,7 +1046,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("iops"); ok {
-			input.Iops = aws.Int64(int64(v.(int)))
+			input.Iops = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("kms_key_id"); ok {
@@ -657,7 +1057,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("monitoring_interval"); ok {
-			input.MonitoringInterval = aws.Int64(int64(v.(int)))
+			input.MonitoringInterval = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("monitoring_role_arn"); ok {
@@ -685,20 +1085,24 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("performance_insights_retention_period"); ok {
-			input.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("replica_mode"); ok {
-			input.ReplicaMode = aws.String(v.(string))
+			input.ReplicaMode = types.ReplicaMode(v.(string))
 			requiresModifyDbInstance = true
 		}
 
 		if v, ok := d.GetOk("storage_throughput"); ok {
-			input.StorageThroughput = aws.Int64(int64(v.(int)))
+			input.StorageThroughput = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("storage_type"); ok {
@@ -706,20 +1110,23 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
-		outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout,
+		outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.CreateDBInstanceReadReplicaWithContext(ctx, input)
+				return connV2.CreateDBInstanceReadReplica(ctx, input)
 			},
-			errCodeInvalidParameterValue, "ENHANCED_MONITORING")
+			func(err error) (bool, error) {
+				return errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING"), err
+			},
+		)
 
 		if err != nil {
 			return errs.AppendErrorf(diags, "creating RDS DB Instance (read replica) (%s): %s", identifier, err)
 		}
 
-		output := outputRaw.(*rds.CreateDBInstanceReadReplicaOutput)
+		output := outputRaw.(*rds_sdkv2.CreateDBInstanceReadReplicaOutput)
 
 		if v, ok := d.GetOk("allow_major_version_upgrade"); ok {
 			// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
@@ -728,8 +1135,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("backup_retention_period"); ok {
-			if current, desired := aws.Int64Value(output.DBInstance.BackupRetentionPeriod), int64(v.(int)); current != desired {
-				modifyDbInstanceInput.BackupRetentionPeriod = aws.Int64(desired)
+			if current, desired := aws.Int32Value(output.DBInstance.BackupRetentionPeriod), int32(v.(int)); current != desired {
+				modifyDbInstanceInput.BackupRetentionPeriod = aws.Int32(desired)
 				requiresModifyDbInstance = true
 			}
 		}
@@ -756,8 +1163,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("max_allocated_storage"); ok {
-			if current, desired := aws.Int64Value(output.DBInstance.MaxAllocatedStorage), int64(v.(int)); current != desired {
-				modifyDbInstanceInput.MaxAllocatedStorage = aws.Int64(desired)
+			if current, desired := aws.Int32Value(output.DBInstance.MaxAllocatedStorage), int32(v.(int)); current != desired {
+				modifyDbInstanceInput.MaxAllocatedStorage = aws.Int32(desired)
 				requiresModifyDbInstance = true
 			}
 		}
@@ -788,7 +1195,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, ok := d.GetOk("engine"); !ok {
 			diags = errs.AppendErrorf(diags, `"engine": required field is not set`)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			diags = errs.AppendErrorf(diags, `"password": required field is not set`)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -806,10 +1213,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		tfMap := v.([]interface{})[0].(map[string]interface{})
-		input := &rds.RestoreDBInstanceFromS3Input{
-			AllocatedStorage:        aws.Int64(int64(d.Get("allocated_storage").(int))),
+		input := &rds_sdkv2.RestoreDBInstanceFromS3Input{
+			AllocatedStorage:        aws.Int32(int32(d.Get("allocated_storage").(int))),
 			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-			BackupRetentionPeriod:   aws.Int64(int64(d.Get("backup_retention_period").(int))),
+			BackupRetentionPeriod:   aws.Int32(int32(d.Get("backup_retention_period").(int))),
 			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
 			DBInstanceIdentifier:    aws.String(identifier),
@@ -818,7 +1225,6 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			S3BucketName:            aws.String(tfMap["bucket_name"].(string)),
 			S3IngestionRoleArn:      aws.String(tfMap["ingestion_role"].(string)),
@@ -826,7 +1232,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			SourceEngine:            aws.String(tfMap["source_engine"].(string)),
 			SourceEngineVersion:     aws.String(tfMap["source_engine_version"].(string)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
-			Tags:                    Tags(tags.IgnoreAWS()),
+			Tags:                    tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if v, ok := d.GetOk("availability_zone"); ok {
@@ -837,16 +1243,28 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.PreferredBackupWindow = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("database_insights_mode"); ok {
+			input.DatabaseInsightsMode = types.DatabaseInsightsMode(v.(string))
+		}
+
 		if v, ok := d.GetOk("db_subnet_group_name"); ok {
 			input.DBSubnetGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("dedicated_log_volume"); ok {
+			input.DedicatedLogVolume = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
 		}
 
 		if v, ok := d.GetOk("iops"); ok {
-			input.Iops = aws.Int64(int64(v.(int)))
+			input.Iops = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("kms_key_id"); ok {
@@ -861,8 +1279,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.PreferredMaintenanceWindow = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("manage_master_user_password"); ok {
+			input.ManageMasterUserPassword = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("monitoring_interval"); ok {
-			input.MonitoringInterval = aws.Int64(int64(v.(int)))
+			input.MonitoringInterval = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("monitoring_role_arn"); ok {
@@ -885,6 +1311,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			input.EnablePerformanceInsights = aws.Bool(v.(bool))
 		}
@@ -894,15 +1324,19 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("performance_insights_retention_period"); ok {
-			input.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("storage_throughput"); ok {
-			input.StorageThroughput = aws.Int64(int64(v.(int)))
+			input.StorageThroughput = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("storage_type"); ok {
@@ -910,25 +1344,25 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceFromS3WithContext(ctx, input)
+				return connV2.RestoreDBInstanceFromS3(ctx, input)
 			},
 			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
 					return true, err
 				}
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "S3_SNAPSHOT_INGESTION") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "S3_SNAPSHOT_INGESTION") {
 					return true, err
 				}
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "S3 bucket cannot be found") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "S3 bucket cannot be found") {
 					return true, err
 				}
 				// InvalidParameterValue: Files from the specified Amazon S3 bucket cannot be downloaded. Make sure that you have created an AWS Identity and Access Management (IAM) role that lets Amazon RDS access Amazon S3 for you.
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Files from the specified Amazon S3 bucket cannot be downloaded") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "Files from the specified Amazon S3 bucket cannot be downloaded") {
 					return true, err
 				}
 
@@ -939,16 +1373,41 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return errs.AppendErrorf(diags, "creating RDS DB Instance (restore from S3) (%s): %s", identifier, err)
 		}
-	} else if v, ok := d.GetOk("snapshot_identifier"); ok {
-		input := &rds.RestoreDBInstanceFromDBSnapshotInput{
+	} else if snapshotID, clusterSnapshotID := d.Get("snapshot_identifier").(string), d.Get("db_cluster_snapshot_identifier").(string); snapshotID != "" || clusterSnapshotID != "" {
+		// Snapshots shared by another account are specified by ARN.
+		if snapshotARN, err := arn.Parse(snapshotID); snapshotID != "" && err == nil && snapshotARN.AccountID != meta.(*conns.AWSClient).AccountID {
+			snapshot, err := findSharedDBSnapshotByARN(ctx, conn, snapshotID)
+
+			if err != nil {
+				return errs.AppendErrorf(diags, "reading RDS DB Snapshot (%s): %s", snapshotID, err)
+			}
+
+			// An encrypted snapshot shared by another account must first be copied into this account and re-encrypted with a key in this account.
+			if aws.BoolValue(snapshot.Encrypted) {
+				return errs.AppendErrorf(diags, `creating RDS DB Instance (restore from snapshot) (%s): encrypted DB snapshot (%s) is shared by another account; copy it into this account with "aws_db_snapshot_copy", setting "kms_key_id", and restore from the copy`, identifier, snapshotID)
+			}
+
+			// The snapshot's option group belongs to the sharing account.
+			if v := aws.StringValue(snapshot.OptionGroupName); v != "" && !strings.HasPrefix(v, "default:") && d.Get("option_group_name").(string) == "" {
+				return errs.AppendErrorf(diags, `creating RDS DB Instance (restore from snapshot) (%s): "option_group_name" must be set to restore from DB snapshot (%s) shared by another account with non-default option group (%s)`, identifier, snapshotID, v)
+			}
+		}
+
+		input := &rds_sdkv2.RestoreDBInstanceFromDBSnapshotInput{
 			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
 			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
 			DBInstanceIdentifier:    aws.String(identifier),
-			DBSnapshotIdentifier:    aws.String(v.(string)),
 			DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
-			Tags:                    Tags(tags.IgnoreAWS()),
+			Tags:                    tagsSDKv2(tags.IgnoreAWS()),
+		}
+
+		// A DB instance can be restored from a Multi-AZ DB cluster snapshot instead of a DB snapshot.
+		if clusterSnapshotID != "" {
+			input.DBClusterSnapshotIdentifier = aws.String(clusterSnapshotID)
+		} else {
+			input.DBSnapshotIdentifier = aws.String(snapshotID)
 		}
 
 		engine := strings.ToLower(d.Get("engine").(string))
@@ -973,7 +1432,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("allocated_storage"); ok {
-			modifyDbInstanceInput.AllocatedStorage = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.AllocatedStorage = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -988,7 +1447,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOkExists("backup_retention_period"); ok {
-			modifyDbInstanceInput.BackupRetentionPeriod = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.BackupRetentionPeriod = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -1009,16 +1468,36 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBSubnetGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("dedicated_log_volume"); ok {
+			input.DedicatedLogVolume = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("domain"); ok {
 			input.Domain = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_auth_secret_arn"); ok {
+			input.DomainAuthSecretArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("domain_dns_ips"); ok && len(v.([]interface{})) > 0 {
+			input.DomainDnsIps = flex.ExpandStringValueList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("domain_fqdn"); ok {
+			input.DomainFqdn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("domain_iam_role_name"); ok {
 			input.DomainIAMRoleName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_ou"); ok {
+			input.DomainOu = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if engine != "" {
@@ -1030,12 +1509,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
 		}
 
 		if v, ok := d.GetOk("iops"); ok {
-			modifyDbInstanceInput.Iops = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.Iops = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -1049,12 +1532,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("max_allocated_storage"); ok {
-			modifyDbInstanceInput.MaxAllocatedStorage = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.MaxAllocatedStorage = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
 		if v, ok := d.GetOk("monitoring_interval"); ok {
-			modifyDbInstanceInput.MonitoringInterval = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.MonitoringInterval = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -1095,6 +1578,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if v, ok := d.GetOk("manage_master_user_password"); ok {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(v.(bool))
+			requiresModifyDbInstance = true
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
 			requiresModifyDbInstance = true
@@ -1104,16 +1596,26 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			if v, ok := d.GetOk("performance_insights_retention_period"); ok {
-				modifyDbInstanceInput.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+				modifyDbInstanceInput.PerformanceInsightsRetentionPeriod = aws.Int32(int32(v.(int)))
 			}
 		}
 
+		// RestoreDBInstanceFromDBSnapshot does not support DatabaseInsightsMode.
+		if v, ok := d.GetOk("database_insights_mode"); ok {
+			modifyDbInstanceInput.DatabaseInsightsMode = types.DatabaseInsightsMode(v.(string))
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("storage_throughput"); ok {
-			modifyDbInstanceInput.StorageThroughput = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.StorageThroughput = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -1127,15 +1629,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v)
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v)
 		}
 
 		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, input)
+				return connV2.RestoreDBInstanceFromDBSnapshot(ctx, input)
 			},
 			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
+				if errMessageContainsSDKv2(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
 					return true, err
 				}
 
@@ -1151,11 +1653,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		// Since engine is not a required argument when using snapshot_identifier
 		// and the RDS API determines this condition, we catch the error
 		// and remove the invalid configuration for it to be fixed afterwards.
-		if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Mirroring cannot be applied to instances with backup retention set to zero") {
+		if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "Mirroring cannot be applied to instances with backup retention set to zero") {
 			input.MultiAZ = aws.Bool(false)
 			modifyDbInstanceInput.MultiAZ = aws.Bool(true)
 			requiresModifyDbInstance = true
-			_, err = conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, input)
+			_, err = connV2.RestoreDBInstanceFromDBSnapshot(ctx, input)
 		}
 
 		if err != nil {
@@ -1164,13 +1666,13 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
 
-		input := &rds.RestoreDBInstanceToPointInTimeInput{
+		input := &rds_sdkv2.RestoreDBInstanceToPointInTimeInput{
 			AutoMinorVersionUpgrade:    aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
 			CopyTagsToSnapshot:         aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:            aws.String(d.Get("instance_class").(string)),
 			DeletionProtection:         aws.Bool(d.Get("deletion_protection").(bool)),
 			PubliclyAccessible:         aws.Bool(d.Get("publicly_accessible").(bool)),
-			Tags:                       Tags(tags.IgnoreAWS()),
+			Tags:                       tagsSDKv2(tags.IgnoreAWS()),
 			TargetDBInstanceIdentifier: aws.String(identifier),
 		}
 
@@ -1185,7 +1687,20 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := tfMap["source_db_instance_identifier"].(string); ok && v != "" {
-			input.SourceDBInstanceIdentifier = aws.String(v)
+			// A DB instance in another Region is restored from its automated backups replicated to this Region.
+			if sourceARN, err := arn.Parse(v); err == nil && sourceARN.Region != meta.(*conns.AWSClient).Region {
+				if input.SourceDBInstanceAutomatedBackupsArn == nil {
+					backup, err := findDBInstanceAutomatedBackupBySourceARN(conn, v)
+
+					if err != nil {
+						return errs.AppendErrorf(diags, "creating RDS DB Instance (restore to point-in-time) (%s): reading replicated automated backups of DB instance (%s): %s", identifier, v, err)
+					}
+
+					input.SourceDBInstanceAutomatedBackupsArn = backup.DBInstanceAutomatedBackupsArn
+				}
+			} else {
+				input.SourceDBInstanceIdentifier = aws.String(v)
+			}
 		}
 
 		if v, ok := tfMap["source_dbi_resource_id"].(string); ok && v != "" {
@@ -1218,40 +1733,75 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBSubnetGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("dedicated_log_volume"); ok {
+			modifyDbInstanceInput.DedicatedLogVolume = aws.Bool(v.(bool))
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk("domain"); ok {
 			input.Domain = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_auth_secret_arn"); ok {
+			input.DomainAuthSecretArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("domain_dns_ips"); ok && len(v.([]interface{})) > 0 {
+			input.DomainDnsIps = flex.ExpandStringValueList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("domain_fqdn"); ok {
+			input.DomainFqdn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("domain_iam_role_name"); ok {
 			input.DomainIAMRoleName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_ou"); ok {
+			input.DomainOu = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
 		if v, ok := d.GetOk("engine"); ok {
 			input.Engine = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
 			input.EnableIAMDatabaseAuthentication = aws.Bool(v.(bool))
 		}
 
 		if v, ok := d.GetOk("iops"); ok {
-			input.Iops = aws.Int64(int64(v.(int)))
+			input.Iops = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("license_model"); ok {
 			input.LicenseModel = aws.String(v.(string))
 		}
 
+		// RestoreDBInstanceToPointInTime does not support ManageMasterUserPassword.
+		if v, ok := d.GetOk("manage_master_user_password"); ok {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(v.(bool))
+			requiresModifyDbInstance = true
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		if v, ok := d.GetOk("max_allocated_storage"); ok {
-			input.MaxAllocatedStorage = aws.Int64(int64(v.(int)))
+			input.MaxAllocatedStorage = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("monitoring_interval"); ok {
-			modifyDbInstanceInput.MonitoringInterval = aws.Int64(int64(v.(int)))
+			modifyDbInstanceInput.MonitoringInterval = aws.Int32(int32(v.(int)))
 			requiresModifyDbInstance = true
 		}
 
@@ -1273,7 +1823,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v, ok := d.GetOk("storage_type"); ok {
@@ -1289,15 +1843,33 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		// RestoreDBInstanceToPointInTime does not support DatabaseInsightsMode or Performance Insights.
+		if v, ok := d.GetOk("database_insights_mode"); ok {
+			modifyDbInstanceInput.DatabaseInsightsMode = types.DatabaseInsightsMode(v.(string))
+			requiresModifyDbInstance = true
+
+			if v, ok := d.GetOk("performance_insights_enabled"); ok {
+				modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
+
+				if v, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+					modifyDbInstanceInput.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+				}
+
+				if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+					modifyDbInstanceInput.PerformanceInsightsRetentionPeriod = aws.Int32(int32(v.(int)))
+				}
+			}
 		}
 
 		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceToPointInTimeWithContext(ctx, input)
+				return connV2.RestoreDBInstanceToPointInTime(ctx, input)
 			},
 			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
+				if errMessageContainsSDKv2(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
 					return true, err
 				}
 
@@ -1320,7 +1892,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, ok := d.GetOk("engine"); !ok {
 			diags = errs.AppendErrorf(diags, `"engine": required field is not set`)
 		}
-		if _, ok := d.GetOk("password"); !ok {
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
 			diags = errs.AppendErrorf(diags, `"password": required field is not set`)
 		}
 		if _, ok := d.GetOk("username"); !ok {
@@ -1330,10 +1902,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return diags
 		}
 
-		input := &rds.CreateDBInstanceInput{
-			AllocatedStorage:        aws.Int64(int64(d.Get("allocated_storage").(int))),
+		input := &rds_sdkv2.CreateDBInstanceInput{
+			AllocatedStorage:        aws.Int32(int32(d.Get("allocated_storage").(int))),
 			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-			BackupRetentionPeriod:   aws.Int64(int64(d.Get("backup_retention_period").(int))),
+			BackupRetentionPeriod:   aws.Int32(int32(d.Get("backup_retention_period").(int))),
 			CopyTagsToSnapshot:      aws.Bool(d.Get("copy_tags_to_snapshot").(bool)),
 			DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
 			DBInstanceIdentifier:    aws.String(identifier),
@@ -1342,10 +1914,9 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
-			Tags:                    Tags(tags.IgnoreAWS()),
+			Tags:                    tagsSDKv2(tags.IgnoreAWS()),
 		}
 
 		if v, ok := d.GetOk("availability_zone"); ok {
@@ -1368,20 +1939,48 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.EnableCustomerOwnedIp = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("database_insights_mode"); ok {
+			input.DatabaseInsightsMode = types.DatabaseInsightsMode(v.(string))
+		}
+
 		if v, ok := d.GetOk("db_subnet_group_name"); ok {
 			input.DBSubnetGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("dedicated_log_volume"); ok {
+			input.DedicatedLogVolume = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("domain"); ok {
 			input.Domain = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_auth_secret_arn"); ok {
+			input.DomainAuthSecretArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("domain_dns_ips"); ok && len(v.([]interface{})) > 0 {
+			input.DomainDnsIps = flex.ExpandStringValueList(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("domain_fqdn"); ok {
+			input.DomainFqdn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("domain_iam_role_name"); ok {
 			input.DomainIAMRoleName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("domain_ou"); ok {
+			input.DomainOu = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("enabled_cloudwatch_logs_exports"); ok && v.(*schema.Set).Len() > 0 {
-			input.EnableCloudwatchLogsExports = flex.ExpandStringSet(v.(*schema.Set))
+			input.EnableCloudwatchLogsExports = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("engine_lifecycle_support"); ok {
+			input.EngineLifecycleSupport = aws.String(v.(string))
 		}
 
 		if v, ok := d.GetOk("iam_database_authentication_enabled"); ok {
@@ -1389,7 +1988,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("iops"); ok {
-			input.Iops = aws.Int64(int64(v.(int)))
+			input.Iops = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("kms_key_id"); ok {
@@ -1404,12 +2003,20 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.PreferredMaintenanceWindow = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("manage_master_user_password"); ok {
+			input.ManageMasterUserPassword = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("max_allocated_storage"); ok {
-			input.MaxAllocatedStorage = aws.Int64(int64(v.(int)))
+			input.MaxAllocatedStorage = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("monitoring_interval"); ok {
-			input.MonitoringInterval = aws.Int64(int64(v.(int)))
+			input.MonitoringInterval = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("monitoring_role_arn"); ok {
@@ -1420,6 +2027,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.MultiAZ = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("multi_tenant"); ok {
+			input.MultiTenant = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("nchar_character_set_name"); ok {
 			input.NcharCharacterSetName = aws.String(v.(string))
 		}
@@ -1436,6 +2047,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			input.EnablePerformanceInsights = aws.Bool(v.(bool))
 		}
@@ -1445,19 +2060,23 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v, ok := d.GetOk("performance_insights_retention_period"); ok {
-			input.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("port"); ok {
-			input.Port = aws.Int64(int64(v.(int)))
+			input.Port = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
 		}
 
 		if v := d.Get("security_group_names").(*schema.Set); v.Len() > 0 {
-			input.DBSecurityGroups = flex.ExpandStringSet(v)
+			input.DBSecurityGroups = flex.ExpandStringValueSet(v)
 		}
 
 		if v, ok := d.GetOk("storage_throughput"); ok {
-			input.StorageThroughput = aws.Int64(int64(v.(int)))
+			input.StorageThroughput = aws.Int32(int32(v.(int)))
 		}
 
 		if v, ok := d.GetOk("storage_type"); ok {
@@ -1469,18 +2088,18 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
-			input.VpcSecurityGroupIds = flex.ExpandStringSet(v)
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v)
 		}
 
 		outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.CreateDBInstanceWithContext(ctx, input)
+				return connV2.CreateDBInstance(ctx, input)
 			},
 			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
+				if errMessageContainsSDKv2(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
 					return true, err
 				}
-				if tfawserr.ErrMessageContains(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
+				if errMessageContainsSDKv2(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
 					return true, err
 				}
 
@@ -1492,7 +2111,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return errs.AppendErrorf(diags, "creating RDS DB Instance (%s): %s", identifier, err)
 		}
 
-		output := outputRaw.(*rds.CreateDBInstanceOutput)
+		output := outputRaw.(*rds_sdkv2.CreateDBInstanceOutput)
 
 		// This is added here to avoid unnecessary modification when ca_cert_identifier is the default one
 		if v, ok := d.GetOk("ca_cert_identifier"); ok && v.(string) != aws.StringValue(output.DBInstance.CACertificateIdentifier) {
@@ -1503,6 +2122,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(identifier)
 
+	// Follow-up modifications can only be made once the DB instance is available.
+	if !d.Get("wait_for_creation").(bool) && !requiresModifyDbInstance && !requiresRebootDbInstance {
+		return append(diags, resourceInstanceRead(ctx, d, meta)...)
+	}
+
 	if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return errs.AppendErrorf(diags, "waiting for RDS DB Instance (%s) create: %s", d.Id(), err)
 	}
@@ -1510,7 +2134,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if requiresModifyDbInstance {
 		modifyDbInstanceInput.DBInstanceIdentifier = aws.String(d.Id())
 
-		_, err := conn.ModifyDBInstanceWithContext(ctx, modifyDbInstanceInput)
+		_, err := connV2.ModifyDBInstance(ctx, modifyDbInstanceInput)
 
 		if err != nil {
 			return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
@@ -1519,6 +2143,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return errs.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", d.Id(), err)
 		}
+
+		if v := modifyDbInstanceInput.DedicatedLogVolume; v != nil {
+			if _, err := waitDBInstanceDedicatedLogVolumeUpdated(ctx, meta.(*conns.AWSClient).RDSClient(), d.Id(), aws.BoolValue(v), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return errs.AppendErrorf(diags, "waiting for RDS DB Instance (%s) dedicated log volume update: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if requiresRebootDbInstance {
@@ -1539,11 +2169,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSClient()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	v, err := findDBInstanceByIDSDKv1(ctx, conn, d.Id())
+	v, err := findDBInstanceByIDSDKv2(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", d.Id())
@@ -1555,10 +2185,28 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return errs.AppendErrorf(diags, "reading RDS DB Instance (%s): %s", d.Id(), err)
 	}
 
-	d.Set("allocated_storage", v.AllocatedStorage)
+	// Storage autoscaling can grow storage beyond the configured allocated_storage.
+	// Keep the configured value and report the current storage in autoscaled_storage.
+	allocatedStorage := aws.Int32Value(v.AllocatedStorage)
+	if configured := int32(d.Get("allocated_storage").(int)); configured > 0 && configured < allocatedStorage && aws.Int32Value(v.MaxAllocatedStorage) > 0 {
+		d.Set("allocated_storage", configured)
+	} else {
+		d.Set("allocated_storage", allocatedStorage)
+	}
+	d.Set("autoscaled_storage", allocatedStorage)
 	arn := aws.StringValue(v.DBInstanceArn)
 	d.Set("arn", arn)
 	d.Set("auto_minor_version_upgrade", v.AutoMinorVersionUpgrade)
+	// The retained Blue/Green Deployment source is not managed by this resource.
+	// Stop reporting it once it has been deleted.
+	if sourceID := d.Get("blue_green_source_identifier").(string); sourceID != "" {
+		if _, err := findDBInstanceByIDSDKv2(ctx, conn, sourceID); tfresource.NotFound(err) {
+			d.Set("blue_green_source_identifier", nil)
+		} else if err != nil {
+			// The source may be in a state or under a policy that this configuration can't describe; keep the last known value.
+			log.Printf("[WARN] reading RDS DB Instance (%s) Blue/Green Deployment source (%s): %s", d.Id(), sourceID, err)
+		}
+	}
 	d.Set("availability_zone", v.AvailabilityZone)
 	d.Set("backup_retention_period", v.BackupRetentionPeriod)
 	d.Set("backup_window", v.PreferredBackupWindow)
@@ -1571,15 +2219,24 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if v.DBSubnetGroup != nil {
 		d.Set("db_subnet_group_name", v.DBSubnetGroup.DBSubnetGroupName)
 	}
+	d.Set("dedicated_log_volume", v.DedicatedLogVolume)
 	d.Set("deletion_protection", v.DeletionProtection)
-	if len(v.DomainMemberships) > 0 && v.DomainMemberships[0] != nil {
+	if len(v.DomainMemberships) > 0 {
 		d.Set("domain", v.DomainMemberships[0].Domain)
+		d.Set("domain_auth_secret_arn", v.DomainMemberships[0].AuthSecretArn)
+		d.Set("domain_dns_ips", v.DomainMemberships[0].DnsIps)
+		d.Set("domain_fqdn", v.DomainMemberships[0].FQDN)
 		d.Set("domain_iam_role_name", v.DomainMemberships[0].IAMRoleName)
+		d.Set("domain_ou", v.DomainMemberships[0].OU)
 	} else {
 		d.Set("domain", nil)
+		d.Set("domain_auth_secret_arn", nil)
+		d.Set("domain_dns_ips", nil)
+		d.Set("domain_fqdn", nil)
 		d.Set("domain_iam_role_name", nil)
+		d.Set("domain_ou", nil)
 	}
-	d.Set("enabled_cloudwatch_logs_exports", aws.StringValueSlice(v.EnabledCloudwatchLogsExports))
+	d.Set("enabled_cloudwatch_logs_exports", v.EnabledCloudwatchLogsExports)
 	d.Set("engine", v.Engine)
 	d.Set("iam_database_authentication_enabled", v.IAMDatabaseAuthenticationEnabled)
 	d.Set("identifier", v.DBInstanceIdentifier)
@@ -1594,26 +2251,45 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("license_model", v.LicenseModel)
 	d.Set("maintenance_window", v.PreferredMaintenanceWindow)
+	if v.MasterUserSecret != nil {
+		d.Set("manage_master_user_password", true)
+		if err := d.Set("master_user_secret", []interface{}{flattenManagedMasterUserSecretSDKv2(v.MasterUserSecret)}); err != nil {
+			return errs.AppendErrorf(diags, "setting master_user_secret: %s", err)
+		}
+		d.Set("master_user_secret_kms_key_id", v.MasterUserSecret.KmsKeyId)
+	} else {
+		d.Set("manage_master_user_password", false)
+		d.Set("master_user_secret", nil)
+	}
 	d.Set("max_allocated_storage", v.MaxAllocatedStorage)
 	d.Set("monitoring_interval", v.MonitoringInterval)
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
 	d.Set("multi_az", v.MultiAZ)
+	d.Set("multi_tenant", v.MultiTenant)
+	d.Set("database_insights_mode", v.DatabaseInsightsMode)
+	d.Set("engine_lifecycle_support", v.EngineLifecycleSupport)
 	d.Set("name", v.DBName)
 	d.Set("nchar_character_set_name", v.NcharCharacterSetName)
 	d.Set("network_type", v.NetworkType)
-	if len(v.OptionGroupMemberships) > 0 && v.OptionGroupMemberships[0] != nil {
+	if len(v.OptionGroupMemberships) > 0 {
 		d.Set("option_group_name", v.OptionGroupMemberships[0].OptionGroupName)
 	}
-	if len(v.DBParameterGroups) > 0 && v.DBParameterGroups[0] != nil {
+	if len(v.DBParameterGroups) > 0 {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValuesSDKv2(v.PendingModifiedValues)); err != nil {
+		return errs.AppendErrorf(diags, "setting pending_modified_values: %s", err)
+	}
 	d.Set("performance_insights_enabled", v.PerformanceInsightsEnabled)
 	d.Set("performance_insights_kms_key_id", v.PerformanceInsightsKMSKeyId)
 	d.Set("performance_insights_retention_period", v.PerformanceInsightsRetentionPeriod)
 	d.Set("port", v.DbInstancePort)
+	if err := d.Set("processor_features", flattenProcessorFeaturesSDKv2(v.ProcessorFeatures)); err != nil {
+		return errs.AppendErrorf(diags, "setting processor_features: %s", err)
+	}
 	d.Set("publicly_accessible", v.PubliclyAccessible)
 	d.Set("replica_mode", v.ReplicaMode)
-	d.Set("replicas", aws.StringValueSlice(v.ReadReplicaDBInstanceIdentifiers))
+	d.Set("replicas", v.ReadReplicaDBInstanceIdentifiers)
 	d.Set("replicate_source_db", v.ReadReplicaSourceDBInstanceIdentifier)
 	d.Set("resource_id", v.DbiResourceId)
 	var securityGroupNames []string
@@ -1636,7 +2312,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if v.Endpoint != nil {
 		d.Set("address", v.Endpoint.Address)
 		if v.Endpoint.Address != nil && v.Endpoint.Port != nil {
-			d.Set("endpoint", fmt.Sprintf("%s:%d", aws.StringValue(v.Endpoint.Address), aws.Int64Value(v.Endpoint.Port)))
+			d.Set("endpoint", fmt.Sprintf("%s:%d", aws.StringValue(v.Endpoint.Address), aws.Int32Value(v.Endpoint.Port)))
 		}
 		d.Set("hosted_zone_id", v.Endpoint.HostedZoneId)
 		d.Set("port", v.Endpoint.Port)
@@ -1644,13 +2320,22 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	dbSetResourceDataEngineVersionFromInstance(d, v)
 
-	tags, err := ListTagsWithContext(ctx, conn, arn)
+	pendingMaintenanceActions, err := FindPendingMaintenanceActionsByResourceARN(ctx, meta.(*conns.AWSClient).RDSConn, arn)
 
-	if err != nil {
-		return errs.AppendErrorf(diags, "listing tags for RDS DB Instance (%s): %s", arn, err)
+	// Don't require the rds:DescribePendingMaintenanceActions permission just to manage the DB instance.
+	switch {
+	case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+		log.Printf("[WARN] reading RDS DB Instance (%s) pending maintenance actions: %s", d.Id(), err)
+		pendingMaintenanceActions = nil
+	case err != nil && !tfresource.NotFound(err):
+		return errs.AppendErrorf(diags, "reading RDS DB Instance (%s) pending maintenance actions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("pending_maintenance_actions", flattenPendingMaintenanceActions(pendingMaintenanceActions)); err != nil {
+		return errs.AppendErrorf(diags, "setting pending_maintenance_actions: %s", err)
 	}
 
-	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := keyValueTagsSDKv2(v.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -1668,6 +2353,39 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).RDSClient()
 	deadline := NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
+	// A DB instance created with wait_for_creation = false may still be creating.
+	if d.Get("status").(string) == InstanceStatusCreating && d.HasChangesExcept("tags", "tags_all", "wait_for_creation") {
+		if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, d.Id(), deadline.remaining()); err != nil {
+			return errs.AppendErrorf(diags, "waiting for RDS DB Instance (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	// Separate request to rename a database, so that subsequent requests use the new identifier.
+	if d.HasChange("identifier") {
+		newID := d.Get("identifier").(string)
+		input := &rds_sdkv2.ModifyDBInstanceInput{
+			// A rename deferred to the next maintenance window would leave the resource ID stale.
+			ApplyImmediately:        aws.Bool(true),
+			DBInstanceIdentifier:    aws.String(d.Id()),
+			NewDBInstanceIdentifier: aws.String(newID),
+		}
+
+		if err := dbInstanceModify(ctx, conn, input, deadline.remaining()); err != nil {
+			return errs.AppendErrorf(diags, "renaming RDS DB Instance (%s) to %s: %s", d.Id(), newID, err)
+		}
+
+		d.SetId(newID)
+
+		// The ARN includes the identifier.
+		output, err := findDBInstanceByIDSDKv2(ctx, conn, d.Id())
+
+		if err != nil {
+			return errs.AppendErrorf(diags, "reading RDS DB Instance (%s): %s", d.Id(), err)
+		}
+
+		d.Set("arn", output.DBInstanceArn)
+	}
+
 	// Separate request to promote a database.
 	if d.HasChange("replicate_source_db") {
 		if d.Get("replicate_source_db").(string) == "" {
@@ -1700,9 +2418,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		"blue_green_update",
 		"delete_automated_backups",
 		"final_snapshot_identifier",
+		"final_snapshot_identifier_prefix",
+		"identifier",
+		"rename_on_identifier_change",
 		"replicate_source_db",
 		"skip_final_snapshot",
 		"tags", "tags_all",
+		"wait_for_creation",
 	) {
 		if d.Get("blue_green_update.0.enabled").(bool) {
 			orchestrator := newBlueGreenOrchestrator(conn)
@@ -1727,15 +2449,25 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
-			createIn := handler.createBlueGreenInput(d)
+			defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+			tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+			createIn := handler.createBlueGreenInput(d, tags)
 
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Creating Blue/Green Deployment", d.Id())
+			tflog.Info(ctx, "Updating RDS DB Instance: Creating Blue/Green Deployment", map[string]interface{}{
+				"id": d.Id(),
+			})
 
 			dep, err := orchestrator.createDeployment(ctx, createIn)
 			if err != nil {
 				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 			deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+			progressFields := map[string]interface{}{
+				"id":                       d.Id(),
+				"blue_green_deployment_id": aws.StringValue(deploymentIdentifier),
+			}
+
+			tflog.Info(ctx, "Updating RDS DB Instance: Blue/Green Deployment created", progressFields)
 			defer func() {
 				log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment", d.Id())
 
@@ -1779,95 +2511,146 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
 			}
 
+			err = handler.waitForGreenReplicas(ctx, dep, deadline.remaining())
+			if err != nil {
+				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: %s", d.Id(), err)
+			}
+
+			tflog.Info(ctx, "Updating RDS DB Instance: Blue/Green Deployment Green environment available", progressFields)
+
 			err = handler.modifyTarget(ctx, targetARN.Identifier, d, deadline.remaining(), fmt.Sprintf("Updating RDS DB Instance (%s)", d.Id()))
 			if err != nil {
 				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Switching over Blue/Green Deployment", d.Id())
+			tflog.Info(ctx, "Updating RDS DB Instance: Blue/Green Deployment switchover started", progressFields)
 
-			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), deadline.remaining())
+			dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), int32(d.Get("blue_green_update.0.switchover_timeout").(int)), deadline.remaining())
 			if err != nil {
 				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
-			log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment source", d.Id())
+			tflog.Info(ctx, "Updating RDS DB Instance: Blue/Green Deployment switchover completed", progressFields)
 
 			sourceARN, err := parseDBInstanceARN(aws.StringValue(dep.Source))
 			if err != nil {
-				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): Blue/Green Deployment source: %s", d.Id(), err)
 			}
-			if d.Get("deletion_protection").(bool) {
-				input := &rds_sdkv2.ModifyDBInstanceInput{
-					ApplyImmediately:     true,
-					DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-					DeletionProtection:   aws.Bool(false),
+
+			if d.Get("blue_green_update.0.delete_source").(bool) {
+				tflog.Info(ctx, "Updating RDS DB Instance: Deleting Blue/Green Deployment source", progressFields)
+
+				d.Set("blue_green_source_identifier", nil)
+				if d.Get("deletion_protection").(bool) {
+					input := &rds_sdkv2.ModifyDBInstanceInput{
+						ApplyImmediately:     aws.Bool(true),
+						DBInstanceIdentifier: aws.String(sourceARN.Identifier),
+						DeletionProtection:   aws.Bool(false),
+					}
+					err := dbInstanceModify(ctx, conn, input, deadline.remaining())
+					if err != nil {
+						return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
+					}
 				}
-				err := dbInstanceModify(ctx, conn, input, deadline.remaining())
+				// The Blue environment's read replicas are replaced by the Green read replicas,
+				// which take over their identifiers. Delete them before the source.
+				blueReplicas, _, err := blueGreenDeploymentInstanceMembers(dep)
 				if err != nil {
-					return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: disabling deletion protection: %s", d.Id(), err)
+					return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source read replicas: %s", d.Id(), err)
 				}
-			}
-			deleteInput := &rds_sdkv2.DeleteDBInstanceInput{
-				DBInstanceIdentifier: aws.String(sourceARN.Identifier),
-				SkipFinalSnapshot:    true,
-			}
-			_, err = tfresource.RetryWhenContext(ctx, 5*time.Minute,
-				func() (any, error) {
-					return conn.DeleteDBInstance(ctx, deleteInput)
-				},
-				func(err error) (bool, error) {
-					// Retry for IAM eventual consistency.
-					apiErr, ok := errs.As[smithy.APIError](err)
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterValue && strings.Contains(apiErr.ErrorMessage(), "IAM role ARN value is invalid or does not include the required permissions") {
-						return true, err
+
+				for _, v := range blueReplicas {
+					replicaARN, err := parseDBInstanceARN(v)
+					if err != nil {
+						return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): Blue/Green Deployment source read replica: %s", d.Id(), err)
 					}
 
-					if ok && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(apiErr.ErrorMessage(), "disable deletion pro") {
-						return true, err
+					log.Printf("[DEBUG] Updating RDS DB Instance (%s): Deleting Blue/Green Deployment source read replica (%s)", d.Id(), replicaARN.Identifier)
+
+					if err := handler.deleteBlueInstance(ctx, replicaARN.Identifier); err != nil {
+						return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source read replica (%s): %s", d.Id(), replicaARN.Identifier, err)
 					}
 
-					return false, err
-				},
-			)
-			if err != nil {
-				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
-			}
+					cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
+						_, err := waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn, replicaARN.Identifier, deadline.remaining(), optFns...)
+						if err != nil {
+							diags = errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source read replica (%s): waiting for completion: %s", d.Id(), replicaARN.Identifier, err)
+						}
+					})
+				}
 
-			cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
-				_, err = waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn, sourceARN.Identifier, deadline.remaining(), optFns...)
-				if err != nil {
-					diags = errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+				if err := handler.deleteBlueInstance(ctx, sourceARN.Identifier); err != nil {
+					return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
 				}
-			})
+
+				cleaupWaiters = append(cleaupWaiters, func(optFns ...tfresource.OptionsFunc) {
+					_, err = waitDBInstanceDeleted(ctx, meta.(*conns.AWSClient).RDSConn, sourceARN.Identifier, deadline.remaining(), optFns...)
+					if err != nil {
+						diags = errs.AppendErrorf(diags, "updating RDS DB Instance (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+						return
+					}
+
+					tflog.Info(ctx, "Updating RDS DB Instance: Blue/Green Deployment source deleted", progressFields)
+				})
+			} else {
+				tflog.Info(ctx, "Updating RDS DB Instance: Retaining Blue/Green Deployment source", map[string]interface{}{
+					"id":                       d.Id(),
+					"blue_green_deployment_id": aws.StringValue(deploymentIdentifier),
+					"source_id":                sourceARN.Identifier,
+				})
+
+				d.Set("blue_green_source_identifier", sourceARN.Identifier)
+			}
 
 			if diags.HasError() {
 				return
 			}
 		} else {
 			input := &rds_sdkv2.ModifyDBInstanceInput{
-				ApplyImmediately:     d.Get("apply_immediately").(bool),
+				ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 				DBInstanceIdentifier: aws.String(d.Id()),
 			}
 
-			if !input.ApplyImmediately {
+			if !aws.BoolValue(input.ApplyImmediately) {
 				log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 			}
 
-			dbInstancePopulateModify(input, d)
+			needsModify := dbInstancePopulateModify(input, d)
+
+			if d.HasChange("instance_class") {
+				needsModify = true
+				input.DBInstanceClass = aws.String(d.Get("instance_class").(string))
+			}
 
 			if d.HasChange("engine_version") {
+				needsModify = true
 				input.EngineVersion = aws.String(d.Get("engine_version").(string))
-				input.AllowMajorVersionUpgrade = d.Get("allow_major_version_upgrade").(bool)
+				input.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 			}
 
 			if d.HasChange("parameter_group_name") {
+				needsModify = true
 				input.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 			}
 
-			err := dbInstanceModify(ctx, conn, input, deadline.remaining())
-			if err != nil {
-				return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
+			if needsModify {
+				if err := dbInstanceModify(ctx, conn, input, deadline.remaining()); err != nil {
+					return errs.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Id(), err)
+				}
+			}
+
+			if storageInput := dbInstanceStorageModification(d, d.Id(), aws.BoolValue(input.ApplyImmediately)); storageInput != nil {
+				if err := dbInstanceModifyStorage(ctx, conn, storageInput, deadline.remaining()); err != nil {
+					return errs.AppendErrorf(diags, "updating RDS DB Instance (%s) storage: %s", d.Id(), err)
+				}
+			}
+
+			// Enabling or disabling a dedicated log volume triggers a storage reconfiguration
+			// that can complete after the instance has returned to the available state.
+			if d.HasChange("dedicated_log_volume") && aws.BoolValue(input.ApplyImmediately) {
+				if _, err := waitDBInstanceDedicatedLogVolumeUpdated(ctx, conn, d.Id(), d.Get("dedicated_log_volume").(bool), deadline.remaining()); err != nil {
+					return errs.AppendErrorf(diags, "waiting for RDS DB Instance (%s) dedicated log volume update: %s", d.Id(), err)
+				}
 			}
 		}
 	}
@@ -1886,12 +2669,6 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.ResourceData) bool {
 	needsModify := false
 
-	if d.HasChanges("allocated_storage", "iops") {
-		needsModify = true
-		input.Iops = aws.Int32(int32(d.Get("iops").(int)))
-		input.AllocatedStorage = aws.Int32(int32(d.Get("allocated_storage").(int)))
-	}
-
 	if d.HasChange("auto_minor_version_upgrade") {
 		needsModify = true
 		input.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
@@ -1927,6 +2704,11 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.DBSubnetGroupName = aws.String(d.Get("db_subnet_group_name").(string))
 	}
 
+	if d.HasChange("dedicated_log_volume") {
+		needsModify = true
+		input.DedicatedLogVolume = aws.Bool(d.Get("dedicated_log_volume").(bool))
+	}
+
 	if d.HasChange("deletion_protection") {
 		needsModify = true
 	}
@@ -1939,6 +2721,20 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
 	}
 
+	if d.HasChanges("domain_auth_secret_arn", "domain_dns_ips", "domain_fqdn", "domain_ou") {
+		needsModify = true
+
+		if v, ok := d.GetOk("domain_fqdn"); ok {
+			input.DomainAuthSecretArn = aws.String(d.Get("domain_auth_secret_arn").(string))
+			input.DomainDnsIps = flex.ExpandStringValueList(d.Get("domain_dns_ips").([]interface{}))
+			input.DomainFqdn = aws.String(v.(string))
+			input.DomainOu = aws.String(d.Get("domain_ou").(string))
+		} else if d.Get("domain").(string) == "" {
+			// Leaving a self-managed Active Directory requires an explicit opt-out.
+			input.DisableDomain = aws.Bool(true)
+		}
+	}
+
 	if d.HasChange("enabled_cloudwatch_logs_exports") {
 		needsModify = true
 		oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
@@ -1959,11 +2755,6 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
 	}
 
-	if d.HasChange("instance_class") {
-		needsModify = true
-		input.DBInstanceClass = aws.String(d.Get("instance_class").(string))
-	}
-
 	if d.HasChange("license_model") {
 		needsModify = true
 		input.LicenseModel = aws.String(d.Get("license_model").(string))
@@ -2003,6 +2794,11 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
 	}
 
+	if d.HasChange("multi_tenant") {
+		needsModify = true
+		input.MultiTenant = aws.Bool(d.Get("multi_tenant").(bool))
+	}
+
 	if d.HasChange("network_type") {
 		needsModify = true
 		input.NetworkType = aws.String(d.Get("network_type").(string))
@@ -2013,12 +2809,31 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.OptionGroupName = aws.String(d.Get("option_group_name").(string))
 	}
 
+	if d.HasChanges("manage_master_user_password", "master_user_secret_kms_key_id") {
+		needsModify = true
+		input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok && d.Get("manage_master_user_password").(bool) {
+			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+		}
+	}
+
 	if d.HasChange("password") {
 		needsModify = true
-		input.MasterUserPassword = aws.String(d.Get("password").(string))
+
+		// Removing the password, e.g. when switching to an RDS-managed master password, leaves it unchanged.
+		if v, ok := d.GetOk("password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+	}
+
+	// Switching to the advanced mode requires the Performance Insights settings in the same request.
+	if d.HasChange("database_insights_mode") {
+		needsModify = true
+		input.DatabaseInsightsMode = types.DatabaseInsightsMode(d.Get("database_insights_mode").(string))
 	}
 
-	if d.HasChanges("performance_insights_enabled", "performance_insights_kms_key_id", "performance_insights_retention_period") {
+	if d.HasChanges("database_insights_mode", "performance_insights_enabled", "performance_insights_kms_key_id", "performance_insights_retention_period") {
 		needsModify = true
 		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
 
@@ -2036,6 +2851,16 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		input.DBPortNumber = aws.Int32(int32(d.Get("port").(int)))
 	}
 
+	if d.HasChange("processor_features") {
+		needsModify = true
+		if v, ok := d.GetOk("processor_features"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProcessorFeatures = expandProcessorFeaturesSDKv2(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			// Removing the block reverts to the DB instance class's default processor features.
+			input.UseDefaultProcessorFeatures = aws.Bool(true)
+		}
+	}
+
 	if d.HasChange("publicly_accessible") {
 		needsModify = true
 		input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
@@ -2053,28 +2878,62 @@ func dbInstancePopulateModify(input *rds_sdkv2.ModifyDBInstanceInput, d *schema.
 		}
 	}
 
+	if d.HasChange("vpc_security_group_ids") {
+		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
+			needsModify = true
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return needsModify
+}
+
+// dbInstanceStorageModification returns the modification that applies only the storage settings that have changed,
+// or nil if none have. Storage is modified separately from other settings, with all changed storage settings in a
+// single modification as RDS rejects a storage modification made while a previous one is being optimized.
+func dbInstanceStorageModification(d *schema.ResourceData, id string, applyImmediately bool) *rds_sdkv2.ModifyDBInstanceInput {
+	input := &rds_sdkv2.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(applyImmediately),
+		DBInstanceIdentifier: aws.String(id),
+	}
+
+	if d.HasChange("allocated_storage") {
+		allocatedStorage := d.Get("allocated_storage").(int)
+		autoscaledStorage := d.Get("autoscaled_storage").(int)
+
+		// Storage can't be decreased, so there's nothing to do if autoscaling has already grown storage beyond the configured value.
+		if allocatedStorage < autoscaledStorage {
+			allocatedStorage = autoscaledStorage
+		}
+
+		if allocatedStorage != autoscaledStorage {
+			input.AllocatedStorage = aws.Int32(int32(allocatedStorage))
+		}
+	}
+
+	if d.HasChange("iops") {
+		input.Iops = aws.Int32(int32(d.Get("iops").(int)))
+	}
+
 	if d.HasChange("storage_throughput") {
-		needsModify = true
 		input.StorageThroughput = aws.Int32(int32(d.Get("storage_throughput").(int)))
 	}
 
 	if d.HasChange("storage_type") {
-		needsModify = true
 		input.StorageType = aws.String(d.Get("storage_type").(string))
+	}
 
-		if aws.StringValue(input.StorageType) == storageTypeIO1 {
-			input.Iops = aws.Int32(int32(d.Get("iops").(int)))
-		}
+	if input.AllocatedStorage == nil && input.Iops == nil && input.StorageThroughput == nil && input.StorageType == nil {
+		return nil
 	}
 
-	if d.HasChange("vpc_security_group_ids") {
-		if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
-			needsModify = true
-			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v)
-		}
+	// Provisioned IOPS must be specified with any modification of io1 or io2 storage, including conversion to io1 or io2,
+	// and with any modification of gp3 storage throughput.
+	if storageTypeRequiresIOPS(d.Get("storage_type").(string)) || input.StorageThroughput != nil {
+		input.Iops = aws.Int32(int32(d.Get("iops").(int)))
 	}
 
-	return needsModify
+	return input
 }
 
 func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.ModifyDBInstanceInput, timeout time.Duration) error {
@@ -2101,12 +2960,30 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sd
 		return err
 	}
 
-	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, aws.StringValue(input.DBInstanceIdentifier), timeout); err != nil {
+	id := aws.StringValue(input.DBInstanceIdentifier)
+	// A renamed DB instance becomes available under its new identifier.
+	if v := aws.StringValue(input.NewDBInstanceIdentifier); v != "" {
+		id = v
+	}
+
+	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, id, timeout); err != nil {
 		return fmt.Errorf("waiting for completion: %w", err)
 	}
 	return nil
 }
 
+// dbInstanceModifyStorage applies the storage modification once any previous storage modification has been optimized.
+// Storage optimization can take several hours, so the wait is bounded by the timeout.
+func dbInstanceModifyStorage(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.ModifyDBInstanceInput, timeout time.Duration) error {
+	deadline := NewDeadline(timeout)
+
+	if _, err := waitDBInstanceStorageOptimized(ctx, conn, aws.StringValue(input.DBInstanceIdentifier), deadline.remaining()); err != nil {
+		return fmt.Errorf("waiting for storage optimization: %w", err)
+	}
+
+	return dbInstanceModify(ctx, conn, input, deadline.remaining())
+}
+
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn
 
@@ -2122,8 +2999,11 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 		if v, ok := d.GetOk("final_snapshot_identifier"); ok {
 			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+		} else if v, ok := d.GetOk("final_snapshot_identifier_prefix"); ok {
+			// The generated suffix is a timestamp, so repeated create/destroy cycles don't collide.
+			input.FinalDBSnapshotIdentifier = aws.String(resource.PrefixedUniqueId(v.(string)))
 		} else {
-			return errs.AppendErrorf(diags, "final_snapshot_identifier is required when skip_final_snapshot is false")
+			return errs.AppendErrorf(diags, "final_snapshot_identifier or final_snapshot_identifier_prefix is required when skip_final_snapshot is false")
 		}
 	}
 
@@ -2182,16 +3062,50 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The DB instance may be imported by identifier, ARN or resource ID (DbiResourceId).
+	if id := d.Id(); arn.IsARN(id) {
+		v, err := parseDBInstanceARN(id)
+
+		if err != nil {
+			return nil, fmt.Errorf("importing RDS DB Instance (%s): %w", id, err)
+		}
+
+		if accountID := meta.(*conns.AWSClient).AccountID; v.AccountID != accountID {
+			return nil, fmt.Errorf("importing RDS DB Instance (%s): ARN account ID (%s) does not match provider account ID (%s)", id, v.AccountID, accountID)
+		}
+
+		if region := meta.(*conns.AWSClient).Region; v.Region != region {
+			return nil, fmt.Errorf("importing RDS DB Instance (%s): ARN region (%s) does not match provider region (%s)", id, v.Region, region)
+		}
+
+		d.SetId(v.Identifier)
+	} else if dbInstanceResourceIDRegexp.MatchString(id) {
+		conn := meta.(*conns.AWSClient).RDSConn
+
+		v, err := findDBInstanceByResourceIDSDKv1(ctx, conn, id)
+
+		switch {
+		case tfresource.NotFound(err):
+			// Resource IDs and identifiers overlap, so fall back to treating the value as an identifier.
+		case err != nil:
+			return nil, fmt.Errorf("importing RDS DB Instance (%s): %w", id, err)
+		default:
+			d.SetId(aws.StringValue(v.DBInstanceIdentifier))
+		}
+	}
+
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
 	// from any API call, so we need to default skip_final_snapshot to true so
 	// that final_snapshot_identifier is not required.
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("rename_on_identifier_change", true)
+	d.Set("wait_for_creation", true)
 	return []*schema.ResourceData{d}, nil
 }
 
-func dbSetResourceDataEngineVersionFromInstance(d *schema.ResourceData, c *rds.DBInstance) {
+func dbSetResourceDataEngineVersionFromInstance(d *schema.ResourceData, c *types.DBInstance) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
 	compareActualEngineVersion(d, oldVersion, newVersion)
@@ -2247,6 +3161,34 @@ func findDBInstanceByIDSDKv1(ctx context.Context, conn *rds.RDS, id string) (*rd
 	return dbInstance, nil
 }
 
+var dbInstanceResourceIDRegexp = regexp.MustCompile(`^db-[0-9A-Z]+$`)
+
+func findDBInstanceByResourceIDSDKv1(ctx context.Context, conn *rds.RDS, resourceID string) (*rds.DBInstance, error) {
+	input := &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("dbi-resource-id"),
+				Values: aws.StringSlice([]string{resourceID}),
+			},
+		},
+	}
+
+	output, err := conn.DescribeDBInstancesWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBInstances) == 0 || output.DBInstances[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBInstances); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.DBInstances[0], nil
+}
+
 func findDBInstanceByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.DBInstance, error) {
 	input := &rds_sdkv2.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(id),
@@ -2270,6 +3212,59 @@ func findDBInstanceByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id str
 	return &output.DBInstances[0], nil
 }
 
+func findDBParameterByTwoPartKey(ctx context.Context, conn *rds_sdkv2.Client, groupName, name string) (*types.Parameter, error) {
+	input := &rds_sdkv2.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+	}
+
+	pages := rds_sdkv2.NewDescribeDBParametersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if errs.IsA[*types.DBParameterGroupNotFoundFault](err) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			if aws.StringValue(v.ParameterName) == name {
+				v := v
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func findSharedDBSnapshotByARN(ctx context.Context, conn *rds.RDS, snapshotARN string) (*rds.DBSnapshot, error) {
+	input := &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotARN),
+		IncludeShared:        aws.Bool(true),
+	}
+
+	output, err := conn.DescribeDBSnapshotsWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBSnapshotNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBSnapshots) == 0 || output.DBSnapshots[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DBSnapshots[0], nil
+}
+
 func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2356,6 +3351,51 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 	return nil, err
 }
 
+func waitDBInstanceDedicatedLogVolumeUpdated(ctx context.Context, conn *rds_sdkv2.Client, id string, enabled bool, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{InstanceStatusModifying, strconv.FormatBool(!enabled)},
+		Target:                    []string{strconv.FormatBool(enabled)},
+		Refresh:                   statusDBInstanceDedicatedLogVolume(ctx, conn, id),
+		Timeout:                   timeout,
+		PollInterval:              10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDBInstanceStorageOptimized waits until the DB instance is available after a previous storage modification has been optimized.
+func waitDBInstanceStorageOptimized(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringIAMDatabaseAuth,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusModifying,
+			InstanceStatusStorageOptimization,
+		},
+		Target:       []string{InstanceStatusAvailable},
+		Refresh:      statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2429,6 +3469,26 @@ func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id strin
 	}
 }
 
+func statusDBInstanceDedicatedLogVolume(ctx context.Context, conn *rds_sdkv2.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.PendingModifiedValues; v != nil && v.DedicatedLogVolume != nil {
+			return output, InstanceStatusModifying, nil
+		}
+
+		return output, strconv.FormatBool(aws.BoolValue(output.DedicatedLogVolume)), nil
+	}
+}
+
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.DescribeBlueGreenDeploymentsInput{
 		BlueGreenDeploymentIdentifier: aws.String(id),
@@ -2562,5 +3622,6 @@ func dbInstanceValidBlueGreenEngines() []string {
 	return []string{
 		InstanceEngineMariaDB,
 		InstanceEngineMySQL,
+		InstanceEnginePostgres,
 	}
 }
@@ -0,0 +1,134 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceActivityStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInstanceActivityStreamCreate,
+		ReadContext:   resourceInstanceActivityStreamRead,
+		DeleteContext: resourceInstanceActivityStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceInstanceActivityStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	resourceArn := d.Get("resource_arn").(string)
+
+	startActivityStreamInput := &rds.StartActivityStreamInput{
+		ResourceArn:                     aws.String(resourceArn),
+		ApplyImmediately:                aws.Bool(true),
+		KmsKeyId:                        aws.String(d.Get("kms_key_id").(string)),
+		Mode:                            aws.String(d.Get("mode").(string)),
+		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+	}
+
+	log.Printf("[DEBUG] RDS DB Instance start activity stream input: %s", startActivityStreamInput)
+
+	_, err := conn.StartActivityStreamWithContext(ctx, startActivityStreamInput)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating RDS DB Instance Activity Stream: %s", err))
+	}
+
+	d.SetId(resourceArn)
+
+	err = waitDBInstanceActivityStreamStarted(ctx, conn, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceInstanceActivityStreamRead(ctx, d, meta)
+}
+
+func resourceInstanceActivityStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	resp, err := FindDBInstanceWithActivityStream(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance Activity Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("describing RDS DB Instance (%s): %s", d.Id(), err))
+	}
+
+	d.Set("resource_arn", resp.DBInstanceArn)
+	d.Set("kms_key_id", resp.ActivityStreamKmsKeyId)
+	d.Set("kinesis_stream_name", resp.ActivityStreamKinesisStreamName)
+	d.Set("mode", resp.ActivityStreamMode)
+	d.Set("engine_native_audit_fields_included", resp.ActivityStreamEngineNativeAuditFieldsIncluded)
+
+	return nil
+}
+
+func resourceInstanceActivityStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	stopActivityStreamInput := &rds.StopActivityStreamInput{
+		ApplyImmediately: aws.Bool(true),
+		ResourceArn:      aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] RDS DB Instance stop activity stream input: %s", stopActivityStreamInput)
+
+	_, err := conn.StopActivityStreamWithContext(ctx, stopActivityStreamInput)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("stopping RDS DB Instance Activity Stream: %w", err))
+	}
+
+	err = waitDBInstanceActivityStreamStopped(ctx, conn, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
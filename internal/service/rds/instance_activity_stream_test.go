@@ -0,0 +1,168 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstanceActivityStream_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_activity_stream.test"
+	dbInstanceResourceName := "aws_db_instance.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceActivityStreamConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActivityStreamExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", dbInstanceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_name"),
+					resource.TestCheckResourceAttr(resourceName, "mode", rds.ActivityStreamModeAsync),
+					resource.TestCheckResourceAttr(resourceName, "engine_native_audit_fields_included", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceActivityStream_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceActivityStreamConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActivityStreamExists(resourceName, &dbInstance),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceInstanceActivityStream(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceActivityStreamExists(resourceName string, v *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("DB Instance ARN is not set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindDBInstanceWithActivityStream(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ActivityStreamStatus); status != rds.ActivityStreamStatusStarted {
+			return fmt.Errorf("incorrect activity stream status: expected: %s, got: %s", rds.ActivityStreamStatusStarted, status)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceActivityStreamDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance_activity_stream" {
+			continue
+		}
+
+		_, err := tfrds.FindDBInstanceWithActivityStream(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS DB Instance Activity Stream %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInstanceActivityStreamConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "Testing for AWS RDS DB Instance Activity Stream"
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  allocated_storage   = 20
+  engine              = "oracle-ee"
+  instance_class      = "db.m5.large"
+  license_model       = "bring-your-own-license"
+  username            = "tfacctest"
+  password            = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_instance_activity_stream" "test" {
+  resource_arn                        = aws_db_instance.test.arn
+  kms_key_id                          = aws_kms_key.test.key_id
+  mode                                = "async"
+  engine_native_audit_fields_included = true
+}
+`, rName)
+}
@@ -78,6 +78,9 @@ func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, m
 
 	if v, ok := d.GetOk("pre_signed_url"); ok {
 		input.PreSignedUrl = aws.String(v.(string))
+	} else if sourceARN, err := arn.Parse(d.Get("source_db_instance_arn").(string)); err == nil {
+		// The AWS SDK generates the pre-signed URL when the source Region is specified.
+		input.SourceRegion = aws.String(sourceARN.Region)
 	}
 
 	log.Printf("[DEBUG] Starting RDS instance automated backups replication: %s", input)
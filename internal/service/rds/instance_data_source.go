@@ -3,8 +3,10 @@ package rds
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -115,6 +117,26 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"master_user_secret": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"master_username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -140,6 +162,38 @@ func DataSourceInstance() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"pending_maintenance_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_applied_after_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forced_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opt_in_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -233,6 +287,11 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("iops", v.Iops)
 	d.Set("kms_key_id", v.KmsKeyId)
 	d.Set("license_model", v.LicenseModel)
+	if v.MasterUserSecret != nil {
+		if err := d.Set("master_user_secret", []interface{}{flattenManagedMasterUserSecret(v.MasterUserSecret)}); err != nil {
+			return errs.AppendErrorf(diags, "setting master_user_secret: %s", err)
+		}
+	}
 	d.Set("master_username", v.MasterUsername)
 	d.Set("monitoring_interval", v.MonitoringInterval)
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
@@ -272,6 +331,21 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("port", nil)
 	}
 
+	pendingMaintenanceActions, err := FindPendingMaintenanceActionsByResourceARN(ctx, conn, aws.StringValue(v.DBInstanceArn))
+
+	// Don't require the rds:DescribePendingMaintenanceActions permission just to read the DB instance.
+	switch {
+	case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+		log.Printf("[WARN] reading RDS DB Instance (%s) pending maintenance actions: %s", d.Id(), err)
+		pendingMaintenanceActions = nil
+	case err != nil && !tfresource.NotFound(err):
+		return errs.AppendErrorf(diags, "reading RDS DB Instance (%s) pending maintenance actions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("pending_maintenance_actions", flattenPendingMaintenanceActions(pendingMaintenanceActions)); err != nil {
+		return errs.AppendErrorf(diags, "setting pending_maintenance_actions: %s", err)
+	}
+
 	tags, err := ListTagsWithContext(ctx, conn, d.Get("db_instance_arn").(string))
 
 	if err != nil {
@@ -0,0 +1,229 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstancePendingMaintenanceAction() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstancePendingMaintenanceActionCreate,
+		ReadWithoutTimeout:   resourceInstancePendingMaintenanceActionRead,
+		UpdateWithoutTimeout: resourceInstancePendingMaintenanceActionUpdate,
+		DeleteWithoutTimeout: resourceInstancePendingMaintenanceActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(PendingMaintenanceAction_Values(), false),
+			},
+			"auto_applied_after_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_apply_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"forced_apply_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"opt_in_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(OptInType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceInstancePendingMaintenanceActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbInstanceID := d.Get("identifier").(string)
+	action := d.Get("action").(string)
+	id := InstancePendingMaintenanceActionCreateResourceID(dbInstanceID, action)
+
+	err := applyInstancePendingMaintenanceAction(ctx, conn, dbInstanceID, action, d.Get("opt_in_type").(string))
+
+	if tfresource.NotFound(err) {
+		return errs.AppendErrorf(diags, "creating RDS DB Instance Pending Maintenance Action (%s): no %s maintenance action is pending for RDS DB Instance (%s)", id, action, dbInstanceID)
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "creating RDS DB Instance Pending Maintenance Action (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceInstancePendingMaintenanceActionRead(ctx, d, meta)...)
+}
+
+func resourceInstancePendingMaintenanceActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbInstanceID, action, err := InstancePendingMaintenanceActionParseResourceID(d.Id())
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+	}
+
+	dbInstance, err := findDBInstanceByIDSDKv1(ctx, conn, dbInstanceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", dbInstanceID)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS DB Instance (%s): %s", dbInstanceID, err)
+	}
+
+	d.Set("action", action)
+	d.Set("identifier", dbInstanceID)
+
+	output, err := FindPendingMaintenanceActionByTwoPartKey(ctx, conn, aws.StringValue(dbInstance.DBInstanceArn), action)
+
+	// Once the action has been applied it is no longer pending.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance Pending Maintenance Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	// An action opted in to immediately may already have been applied.
+	if tfresource.NotFound(err) {
+		d.Set("auto_applied_after_date", nil)
+		d.Set("current_apply_date", nil)
+		d.Set("description", nil)
+		d.Set("forced_apply_date", nil)
+
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+	}
+
+	if v := output.AutoAppliedAfterDate; v != nil {
+		d.Set("auto_applied_after_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("auto_applied_after_date", nil)
+	}
+	if v := output.CurrentApplyDate; v != nil {
+		d.Set("current_apply_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("current_apply_date", nil)
+	}
+	d.Set("description", output.Description)
+	if v := output.ForcedApplyDate; v != nil {
+		d.Set("forced_apply_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("forced_apply_date", nil)
+	}
+	// A pending action that has not been opted in to shows as a difference so that it is applied.
+	d.Set("opt_in_type", output.OptInStatus)
+
+	return diags
+}
+
+func resourceInstancePendingMaintenanceActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbInstanceID, action, err := InstancePendingMaintenanceActionParseResourceID(d.Id())
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "updating RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("opt_in_type") {
+		if err := applyInstancePendingMaintenanceAction(ctx, conn, dbInstanceID, action, d.Get("opt_in_type").(string)); err != nil {
+			return errs.AppendErrorf(diags, "updating RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstancePendingMaintenanceActionRead(ctx, d, meta)...)
+}
+
+func resourceInstancePendingMaintenanceActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbInstanceID, action, err := InstancePendingMaintenanceActionParseResourceID(d.Id())
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "deleting RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+	}
+
+	// Only a scheduled opt-in can be withdrawn.
+	if d.Get("opt_in_type").(string) != OptInTypeNextMaintenance {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Instance Pending Maintenance Action: %s", d.Id())
+	err = applyInstancePendingMaintenanceAction(ctx, conn, dbInstanceID, action, OptInTypeUndoOptIn)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault, rds.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "deleting RDS DB Instance Pending Maintenance Action (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// applyInstancePendingMaintenanceAction opts in to (or withdraws from) the specified pending maintenance action.
+// A NotFoundError is returned if the action is not currently pending for the DB instance.
+func applyInstancePendingMaintenanceAction(ctx context.Context, conn *rds.RDS, dbInstanceID, action, optInType string) error {
+	dbInstance, err := findDBInstanceByIDSDKv1(ctx, conn, dbInstanceID)
+
+	if err != nil {
+		return err
+	}
+
+	arn := aws.StringValue(dbInstance.DBInstanceArn)
+
+	if _, err := FindPendingMaintenanceActionByTwoPartKey(ctx, conn, arn, action); err != nil {
+		return err
+	}
+
+	input := &rds.ApplyPendingMaintenanceActionInput{
+		ApplyAction:        aws.String(action),
+		OptInType:          aws.String(optInType),
+		ResourceIdentifier: aws.String(arn),
+	}
+
+	_, err = conn.ApplyPendingMaintenanceActionWithContext(ctx, input)
+
+	return err
+}
@@ -0,0 +1,84 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstancePendingMaintenanceAction_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_instance_pending_maintenance_action.test"
+	dbInstanceResourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(dbInstanceResourceName, &v),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+					// No maintenance action is guaranteed to be pending on a new DB instance.
+					if _, err := tfrds.FindPendingMaintenanceActionByTwoPartKey(context.Background(), conn, aws.StringValue(v.DBInstanceArn), "system-update"); tfresource.NotFound(err) {
+						t.Skipf("skipping acceptance test: %s", err)
+					}
+				},
+				Config: testAccInstancePendingMaintenanceActionConfig_basic(rName, "next-maintenance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "system-update"),
+					resource.TestCheckResourceAttrPair(resourceName, "identifier", dbInstanceResourceName, "identifier"),
+					resource.TestCheckResourceAttr(resourceName, "opt_in_type", "next-maintenance"),
+					resource.TestCheckResourceAttrSet(dbInstanceResourceName, "pending_maintenance_actions.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstancePendingMaintenanceActionConfig_basic(rName, "immediate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "opt_in_type", "immediate"),
+				),
+				// Once applied, the action is no longer pending and the resource is removed from state.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccInstancePendingMaintenanceActionConfig_basic(rName, optInType string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_rds_instance_pending_maintenance_action" "test" {
+  identifier  = aws_db_instance.test.identifier
+  action      = "system-update"
+  opt_in_type = %[1]q
+}
+`, optInType))
+}
@@ -0,0 +1,240 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceStateCreate,
+		ReadWithoutTimeout:   resourceInstanceStateRead,
+		UpdateWithoutTimeout: resourceInstanceStateUpdate,
+		DeleteWithoutTimeout: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(InstanceState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	id := d.Get("identifier").(string)
+
+	if err := updateInstanceState(ctx, conn, id, d.Get("state").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return errs.AppendErrorf(diags, "creating RDS DB Instance State (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
+}
+
+func resourceInstanceStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	output, err := findDBInstanceByIDSDKv1(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS DB Instance State (%s): %s", d.Id(), err)
+	}
+
+	status := aws.StringValue(output.DBInstanceStatus)
+	if instanceStatusUnrecoverable(status) {
+		diags = errs.AppendWarningf(diags, "RDS DB Instance (%s) status is %q, it can't be started or stopped until the DB instance is recovered", d.Id(), status)
+	}
+
+	d.Set("identifier", output.DBInstanceIdentifier)
+	d.Set("state", instanceState(status))
+
+	return diags
+}
+
+func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if err := updateInstanceState(ctx, conn, d.Id(), d.Get("state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return errs.AppendErrorf(diags, "updating RDS DB Instance State (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInstanceStateRead(ctx, d, meta)...)
+}
+
+func resourceInstanceStateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	// The DB instance is left in its current state.
+	log.Printf("[DEBUG] Removing RDS DB Instance State (%s) from state", d.Id())
+
+	return diags
+}
+
+// instanceState maps a DB instance status to the desired state that it corresponds to.
+// Other statuses are returned as is, so that they differ from the configured state and the next apply waits
+// for the DB instance to be available or stopped.
+func instanceState(status string) string {
+	switch status {
+	case InstanceStatusAvailable, InstanceStatusStarting:
+		return InstanceStatusAvailable
+	case InstanceStatusStopped, InstanceStatusStopping:
+		return InstanceStatusStopped
+	default:
+		return status
+	}
+}
+
+// instanceStatusUnrecoverable returns whether the DB instance can't be started or stopped from the status
+// without first resolving the underlying issue.
+func instanceStatusUnrecoverable(status string) bool {
+	switch status {
+	case InstanceStatusFailed, InstanceStatusInaccessibleEncryptionCredentials, InstanceStatusIncompatibleParameters, InstanceStatusStorageFull:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateInstanceState starts or stops the DB instance so that it reaches the desired state.
+func updateInstanceState(ctx context.Context, conn *rds.RDS, id, state string, timeout time.Duration) error {
+	output, err := findDBInstanceByIDSDKv1(ctx, conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if status := aws.StringValue(output.DBInstanceStatus); instanceStatusUnrecoverable(status) {
+		return fmt.Errorf("DB instance status is %q, it can't be started or stopped", status)
+	}
+
+	// Wait for any in-progress operation, e.g. an earlier start or stop, to complete.
+	output, err = waitDBInstanceStartedOrStopped(ctx, conn, id, timeout)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(output.DBInstanceStatus) == state {
+		return nil
+	}
+
+	switch state {
+	case InstanceStatusAvailable:
+		input := &rds.StartDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		}
+
+		log.Printf("[DEBUG] Starting RDS DB Instance: %s", id)
+		if _, err := conn.StartDBInstanceWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, id, timeout); err != nil {
+			return err
+		}
+
+	case InstanceStatusStopped:
+		input := &rds.StopDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		}
+
+		log.Printf("[DEBUG] Stopping RDS DB Instance: %s", id)
+		if _, err := conn.StopDBInstanceWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		if _, err := waitDBInstanceStopped(ctx, conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitDBInstanceStartedOrStopped(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringIAMDatabaseAuth,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusCreating,
+			InstanceStatusMaintenance,
+			InstanceStatusModifying,
+			InstanceStatusRebooting,
+			InstanceStatusRenaming,
+			InstanceStatusResettingMasterCredentials,
+			InstanceStatusStarting,
+			InstanceStatusStopping,
+			InstanceStatusStorageOptimization,
+			InstanceStatusUpgrading,
+		},
+		Target:       []string{InstanceStatusAvailable, InstanceStatusStopped},
+		Refresh:      statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceStopped(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{InstanceStatusAvailable, InstanceStatusStopping, InstanceStatusStorageOptimization},
+		Target:       []string{InstanceStatusStopped},
+		Refresh:      statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
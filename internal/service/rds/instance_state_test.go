@@ -0,0 +1,63 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRDSInstanceState_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_instance_state.test"
+	dbInstanceResourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig_basic(rName, "stopped"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(dbInstanceResourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "identifier", dbInstanceResourceName, "identifier"),
+					resource.TestCheckResourceAttr(resourceName, "state", "stopped"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceStateConfig_basic(rName, "available"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(dbInstanceResourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceStateConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_rds_instance_state" "test" {
+  identifier = aws_db_instance.test.identifier
+  state      = %[1]q
+}
+`, state))
+}
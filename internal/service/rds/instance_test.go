@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -96,6 +97,57 @@ func TestAccRDSInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_importByARNAndResourceID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstanceImportStateIdFunc(resourceName, "arn"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstanceImportStateIdFunc(resourceName, "resource_id"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_identifierPrefix(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -164,6 +216,126 @@ func TestAccRDSInstance_identifierGenerated(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_identifierRename(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_identifierRename(rName1, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName1),
+					resource.TestCheckResourceAttr(resourceName, "id", rName1),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_identifierRename(rName2, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName2),
+					resource.TestCheckResourceAttr(resourceName, "id", rName2),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "rds", fmt.Sprintf("db:%s", rName2)),
+					resource.TestMatchResourceAttr(resourceName, "address", regexp.MustCompile(fmt.Sprintf(`^%s\.`, rName2))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+					"rename_on_identifier_change",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_identifierReplace(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_identifierRename(rName1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName1),
+					resource.TestCheckResourceAttr(resourceName, "rename_on_identifier_change", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_identifierRename(rName2, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_waitForCreationDisabled(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_waitForCreationDisabled(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.InstanceStatusCreating),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_creation", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_waitForCreationDisabled(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.InstanceStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -729,6 +901,33 @@ func TestAccRDSInstance_finalSnapshotIdentifier(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_finalSnapshotIdentifierPrefix(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroyWithFinalSnapshotPrefix,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_finalSnapshotIDPrefix(rName, "tf-acc-final-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_identifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_identifier_prefix", "tf-acc-final-"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_FinalSnapshotIdentifier_skipFinalSnapshot(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -816,6 +1015,7 @@ func TestAccRDSInstance_maxAllocatedStorage(t *testing.T) {
 				Config: testAccInstanceConfig_maxAllocatedStorage(rName, 10),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "autoscaled_storage", resourceName, "allocated_storage"),
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "10"),
 				),
 			},
@@ -886,15 +1086,13 @@ func TestAccRDSInstance_password(t *testing.T) {
 	})
 }
 
-func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
+func TestAccRDSInstance_manageMasterPassword(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var dbInstance, sourceDbInstance rds.DBInstance
-
+	var v rds.DBInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	sourceResourceName := "aws_db_instance.source"
 	resourceName := "aws_db_instance.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -904,34 +1102,14 @@ func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_ReplicateSourceDB_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckInstanceExists(sourceResourceName, &sourceDbInstance),
-					testAccCheckInstanceExists(resourceName, &dbInstance),
-					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
-					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", ""),
-					testAccCheckInstanceReplicaAttributes(&sourceDbInstance, &dbInstance),
-					resource.TestCheckResourceAttrPair(resourceName, "name", sourceResourceName, "name"),
-					resource.TestCheckResourceAttrPair(resourceName, "username", sourceResourceName, "username"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"password",
-				},
-			},
-			{
-				Config: testAccInstanceConfig_ReplicateSourceDB_promote(rName),
+				Config: testAccInstanceConfig_manageMasterPassword(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckInstanceExists(sourceResourceName, &sourceDbInstance),
-					testAccCheckInstanceExists(resourceName, &dbInstance),
-					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
-					resource.TestCheckResourceAttr(resourceName, "replicate_source_db", ""),
-					resource.TestCheckResourceAttrPair(resourceName, "name", sourceResourceName, "name"),
-					resource.TestCheckResourceAttrPair(resourceName, "username", sourceResourceName, "username"),
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.kms_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_status"),
 				),
 			},
 			{
@@ -939,23 +1117,24 @@ func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"password",
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"skip_final_snapshot",
 				},
 			},
 		},
 	})
 }
 
-func TestAccRDSInstance_ReplicateSourceDB_namePrefix(t *testing.T) {
+func TestAccRDSInstance_manageMasterPassword_kmsKey(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
 	var v rds.DBInstance
-
-	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	const identifierPrefix = "tf-acc-test-prefix-"
-	const resourceName = "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	kmsKeyResourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -964,34 +1143,28 @@ func TestAccRDSInstance_ReplicateSourceDB_namePrefix(t *testing.T) {
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_ReplicateSourceDB_namePrefix(identifierPrefix, sourceName),
+				Config: testAccInstanceConfig_manageMasterPasswordKMSKey(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v),
-					acctest.CheckResourceAttrNameFromPrefix(resourceName, "identifier", identifierPrefix),
-					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", identifierPrefix),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_user_secret_kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_user_secret.0.kms_key_id", kmsKeyResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
 				),
 			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"password",
-				},
-			},
 		},
 	})
 }
 
-func TestAccRDSInstance_ReplicateSourceDB_nameGenerated(t *testing.T) {
+func TestAccRDSInstance_Password_manageMasterPassword(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
 	var v rds.DBInstance
-
-	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	const resourceName = "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -1000,33 +1173,189 @@ func TestAccRDSInstance_ReplicateSourceDB_nameGenerated(t *testing.T) {
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_ReplicateSourceDB_nameGenerated(sourceName),
+				Config: testAccInstanceConfig_password(rName, "valid-password"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v),
-					acctest.CheckResourceAttrNameGenerated(resourceName, "identifier"),
-					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", resource.UniqueIdPrefix),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"password",
-				},
+				Config: testAccInstanceConfig_manageMasterPassword(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_password(rName, "valid-password2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "0"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccRDSInstance_ReplicateSourceDB_addLater(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
-	}
-
-	var dbInstance, sourceDbInstance rds.DBInstance
-
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestAccRDSInstance_manageMasterPassword_conflictsWithPassword(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_manageMasterPasswordConflict(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)),
+				ExpectError: regexp.MustCompile(`"manage_master_user_password": conflicts with password`),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceResourceName := "aws_db_instance.source"
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(sourceResourceName, &sourceDbInstance),
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", ""),
+					testAccCheckInstanceReplicaAttributes(&sourceDbInstance, &dbInstance),
+					resource.TestCheckResourceAttrPair(resourceName, "name", sourceResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "username", sourceResourceName, "username"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_promote(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(sourceResourceName, &sourceDbInstance),
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "replicate_source_db", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "name", sourceResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "username", sourceResourceName, "username"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_ReplicateSourceDB_namePrefix(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	const identifierPrefix = "tf-acc-test-prefix-"
+	const resourceName = "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_namePrefix(identifierPrefix, sourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, "identifier", identifierPrefix),
+					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", identifierPrefix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_ReplicateSourceDB_nameGenerated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+
+	sourceName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	const resourceName = "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_nameGenerated(sourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					acctest.CheckResourceAttrNameGenerated(resourceName, "identifier"),
+					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", resource.UniqueIdPrefix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_ReplicateSourceDB_addLater(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	sourceResourceName := "aws_db_instance.source"
 	resourceName := "aws_db_instance.test"
 
@@ -2958,6 +3287,68 @@ func TestAccRDSInstance_SnapshotIdentifier_vpcSecurityGroupIDsTags(t *testing.T)
 	})
 }
 
+func TestAccRDSInstance_SnapshotIdentifier_sharedARNRegion(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_SnapshotIdentifier_sharedARN(rName, fmt.Sprintf("arn:%s:rds:%s:123456789012:snapshot:%s", acctest.Partition(), acctest.AlternateRegion(), rName)),
+				ExpectError: regexp.MustCompile(`"snapshot_identifier" .* is in Region`),
+			},
+			{
+				Config:      testAccInstanceConfig_SnapshotIdentifier_sharedARN(rName, fmt.Sprintf("arn:%s:rds:%s:123456789012:cluster-snapshot:%s", acctest.Partition(), acctest.Region(), rName)),
+				ExpectError: regexp.MustCompile(`invalid resource value`),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_dbClusterSnapshotIdentifier(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clusterSnapshotResourceName := "aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_dbClusterSnapshotIdentifier(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_snapshot_identifier", clusterSnapshotResourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"db_cluster_snapshot_identifier",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_monitoringInterval(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -3221,6 +3612,63 @@ func TestAccRDSInstance_portUpdate(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_processorFeatures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2, v3 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_processorFeatures(rName, 2, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.0.core_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.0.threads_per_core", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_processorFeatures(rName, 1, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.0.core_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.0.threads_per_core", "2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_processorFeaturesDefault(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v3),
+					testAccCheckDBInstanceNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "processor_features.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_MSSQL_tz(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -3331,12 +3779,46 @@ func TestAccRDSInstance_MSSQL_domainSnapshotRestore(t *testing.T) {
 	})
 }
 
-func TestAccRDSInstance_MySQL_snapshotRestoreWithEngineVersion(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping long-running test in short mode")
-	}
-
-	var v, vRestoredInstance rds.DBInstance
+func TestAccRDSInstance_MSSQL_selfManagedDomainConflictsWithDomain(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_mssqlSelfManagedDomainConflict(rName),
+				ExpectError: regexp.MustCompile(`"domain_auth_secret_arn" cannot be set when "domain" or "domain_iam_role_name" is set`),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_MSSQL_selfManagedDomainRequiredWith(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_mssqlSelfManagedDomainIncomplete(rName),
+				ExpectError: regexp.MustCompile(`all of .*domain_auth_secret_arn.* must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_MySQL_snapshotRestoreWithEngineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v, vRestoredInstance rds.DBInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_instance.test"
 	restoreResourceName := "aws_db_instance.restore"
@@ -3760,6 +4242,56 @@ func TestAccRDSInstance_performanceInsightsKMSKeyID(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_databaseInsightsMode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPerformanceInsightsDefaultVersionPreCheck(t, "mysql") },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_databaseInsightsMode(rName, "advanced", 7),
+				ExpectError: regexp.MustCompile(`"performance_insights_retention_period" must be at least 465`),
+			},
+			{
+				Config: testAccInstanceConfig_databaseInsightsMode(rName, "advanced", 465),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "database_insights_mode", "advanced"),
+					resource.TestCheckResourceAttr(resourceName, "performance_insights_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "performance_insights_retention_period", "465"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+					"skip_final_snapshot",
+					"final_snapshot_identifier",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_databaseInsightsMode(rName, "standard", 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "database_insights_mode", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "performance_insights_retention_period", "7"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_performanceInsightsRetentionPeriod(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -3990,6 +4522,52 @@ func TestAccRDSInstance_RestoreToPointInTime_sourceResourceID(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_RestoreToPointInTime_crossRegion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+	resourceName := "aws_db_instance.restore"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_RestoreToPointInTime_crossRegion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "engine", "postgres"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_RestoreToPointInTime_automatedBackupsARNRegion(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_RestoreToPointInTime_automatedBackupsARN(rName, fmt.Sprintf("arn:%s:rds:%s:123456789012:auto-backup:ab-%s", acctest.Partition(), acctest.AlternateRegion(), rName)),
+				ExpectError: regexp.MustCompile(`replicate the automated backups`),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_RestoreToPointInTime_monitoring(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -4018,6 +4596,36 @@ func TestAccRDSInstance_RestoreToPointInTime_monitoring(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_RestoreToPointInTime_manageMasterPassword(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance rds.DBInstance
+	sourceName := "aws_db_instance.test"
+	resourceName := "aws_db_instance.restore"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_RestoreToPointInTime_manageMasterPassword(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(sourceName, &sourceDbInstance),
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", "true"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_user_secret.0.secret_arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_NationalCharacterSet_oracle(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -4057,6 +4665,46 @@ func TestAccRDSInstance_NationalCharacterSet_oracle(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_multiTenant(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_multiTenant(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "engine", "oracle-ee-cdb"),
+					resource.TestCheckResourceAttr(resourceName, "multi_tenant", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_NoNationalCharacterSet_oracle(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -4510,7 +5158,7 @@ func TestAccRDSInstance_BlueGreenDeployment_updateInstanceClass(t *testing.T) {
 	})
 }
 
-func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T) {
+func TestAccRDSInstance_BlueGreenDeployment_targetOverrides(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
@@ -4518,7 +5166,6 @@ func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T
 	var v1, v2 rds.DBInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_instance.test"
-	sourceResourceName := "aws_db_instance.source"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -4527,21 +5174,26 @@ func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_ReplicateSourceDB_backupRetentionPeriod(rName, 1),
+				Config: testAccInstanceConfig_BlueGreenDeployment_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v1),
-					resource.TestCheckResourceAttrPair(resourceName, "replicate_source_db", sourceResourceName, "identifier"),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "10"),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
-					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
 				),
 			},
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_promote(rName),
+				Config:      testAccInstanceConfig_BlueGreenDeployment_targetOverrides(rName, 30),
+				ExpectError: regexp.MustCompile(`"blue_green_update.target_allocated_storage" \(30\) must match "allocated_storage" \(20\)`),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_targetOverrides(rName, 20),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v2),
 					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "20"),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
-					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.target_allocated_storage", "20"),
+					resource.TestCheckResourceAttrPair(resourceName, "blue_green_update.0.target_db_instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
 				),
 			},
 			{
@@ -4561,14 +5213,15 @@ func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T
 	})
 }
 
-func TestAccRDSInstance_BlueGreenDeployment_updateAndEnableBackups(t *testing.T) {
+func TestAccRDSInstance_BlueGreenDeployment_withReplica(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var v1, v2 rds.DBInstance
+	var v1, v2, replica1, replica2 rds.DBInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_instance.test"
+	replicaResourceName := "aws_db_instance.replica"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -4577,42 +5230,38 @@ func TestAccRDSInstance_BlueGreenDeployment_updateAndEnableBackups(t *testing.T)
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_basic(rName),
+				Config: testAccInstanceConfig_BlueGreenDeployment_withReplica(rName, "test"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v1),
+					testAccCheckInstanceExists(replicaResourceName, &replica1),
+					resource.TestCheckResourceAttr(resourceName, "replicas.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
-					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "0"),
 				),
 			},
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClass(rName),
+				Config: testAccInstanceConfig_BlueGreenDeployment_withReplica(rName, "updated"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v2),
 					testAccCheckDBInstanceRecreated(&v1, &v2),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
-					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
-					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "replicas.#", "1"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"apply_immediately",
-					"final_snapshot_identifier",
-					"password",
-					"skip_final_snapshot",
-					"delete_automated_backups",
-					"blue_green_update",
-				},
+				// Refresh the read replica, which has been replaced by its Green counterpart.
+				Config: testAccInstanceConfig_BlueGreenDeployment_withReplica(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(replicaResourceName, &replica2),
+					testAccCheckDBInstanceRecreated(&replica1, &replica2),
+					resource.TestCheckResourceAttr(replicaResourceName, "identifier", rName+"-replica"),
+					resource.TestCheckResourceAttrPair(replicaResourceName, "replicate_source_db", resourceName, "identifier"),
+				),
 			},
 		},
 	})
 }
 
-// TODO: When the only change is to disable deletion_protection, bypass Blue/Green
-func TestAccRDSInstance_BlueGreenDeployment_deletionProtection(t *testing.T) {
+func TestAccRDSInstance_BlueGreenDeployment_postgres(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
@@ -4628,33 +5277,19 @@ func TestAccRDSInstance_BlueGreenDeployment_deletionProtection(t *testing.T) {
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, true),
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, true, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v1),
-					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
-					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "postgres"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"apply_immediately",
-					"final_snapshot_identifier",
-					"password",
-					"skip_final_snapshot",
-					"delete_automated_backups",
-					"blue_green_update",
-				},
-			},
-			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, false),
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, true, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v2),
-					// TODO: This should bypass Blue/Green Deployment
-					// testAccCheckDBInstanceNotRecreated(&v2, &v3),
-					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
 					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
 				),
 			},
@@ -4675,12 +5310,12 @@ func TestAccRDSInstance_BlueGreenDeployment_deletionProtection(t *testing.T) {
 	})
 }
 
-func TestAccRDSInstance_BlueGreenDeployment_updateWithDeletionProtection(t *testing.T) {
+func TestAccRDSInstance_BlueGreenDeployment_postgresLogicalReplicationDisabled(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var v1, v2, v3 rds.DBInstance
+	var v1, v2 rds.DBInstance
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_instance.test"
 
@@ -4691,34 +5326,208 @@ func TestAccRDSInstance_BlueGreenDeployment_updateWithDeletionProtection(t *test
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, true),
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, false, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v1),
-					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
-					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
-					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"apply_immediately",
-					"final_snapshot_identifier",
-					"password",
-					"skip_final_snapshot",
-					"delete_automated_backups",
-					"blue_green_update",
-				},
+				Config:      testAccInstanceConfig_BlueGreenDeployment_postgres(rName, false, true),
+				ExpectError: regexp.MustCompile(`must set "rds\.logical_replication" to "1" for a Blue/Green Deployment`),
 			},
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClassWithDeletionProtection(rName, true),
+				Config: testAccInstanceConfig_BlueGreenDeployment_postgres(rName, false, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v2),
-					testAccCheckDBInstanceRecreated(&v1, &v2),
-					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
-					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_retainSource(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_retainSource(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.delete_source", "false"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+					resource.TestCheckResourceAttrSet(resourceName, "blue_green_source_identifier"),
+					testAccCheckInstanceBlueGreenSourceRetained(&v1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_updateAndPromoteReplica(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	sourceResourceName := "aws_db_instance.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_backupRetentionPeriod(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "replicate_source_db", sourceResourceName, "identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_promote(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_updateAndEnableBackups(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "0"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClass(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+		},
+	})
+}
+
+// TODO: When the only change is to disable deletion_protection, bypass Blue/Green
+func TestAccRDSInstance_BlueGreenDeployment_deletionProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					// TODO: This should bypass Blue/Green Deployment
+					// testAccCheckDBInstanceNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
 					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
 				),
 			},
@@ -4735,21 +5544,172 @@ func TestAccRDSInstance_BlueGreenDeployment_updateWithDeletionProtection(t *test
 					"blue_green_update",
 				},
 			},
+		},
+	})
+}
+
+func TestAccRDSInstance_BlueGreenDeployment_updateWithDeletionProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2, v3 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_deletionProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.test", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClassWithDeletionProtection(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_class", "data.aws_rds_orderable_db_instance.updated", "instance_class"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClassWithDeletionProtection(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v3),
+					// TODO: This should bypass Blue/Green Deployment
+					// testAccCheckDBInstanceNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_gp3(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_gp3(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "125"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "gp3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_dedicatedLogVolume(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_dedicatedLogVolume(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
 			{
-				Config: testAccInstanceConfig_BlueGreenDeployment_updateInstanceClassWithDeletionProtection(rName, false),
+				Config: testAccInstanceConfig_dedicatedLogVolume(rName, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckInstanceExists(resourceName, &v3),
-					// TODO: This should bypass Blue/Green Deployment
-					// testAccCheckDBInstanceNotRecreated(&v2, &v3),
-					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
-					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dedicated_log_volume", "false"),
 				),
 			},
 		},
 	})
 }
 
-func TestAccRDSInstance_gp3(t *testing.T) {
+func TestAccRDSInstance_engineLifecycleSupport(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
@@ -4765,12 +5725,10 @@ func TestAccRDSInstance_gp3(t *testing.T) {
 		CheckDestroy:             testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInstanceConfig_gp3(rName),
+				Config: testAccInstanceConfig_engineLifecycleSupport(rName, tfrds.EngineLifecycleSupportExtendedSupportDisabled),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckInstanceExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
-					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "125"),
-					resource.TestCheckResourceAttr(resourceName, "storage_type", "gp3"),
+					resource.TestCheckResourceAttr(resourceName, "engine_lifecycle_support", tfrds.EngineLifecycleSupportExtendedSupportDisabled),
 				),
 			},
 			{
@@ -4783,7 +5741,6 @@ func TestAccRDSInstance_gp3(t *testing.T) {
 					"password",
 					"skip_final_snapshot",
 					"delete_automated_backups",
-					"blue_green_update",
 				},
 			},
 		},
@@ -4840,6 +5797,184 @@ func TestAccRDSInstance_storageThroughput(t *testing.T) {
 	})
 }
 
+// Modifying IOPS or storage throughput alone must not modify allocated storage.
+func TestAccRDSInstance_storageThroughputIndependentOfAllocatedStorage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_storageThroughput(rName, 12000, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "400"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "500"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageThroughput(rName, 12000, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "400"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "12000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_storageThroughputWithAllocatedStorage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_storageThroughputAllocatedStorage(rName, 400, 12000, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "400"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "12000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "500"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageThroughputAllocatedStorage(rName, 500, 15000, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "allocated_storage", "500"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "15000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_throughput", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_io2(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_storageTypeIOPS(rName, "io2", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "io2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+					"delete_automated_backups",
+					"blue_green_update",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_storageTypeConversion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2, v3 rds.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_storageTypeIOPS(rName, "io1", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "io1"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageTypeIOPS(rName, "io2", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "io2"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_storageTypeIOPS(rName, "gp3", 12000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v3),
+					testAccCheckDBInstanceNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "iops", "12000"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "gp3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_storageTypeIOPSRequired(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_storageTypeNoIOPS(rName, "io2"),
+				ExpectError: regexp.MustCompile(`"iops" must be set when "storage_type" is "io2"`),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceAutomatedBackups(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
 
@@ -4874,6 +6009,39 @@ func testAccCheckInstanceAutomatedBackups(s *terraform.State) error {
 	return testAccCheckInstanceDestroy(s)
 }
 
+// testAccCheckInstanceBlueGreenSourceRetained verifies that the Blue/Green Deployment source DB instance
+// still exists after switchover and then deletes it, as it is no longer managed by Terraform.
+func testAccCheckInstanceBlueGreenSourceRetained(source *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := conn.DescribeDBInstancesWithContext(ctx, &rds.DescribeDBInstancesInput{
+			Filters: []*rds.Filter{
+				{
+					Name:   aws.String("dbi-resource-id"),
+					Values: aws.StringSlice([]string{aws.StringValue(source.DbiResourceId)}),
+				},
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.DBInstances) == 0 {
+			return fmt.Errorf("RDS DB Instance %s not retained", aws.StringValue(source.DbiResourceId))
+		}
+
+		_, err = conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: output.DBInstances[0].DBInstanceIdentifier,
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckInstanceDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
@@ -5030,6 +6198,63 @@ func testAccCheckInstanceDestroyWithFinalSnapshot(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckInstanceDestroyWithFinalSnapshotPrefix verifies that a final snapshot
+// with a generated identifier was created, and subsequently deletes it.
+func testAccCheckInstanceDestroyWithFinalSnapshotPrefix(s *terraform.State) error {
+	ctx := context.Background()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance" {
+			continue
+		}
+
+		prefix := rs.Primary.Attributes["final_snapshot_identifier_prefix"]
+		output, err := conn.DescribeDBSnapshotsWithContext(ctx, &rds.DescribeDBSnapshotsInput{
+			DBInstanceIdentifier: aws.String(rs.Primary.ID),
+			SnapshotType:         aws.String("manual"),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var finalSnapshotID string
+		for _, v := range output.DBSnapshots {
+			if id := aws.StringValue(v.DBSnapshotIdentifier); strings.HasPrefix(id, prefix) {
+				finalSnapshotID = id
+				break
+			}
+		}
+
+		if finalSnapshotID == "" {
+			return fmt.Errorf("RDS DB Instance %s final snapshot with prefix %q not found", rs.Primary.ID, prefix)
+		}
+
+		_, err = conn.DeleteDBSnapshotWithContext(ctx, &rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(finalSnapshotID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrds.FindDBInstanceByID(ctx, conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS DB Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
 // testAccCheckInstanceDestroyWithoutFinalSnapshot verifies that:
 // - The DBInstance has been destroyed
 // - No DBSnapshot has been produced
@@ -5091,8 +6316,19 @@ func dbInstanceIdentityEqual(i, j *rds.DBInstance) bool {
 	return dbInstanceIdentity(i) == dbInstanceIdentity(j)
 }
 
-func dbInstanceIdentity(v *rds.DBInstance) string {
-	return aws.StringValue(v.DbiResourceId)
+func dbInstanceIdentity(v *rds.DBInstance) string {
+	return aws.StringValue(v.DbiResourceId)
+}
+
+func testAccInstanceImportStateIdFunc(n, attr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[attr], nil
+	}
 }
 
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
@@ -5150,6 +6386,10 @@ func testAccInstanceConfig_orderableClassMariadb() string {
 	return testAccInstanceConfig_orderableClass("mariadb", "general-public-license", "standard", mariaDBPreferredInstanceClasses)
 }
 
+func testAccInstanceConfig_orderableClassPostgres() string {
+	return testAccInstanceConfig_orderableClass("postgres", "postgresql-license", "standard", postgresPreferredInstanceClasses)
+}
+
 func testAccInstanceConfig_orderableClassSQLServerEx() string {
 	return testAccInstanceConfig_orderableClass("sqlserver-ex", "license-included", "standard", sqlServerPreferredInstanceClasses)
 }
@@ -5203,6 +6443,47 @@ resource "aws_db_instance" "test" {
 `, identifierPrefix))
 }
 
+func testAccInstanceConfig_identifierRename(rName string, renameOnIdentifierChange bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier                   = %[1]q
+  allocated_storage            = 10
+  backup_retention_period      = 0
+  engine                       = data.aws_rds_orderable_db_instance.test.engine
+  engine_version               = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class               = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                      = "test"
+  skip_final_snapshot          = true
+  password                     = "avoid-plaintext-passwords"
+  username                     = "tfacctest"
+  rename_on_identifier_change  = %[2]t
+}
+`, rName, renameOnIdentifierChange))
+}
+
+func testAccInstanceConfig_waitForCreationDisabled(rName string, backupRetentionPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  apply_immediately       = true
+  backup_retention_period = %[2]d
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  wait_for_creation       = false
+}
+`, rName, backupRetentionPeriod))
+}
+
 func testAccInstanceConfig_identifierGenerated() string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(), `
@@ -5735,6 +7016,25 @@ resource "aws_db_instance" "test" {
 `, rName1, rName2))
 }
 
+func testAccInstanceConfig_finalSnapshotIDPrefix(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier = %[1]q
+
+  allocated_storage       = 5
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  backup_retention_period = 1
+
+  final_snapshot_identifier_prefix = %[2]q
+}
+`, rName, prefix))
+}
+
 func testAccInstanceConfig_monitoringInterval(rName string, monitoringInterval int) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -5937,6 +7237,47 @@ resource "aws_db_instance" "restore" {
 `, rName))
 }
 
+func testAccInstanceConfig_RestoreToPointInTime_crossRegion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupsReplicationConfig_base(rName, false),
+		fmt.Sprintf(`
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+}
+
+resource "aws_db_instance" "restore" {
+  identifier     = "%[1]s-restore"
+  instance_class = aws_db_instance.test.instance_class
+
+  restore_to_point_in_time {
+    # Resolved to the automated backups replicated to this Region.
+    source_db_instance_identifier = aws_db_instance_automated_backups_replication.test.source_db_instance_arn
+    use_latest_restorable_time    = true
+  }
+
+  skip_final_snapshot = true
+}
+`, rName))
+}
+
+func testAccInstanceConfig_RestoreToPointInTime_automatedBackupsARN(rName, automatedBackupsARN string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMariadb(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier     = %[1]q
+  instance_class = data.aws_rds_orderable_db_instance.test.instance_class
+
+  restore_to_point_in_time {
+    source_db_instance_automated_backups_arn = %[2]q
+    use_latest_restorable_time               = true
+  }
+
+  skip_final_snapshot = true
+}
+`, rName, automatedBackupsARN))
+}
+
 func testAccInstanceConfig_RestoreToPointInTime_monitoring(rName string, monitoringInterval int) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_baseForPITR(rName),
@@ -5958,6 +7299,25 @@ resource "aws_db_instance" "restore" {
 `, rName, monitoringInterval))
 }
 
+func testAccInstanceConfig_RestoreToPointInTime_manageMasterPassword(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_baseForPITR(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance" "restore" {
+  identifier                  = "%[1]s-restore"
+  instance_class              = aws_db_instance.test.instance_class
+  manage_master_user_password = true
+
+  restore_to_point_in_time {
+    source_dbi_resource_id     = aws_db_instance.test.resource_id
+    use_latest_restorable_time = true
+  }
+
+  skip_final_snapshot = true
+}
+`, rName))
+}
+
 func testAccInstanceConfig_iopsUpdate(rName string, iops int) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -6312,6 +7672,56 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_mssqlSelfManagedDomainConflict(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassSQLServerEx(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  skip_final_snapshot = true
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+
+  domain                 = "d-1234567890"
+  domain_iam_role_name   = "rds-directoryservice-access-role"
+  domain_auth_secret_arn = "arn:${data.aws_partition.current.partition}:secretsmanager:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:secret:%[1]s"
+  domain_dns_ips         = ["10.0.0.10", "10.0.0.11"]
+  domain_fqdn            = "corp.example.com"
+  domain_ou              = "OU=RDS,DC=corp,DC=example,DC=com"
+}
+`, rName))
+}
+
+func testAccInstanceConfig_mssqlSelfManagedDomainIncomplete(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassSQLServerEx(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  skip_final_snapshot = true
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+
+  domain_fqdn = "corp.example.com"
+  domain_ou   = "OU=RDS,DC=corp,DC=example,DC=com"
+}
+`, rName))
+}
+
 func testAccInstanceConfig_mySQLSnapshotRestoreEngineVersion(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -6699,6 +8109,55 @@ resource "aws_db_instance" "test" {
 `, rName)
 }
 
+func testAccInstanceConfig_processorFeaturesBase() string {
+	return `
+data "aws_rds_orderable_db_instance" "test" {
+  engine        = "oracle-se2"
+  license_model = "bring-your-own-license"
+  storage_type  = "standard"
+
+  preferred_instance_classes = ["db.m5.xlarge", "db.r5.xlarge"]
+}
+`
+}
+
+func testAccInstanceConfig_processorFeatures(rName string, coreCount, threadsPerCore int) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_processorFeaturesBase(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 10
+  apply_immediately   = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+
+  processor_features {
+    core_count       = %[2]d
+    threads_per_core = %[3]d
+  }
+}
+`, rName, coreCount, threadsPerCore))
+}
+
+func testAccInstanceConfig_processorFeaturesDefault(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_processorFeaturesBase(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 10
+  apply_immediately   = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+}
+`, rName))
+}
+
 func testAccInstanceConfig_NationalCharacterSet_oracle(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -6723,6 +8182,31 @@ resource "aws_db_instance" "test" {
 `, rName)
 }
 
+func testAccInstanceConfig_multiTenant(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine        = "oracle-ee-cdb"
+  license_model = "bring-your-own-license"
+  storage_type  = "gp3"
+
+  preferred_instance_classes = ["db.m5.large", "db.r5.large", "db.m6i.large"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  multi_tenant        = true
+  password            = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+  storage_type        = data.aws_rds_orderable_db_instance.test.storage_type
+  username            = "tfacctest"
+}
+`, rName)
+}
+
 func testAccInstanceConfig_NoNationalCharacterSet_oracle(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -6818,6 +8302,55 @@ resource "aws_db_instance" "test" {
 `, rName, password))
 }
 
+func testAccInstanceConfig_manageMasterPassword(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  username                    = "tfacctest"
+  skip_final_snapshot         = true
+}
+`, rName))
+}
+
+func testAccInstanceConfig_manageMasterPasswordKMSKey(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage             = 5
+  engine                        = data.aws_rds_orderable_db_instance.test.engine
+  identifier                    = %[1]q
+  instance_class                = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password   = true
+  master_user_secret_kms_key_id = aws_kms_key.test.arn
+  username                      = "tfacctest"
+  skip_final_snapshot           = true
+}
+`, rName))
+}
+
+func testAccInstanceConfig_manageMasterPasswordConflict(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  password                    = "avoid-plaintext-passwords"
+  username                    = "tfacctest"
+  skip_final_snapshot         = true
+}
+`, rName))
+}
+
 func testAccInstanceConfig_ReplicateSourceDB_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -9191,12 +10724,45 @@ resource "aws_db_instance" "test" {
 `, rName)
 }
 
-func testAccInstanceConfig_performanceInsightsKMSKeyID(rName string) string {
+func testAccInstanceConfig_performanceInsightsKMSKeyID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+data "aws_rds_engine_version" "default" {
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                        = data.aws_rds_engine_version.default.engine
+  engine_version                = data.aws_rds_engine_version.default.version
+  license_model                 = "general-public-license"
+  storage_type                  = "standard"
+  supports_performance_insights = true
+  preferred_instance_classes    = ["db.m3.medium", "db.m3.large", "db.m4.large"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage                     = 5
+  backup_retention_period               = 0
+  engine                                = data.aws_rds_engine_version.default.engine
+  engine_version                        = data.aws_rds_engine_version.default.version
+  identifier                            = %[1]q
+  instance_class                        = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                               = "mydb"
+  password                              = "mustbeeightcharaters"
+  performance_insights_enabled          = true
+  performance_insights_kms_key_id       = aws_kms_key.test.arn
+  performance_insights_retention_period = 7
+  skip_final_snapshot                   = true
+  username                              = "foo"
+}
+`, rName)
+}
+
+func testAccInstanceConfig_performanceInsightsRetentionPeriod(rName string, performanceInsightsRetentionPeriod int) string {
 	return fmt.Sprintf(`
-resource "aws_kms_key" "test" {
-  deletion_window_in_days = 7
-}
-
 data "aws_rds_engine_version" "default" {
   engine = "mysql"
 }
@@ -9220,15 +10786,14 @@ resource "aws_db_instance" "test" {
   db_name                               = "mydb"
   password                              = "mustbeeightcharaters"
   performance_insights_enabled          = true
-  performance_insights_kms_key_id       = aws_kms_key.test.arn
-  performance_insights_retention_period = 7
+  performance_insights_retention_period = %[2]d
   skip_final_snapshot                   = true
   username                              = "foo"
 }
-`, rName)
+`, rName, performanceInsightsRetentionPeriod)
 }
 
-func testAccInstanceConfig_performanceInsightsRetentionPeriod(rName string, performanceInsightsRetentionPeriod int) string {
+func testAccInstanceConfig_databaseInsightsMode(rName, databaseInsightsMode string, performanceInsightsRetentionPeriod int) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
   engine = "mysql"
@@ -9238,14 +10803,15 @@ data "aws_rds_orderable_db_instance" "test" {
   engine                        = data.aws_rds_engine_version.default.engine
   engine_version                = data.aws_rds_engine_version.default.version
   license_model                 = "general-public-license"
-  storage_type                  = "standard"
+  storage_type                  = "gp2"
   supports_performance_insights = true
-  preferred_instance_classes    = ["db.m3.medium", "db.m3.large", "db.m4.large"]
+  preferred_instance_classes    = ["db.t3.medium", "db.m5.large", "db.m6g.large"]
 }
 
 resource "aws_db_instance" "test" {
-  allocated_storage                     = 5
+  allocated_storage                     = 20
   backup_retention_period               = 0
+  database_insights_mode                = %[2]q
   engine                                = data.aws_rds_engine_version.default.engine
   engine_version                        = data.aws_rds_engine_version.default.version
   identifier                            = %[1]q
@@ -9253,11 +10819,12 @@ resource "aws_db_instance" "test" {
   db_name                               = "mydb"
   password                              = "mustbeeightcharaters"
   performance_insights_enabled          = true
-  performance_insights_retention_period = %[2]d
+  performance_insights_retention_period = %[3]d
   skip_final_snapshot                   = true
+  storage_type                          = data.aws_rds_orderable_db_instance.test.storage_type
   username                              = "foo"
 }
-`, rName, performanceInsightsRetentionPeriod)
+`, rName, databaseInsightsMode, performanceInsightsRetentionPeriod)
 }
 
 func testAccInstanceConfig_ReplicateSourceDB_performanceInsightsEnabled(rName string) string {
@@ -9718,6 +11285,160 @@ data "aws_rds_orderable_db_instance" "updated" {
 `, rName))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_targetOverrides(rName string, targetAllocatedStorage int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 20
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.updated.engine
+  engine_version          = data.aws_rds_orderable_db_instance.updated.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.updated.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled                  = true
+    target_allocated_storage = %[2]d
+    target_db_instance_class = data.aws_rds_orderable_db_instance.updated.instance_class
+  }
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName, targetAllocatedStorage))
+}
+
+func testAccInstanceConfig_BlueGreenDeployment_withReplica(rName, orderable string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.%[2]s.engine
+  engine_version          = data.aws_rds_orderable_db_instance.%[2]s.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.%[2]s.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+resource "aws_db_instance" "replica" {
+  identifier          = "%[1]s-replica"
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  replicate_source_db = aws_db_instance.test.identifier
+  skip_final_snapshot = true
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName, orderable))
+}
+
+func testAccInstanceConfig_BlueGreenDeployment_postgres(rName string, logicalReplication, updated bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassPostgres(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = %[3]t ? data.aws_rds_orderable_db_instance.updated.instance_class : data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  parameter_group_name    = aws_db_parameter_group.test.id
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+
+resource "aws_db_parameter_group" "test" {
+  family = data.aws_rds_engine_version.default.parameter_group_family
+  name   = %[1]q
+
+  parameter {
+    name         = "rds.logical_replication"
+    value        = %[2]t ? "1" : "0"
+    apply_method = "pending-reboot"
+  }
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "postgresql-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName, logicalReplication, updated))
+}
+
+func testAccInstanceConfig_BlueGreenDeployment_retainSource(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.updated.engine
+  engine_version          = data.aws_rds_orderable_db_instance.updated.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.updated.instance_class
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+
+  blue_green_update {
+    enabled            = true
+    delete_source      = false
+    switchover_timeout = 600
+  }
+}
+
+data "aws_rds_orderable_db_instance" "updated" {
+  engine         = data.aws_rds_engine_version.default.engine
+  engine_version = data.aws_rds_engine_version.default.version
+  license_model  = "general-public-license"
+  storage_type   = "standard"
+
+  preferred_instance_classes = ["db.t4g.micro", "db.t4g.small"]
+}
+`, rName))
+}
+
 func testAccInstanceConfig_BlueGreenDeployment_promote(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -9838,6 +11559,51 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_dedicatedLogVolume(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClass("postgres", "postgresql-license", "io1", postgresPreferredInstanceClasses),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name              = "test"
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  parameter_group_name = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot  = true
+
+  apply_immediately = true
+
+  storage_type      = data.aws_rds_orderable_db_instance.test.storage_type
+  allocated_storage = 1000
+  iops              = 3000
+
+  dedicated_log_volume = %[2]t
+}
+`, rName, enabled))
+}
+
+func testAccInstanceConfig_engineLifecycleSupport(rName, engineLifecycleSupport string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier               = %[1]q
+  allocated_storage        = 10
+  engine                   = data.aws_rds_orderable_db_instance.test.engine
+  engine_lifecycle_support = %[2]q
+  engine_version           = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class           = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                  = "test"
+  password                 = "avoid-plaintext-passwords"
+  username                 = "tfacctest"
+  skip_final_snapshot      = true
+}
+`, rName, engineLifecycleSupport))
+}
+
 func testAccInstanceConfig_storageThroughput(rName string, iops, throughput int) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQLGP3(),
@@ -9863,3 +11629,110 @@ resource "aws_db_instance" "test" {
 }
 `, rName, iops, throughput))
 }
+
+func testAccInstanceConfig_storageThroughputAllocatedStorage(rName string, allocatedStorage, iops, throughput int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQLGP3(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name              = "test"
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  parameter_group_name = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot  = true
+
+  apply_immediately = true
+
+  storage_type      = data.aws_rds_orderable_db_instance.test.storage_type
+  allocated_storage = %[2]d
+
+  iops               = %[3]d
+  storage_throughput = %[4]d
+}
+`, rName, allocatedStorage, iops, throughput))
+}
+
+func testAccInstanceConfig_storageTypeIOPS(rName, storageType string, iops int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClass("postgres", "postgresql-license", "io2", `"db.m5.large", "db.m6i.large", "db.r5.large"`),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name              = "test"
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  parameter_group_name = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot  = true
+
+  apply_immediately = true
+
+  storage_type      = %[2]q
+  allocated_storage = 400
+  iops              = %[3]d
+}
+`, rName, storageType, iops))
+}
+
+func testAccInstanceConfig_storageTypeNoIOPS(rName, storageType string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  engine              = "postgres"
+  instance_class      = "db.m5.large"
+  password            = "avoid-plaintext-passwords"
+  username            = "tfacctest"
+  skip_final_snapshot = true
+
+  storage_type      = %[2]q
+  allocated_storage = 400
+}
+`, rName, storageType)
+}
+
+func testAccInstanceConfig_dbClusterSnapshotIdentifier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier        = %[1]q
+  db_cluster_instance_class = "db.r6gd.xlarge"
+  engine                    = "mysql"
+  storage_type              = "io1"
+  allocated_storage         = 100
+  iops                      = 1000
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_rds_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+resource "aws_db_instance" "test" {
+  identifier                     = %[1]q
+  db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.id
+  instance_class                 = "db.r6gd.large"
+  skip_final_snapshot            = true
+}
+`, rName)
+}
+
+func testAccInstanceConfig_SnapshotIdentifier_sharedARN(rName, snapshotARN string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMariadb(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  snapshot_identifier = %[2]q
+  skip_final_snapshot = true
+}
+`, rName, snapshotARN))
+}
@@ -42,6 +42,11 @@ func ResourceSnapshotCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"copy_option_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 			"copy_tags": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -103,7 +108,9 @@ func ResourceSnapshotCopy() *schema.Resource {
 			},
 			"source_region": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 			"snapshot_type": {
 				Type:     schema.TypeString,
@@ -150,6 +157,9 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 		Tags:                       Tags(tags.IgnoreAWS()),
 	}
 
+	if v, ok := d.GetOk("copy_option_group"); ok {
+		in.CopyOptionGroup = aws.Bool(v.(bool))
+	}
 	if v, ok := d.GetOk("copy_tags"); ok {
 		in.CopyTags = aws.Bool(v.(bool))
 	}
@@ -165,6 +175,13 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 	if v, ok := d.GetOk("presigned_url"); ok {
 		in.PreSignedUrl = aws.String(v.(string))
 	}
+	// For cross-region copies the SDK generates the presigned URL from the source region.
+	if v, ok := d.GetOk("source_region"); ok {
+		in.SourceRegion = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("target_custom_availability_zone"); ok {
+		in.TargetCustomAvailabilityZone = aws.String(v.(string))
+	}
 
 	out, err := conn.CopyDBSnapshotWithContext(ctx, in)
 	if err != nil {
@@ -296,7 +313,7 @@ func waitSnapshotCopyAvailable(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] Waiting for Snapshot %s to become available...", d.Id())
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"creating"},
+		Pending:    []string{"copying", "creating", "pending"},
 		Target:     []string{"available"},
 		Refresh:    resourceSnapshotStateRefreshFunc(d, meta),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -46,6 +46,42 @@ func TestAccRDSSnapshotCopy_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSSnapshotCopy_sourceRegion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBSnapshot
+	resourceName := "aws_db_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfig_sourceRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "source_region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_tags"},
+			},
+		},
+	})
+}
+
 func TestAccRDSSnapshotCopy_tags(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -240,3 +276,52 @@ resource "aws_db_snapshot_copy" "test" {
   }
 }`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccSnapshotCopyConfig_sourceRegion(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  provider = "awsalternate"
+
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  provider = "awsalternate"
+
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.small", "db.t2.small", "db.t2.medium"]
+}
+
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  allocated_storage       = 10
+  engine                  = data.aws_rds_engine_version.default.engine
+  engine_version          = data.aws_rds_engine_version.default.version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  name                    = "baz"
+  identifier              = %[1]q
+  password                = "barbarbarbar"
+  username                = "foo"
+  backup_retention_period = 0
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_snapshot" "test" {
+  provider = "awsalternate"
+
+  db_instance_identifier = aws_db_instance.test.id
+  db_snapshot_identifier = "%[1]s-source"
+}
+
+resource "aws_db_snapshot_copy" "test" {
+  copy_tags                     = true
+  source_db_snapshot_identifier = aws_db_snapshot.test.db_snapshot_arn
+  source_region                 = %[2]q
+  target_db_snapshot_identifier = "%[1]s-target"
+}`, rName, acctest.AlternateRegion()))
+}
@@ -87,6 +87,22 @@ func statusDBClusterActivityStream(ctx context.Context, conn *rds.RDS, dbCluster
 	}
 }
 
+func statusDBInstanceActivityStream(ctx context.Context, conn *rds.RDS, dbInstanceArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBInstanceWithActivityStream(ctx, conn, dbInstanceArn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ActivityStreamStatus), nil
+	}
+}
+
 func statusDBInstanceAutomatedBackup(conn *rds.RDS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDBInstanceAutomatedBackupByARN(conn, arn)
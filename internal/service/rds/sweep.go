@@ -36,6 +36,7 @@ func init() {
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
+			"rds_blue_green_deployments",
 			"aws_db_instance",
 			"aws_db_cluster_snapshot",
 		},
@@ -58,6 +59,7 @@ func init() {
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
+			"rds_blue_green_deployments",
 			"aws_opsworks_rds_db_instance",
 			"aws_db_cluster_snapshot",
 		},
@@ -86,6 +88,7 @@ func init() {
 		F:    sweepSnapshots,
 		Dependencies: []string{
 			"aws_db_instance",
+			"rds_export_tasks",
 		},
 	})
 
@@ -106,6 +109,19 @@ func init() {
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackupsReplication,
 	})
+
+	// Blue/Green Deployments (created during aws_db_instance updates) and snapshot
+	// export tasks have no corresponding resources, but can be left behind by failed tests.
+	// Their sweepers are named after what they sweep rather than a resource type.
+	resource.AddTestSweepers("rds_blue_green_deployments", &resource.Sweeper{
+		Name: "rds_blue_green_deployments",
+		F:    sweepBlueGreenDeployments,
+	})
+
+	resource.AddTestSweepers("rds_export_tasks", &resource.Sweeper{
+		Name: "rds_export_tasks",
+		F:    sweepExportTasks,
+	})
 }
 
 func sweepClusterParameterGroups(region string) error {
@@ -675,3 +691,111 @@ func sweepInstanceAutomatedBackupsReplication(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepBlueGreenDeployments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RDSConn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeBlueGreenDeploymentsPages(&rds.DescribeBlueGreenDeploymentsInput{}, func(out *rds.DescribeBlueGreenDeploymentsOutput, lastPage bool) bool {
+		for _, v := range out.BlueGreenDeployments {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.BlueGreenDeploymentIdentifier)
+			input := &rds.DeleteBlueGreenDeploymentInput{
+				BlueGreenDeploymentIdentifier: v.BlueGreenDeploymentIdentifier,
+			}
+
+			// The Green environment can only be deleted along with the deployment before switchover.
+			// After switchover, the old Blue environment is left for the DB Instance and Cluster sweepers.
+			switch aws.StringValue(v.Status) {
+			case "SWITCHOVER_COMPLETED", "SWITCHOVER_IN_PROGRESS", "DELETING":
+			default:
+				input.DeleteTarget = aws.Bool(true)
+			}
+
+			log.Printf("[INFO] Deleting RDS Blue/Green Deployment: %s", id)
+			_, err := conn.DeleteBlueGreenDeployment(input)
+
+			if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting RDS Blue/Green Deployment (%s): %w", id, err))
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping RDS Blue/Green Deployment sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving RDS Blue/Green Deployments: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepExportTasks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RDSConn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeExportTasksPages(&rds.DescribeExportTasksInput{}, func(out *rds.DescribeExportTasksOutput, lastPage bool) bool {
+		for _, v := range out.ExportTasks {
+			if v == nil {
+				continue
+			}
+
+			// Only running export tasks can be canceled; completed tasks don't incur further cost.
+			switch status := strings.ToUpper(aws.StringValue(v.Status)); status {
+			case "STARTING", "IN_PROGRESS":
+			default:
+				continue
+			}
+
+			id := aws.StringValue(v.ExportTaskIdentifier)
+
+			log.Printf("[INFO] Canceling RDS Export Task: %s", id)
+			_, err := conn.CancelExportTask(&rds.CancelExportTaskInput{
+				ExportTaskIdentifier: v.ExportTaskIdentifier,
+			})
+
+			if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault, rds.ErrCodeInvalidExportTaskStateFault) {
+				continue
+			}
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error canceling RDS Export Task (%s): %w", id, err))
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping RDS Export Task sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving RDS Export Tasks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
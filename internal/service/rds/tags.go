@@ -0,0 +1,36 @@
+package rds
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagsSDKv2 returns rds service tags for the AWS SDK for Go v2.
+func tagsSDKv2(tags tftags.KeyValueTags) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// keyValueTagsSDKv2 creates tftags.KeyValueTags from rds service tags returned by the AWS SDK for Go v2.
+func keyValueTagsSDKv2(tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
@@ -0,0 +1,430 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTenantDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTenantDatabaseCreate,
+		ReadWithoutTimeout:   resourceTenantDatabaseRead,
+		UpdateWithoutTimeout: resourceTenantDatabaseUpdate,
+		DeleteWithoutTimeout: resourceTenantDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTenantDatabaseImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"character_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"db_instance_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"dbi_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"final_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"nchar_character_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tenant_database_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_db_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8),
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTenantDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	dbInstanceID := d.Get("db_instance_identifier").(string)
+	tenantDBName := d.Get("tenant_db_name").(string)
+	id := TenantDatabaseCreateResourceID(dbInstanceID, tenantDBName)
+	input := &rds.CreateTenantDatabaseInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		MasterUserPassword:   aws.String(d.Get("password").(string)),
+		MasterUsername:       aws.String(d.Get("username").(string)),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+
+	if v, ok := d.GetOk("character_set_name"); ok {
+		input.CharacterSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("nchar_character_set_name"); ok {
+		input.NcharCharacterSetName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	// The DB instance is unavailable while any of its tenant databases are being created, modified or deleted.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate),
+		func() (interface{}, error) {
+			return conn.CreateTenantDatabaseWithContext(ctx, input)
+		},
+		rds.ErrCodeInvalidDBInstanceStateFault,
+	)
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "creating RDS Tenant Database (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTenantDatabaseCreated(ctx, conn, dbInstanceID, tenantDBName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return errs.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTenantDatabaseRead(ctx, d, meta)...)
+}
+
+func resourceTenantDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	dbInstanceID, tenantDBName, err := TenantDatabaseParseResourceID(d.Id())
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceID, tenantDBName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Tenant Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "reading RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TenantDatabaseARN)
+	d.Set("character_set_name", output.CharacterSetName)
+	d.Set("db_instance_identifier", output.DBInstanceIdentifier)
+	d.Set("dbi_resource_id", output.DbiResourceId)
+	d.Set("nchar_character_set_name", output.NcharCharacterSetName)
+	d.Set("tenant_database_resource_id", output.TenantDatabaseResourceId)
+	d.Set("tenant_db_name", output.TenantDBName)
+	d.Set("username", output.MasterUsername)
+
+	tags := KeyValueTags(output.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return errs.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return errs.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTenantDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	if d.HasChanges("password", "tenant_db_name") {
+		dbInstanceID := d.Get("db_instance_identifier").(string)
+		o, n := d.GetChange("tenant_db_name")
+		input := &rds.ModifyTenantDatabaseInput{
+			DBInstanceIdentifier: aws.String(dbInstanceID),
+			TenantDBName:         aws.String(o.(string)),
+		}
+
+		if d.HasChange("password") {
+			input.MasterUserPassword = aws.String(d.Get("password").(string))
+		}
+
+		if d.HasChange("tenant_db_name") {
+			input.NewTenantDBName = aws.String(n.(string))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate),
+			func() (interface{}, error) {
+				return conn.ModifyTenantDatabaseWithContext(ctx, input)
+			},
+			rds.ErrCodeInvalidDBInstanceStateFault,
+		)
+
+		if err != nil {
+			return errs.AppendErrorf(diags, "updating RDS Tenant Database (%s): %s", d.Id(), err)
+		}
+
+		// Renaming the tenant database changes the resource ID.
+		d.SetId(TenantDatabaseCreateResourceID(dbInstanceID, n.(string)))
+
+		if _, err := waitTenantDatabaseUpdated(ctx, conn, dbInstanceID, n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return errs.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return errs.AppendErrorf(diags, "updating RDS Tenant Database (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTenantDatabaseRead(ctx, d, meta)...)
+}
+
+func resourceTenantDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbInstanceID, tenantDBName, err := TenantDatabaseParseResourceID(d.Id())
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "deleting RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	input := &rds.DeleteTenantDatabaseInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+
+	if d.Get("skip_final_snapshot").(bool) {
+		input.SkipFinalSnapshot = aws.Bool(true)
+	} else {
+		input.SkipFinalSnapshot = aws.Bool(false)
+
+		if v, ok := d.GetOk("final_snapshot_identifier"); ok {
+			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+		} else {
+			return errs.AppendErrorf(diags, "final_snapshot_identifier is required when skip_final_snapshot is false")
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Tenant Database: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, error) {
+			return conn.DeleteTenantDatabaseWithContext(ctx, input)
+		},
+		rds.ErrCodeInvalidDBInstanceStateFault,
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault, rds.ErrCodeTenantDatabaseNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return errs.AppendErrorf(diags, "deleting RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTenantDatabaseDeleted(ctx, conn, dbInstanceID, tenantDBName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return errs.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTenantDatabaseImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
+	// from any API call, so we need to default skip_final_snapshot to true so
+	// that final_snapshot_identifier is not required.
+	d.Set("skip_final_snapshot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+const tenantDatabaseResourceIDSeparator = ","
+
+func TenantDatabaseCreateResourceID(dbInstanceID, tenantDBName string) string {
+	parts := []string{dbInstanceID, tenantDBName}
+	id := strings.Join(parts, tenantDatabaseResourceIDSeparator)
+
+	return id
+}
+
+func TenantDatabaseParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, tenantDatabaseResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DB-INSTANCE-ID%[2]sTENANT-DB-NAME", id, tenantDatabaseResourceIDSeparator)
+}
+
+func FindTenantDatabaseByTwoPartKey(ctx context.Context, conn *rds.RDS, dbInstanceID, tenantDBName string) (*rds.TenantDatabase, error) {
+	input := &rds.DescribeTenantDatabasesInput{
+		DBInstanceIdentifier: aws.String(dbInstanceID),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+
+	output, err := conn.DescribeTenantDatabasesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault, rds.ErrCodeTenantDatabaseNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TenantDatabases) == 0 || output.TenantDatabases[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.TenantDatabases); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.TenantDatabases[0], nil
+}
+
+func statusTenantDatabase(ctx context.Context, conn *rds.RDS, dbInstanceID, tenantDBName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceID, tenantDBName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitTenantDatabaseCreated(ctx context.Context, conn *rds.RDS, dbInstanceID, tenantDBName string, timeout time.Duration) (*rds.TenantDatabase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{TenantDatabaseStatusCreating},
+		Target:     []string{TenantDatabaseStatusAvailable},
+		Refresh:    statusTenantDatabase(ctx, conn, dbInstanceID, tenantDBName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTenantDatabaseUpdated(ctx context.Context, conn *rds.RDS, dbInstanceID, tenantDBName string, timeout time.Duration) (*rds.TenantDatabase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{TenantDatabaseStatusModifying},
+		Target:     []string{TenantDatabaseStatusAvailable},
+		Refresh:    statusTenantDatabase(ctx, conn, dbInstanceID, tenantDBName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+		// A renamed tenant database may not be immediately visible under its new name.
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTenantDatabaseDeleted(ctx context.Context, conn *rds.RDS, dbInstanceID, tenantDBName string, timeout time.Duration) (*rds.TenantDatabase, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			TenantDatabaseStatusAvailable,
+			TenantDatabaseStatusDeleting,
+			TenantDatabaseStatusModifying,
+		},
+		Target:     []string{},
+		Refresh:    statusTenantDatabase(ctx, conn, dbInstanceID, tenantDBName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,319 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSTenantDatabase_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTenantDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tfpdb1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`tenant-database:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "character_set_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_instance_identifier", "aws_db_instance.test", "identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "dbi_resource_id", "aws_db_instance.test", "resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_database_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "tenant_db_name", "tfpdb1"),
+					resource.TestCheckResourceAttr(resourceName, "username", "tfacctest"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSTenantDatabase_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTenantDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tfpdb1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrds.ResourceTenantDatabase(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSTenantDatabase_rename(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTenantDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tfpdb1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "tenant_db_name", "tfpdb1"),
+				),
+			},
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tfpdb2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v2),
+					testAccCheckTenantDatabaseNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "tenant_db_name", "tfpdb2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSTenantDatabase_tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTenantDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"password",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccTenantDatabaseConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTenantDatabaseConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTenantDatabaseDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_tenant_database" {
+			continue
+		}
+
+		dbInstanceID, tenantDBName, err := tfrds.TenantDatabaseParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfrds.FindTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceID, tenantDBName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS Tenant Database %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTenantDatabaseExists(n string, v *rds.TenantDatabase) resource.TestCheckFunc {
+	ctx := context.Background()
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Tenant Database ID is set")
+		}
+
+		dbInstanceID, tenantDBName, err := tfrds.TenantDatabaseParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceID, tenantDBName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTenantDatabaseNotRecreated(i, j *rds.TenantDatabase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *i.TenantDatabaseResourceId, *j.TenantDatabaseResourceId; before != after {
+			return fmt.Errorf("RDS Tenant Database (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccTenantDatabaseConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine        = "oracle-ee-cdb"
+  license_model = "bring-your-own-license"
+  storage_type  = "gp3"
+
+  preferred_instance_classes = ["db.m5.large", "db.r5.large", "db.m6i.large"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  apply_immediately   = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  multi_tenant        = true
+  password            = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+  storage_type        = data.aws_rds_orderable_db_instance.test.storage_type
+  username            = "tfacctest"
+}
+`, rName)
+}
+
+func testAccTenantDatabaseConfig_basic(rName, tenantDBName string) string {
+	return acctest.ConfigCompose(testAccTenantDatabaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_tenant_database" "test" {
+  db_instance_identifier = aws_db_instance.test.identifier
+  password               = "avoid-plaintext-passwords"
+  skip_final_snapshot    = true
+  tenant_db_name         = %[1]q
+  username               = "tfacctest"
+}
+`, tenantDBName))
+}
+
+func testAccTenantDatabaseConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTenantDatabaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_tenant_database" "test" {
+  db_instance_identifier = aws_db_instance.test.identifier
+  password               = "avoid-plaintext-passwords"
+  skip_final_snapshot    = true
+  tenant_db_name         = "tfpdb1"
+  username               = "tfacctest"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTenantDatabaseConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTenantDatabaseConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_tenant_database" "test" {
+  db_instance_identifier = aws_db_instance.test.identifier
+  password               = "avoid-plaintext-passwords"
+  skip_final_snapshot    = true
+  tenant_db_name         = "tfpdb1"
+  username               = "tfacctest"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func validEventSubscriptionName(v interface{}, k string) (ws []string, errors []error) {
@@ -184,3 +186,12 @@ func validIdentifierPrefix(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validSnapshotIdentifierOrARN validates a DB snapshot identifier or, for DB snapshots shared by another account, a DB snapshot ARN.
+func validSnapshotIdentifierOrARN(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(string); !arn.IsARN(value) {
+		return
+	}
+
+	return verify.ValidARNCheck(verify.ARNService("rds"), verify.ARNResourcePrefix("snapshot:"))(v, k)
+}
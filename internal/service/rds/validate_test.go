@@ -211,3 +211,39 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidSnapshotIdentifierOrARN(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "mydb-snapshot",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rds:mydb-2023-01-01-00-00",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:rds:us-west-2:123456789012:snapshot:mydb-snapshot",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:mydb-snapshot",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:kms:us-west-2:123456789012:snapshot:mydb-snapshot",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validSnapshotIdentifierOrARN(tc.Value, "snapshot_identifier")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
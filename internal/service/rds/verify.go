@@ -1,6 +1,8 @@
 package rds
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,3 +19,9 @@ func compareActualEngineVersion(d *schema.ResourceData, oldVersion string, newVe
 
 	d.Set("engine_version_actual", newVersion)
 }
+
+// engineVersionMatches returns whether the actual engine version satisfies the configured one,
+// which may be a prefix of the actual version, e.g. "8.0" and "8.0.mysql_aurora.3.04.0".
+func engineVersionMatches(configured, actual string) bool {
+	return configured == actual || strings.HasPrefix(actual, configured+".")
+}
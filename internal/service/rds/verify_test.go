@@ -0,0 +1,28 @@
+package rds
+
+import (
+	"testing"
+)
+
+func TestEngineVersionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		configured string
+		actual     string
+		expected   bool
+	}{
+		{configured: "8.0.mysql_aurora.3.04.0", actual: "8.0.mysql_aurora.3.04.0", expected: true},
+		{configured: "8.0", actual: "8.0.mysql_aurora.3.04.0", expected: true},
+		{configured: "15", actual: "15.4", expected: true},
+		{configured: "15.4", actual: "15.3", expected: false},
+		{configured: "1", actual: "15.4", expected: false},
+		{configured: "8.0.mysql_aurora.3.05.2", actual: "8.0.mysql_aurora.3.04.0", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := engineVersionMatches(testCase.configured, testCase.actual); got != testCase.expected {
+			t.Errorf("engineVersionMatches(%q, %q) = %t, want %t", testCase.configured, testCase.actual, got, testCase.expected)
+		}
+	}
+}
@@ -17,6 +17,9 @@ const (
 
 	dbClusterActivityStreamStartedTimeout = 30 * time.Minute
 	dbClusterActivityStreamStoppedTimeout = 30 * time.Minute
+
+	dbInstanceActivityStreamStartedTimeout = 30 * time.Minute
+	dbInstanceActivityStreamStoppedTimeout = 30 * time.Minute
 )
 
 func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -279,6 +282,46 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.RDS, dbClusterArn
 	return nil
 }
 
+// waitDBInstanceActivityStreamStarted waits for DB Instance Activity Stream to be started
+func waitDBInstanceActivityStreamStarted(ctx context.Context, conn *rds.RDS, dbInstanceArn string) error {
+	log.Printf("[DEBUG] Waiting for RDS DB Instance Activity Stream %s to become started...", dbInstanceArn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStarting},
+		Target:     []string{rds.ActivityStreamStatusStarted},
+		Refresh:    statusDBInstanceActivityStream(ctx, conn, dbInstanceArn),
+		Timeout:    dbInstanceActivityStreamStartedTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Activity Stream (%s) to be started: %v", dbInstanceArn, err)
+	}
+	return nil
+}
+
+// waitDBInstanceActivityStreamStopped waits for DB Instance Activity Stream to be stopped
+func waitDBInstanceActivityStreamStopped(ctx context.Context, conn *rds.RDS, dbInstanceArn string) error {
+	log.Printf("[DEBUG] Waiting for RDS DB Instance Activity Stream %s to become stopped...", dbInstanceArn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStopping},
+		Target:     []string{},
+		Refresh:    statusDBInstanceActivityStream(ctx, conn, dbInstanceArn),
+		Timeout:    dbInstanceActivityStreamStoppedTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Activity Stream (%s) to be stopped: %v", dbInstanceArn, err)
+	}
+	return nil
+}
+
 func waitDBInstanceAutomatedBackupCreated(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBInstanceAutomatedBackup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{InstanceAutomatedBackupStatusPending},
@@ -3,5 +3,7 @@ package resourceexplorer2
 // Exports for use in tests only.
 var (
 	FindIndex     = findIndex
+	FindViewByARN = findViewByARN
 	ResourceIndex = newResourceIndex
+	ResourceView  = newResourceView
 )
@@ -12,6 +12,13 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 			"tags":       testAccIndex_tags,
 			"type":       testAccIndex_type,
 		},
+		"View": {
+			"basic":       testAccView_basic,
+			"defaultView": testAccView_defaultView,
+			"disappears":  testAccView_disappears,
+			"filter":      testAccView_filter,
+			"tags":        testAccView_tags,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,537 @@
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func init() {
+	registerFrameworkResourceFactory(newResourceView)
+}
+
+func newResourceView(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceView{}, nil
+}
+
+type resourceView struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceView) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_view"
+}
+
+func (r *resourceView) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_view": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					planmodifiers.BoolDefaultValue(false),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9\-]{1,64}$`), `can include letters, digits, and the dash (-) character`),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filters": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"filter_string": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 2048),
+							},
+						},
+					},
+				},
+			},
+			"included_property": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1011),
+								stringvalidator.OneOf("tags"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceView) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceViewData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client
+	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(data.Tags))
+
+	input := &resourceexplorer2.CreateViewInput{
+		ClientToken: aws.String(sdkresource.UniqueId()),
+		ViewName:    flex.StringFromFramework(ctx, data.Name),
+	}
+
+	filters, diags := expandSearchFilter(ctx, data.Filters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Filters = filters
+
+	includedProperties, diags := expandIncludedProperties(ctx, data.IncludedProperties)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.IncludedProperties = includedProperties
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateView(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resource Explorer View (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.View.ViewArn)
+	data.ID = types.StringValue(arn)
+
+	if data.DefaultView.ValueBool() {
+		input := &resourceexplorer2.AssociateDefaultViewInput{
+			ViewArn: aws.String(arn),
+		}
+
+		_, err := conn.AssociateDefaultView(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	data.ARN = types.StringValue(arn)
+	data.Scope = flex.StringToFramework(ctx, output.View.Scope)
+	data.TagsAll = flex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceView) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceViewData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client
+	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+
+	output, err := findViewByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer View (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	defaultViewARN, err := findDefaultViewARN(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Explorer Default View", err.Error())
+
+		return
+	}
+
+	view := output.View
+	data.ARN = flex.StringToFramework(ctx, view.ViewArn)
+	data.DefaultView = types.BoolValue(defaultViewARN == aws.ToString(view.ViewArn))
+	data.Filters = flattenSearchFilter(ctx, view.Filters)
+	data.IncludedProperties = flattenIncludedProperties(ctx, view.IncludedProperties)
+	data.Scope = flex.StringToFramework(ctx, view.Scope)
+
+	name, err := viewNameFromARN(aws.ToString(view.ViewArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer View (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Name = types.StringValue(name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	// AWS APIs often return empty lists of tags when none have been configured.
+	if tags := tags.RemoveDefaultConfig(defaultTagsConfig).Map(); len(tags) == 0 {
+		data.Tags = tftags.Null
+	} else {
+		data.Tags = flex.FlattenFrameworkStringValueMap(ctx, tags)
+	}
+	data.TagsAll = flex.FlattenFrameworkStringValueMap(ctx, tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceView) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceViewData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client
+
+	if !new.Filters.Equal(old.Filters) || !new.IncludedProperties.Equal(old.IncludedProperties) {
+		input := &resourceexplorer2.UpdateViewInput{
+			ViewArn: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		filters, diags := expandSearchFilter(ctx, new.Filters)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Filters = filters
+
+		includedProperties, diags := expandIncludedProperties(ctx, new.IncludedProperties)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.IncludedProperties = includedProperties
+
+		// An empty filter string removes any existing filter.
+		if input.Filters == nil {
+			input.Filters = &awstypes.SearchFilter{
+				FilterString: aws.String(""),
+			}
+		}
+
+		_, err := conn.UpdateView(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer View (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.DefaultView.Equal(old.DefaultView) {
+		if new.DefaultView.ValueBool() {
+			input := &resourceexplorer2.AssociateDefaultViewInput{
+				ViewArn: flex.StringFromFramework(ctx, new.ID),
+			}
+
+			_, err := conn.AssociateDefaultView(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		} else {
+			input := &resourceexplorer2.DisassociateDefaultViewInput{}
+
+			_, err := conn.DisassociateDefaultView(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("unsetting Resource Explorer View (%s) as the default", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ID.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer View (%s) tags", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceView) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceViewData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client
+
+	tflog.Debug(ctx, "deleting Resource Explorer View", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteView(ctx, &resourceexplorer2.DeleteViewInput{
+		ViewArn: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer View (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceView) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceView) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceViewData struct {
+	ARN                types.String `tfsdk:"arn"`
+	DefaultView        types.Bool   `tfsdk:"default_view"`
+	Filters            types.List   `tfsdk:"filters"`
+	ID                 types.String `tfsdk:"id"`
+	IncludedProperties types.List   `tfsdk:"included_property"`
+	Name               types.String `tfsdk:"name"`
+	Scope              types.String `tfsdk:"scope"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+}
+
+type searchFilterData struct {
+	FilterString types.String `tfsdk:"filter_string"`
+}
+
+type includedPropertyData struct {
+	Name types.String `tfsdk:"name"`
+}
+
+var (
+	searchFilterAttrTypes = map[string]attr.Type{
+		"filter_string": types.StringType,
+	}
+
+	includedPropertyAttrTypes = map[string]attr.Type{
+		"name": types.StringType,
+	}
+)
+
+func findViewByARN(ctx context.Context, conn *resourceexplorer2.Client, arn string) (*resourceexplorer2.GetViewOutput, error) {
+	input := &resourceexplorer2.GetViewInput{
+		ViewArn: aws.String(arn),
+	}
+
+	output, err := conn.GetView(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &sdkresource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.View == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findDefaultViewARN returns the ARN of the Region's default view, or "" if no default view is set.
+func findDefaultViewARN(ctx context.Context, conn *resourceexplorer2.Client) (string, error) {
+	input := &resourceexplorer2.GetDefaultViewInput{}
+
+	output, err := conn.GetDefaultView(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", nil
+	}
+
+	return aws.ToString(output.ViewArn), nil
+}
+
+// viewNameFromARN returns the view name from an ARN of the form
+// arn:${Partition}:resource-explorer-2:${Region}:${Account}:view/${ViewName}/${ViewUuid}.
+func viewNameFromARN(arn string) (string, error) {
+	matches := regexp.MustCompile(`:view/([^/]+)/`).FindStringSubmatch(arn)
+
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unexpected format for View ARN (%s)", arn)
+	}
+
+	return matches[1], nil
+}
+
+func expandSearchFilter(ctx context.Context, tfList types.List) (*awstypes.SearchFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil, diags
+	}
+
+	var data []searchFilterData
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	return &awstypes.SearchFilter{
+		FilterString: flex.StringFromFramework(ctx, data[0].FilterString),
+	}, diags
+}
+
+func expandIncludedProperties(ctx context.Context, tfList types.List) ([]awstypes.IncludedProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil, diags
+	}
+
+	var data []includedPropertyData
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.IncludedProperty
+
+	for _, v := range data {
+		apiObjects = append(apiObjects, awstypes.IncludedProperty{
+			Name: flex.StringFromFramework(ctx, v.Name),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func flattenSearchFilter(ctx context.Context, apiObject *awstypes.SearchFilter) types.List {
+	elemType := types.ObjectType{AttrTypes: searchFilterAttrTypes}
+
+	// An empty filter string is equivalent to no filter.
+	if apiObject == nil || aws.ToString(apiObject.FilterString) == "" {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+
+	obj := types.ObjectValueMust(searchFilterAttrTypes, map[string]attr.Value{
+		"filter_string": flex.StringToFramework(ctx, apiObject.FilterString),
+	})
+
+	return types.ListValueMust(elemType, []attr.Value{obj})
+}
+
+func flattenIncludedProperties(ctx context.Context, apiObjects []awstypes.IncludedProperty) types.List {
+	elemType := types.ObjectType{AttrTypes: includedPropertyAttrTypes}
+
+	elems := []attr.Value{}
+
+	for _, apiObject := range apiObjects {
+		elems = append(elems, types.ObjectValueMust(includedPropertyAttrTypes, map[string]attr.Value{
+			"name": flex.StringToFramework(ctx, apiObject.Name),
+		}))
+	}
+
+	return types.ListValueMust(elemType, elems)
+}
@@ -0,0 +1,110 @@
+package resourcegroups
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceGroupResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGroupResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
+			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	group := d.Get("group").(string)
+	input := &resourcegroups.ListGroupResourcesInput{
+		Group: aws.String(group),
+	}
+
+	output, err := findGroupResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading Resource Groups Group (%s) resources: %s", group, err)
+	}
+
+	var resourceARNs []string
+	var resources []interface{}
+
+	for _, v := range output {
+		if v.Identifier == nil {
+			continue
+		}
+
+		resourceARNs = append(resourceARNs, aws.StringValue(v.Identifier.ResourceArn))
+		resources = append(resources, map[string]interface{}{
+			"arn":  aws.StringValue(v.Identifier.ResourceArn),
+			"type": aws.StringValue(v.Identifier.ResourceType),
+		})
+	}
+
+	d.SetId(group)
+	d.Set("resource_arns", resourceARNs)
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.Errorf("setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func findGroupResources(ctx context.Context, conn *resourcegroups.ResourceGroups, input *resourcegroups.ListGroupResourcesInput) ([]*resourcegroups.ListGroupResourcesItem, error) {
+	var output []*resourcegroups.ListGroupResourcesItem
+
+	err := conn.ListGroupResourcesPagesWithContext(ctx, input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Resources {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,42 @@
+package resourcegroups_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResourceGroupsGroupResourcesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroups_group_resources.test"
+	capacityReservationResourceName := "aws_ec2_capacity_reservation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", capacityReservationResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", capacityReservationResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "AWS::EC2::CapacityReservation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupResourcesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourceConfig_basic(rName), `
+data "aws_resourcegroups_group_resources" "test" {
+  group = aws_resourcegroups_resource.test.group_arn
+}
+`)
+}
@@ -0,0 +1,269 @@
+package resourcegroups
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceResource manages the membership of a resource in a configuration group, such as a
+// capacity reservation pool, whose members are added explicitly rather than by a resource query.
+func ResourceResource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceCreate,
+		ReadWithoutTimeout:   resourceResourceRead,
+		DeleteWithoutTimeout: resourceResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN := d.Get("group_arn").(string)
+	resourceARN := d.Get("resource_arn").(string)
+	id := ResourceCreateResourceID(groupARN, resourceARN)
+	input := &resourcegroups.GroupResourcesInput{
+		Group:        aws.String(groupARN),
+		ResourceArns: aws.StringSlice([]string{resourceARN}),
+	}
+
+	output, err := conn.GroupResourcesWithContext(ctx, input)
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Failed[0].ErrorCode), aws.StringValue(output.Failed[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return diag.Errorf("creating Resource Groups Resource (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitResourceCreated(ctx, conn, groupARN, resourceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Resource Groups Resource (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceResourceRead(ctx, d, meta)
+}
+
+func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN, resourceARN, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindResourceByTwoPartKey(ctx, conn, groupARN, resourceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resource Groups Resource (%s): %s", d.Id(), err)
+	}
+
+	d.Set("group_arn", groupARN)
+	d.Set("resource_arn", output.Identifier.ResourceArn)
+	d.Set("resource_type", output.Identifier.ResourceType)
+
+	return nil
+}
+
+func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsConn
+
+	groupARN, resourceARN, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Resource: %s", d.Id())
+	output, err := conn.UngroupResourcesWithContext(ctx, &resourcegroups.UngroupResourcesInput{
+		Group:        aws.String(groupARN),
+		ResourceArns: aws.StringSlice([]string{resourceARN}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Failed[0].ErrorCode), aws.StringValue(output.Failed[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resource Groups Resource (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitResourceDeleted(ctx, conn, groupARN, resourceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Resource Groups Resource (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const resourceResourceIDSeparator = ","
+
+func ResourceCreateResourceID(groupARN, resourceARN string) string {
+	parts := []string{groupARN, resourceARN}
+	id := strings.Join(parts, resourceResourceIDSeparator)
+
+	return id
+}
+
+func ResourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GroupARN%[2]sResourceARN", id, resourceResourceIDSeparator)
+}
+
+func FindResourceByTwoPartKey(ctx context.Context, conn *resourcegroups.ResourceGroups, groupARN, resourceARN string) (*resourcegroups.ListGroupResourcesItem, error) {
+	input := &resourcegroups.ListGroupResourcesInput{
+		Group: aws.String(groupARN),
+	}
+	var output *resourcegroups.ListGroupResourcesItem
+
+	err := conn.ListGroupResourcesPagesWithContext(ctx, input, func(page *resourcegroups.ListGroupResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Resources {
+			if v != nil && v.Identifier != nil && aws.StringValue(v.Identifier.ResourceArn) == resourceARN {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resourcegroups.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+const (
+	resourceStatusGrouped = "GROUPED"
+)
+
+func statusResource(ctx context.Context, conn *resourcegroups.ResourceGroups, groupARN, resourceARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindResourceByTwoPartKey(ctx, conn, groupARN, resourceARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// A resource that has finished being grouped or ungrouped has no status.
+		if output.Status != nil && aws.StringValue(output.Status.Name) != "" {
+			return output, aws.StringValue(output.Status.Name), nil
+		}
+
+		return output, resourceStatusGrouped, nil
+	}
+}
+
+func waitResourceCreated(ctx context.Context, conn *resourcegroups.ResourceGroups, groupARN, resourceARN string, timeout time.Duration) (*resourcegroups.ListGroupResourcesItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcegroups.ResourceStatusValuePending},
+		Target:  []string{resourceStatusGrouped},
+		Refresh: statusResource(ctx, conn, groupARN, resourceARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resourcegroups.ListGroupResourcesItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitResourceDeleted(ctx context.Context, conn *resourcegroups.ResourceGroups, groupARN, resourceARN string, timeout time.Duration) (*resourcegroups.ListGroupResourcesItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resourcegroups.ResourceStatusValuePending, resourceStatusGrouped},
+		Target:  []string{},
+		Refresh: statusResource(ctx, conn, groupARN, resourceARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resourcegroups.ListGroupResourcesItem); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,174 @@
+package resourcegroups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroups "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccResourceGroupsResource_basic(t *testing.T) {
+	var v resourcegroups.ListGroupResourcesItem
+	resourceName := "aws_resourcegroups_resource.test"
+	groupResourceName := "aws_resourcegroups_group.test"
+	capacityReservationResourceName := "aws_ec2_capacity_reservation.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "group_arn", groupResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", capacityReservationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::EC2::CapacityReservation"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsResource_disappears(t *testing.T) {
+	var v resourcegroups.ListGroupResourcesItem
+	resourceName := "aws_resourcegroups_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresourcegroups.ResourceResource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceExists(n string, v *resourcegroups.ListGroupResourcesItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resource Groups Resource ID is set")
+		}
+
+		groupARN, resourceARN, err := tfresourcegroups.ResourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+
+		output, err := tfresourcegroups.FindResourceByTwoPartKey(context.Background(), conn, groupARN, resourceARN)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckResourceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resourcegroups_resource" {
+			continue
+		}
+
+		groupARN, resourceARN, err := tfresourcegroups.ResourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfresourcegroups.FindResourceByTwoPartKey(context.Background(), conn, groupARN, resourceARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Resource Groups Resource %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::EC2::CapacityReservationPool"
+  }
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name = "allowed-resource-types"
+      values = [
+        "AWS::EC2::CapacityReservation",
+      ]
+    }
+  }
+}
+
+resource "aws_ec2_capacity_reservation" "test" {
+  instance_type     = "t2.micro"
+  instance_platform = "Linux/UNIX"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_count    = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourceConfig_base(rName), `
+resource "aws_resourcegroups_resource" "test" {
+  group_arn    = aws_resourcegroups_group.test.arn
+  resource_arn = aws_ec2_capacity_reservation.test.arn
+}
+`)
+}
@@ -0,0 +1,229 @@
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceTagSyncTask manages a task that keeps the membership of an application group in sync
+// with the resources carrying a given tag.
+func ResourceTagSyncTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagSyncTaskCreate,
+		ReadWithoutTimeout:   resourceTagSyncTaskRead,
+		DeleteWithoutTimeout: resourceTagSyncTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagSyncTaskImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
+			},
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tag_value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"task_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagSyncTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient()
+
+	group := d.Get("group").(string)
+	input := &resourcegroups.StartTagSyncTaskInput{
+		Group:    aws.String(group),
+		RoleArn:  aws.String(d.Get("role_arn").(string)),
+		TagKey:   aws.String(d.Get("tag_key").(string)),
+		TagValue: aws.String(d.Get("tag_value").(string)),
+	}
+
+	output, err := conn.StartTagSyncTask(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Resource Groups Tag Sync Task (%s): %s", group, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskArn))
+
+	if _, err := waitTagSyncTaskActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Resource Groups Tag Sync Task (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTagSyncTaskRead(ctx, d, meta)
+}
+
+func resourceTagSyncTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient()
+
+	output, err := FindTagSyncTaskByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Tag Sync Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("group_arn", output.GroupArn)
+	d.Set("group_name", output.GroupName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+	d.Set("tag_key", output.TagKey)
+	d.Set("tag_value", output.TagValue)
+	d.Set("task_arn", output.TaskArn)
+
+	return nil
+}
+
+func resourceTagSyncTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient()
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tag Sync Task: %s", d.Id())
+	_, err := conn.CancelTagSyncTask(ctx, &resourcegroups.CancelTagSyncTaskInput{
+		TaskArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTagSyncTaskImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient()
+
+	output, err := FindTagSyncTaskByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("group", output.GroupArn)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindTagSyncTaskByARN(ctx context.Context, conn *resourcegroups.Client, arn string) (*resourcegroups.GetTagSyncTaskOutput, error) {
+	input := &resourcegroups.GetTagSyncTaskInput{
+		TaskArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTagSyncTask(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTagSyncTask(ctx context.Context, conn *resourcegroups.Client, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTagSyncTaskByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTagSyncTaskActive(ctx context.Context, conn *resourcegroups.Client, arn string, timeout time.Duration) (*resourcegroups.GetTagSyncTaskOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{},
+		Target:         []string{string(types.TagSyncTaskStatusActive)},
+		Refresh:        statusTagSyncTask(ctx, conn, arn),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resourcegroups.GetTagSyncTaskOutput); ok {
+		if output.Status == types.TagSyncTaskStatusError {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
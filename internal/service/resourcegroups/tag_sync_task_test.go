@@ -0,0 +1,165 @@
+package resourcegroups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroups "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccResourceGroupsTagSyncTask_basic(t *testing.T) {
+	resourceName := "aws_resourcegroups_tag_sync_task.test"
+	groupResourceName := "aws_resourcegroups_group.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagSyncTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagSyncTaskExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_arn", groupResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tag_key", "Project"),
+					resource.TestCheckResourceAttr(resourceName, "tag_value", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "task_arn", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTagSyncTask_disappears(t *testing.T) {
+	resourceName := "aws_resourcegroups_tag_sync_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagSyncTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagSyncTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagSyncTaskExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresourcegroups.ResourceTagSyncTask(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTagSyncTaskExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resource Groups Tag Sync Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsClient()
+
+		_, err := tfresourcegroups.FindTagSyncTaskByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTagSyncTaskDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resourcegroups_tag_sync_task" {
+			continue
+		}
+
+		_, err := tfresourcegroups.FindTagSyncTaskByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Resource Groups Tag Sync Task %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTagSyncTaskConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "resource-groups.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ResourceGroupsTaggingAPITagUntagSupportedResources"
+}
+
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  configuration {
+    type = "AWS::ResourceGroups::Generic"
+
+    parameters {
+      name = "allowed-resource-types"
+      values = [
+        "AWS::AllSupported",
+      ]
+    }
+  }
+}
+
+resource "aws_resourcegroups_tag_sync_task" "test" {
+  group     = aws_resourcegroups_group.test.arn
+  role_arn  = aws_iam_role.test.arn
+  tag_key   = "Project"
+  tag_value = %[1]q
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -75,13 +76,18 @@ func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
 						},
 						"days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(intelligentTieringArchiveAccessMinDays, intelligentTieringMaxDays),
 						},
 					},
 				},
 			},
 		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validIntelligentTieringTierings(d.Get("tiering").(*schema.Set).List())
+		},
 	}
 }
 
@@ -188,6 +194,52 @@ func resourceBucketIntelligentTieringConfigurationDelete(d *schema.ResourceData,
 	return nil
 }
 
+const (
+	intelligentTieringArchiveAccessMinDays     = 90
+	intelligentTieringDeepArchiveAccessMinDays = 180
+	intelligentTieringMaxDays                  = 730
+)
+
+// validIntelligentTieringTierings validates the number of days for each access tier.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/intelligent-tiering-overview.html.
+func validIntelligentTieringTierings(tfList []interface{}) error {
+	days := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		accessTier, n := tfMap["access_tier"].(string), tfMap["days"].(int)
+
+		// The number of days may not be known until apply.
+		if n == 0 {
+			continue
+		}
+
+		if _, ok := days[accessTier]; ok {
+			return fmt.Errorf(`"tiering" contains more than one %s access tier`, accessTier)
+		}
+
+		days[accessTier] = n
+
+		if accessTier == s3.IntelligentTieringAccessTierDeepArchiveAccess && n < intelligentTieringDeepArchiveAccessMinDays {
+			return fmt.Errorf(`"days" for the %s access tier must be at least %d, got %d`, accessTier, intelligentTieringDeepArchiveAccessMinDays, n)
+		}
+	}
+
+	archive, okArchive := days[s3.IntelligentTieringAccessTierArchiveAccess]
+	deepArchive, okDeepArchive := days[s3.IntelligentTieringAccessTierDeepArchiveAccess]
+
+	if okArchive && okDeepArchive && deepArchive <= archive {
+		return fmt.Errorf(`"days" for the %s access tier (%d) must be greater than for the %s access tier (%d)`, s3.IntelligentTieringAccessTierDeepArchiveAccess, deepArchive, s3.IntelligentTieringAccessTierArchiveAccess, archive)
+	}
+
+	return nil
+}
+
 const bucketIntelligentTieringConfigurationResourceIDSeparator = ":"
 
 func BucketIntelligentTieringConfigurationCreateResourceID(bucketName, configurationName string) string {
@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -71,6 +72,31 @@ func TestAccS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketIntelligentTieringConfiguration_invalidDays(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 30, 180),
+				ExpectError: regexp.MustCompile(`expected tiering.0.days to be in the range \(90 - 730\)`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 90, 120),
+				ExpectError: regexp.MustCompile(`"days" for the DEEP_ARCHIVE_ACCESS access tier must be at least 180`),
+			},
+			{
+				Config:      testAccBucketIntelligentTieringConfigurationConfig_tierings(rName, 365, 180),
+				ExpectError: regexp.MustCompile(`must be greater than for the ARCHIVE_ACCESS access tier`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
 	var itc s3.IntelligentTieringConfiguration
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -205,6 +231,29 @@ resource "aws_s3_bucket" "test" {
 `, rName)
 }
 
+func testAccBucketIntelligentTieringConfigurationConfig_tierings(rName string, archiveDays, deepArchiveDays int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = %[2]d
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = %[3]d
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName, archiveDays, deepArchiveDays)
+}
+
 func testAccBucketIntelligentTieringConfigurationConfig_filterPrefix(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
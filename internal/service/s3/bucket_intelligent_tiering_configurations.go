@@ -0,0 +1,361 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceBucketIntelligentTieringConfigurations manages all of a bucket's Intelligent-Tiering configurations.
+// Configurations that are not declared are removed from the bucket.
+func ResourceBucketIntelligentTieringConfigurations() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketIntelligentTieringConfigurationsPut,
+		Read:   resourceBucketIntelligentTieringConfigurationsRead,
+		Update: resourceBucketIntelligentTieringConfigurationsPut,
+		Delete: resourceBucketIntelligentTieringConfigurationsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      s3.IntelligentTieringStatusEnabled,
+							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringStatus_Values(), false),
+						},
+						"tiering": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_tier": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(intelligentTieringArchiveAccessMinDays, intelligentTieringMaxDays),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			names := make(map[string]struct{})
+
+			for _, tfMapRaw := range d.Get("configuration").(*schema.Set).List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				name := tfMap["name"].(string)
+
+				if name != "" {
+					if _, ok := names[name]; ok {
+						return fmt.Errorf("duplicate Intelligent-Tiering configuration name: %s", name)
+					}
+
+					names[name] = struct{}{}
+				}
+
+				if v, ok := tfMap["tiering"].(*schema.Set); ok {
+					if err := validIntelligentTieringTierings(v.List()); err != nil {
+						return fmt.Errorf("configuration %q: %w", name, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceBucketIntelligentTieringConfigurationsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucketName := d.Get("bucket").(string)
+
+	var existing []*s3.IntelligentTieringConfiguration
+	var err error
+
+	if d.IsNewResource() {
+		existing, err = findBucketIntelligentTieringConfigurationsWithRetry(conn, bucketName)
+	} else {
+		existing, err = FindBucketIntelligentTieringConfigurations(conn, bucketName)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Intelligent-Tiering Configurations (%s): %w", bucketName, err)
+	}
+
+	desired := make(map[string]*s3.IntelligentTieringConfiguration)
+
+	for _, tfMapRaw := range d.Get("configuration").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandIntelligentTieringConfiguration(tfMap)
+		desired[aws.StringValue(apiObject.Id)] = apiObject
+	}
+
+	for _, apiObject := range existing {
+		id := aws.StringValue(apiObject.Id)
+
+		if _, ok := desired[id]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting S3 Intelligent-Tiering Configuration: (%s:%s)", bucketName, id)
+		_, err := conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucketName),
+			Id:     aws.String(id),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchConfiguration) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Intelligent-Tiering Configuration (%s:%s): %w", bucketName, id, err)
+		}
+	}
+
+	for id, apiObject := range desired {
+		input := &s3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          aws.String(bucketName),
+			Id:                              aws.String(id),
+			IntelligentTieringConfiguration: apiObject,
+		}
+
+		log.Printf("[DEBUG] Putting S3 Intelligent-Tiering Configuration: %s", input)
+		_, err := conn.PutBucketIntelligentTieringConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error putting S3 Intelligent-Tiering Configuration (%s:%s): %w", bucketName, id, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(bucketName)
+	}
+
+	return resourceBucketIntelligentTieringConfigurationsRead(d, meta)
+}
+
+func resourceBucketIntelligentTieringConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	output, err := FindBucketIntelligentTieringConfigurations(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Intelligent-Tiering Configurations (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("configuration", flattenIntelligentTieringConfigurations(output)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketIntelligentTieringConfigurationsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	output, err := FindBucketIntelligentTieringConfigurations(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Intelligent-Tiering Configurations (%s): %w", d.Id(), err)
+	}
+
+	for _, apiObject := range output {
+		id := aws.StringValue(apiObject.Id)
+
+		log.Printf("[DEBUG] Deleting S3 Intelligent-Tiering Configuration: (%s:%s)", d.Id(), id)
+		_, err := conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(d.Id()),
+			Id:     aws.String(id),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchConfiguration) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Intelligent-Tiering Configuration (%s:%s): %w", d.Id(), id, err)
+		}
+	}
+
+	return nil
+}
+
+func findBucketIntelligentTieringConfigurationsWithRetry(conn *s3.S3, bucketName string) ([]*s3.IntelligentTieringConfiguration, error) {
+	outputRaw, err := tfresource.RetryWhenNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindBucketIntelligentTieringConfigurations(conn, bucketName)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.([]*s3.IntelligentTieringConfiguration), nil
+}
+
+func FindBucketIntelligentTieringConfigurations(conn *s3.S3, bucketName string) ([]*s3.IntelligentTieringConfiguration, error) {
+	input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: aws.String(bucketName),
+	}
+	var output []*s3.IntelligentTieringConfiguration
+
+	for {
+		page, err := conn.ListBucketIntelligentTieringConfigurations(input)
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.IntelligentTieringConfigurationList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return output, nil
+}
+
+func expandIntelligentTieringConfiguration(tfMap map[string]interface{}) *s3.IntelligentTieringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.IntelligentTieringConfiguration{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandIntelligentTieringFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	if v, ok := tfMap["tiering"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Tierings = expandTierings(v.List())
+	}
+
+	return apiObject
+}
+
+func flattenIntelligentTieringConfigurations(apiObjects []*s3.IntelligentTieringConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":    aws.StringValue(apiObject.Id),
+			"status":  aws.StringValue(apiObject.Status),
+			"tiering": flattenTierings(apiObject.Tierings),
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{flattenIntelligentTieringFilter(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,209 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3BucketIntelligentTieringConfigurations_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configurations.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketIntelligentTieringConfigurationsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsCount(resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"name":      "entire-bucket",
+						"status":    "Enabled",
+						"tiering.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"name":            "exports",
+						"status":          "Disabled",
+						"filter.#":        "1",
+						"filter.0.prefix": "exports/",
+						"tiering.#":       "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// A configuration added outside of Terraform is removed.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+					_, err := conn.PutBucketIntelligentTieringConfiguration(&s3.PutBucketIntelligentTieringConfigurationInput{
+						Bucket: aws.String(rName),
+						Id:     aws.String("out-of-band"),
+						IntelligentTieringConfiguration: &s3.IntelligentTieringConfiguration{
+							Id:     aws.String("out-of-band"),
+							Status: aws.String(s3.IntelligentTieringStatusEnabled),
+							Tierings: []*s3.Tiering{{
+								AccessTier: aws.String(s3.IntelligentTieringAccessTierArchiveAccess),
+								Days:       aws.Int64(90),
+							}},
+						},
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "2"),
+				),
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationsConfig_single(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationsCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration.*", map[string]string{
+						"name":   "entire-bucket",
+						"status": "Enabled",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationsCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Intelligent-Tiering Configurations ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := tfs3.FindBucketIntelligentTieringConfigurations(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != count {
+			return fmt.Errorf("S3 Bucket (%s) has %d Intelligent-Tiering Configurations, expected %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_intelligent_tiering_configurations" {
+			continue
+		}
+
+		output, err := tfs3.FindBucketIntelligentTieringConfigurations(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("S3 Bucket (%s) Intelligent-Tiering Configurations still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketIntelligentTieringConfigurationsConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  configuration {
+    name = "entire-bucket"
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+
+  configuration {
+    name   = "exports"
+    status = "Disabled"
+
+    filter {
+      prefix = "exports/"
+    }
+
+    tiering {
+      access_tier = "ARCHIVE_ACCESS"
+      days        = 90
+    }
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 365
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketIntelligentTieringConfigurationsConfig_single(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configurations" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  configuration {
+    name = "entire-bucket"
+
+    tiering {
+      access_tier = "DEEP_ARCHIVE_ACCESS"
+      days        = 180
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
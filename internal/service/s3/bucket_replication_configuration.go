@@ -1,11 +1,14 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,6 +30,39 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"batch_replication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"job_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"report_bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"bucket": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -299,6 +335,8 @@ func ResourceBucketReplicationConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customizeDiffBucketReplicationConfigurationRules,
 	}
 }
 
@@ -342,6 +380,12 @@ func resourceBucketReplicationConfigurationCreate(d *schema.ResourceData, meta i
 
 	d.SetId(bucket)
 
+	if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := startBucketBatchReplicationJob(d, meta, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceBucketReplicationConfigurationRead(d, meta)
 }
 
@@ -381,6 +425,29 @@ func resourceBucketReplicationConfigurationRead(d *schema.ResourceData, meta int
 		return fmt.Errorf("error setting rule: %w", err)
 	}
 
+	if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if jobID, ok := tfMap["job_id"].(string); ok && jobID != "" {
+			job, err := findJobByID(meta.(*conns.AWSClient).S3ControlConn, meta.(*conns.AWSClient).AccountID, jobID)
+
+			switch {
+			case tfresource.NotFound(err):
+				// Completed jobs are only retained for 90 days.
+				log.Printf("[WARN] S3 Batch Operations Job (%s) not found", jobID)
+				tfMap["job_status"] = ""
+			case err != nil:
+				return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", jobID, err)
+			default:
+				tfMap["job_status"] = aws.StringValue(job.Status)
+			}
+		}
+
+		if err := d.Set("batch_replication", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting batch_replication: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -420,6 +487,18 @@ func resourceBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("error updating S3 replication configuration for bucket (%s): %w", d.Id(), err)
 	}
 
+	// Replicate existing objects when batch replication is enabled or replication rules are added.
+	if v, ok := d.GetOk("batch_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		o, n := d.GetChange("batch_replication")
+		oRules, nRules := d.GetChange("rule")
+
+		if len(o.([]interface{})) == 0 || replicationRulesAdded(oRules.([]interface{}), nRules.([]interface{})) {
+			if err := startBucketBatchReplicationJob(d, meta, n.([]interface{})[0].(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceBucketReplicationConfigurationRead(d, meta)
 }
 
@@ -442,3 +521,161 @@ func resourceBucketReplicationConfigurationDelete(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+// startBucketBatchReplicationJob creates an S3 Batch Operations job that replicates the bucket's existing objects
+// which haven't been replicated, and records the job in state.
+func startBucketBatchReplicationJob(d *schema.ResourceData, meta interface{}, tfMap map[string]interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	accountID := meta.(*conns.AWSClient).AccountID
+
+	roleARN := d.Get("role").(string)
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		roleARN = v
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(false),
+		Description:          aws.String(fmt.Sprintf("Batch Replication for S3 Bucket %s", d.Id())),
+		ManifestGenerator: &s3control.JobManifestGenerator{
+			S3JobManifestGenerator: &s3control.S3JobManifestGenerator{
+				EnableManifestOutput: aws.Bool(false),
+				Filter: &s3control.JobManifestGeneratorFilter{
+					EligibleForReplication:    aws.Bool(true),
+					ObjectReplicationStatuses: aws.StringSlice([]string{s3control.ReplicationStatusNone, s3control.ReplicationStatusFailed}),
+				},
+				SourceBucket: aws.String(arn.ARN{
+					Partition: meta.(*conns.AWSClient).Partition,
+					Service:   s3.ServiceName,
+					Resource:  d.Id(),
+				}.String()),
+			},
+		},
+		Operation: &s3control.JobOperation{
+			S3ReplicateObject: &s3control.S3ReplicateObjectOperation{},
+		},
+		Priority: aws.Int64(int64(tfMap["priority"].(int))),
+		Report: &s3control.JobReport{
+			Enabled: aws.Bool(false),
+		},
+		RoleArn: aws.String(roleARN),
+	}
+
+	if v, ok := tfMap["report_bucket"].(string); ok && v != "" {
+		input.Report = &s3control.JobReport{
+			Bucket:      aws.String(v),
+			Enabled:     aws.Bool(true),
+			Format:      aws.String(s3control.JobReportFormatReportCsv20180820),
+			ReportScope: aws.String(s3control.JobReportScopeAllTasks),
+		}
+	}
+
+	output, err := conn.CreateJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Batch Replication Job for bucket (%s): %w", d.Id(), err)
+	}
+
+	tfMap["job_id"] = aws.StringValue(output.JobId)
+
+	if err := d.Set("batch_replication", []interface{}{tfMap}); err != nil {
+		return fmt.Errorf("error setting batch_replication: %w", err)
+	}
+
+	return nil
+}
+
+func findJobByID(conn *s3control.S3Control, accountID, id string) (*s3control.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(id),
+	}
+
+	output, err := conn.DescribeJob(input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+// replicationRulesAdded returns whether any rule in n is not in o. Rules are matched by ID.
+func replicationRulesAdded(o, n []interface{}) bool {
+	ids := make(map[string]struct{})
+
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			ids[tfMap["id"].(string)] = struct{}{}
+		}
+	}
+
+	for _, tfMapRaw := range n {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := ids[tfMap["id"].(string)]; !ok || tfMap["id"].(string) == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// customizeDiffBucketReplicationConfigurationRules validates each rule's S3 Replication Time Control (S3 RTC)
+// and replication metrics configuration. S3 RTC requires that replication metrics, including the event threshold,
+// are also enabled.
+func customizeDiffBucketReplicationConfigurationRules(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		destinations, ok := tfMap["destination"].([]interface{})
+
+		if !ok || len(destinations) == 0 || destinations[0] == nil {
+			continue
+		}
+
+		destination := destinations[0].(map[string]interface{})
+
+		replicationTime, ok := destination["replication_time"].([]interface{})
+
+		if !ok || len(replicationTime) == 0 || replicationTime[0] == nil {
+			continue
+		}
+
+		if replicationTime[0].(map[string]interface{})["status"].(string) != s3.ReplicationTimeStatusEnabled {
+			continue
+		}
+
+		metrics, ok := destination["metrics"].([]interface{})
+
+		if !ok || len(metrics) == 0 || metrics[0] == nil || metrics[0].(map[string]interface{})["status"].(string) != s3.MetricsStatusEnabled {
+			return fmt.Errorf(`rule.%d: "destination.metrics" must be enabled when "destination.replication_time" is enabled`, i)
+		}
+
+		if v, ok := metrics[0].(map[string]interface{})["event_threshold"].([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+			return fmt.Errorf(`rule.%d: "destination.metrics.event_threshold" must be set when "destination.replication_time" is enabled`, i)
+		}
+	}
+
+	return nil
+}
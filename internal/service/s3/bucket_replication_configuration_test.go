@@ -2,6 +2,7 @@ package s3_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -491,6 +492,73 @@ func TestAccS3BucketReplicationConfiguration_replicationTimeControl(t *testing.T
 	})
 }
 
+func TestAccS3BucketReplicationConfiguration_replicationTimeControlWithoutMetrics(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(t, &providers),
+		CheckDestroy:      acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroy, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcMetricsNoEventThreshold(rName, "Disabled"),
+				ExpectError: regexp.MustCompile(`"destination.metrics" must be enabled when "destination.replication_time" is enabled`),
+			},
+			{
+				Config:      testAccBucketReplicationConfigurationConfig_rtcMetricsNoEventThreshold(rName, "Enabled"),
+				ExpectError: regexp.MustCompile(`"destination.metrics.event_threshold" must be set when "destination.replication_time" is enabled`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketReplicationConfiguration_batchReplication(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	batchRoleResourceName := "aws_iam_role.batch"
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(t, &providers),
+		CheckDestroy:      acctest.CheckWithProviders(testAccCheckBucketReplicationConfigurationDestroy, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketReplicationConfigurationConfig_batchReplication(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "batch_replication.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "batch_replication.0.job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "batch_replication.0.job_status"),
+					resource.TestCheckResourceAttr(resourceName, "batch_replication.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "batch_replication.0.role_arn", batchRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"batch_replication",
+				},
+			},
+		},
+	})
+}
+
 func TestAccS3BucketReplicationConfiguration_replicaModifications(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dstBucketResourceName := "aws_s3_bucket.destination"
@@ -1377,6 +1445,134 @@ resource "aws_s3_bucket_replication_configuration" "test" {
 }`)
 }
 
+func testAccBucketReplicationConfigurationConfig_rtcMetricsNoEventThreshold(rName, metricsStatus string) string {
+	return acctest.ConfigCompose(
+		testAccBucketReplicationConfigurationBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Enabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+      replication_time {
+        status = "Enabled"
+        time {
+          minutes = 15
+        }
+      }
+      metrics {
+        status = %[1]q
+      }
+    }
+  }
+}`, metricsStatus))
+}
+
+func testAccBucketReplicationConfigurationConfig_batchReplication(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBucketReplicationConfigurationBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "batch" {
+  name = "%[1]s-batch"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "batch" {
+  name = %[1]q
+  role = aws_iam_role.batch.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:InitiateReplication"
+      ],
+      "Effect": "Allow",
+      "Resource": "${aws_s3_bucket.source.arn}/*"
+    },
+    {
+      "Action": [
+        "s3:GetReplicationConfiguration",
+        "s3:PutInventoryConfiguration"
+      ],
+      "Effect": "Allow",
+      "Resource": "${aws_s3_bucket.source.arn}"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_object" "test" {
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket  = aws_s3_bucket.source.id
+  key     = "foo/existing"
+  content = "existing object"
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_iam_role_policy.batch,
+    aws_s3_object.test,
+    aws_s3_bucket_versioning.source,
+    aws_s3_bucket_versioning.destination
+  ]
+
+  bucket = aws_s3_bucket.source.id
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id = "foobar"
+    filter {
+      prefix = "foo"
+    }
+    status = "Enabled"
+    delete_marker_replication {
+      status = "Disabled"
+    }
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+    }
+  }
+
+  batch_replication {
+    role_arn = aws_iam_role.batch.arn
+  }
+}`, rName))
+}
+
 func testAccBucketReplicationConfigurationConfig_replicaMods(rName string) string {
 	return testAccBucketReplicationConfigurationBase(rName) + `
 resource "aws_s3_bucket_replication_configuration" "test" {
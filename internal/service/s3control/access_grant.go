@@ -0,0 +1,322 @@
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantCreate,
+		ReadWithoutTimeout:   resourceAccessGrantRead,
+		DeleteWithoutTimeout: resourceAccessGrantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grant_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_sub_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"grant_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grantee": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"grantee_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(enum.Values[types.GranteeType](), false),
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enum.Values[types.Permission](), false),
+			},
+			"s3_prefix_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enum.Values[types.S3PrefixType](), false),
+			},
+		},
+	}
+}
+
+func resourceAccessGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantInput{
+		AccessGrantsLocationId: aws.String(d.Get("access_grants_location_id").(string)),
+		AccountId:              aws.String(accountID),
+		Permission:             types.Permission(d.Get("permission").(string)),
+	}
+
+	if v, ok := d.GetOk("access_grants_location_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessGrantsLocationConfiguration = expandAccessGrantsLocationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("grantee"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Grantee = expandGrantee(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_prefix_type"); ok {
+		input.S3PrefixType = types.S3PrefixType(v.(string))
+	}
+
+	output, err := conn.CreateAccessGrant(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grant: %s", err)
+	}
+
+	d.SetId(AccessGrantCreateResourceID(accountID, aws.ToString(output.AccessGrantId)))
+
+	return resourceAccessGrantRead(ctx, d, meta)
+}
+
+func resourceAccessGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	accountID, grantID, err := AccessGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindAccessGrantByTwoPartKey(ctx, conn, accountID, grantID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grant (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grant_arn", output.AccessGrantArn)
+	d.Set("access_grant_id", output.AccessGrantId)
+	if output.AccessGrantsLocationConfiguration != nil {
+		if err := d.Set("access_grants_location_configuration", []interface{}{flattenAccessGrantsLocationConfiguration(output.AccessGrantsLocationConfiguration)}); err != nil {
+			return diag.Errorf("setting access_grants_location_configuration: %s", err)
+		}
+	} else {
+		d.Set("access_grants_location_configuration", nil)
+	}
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("grant_scope", output.GrantScope)
+	if output.Grantee != nil {
+		if err := d.Set("grantee", []interface{}{flattenGrantee(output.Grantee)}); err != nil {
+			return diag.Errorf("setting grantee: %s", err)
+		}
+	} else {
+		d.Set("grantee", nil)
+	}
+	d.Set("permission", output.Permission)
+
+	return nil
+}
+
+func resourceAccessGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	accountID, grantID, err := AccessGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grant: %s", d.Id())
+	_, err = conn.DeleteAccessGrant(ctx, &s3control.DeleteAccessGrantInput{
+		AccessGrantId: aws.String(grantID),
+		AccountId:     aws.String(accountID),
+	})
+
+	if errCodeEqualsV2(err, errCodeAccessGrantNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grant (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const accessGrantResourceIDSeparator = ","
+
+func AccessGrantCreateResourceID(accountID, grantID string) string {
+	parts := []string{accountID, grantID}
+	id := strings.Join(parts, accessGrantResourceIDSeparator)
+
+	return id
+}
+
+func AccessGrantParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, accessGrantResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-grant-id", id, accessGrantResourceIDSeparator)
+}
+
+func FindAccessGrantByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, grantID string) (*s3control.GetAccessGrantOutput, error) {
+	input := &s3control.GetAccessGrantInput{
+		AccessGrantId: aws.String(grantID),
+		AccountId:     aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrant(ctx, input)
+
+	if errCodeEqualsV2(err, errCodeAccessGrantNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAccessGrantsLocationConfiguration(tfMap map[string]interface{}) *types.AccessGrantsLocationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AccessGrantsLocationConfiguration{}
+
+	if v, ok := tfMap["s3_sub_prefix"].(string); ok && v != "" {
+		apiObject.S3SubPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccessGrantsLocationConfiguration(apiObject *types.AccessGrantsLocationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3SubPrefix; v != nil {
+		tfMap["s3_sub_prefix"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandGrantee(tfMap map[string]interface{}) *types.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Grantee{}
+
+	if v, ok := tfMap["grantee_identifier"].(string); ok && v != "" {
+		apiObject.GranteeIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["grantee_type"].(string); ok && v != "" {
+		apiObject.GranteeType = types.GranteeType(v)
+	}
+
+	return apiObject
+}
+
+func flattenGrantee(apiObject *types.Grantee) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"grantee_type": string(apiObject.GranteeType),
+	}
+
+	if v := apiObject.GranteeIdentifier; v != nil {
+		tfMap["grantee_identifier"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
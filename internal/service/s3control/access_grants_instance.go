@@ -0,0 +1,196 @@
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrantsInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsInstanceCreate,
+		ReadWithoutTimeout:   resourceAccessGrantsInstanceRead,
+		UpdateWithoutTimeout: resourceAccessGrantsInstanceUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantsInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"identity_center_application_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_center_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccessGrantsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	if v, ok := d.GetOk("identity_center_arn"); ok {
+		input.IdentityCenterArn = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAccessGrantsInstance(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grants Instance (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	output, err := FindAccessGrantsInstance(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grants_instance_arn", output.AccessGrantsInstanceArn)
+	d.Set("access_grants_instance_id", output.AccessGrantsInstanceId)
+	d.Set("account_id", d.Id())
+	d.Set("identity_center_application_arn", output.IdentityCenterApplicationArn)
+	d.Set("identity_center_arn", output.IdentityCenterArn)
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	if d.HasChange("identity_center_arn") {
+		o, n := d.GetChange("identity_center_arn")
+
+		if o.(string) != "" {
+			_, err := conn.DissociateAccessGrantsIdentityCenter(ctx, &s3control.DissociateAccessGrantsIdentityCenterInput{
+				AccountId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("dissociating S3 Access Grants Instance (%s) IAM Identity Center: %s", d.Id(), err)
+			}
+		}
+
+		if n.(string) != "" {
+			_, err := conn.AssociateAccessGrantsIdentityCenter(ctx, &s3control.AssociateAccessGrantsIdentityCenterInput{
+				AccountId:         aws.String(d.Id()),
+				IdentityCenterArn: aws.String(n.(string)),
+			})
+
+			if err != nil {
+				return diag.Errorf("associating S3 Access Grants Instance (%s) IAM Identity Center (%s): %s", d.Id(), n, err)
+			}
+		}
+	}
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient()
+
+	// The instance must be dissociated from IAM Identity Center before it can be deleted.
+	if v, ok := d.GetOk("identity_center_arn"); ok && v.(string) != "" {
+		_, err := conn.DissociateAccessGrantsIdentityCenter(ctx, &s3control.DissociateAccessGrantsIdentityCenterInput{
+			AccountId: aws.String(d.Id()),
+		})
+
+		if errCodeEqualsV2(err, errCodeAccessGrantsInstanceNotExistsError) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("dissociating S3 Access Grants Instance (%s) IAM Identity Center: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance: %s", d.Id())
+	_, err := conn.DeleteAccessGrantsInstance(ctx, &s3control.DeleteAccessGrantsInstanceInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if errCodeEqualsV2(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAccessGrantsInstance(ctx context.Context, conn *s3control.Client, accountID string) (*s3control.GetAccessGrantsInstanceOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstance(ctx, input)
+
+	if errCodeEqualsV2(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,195 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Only one S3 Access Grants Instance may exist per account and Region,
+// so all Access Grants tests are run serially.
+func TestAccS3ControlAccessGrants_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Instance": {
+			"basic":          testAccAccessGrantsInstance_basic,
+			"disappears":     testAccAccessGrantsInstance_disappears,
+			"identityCenter": testAccAccessGrantsInstance_identityCenter,
+		},
+		"Location": {
+			"basic":      testAccAccessGrantsLocation_basic,
+			"disappears": testAccAccessGrantsLocation_disappears,
+			"update":     testAccAccessGrantsLocation_update,
+		},
+		"Grant": {
+			"basic":      testAccAccessGrant_basic,
+			"disappears": testAccAccessGrant_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccAccessGrantsInstance_basic(t *testing.T) {
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_instance_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "identity_center_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_disappears(t *testing.T) {
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceAccessGrantsInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsInstance_identityCenter(t *testing.T) {
+	resourceName := "aws_s3control_access_grants_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckSSOAdminInstances(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_center_arn", ""),
+				),
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_identityCenter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_center_application_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_center_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessGrantsInstanceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_center_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsInstanceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_access_grants_instance" {
+			continue
+		}
+
+		_, err := tfs3control.FindAccessGrantsInstance(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Access Grants Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAccessGrantsInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Access Grants Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient()
+
+		_, err := tfs3control.FindAccessGrantsInstance(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+const testAccAccessGrantsInstanceConfig_basic = `
+resource "aws_s3control_access_grants_instance" "test" {}
+`
+
+const testAccAccessGrantsInstanceConfig_identityCenter = `
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_s3control_access_grants_instance" "test" {
+  identity_center_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`
@@ -0,0 +1,189 @@
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccessGrantsLocation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_id"),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test1", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "location_scope", "s3://"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsLocation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceAccessGrantsLocation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsLocation_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3control.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test1", "arn"),
+				),
+			},
+			{
+				Config: testAccAccessGrantsLocationConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessGrantsLocationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_access_grants_location" {
+			continue
+		}
+
+		accountID, locationID, err := tfs3control.AccessGrantsLocationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3control.FindAccessGrantsLocationByTwoPartKey(context.Background(), conn, accountID, locationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Access Grants Location %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAccessGrantsLocationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Access Grants Location ID is set")
+		}
+
+		accountID, locationID, err := tfs3control.AccessGrantsLocationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient()
+
+		_, err = tfs3control.FindAccessGrantsLocationByTwoPartKey(context.Background(), conn, accountID, locationID)
+
+		return err
+	}
+}
+
+func testAccAccessGrantsLocationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_access_grants_instance" "test" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole", "sts:SetSourceIdentity"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["access-grants.s3.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test1" {
+  name               = "%[1]s-1"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_iam_role" "test2" {
+  name               = "%[1]s-2"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+`, rName)
+}
+
+func testAccAccessGrantsLocationConfig_basic(rName, roleResourceName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantsLocationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3control_access_grants_location" "test" {
+  iam_role_arn   = aws_iam_role.%[1]s.arn
+  location_scope = "s3://"
+
+  depends_on = [aws_s3control_access_grants_instance.test]
+}
+`, roleResourceName))
+}
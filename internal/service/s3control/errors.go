@@ -1,11 +1,32 @@
 package s3control
 
+import (
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
-	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
-	errCodeNoSuchAsyncRequest      = "NoSuchAsyncRequest"
+	errCodeAccessGrantNotExistsError          = "AccessGrantNotExistsError"
+	errCodeAccessGrantsInstanceNotExistsError = "AccessGrantsInstanceNotExistsError"
+	errCodeAccessGrantsLocationNotExistsError = "AccessGrantsLocationNotExistsError"
+	errCodeNoSuchAccessPoint                  = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy            = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAsyncRequest                 = "NoSuchAsyncRequest"
 	// errCodeNoSuchConfiguration          = "NoSuchConfiguration"
 	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
 )
+
+// errCodeEqualsV2 returns true if the AWS SDK for Go v2 error has one of the specified error codes.
+func errCodeEqualsV2(err error, codes ...string) bool {
+	if apiErr, ok := errs.As[smithy.APIError](err); ok {
+		for _, code := range codes {
+			if apiErr.ErrorCode() == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,209 @@
+package servicequotas
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Usage metrics are published at most once a minute, but some services only report hourly.
+	serviceQuotaUtilizationLookback = 24 * time.Hour
+	serviceQuotaUtilizationPeriod   = 1 * time.Hour
+)
+
+// DataSourceServiceQuotaUtilization reports the current usage of a Service Quota, as recorded by
+// the quota's CloudWatch usage metric, against its applied value.
+func DataSourceServiceQuotaUtilization() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceQuotaUtilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"quota_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateQuotaCode,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateServiceCode,
+			},
+			"usage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"usage_metric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_dimensions": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_statistic_recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceQuotaUtilizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+
+	// A Service Quota will always have a default value, but will only have a current value if it has been set.
+	quota, err := findServiceQuotaDefaultByID(conn, serviceCode, quotaCode)
+
+	if err != nil {
+		return diag.Errorf("reading Default Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+	}
+
+	serviceQuota, err := findServiceQuotaByID(conn, serviceCode, quotaCode)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return diag.Errorf("reading Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+	default:
+		quota.Value = serviceQuota.Value
+	}
+
+	if quota.UsageMetric == nil || aws.StringValue(quota.UsageMetric.MetricName) == "" {
+		return diag.Errorf("Service Quota (%s/%s) does not publish a usage metric", serviceCode, quotaCode)
+	}
+
+	usage, err := findServiceQuotaUsage(ctx, meta.(*conns.AWSClient).CloudWatchConn, quota.UsageMetric)
+
+	if err != nil {
+		return diag.Errorf("reading Service Quota (%s/%s) usage: %s", serviceCode, quotaCode, err)
+	}
+
+	value := aws.Float64Value(quota.Value)
+	var utilization float64
+	if value > 0 {
+		utilization = usage / value * 100
+	}
+
+	d.SetId(aws.StringValue(quota.QuotaArn))
+	d.Set("quota_code", quota.QuotaCode)
+	d.Set("quota_name", quota.QuotaName)
+	d.Set("service_code", quota.ServiceCode)
+	d.Set("usage", usage)
+	if err := d.Set("usage_metric", flattenMetricInfo(quota.UsageMetric)); err != nil {
+		return diag.Errorf("setting usage_metric: %s", err)
+	}
+	d.Set("utilization", utilization)
+	d.Set("value", value)
+
+	return nil
+}
+
+// findServiceQuotaUsage returns the most recent datapoint of the quota's usage metric,
+// or zero if the metric has not been published recently.
+func findServiceQuotaUsage(ctx context.Context, conn *cloudwatch.CloudWatch, metric *servicequotas.MetricInfo) (float64, error) {
+	statistic := aws.StringValue(metric.MetricStatisticRecommendation)
+	if !slices.Contains(cloudwatch.Statistic_Values(), statistic) {
+		statistic = cloudwatch.StatisticMaximum
+	}
+
+	var dimensions []*cloudwatch.Dimension
+	for k, v := range metric.MetricDimensions {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: v,
+		})
+	}
+
+	now := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: dimensions,
+		EndTime:    aws.Time(now),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws.Int64(int64(serviceQuotaUtilizationPeriod.Seconds())),
+		StartTime:  aws.Time(now.Add(-serviceQuotaUtilizationLookback)),
+		Statistics: aws.StringSlice([]string{statistic}),
+	}
+
+	output, err := conn.GetMetricStatisticsWithContext(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || len(output.Datapoints) == 0 {
+		return 0, nil
+	}
+
+	datapoints := output.Datapoints
+	sort.Slice(datapoints, func(i, j int) bool {
+		return aws.TimeValue(datapoints[i].Timestamp).After(aws.TimeValue(datapoints[j].Timestamp))
+	})
+
+	switch datapoint := datapoints[0]; statistic {
+	case cloudwatch.StatisticAverage:
+		return aws.Float64Value(datapoint.Average), nil
+	case cloudwatch.StatisticMinimum:
+		return aws.Float64Value(datapoint.Minimum), nil
+	case cloudwatch.StatisticSampleCount:
+		return aws.Float64Value(datapoint.SampleCount), nil
+	case cloudwatch.StatisticSum:
+		return aws.Float64Value(datapoint.Sum), nil
+	default:
+		return aws.Float64Value(datapoint.Maximum), nil
+	}
+}
+
+func flattenMetricInfo(apiObject *servicequotas.MetricInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"metric_dimensions":               flex.PointersMapToStringList(apiObject.MetricDimensions),
+		"metric_name":                     aws.StringValue(apiObject.MetricName),
+		"metric_namespace":                aws.StringValue(apiObject.MetricNamespace),
+		"metric_statistic_recommendation": aws.StringValue(apiObject.MetricStatisticRecommendation),
+	}
+
+	return []interface{}{tfMap}
+}
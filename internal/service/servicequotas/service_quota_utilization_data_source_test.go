@@ -0,0 +1,55 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceQuotasServiceQuotaUtilizationDataSource_basic(t *testing.T) {
+	const dataSourceName = "data.aws_servicequotas_service_quota_utilization.test"
+	const quotaDataSourceName = "data.aws_servicequotas_service_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// RDS: DB instances
+				Config: testAccServiceQuotaUtilizationDataSourceConfig_basic("rds", "L-7B6409FD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "quota_name", quotaDataSourceName, "quota_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "value", quotaDataSourceName, "value"),
+					resource.TestMatchResourceAttr(dataSourceName, "usage", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage_metric.0.metric_namespace", "AWS/Usage"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "usage_metric.0.metric_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "utilization"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceQuotaUtilizationDataSourceConfig_basic(serviceCode, quotaCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quota" "test" {
+  quota_code   = %[1]q
+  service_code = %[2]q
+}
+
+data "aws_servicequotas_service_quota_utilization" "test" {
+  quota_code   = %[1]q
+  service_code = %[2]q
+}
+`, quotaCode, serviceCode)
+}
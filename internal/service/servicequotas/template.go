@@ -0,0 +1,209 @@
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const templateIDSeparator = ","
+
+// ResourceTemplate manages a quota increase request in the Service Quotas template
+// that is applied to new accounts created in the organization.
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplatePut,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplatePut,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_quota": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateQuotaCode,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"service_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceCode,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceTemplatePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region := d.Get("region").(string)
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+	id := templateCreateResourceID(region, quotaCode, serviceCode)
+	input := &servicequotas.PutServiceQuotaIncreaseRequestIntoTemplateInput{
+		AwsRegion:    aws.String(region),
+		DesiredValue: aws.Float64(d.Get("value").(float64)),
+		QuotaCode:    aws.String(quotaCode),
+		ServiceCode:  aws.String(serviceCode),
+	}
+
+	_, err := conn.PutServiceQuotaIncreaseRequestIntoTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting Service Quotas Template (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region, quotaCode, serviceCode, err := templateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindTemplateByID(ctx, conn, region, quotaCode, serviceCode)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("global_quota", output.GlobalQuota)
+	d.Set("quota_code", output.QuotaCode)
+	d.Set("quota_name", output.QuotaName)
+	d.Set("region", output.AwsRegion)
+	d.Set("service_code", output.ServiceCode)
+	d.Set("service_name", output.ServiceName)
+	d.Set("unit", output.Unit)
+	d.Set("value", output.DesiredValue)
+
+	return nil
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region, quotaCode, serviceCode, err := templateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template: %s", d.Id())
+	_, err = conn.DeleteServiceQuotaIncreaseRequestFromTemplateWithContext(ctx, &servicequotas.DeleteServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func templateCreateResourceID(region, quotaCode, serviceCode string) string {
+	parts := []string{region, quotaCode, serviceCode}
+	id := strings.Join(parts, templateIDSeparator)
+
+	return id
+}
+
+func templateParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected REGION%[2]sQUOTA-CODE%[2]sSERVICE-CODE", id, templateIDSeparator)
+}
+
+func FindTemplateByID(ctx context.Context, conn *servicequotas.ServiceQuotas, region, quotaCode, serviceCode string) (*servicequotas.ServiceQuotaIncreaseRequestInTemplate, error) {
+	input := &servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	output, err := conn.GetServiceQuotaIncreaseRequestFromTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceQuotaIncreaseRequestInTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceQuotaIncreaseRequestInTemplate, nil
+}
@@ -0,0 +1,127 @@
+package servicequotas
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceTemplateAssociation associates the Service Quotas template with the organization
+// so that its quota increase requests are applied to new member accounts.
+func ResourceTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateAssociationCreate,
+		ReadWithoutTimeout:   resourceTemplateAssociationRead,
+		DeleteWithoutTimeout: resourceTemplateAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTemplateAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	_, err := conn.AssociateServiceQuotaTemplateWithContext(ctx, &servicequotas.AssociateServiceQuotaTemplateInput{})
+
+	if err != nil {
+		return diag.Errorf("creating Service Quotas Template Association: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceTemplateAssociationRead(ctx, d, meta)
+}
+
+func resourceTemplateAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	status, err := FindTemplateAssociation(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceTemplateAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Service Quotas Template Association: %s", d.Id())
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template Association: %s", d.Id())
+	_, err := conn.DisassociateServiceQuotaTemplateWithContext(ctx, &servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Service Quotas Template Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTemplateAssociation(ctx context.Context, conn *servicequotas.ServiceQuotas) (string, error) {
+	input := &servicequotas.GetAssociationForServiceQuotaTemplateInput{}
+
+	output, err := conn.GetAssociationForServiceQuotaTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus); status == servicequotas.ServiceQuotaTemplateAssociationStatusDisassociated {
+		return "", &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(output.ServiceQuotaTemplateAssociationStatus), nil
+}
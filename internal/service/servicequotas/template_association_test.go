@@ -0,0 +1,148 @@
+package servicequotas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Template association tests are run from TestAccServiceQuotasTemplate_serial
+// as the association is a singleton per organization.
+
+func testAccTemplateAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAssociationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateAssociationExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicequotas.ServiceQuotaTemplateAssociationStatusAssociated),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func testAccTemplateAssociation_skipDestroy(t *testing.T) {
+	resourceName := "aws_servicequotas_template_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateAssociationRetained,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateAssociationConfig_skipDestroy(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template_association" {
+			continue
+		}
+
+		_, err := tfservicequotas.FindTemplateAssociation(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCheckTemplateAssociationRetained verifies the association is left in place
+// by skip_destroy and then removes it so later tests start clean.
+func testAccCheckTemplateAssociationRetained(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+	ctx := context.Background()
+
+	if _, err := tfservicequotas.FindTemplateAssociation(ctx, conn); err != nil {
+		return fmt.Errorf("expected Service Quotas Template Association to be retained: %w", err)
+	}
+
+	_, err := conn.DisassociateServiceQuotaTemplateWithContext(ctx, &servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	return err
+}
+
+func testAccCheckTemplateAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+		_, err := tfservicequotas.FindTemplateAssociation(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccTemplateAssociationConfig_basic() string {
+	return `
+resource "aws_servicequotas_template_association" "test" {}
+`
+}
+
+func testAccTemplateAssociationConfig_skipDestroy() string {
+	return `
+resource "aws_servicequotas_template_association" "test" {
+  skip_destroy = true
+}
+`
+}
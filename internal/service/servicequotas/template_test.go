@@ -0,0 +1,190 @@
+package servicequotas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	templateQuotaCode   = "L-2ACBD22F" // Lambda: Function and layer storage
+	templateServiceCode = "lambda"
+)
+
+func TestAccServiceQuotasTemplate_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":       testAccTemplate_basic,
+		"disappears":  testAccTemplate_disappears,
+		"value":       testAccTemplate_value,
+		"association": testAccTemplateAssociation_basic,
+		"skipDestroy": testAccTemplateAssociation_skipDestroy,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccTemplate_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+	regionDataSourceName := "data.aws_region.current"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(templateQuotaCode, templateServiceCode, "80"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "global_quota"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", templateQuotaCode),
+					resource.TestCheckResourceAttrSet(resourceName, "quota_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "region", regionDataSourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_code", templateServiceCode),
+					resource.TestCheckResourceAttrSet(resourceName, "service_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "unit"),
+					resource.TestCheckResourceAttr(resourceName, "value", "80"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_disappears(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(templateQuotaCode, templateServiceCode, "80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicequotas.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_value(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(templateQuotaCode, templateServiceCode, "80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "80"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_basic(templateQuotaCode, templateServiceCode, "90"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template" {
+			continue
+		}
+
+		_, err := tfservicequotas.FindTemplateByID(context.Background(), conn, rs.Primary.Attributes["region"], rs.Primary.Attributes["quota_code"], rs.Primary.Attributes["service_code"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+		_, err := tfservicequotas.FindTemplateByID(context.Background(), conn, rs.Primary.Attributes["region"], rs.Primary.Attributes["quota_code"], rs.Primary.Attributes["service_code"])
+
+		return err
+	}
+}
+
+func testAccTemplateConfig_basic(quotaCode, serviceCode, value string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicequotas_template" "test" {
+  region       = data.aws_region.current.name
+  quota_code   = %[1]q
+  service_code = %[2]q
+  value        = %[3]s
+}
+`, quotaCode, serviceCode, value)
+}
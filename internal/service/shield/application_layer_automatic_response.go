@@ -0,0 +1,194 @@
+package shield
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	applicationLayerAutomaticResponseActionBlock = "BLOCK"
+	applicationLayerAutomaticResponseActionCount = "COUNT"
+)
+
+func applicationLayerAutomaticResponseAction_Values() []string {
+	return []string{
+		applicationLayerAutomaticResponseActionBlock,
+		applicationLayerAutomaticResponseActionCount,
+	}
+}
+
+func ResourceApplicationLayerAutomaticResponse() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationLayerAutomaticResponseCreate,
+		ReadWithoutTimeout:   resourceApplicationLayerAutomaticResponseRead,
+		UpdateWithoutTimeout: resourceApplicationLayerAutomaticResponseUpdate,
+		DeleteWithoutTimeout: resourceApplicationLayerAutomaticResponseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(applicationLayerAutomaticResponseAction_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceApplicationLayerAutomaticResponseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &shield.EnableApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	_, err := conn.EnableApplicationLayerAutomaticResponseWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("enabling Shield Application Layer Automatic Response (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	return resourceApplicationLayerAutomaticResponseRead(ctx, d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	config, err := FindApplicationLayerAutomaticResponseByResourceARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Application Layer Automatic Response (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", flattenResponseAction(config.Action))
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceApplicationLayerAutomaticResponseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	input := &shield.UpdateApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateApplicationLayerAutomaticResponseWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(ctx, d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Deleting Shield Application Layer Automatic Response: %s", d.Id())
+	_, err := conn.DisableApplicationLayerAutomaticResponseWithContext(ctx, &shield.DisableApplicationLayerAutomaticResponseInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Shield Application Layer Automatic Response (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindApplicationLayerAutomaticResponseByResourceARN(ctx context.Context, conn *shield.Shield, arn string) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	input := &shield.DescribeProtectionInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeProtectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil || output.Protection.ApplicationLayerAutomaticResponseConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	config := output.Protection.ApplicationLayerAutomaticResponseConfiguration
+
+	if status := aws.StringValue(config.Status); status == shield.ApplicationLayerAutomaticResponseStatusDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return config, nil
+}
+
+func expandResponseAction(action string) *shield.ResponseAction {
+	switch action {
+	case applicationLayerAutomaticResponseActionBlock:
+		return &shield.ResponseAction{Block: &shield.BlockAction{}}
+	case applicationLayerAutomaticResponseActionCount:
+		return &shield.ResponseAction{Count: &shield.CountAction{}}
+	}
+
+	return nil
+}
+
+func flattenResponseAction(apiObject *shield.ResponseAction) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	if apiObject.Block != nil {
+		return applicationLayerAutomaticResponseActionBlock
+	}
+
+	if apiObject.Count != nil {
+		return applicationLayerAutomaticResponseActionCount
+	}
+
+	return ""
+}
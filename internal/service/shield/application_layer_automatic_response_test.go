@@ -0,0 +1,181 @@
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldApplicationLayerAutomaticResponse_basic(t *testing.T) {
+	resourceName := "aws_shield_application_layer_automatic_response.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			// CLOUDFRONT scoped web ACLs can only be managed in us-east-1.
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationLayerAutomaticResponseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "COUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "COUNT"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_cloudfront_distribution.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationLayerAutomaticResponseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_application_layer_automatic_response" {
+			continue
+		}
+
+		_, err := tfshield.FindApplicationLayerAutomaticResponseByResourceARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield Application Layer Automatic Response %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationLayerAutomaticResponseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Application Layer Automatic Response ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindApplicationLayerAutomaticResponseByResourceARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccApplicationLayerAutomaticResponseConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "CLOUDFRONT"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "aws_cloudfront_distribution" "test" {
+  origin {
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+
+    # This is a fake origin and it's set to this name to indicate that.
+    domain_name = "%[1]s.com"
+    origin_id   = %[1]q
+  }
+
+  enabled             = false
+  wait_for_deployment = false
+  web_acl_id          = aws_wafv2_web_acl.test.arn
+
+  default_cache_behavior {
+    allowed_methods  = ["HEAD", "GET"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = %[1]q
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "redirect-to-https"
+    min_ttl                = 0
+    default_ttl            = 0
+    max_ttl                = 0
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = aws_cloudfront_distribution.test.arn
+}
+
+resource "aws_shield_application_layer_automatic_response" "test" {
+  resource_arn = aws_shield_protection.test.resource_arn
+  action       = %[2]q
+}
+`, rName, action)
+}
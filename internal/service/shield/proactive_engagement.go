@@ -0,0 +1,224 @@
+package shield
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProactiveEngagementPut,
+		ReadWithoutTimeout:   resourceProactiveEngagementRead,
+		UpdateWithoutTimeout: resourceProactiveEngagementPut,
+		DeleteWithoutTimeout: resourceProactiveEngagementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.IsNewResource() || d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Shield emergency contact settings: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{})
+		} else {
+			_, err = conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{})
+		}
+
+		// Enabling or disabling an already enabled or disabled proactive engagement is an error.
+		if err != nil && !tfawserr.ErrCodeEquals(err, shield.ErrCodeInvalidOperationException) {
+			return diag.Errorf("updating Shield proactive engagement: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceProactiveEngagementRead(ctx, d, meta)
+}
+
+func resourceProactiveEngagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	subscription, err := FindSubscription(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Shield Proactive Engagement (%s): %s", d.Id(), err)
+	}
+
+	output, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, &shield.DescribeEmergencyContactSettingsInput{})
+
+	if err != nil {
+		return diag.Errorf("reading Shield emergency contact settings: %s", err)
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return diag.Errorf("setting emergency_contact: %s", err)
+	}
+	d.Set("enabled", aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceProactiveEngagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Deleting Shield Proactive Engagement: %s", d.Id())
+	_, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException, shield.ErrCodeInvalidOperationException) {
+		err = nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Shield proactive engagement: %s", err)
+	}
+
+	_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("removing Shield emergency contact settings: %s", err)
+	}
+
+	return nil
+}
+
+func FindSubscription(ctx context.Context, conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscriptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
+
+func expandEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	apiObjects := []*shield.EmergencyContact{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
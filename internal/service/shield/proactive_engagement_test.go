@@ -0,0 +1,117 @@
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Proactive engagement is an account-level setting, so these tests must not run in parallel.
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		subscription, err := tfshield.FindSubscription(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+			return fmt.Errorf("Shield Proactive Engagement %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProactiveEngagementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Proactive Engagement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindSubscription(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccProactiveEngagementConfig_basic(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = "test@example.com"
+    phone_number  = "+12358132134"
+  }
+}
+`, enabled)
+}
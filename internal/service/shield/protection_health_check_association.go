@@ -3,7 +3,6 @@ package shield
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/shield"
@@ -28,9 +27,10 @@ func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"health_check_arn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validHealthCheckARN,
 			},
 		},
 	}
@@ -81,10 +81,17 @@ func ResourceProtectionHealthCheckAssociationRead(d *schema.ResourceData, meta i
 		return fmt.Errorf("error reading Shield Protection Health Check Association (%s): %s", d.Id(), err)
 	}
 
-	isHealthCheck := stringInSlice(strings.Split(healthCheckArn, "/")[1], aws.StringValueSlice(resp.Protection.HealthCheckIds))
-	if !isHealthCheck {
+	healthCheckID, err := healthCheckIDFromARN(healthCheckArn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Protection Health Check Association (%s): %w", d.Id(), err)
+	}
+
+	isHealthCheck := stringInSlice(healthCheckID, aws.StringValueSlice(resp.Protection.HealthCheckIds))
+	if !d.IsNewResource() && !isHealthCheck {
 		log.Printf("[WARN] Shield Protection Health Check Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
 	d.Set("health_check_arn", healthCheckArn)
@@ -109,6 +116,10 @@ func ResourceProtectionHealthCheckAssociationDelete(d *schema.ResourceData, meta
 
 	_, err = conn.DisassociateHealthCheck(input)
 
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error disassociating Route53 Health Check (%s) from Shield Protected resource (%s): %s", d.Get("health_check_arn"), d.Get("shield_protection_id"), err)
 	}
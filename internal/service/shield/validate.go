@@ -0,0 +1,45 @@
+package shield
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validHealthCheckARN validates that the value is the ARN of a Route 53 health check,
+// e.g. arn:aws:route53:::healthcheck/abcdef01-2345-6789-abcd-ef0123456789.
+func validHealthCheckARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	if _, err := healthCheckIDFromARN(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a Route 53 health check ARN: %w", k, v, err))
+	}
+
+	return ws, errors
+}
+
+func healthCheckIDFromARN(v string) (string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	if parsedARN.Service != "route53" {
+		return "", fmt.Errorf("unexpected service %q", parsedARN.Service)
+	}
+
+	resourceType, healthCheckID, found := strings.Cut(parsedARN.Resource, "/")
+
+	if !found || resourceType != "healthcheck" || healthCheckID == "" {
+		return "", fmt.Errorf("unexpected resource %q", parsedARN.Resource)
+	}
+
+	return healthCheckID, nil
+}
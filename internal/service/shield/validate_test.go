@@ -0,0 +1,33 @@
+package shield
+
+import (
+	"testing"
+)
+
+func TestValidHealthCheckARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:route53:::healthcheck/abcdef01-2345-6789-abcd-ef0123456789",
+		"arn:aws-us-gov:route53:::healthcheck/abcdef01-2345-6789-abcd-ef0123456789",
+	}
+
+	for _, v := range validARNs {
+		if _, errors := validHealthCheckARN(v, "health_check_arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid health check ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"abcdef01-2345-6789-abcd-ef0123456789",
+		"arn:aws:route53:::hostedzone/Z1D633PJN98FT9",
+		"arn:aws:route53:::healthcheck/",
+		"arn:aws:ec2:us-east-1:123456789012:eip-allocation/eipalloc-12345678",
+	}
+
+	for _, v := range invalidARNs {
+		if _, errors := validHealthCheckARN(v, "health_check_arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid health check ARN", v)
+		}
+	}
+}
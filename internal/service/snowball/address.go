@@ -0,0 +1,194 @@
+package snowball
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressCreate,
+		ReadWithoutTimeout:   resourceAddressRead,
+		DeleteWithoutTimeout: resourceAddressDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_restricted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"landmark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefecture_or_district": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state_or_province": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street1": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street2": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"street3": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameAddress = "Address"
+)
+
+func resourceAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	name := d.Get("name").(string)
+	address := &snowball.Address{
+		City:            aws.String(d.Get("city").(string)),
+		Country:         aws.String(d.Get("country").(string)),
+		Name:            aws.String(name),
+		PhoneNumber:     aws.String(d.Get("phone_number").(string)),
+		PostalCode:      aws.String(d.Get("postal_code").(string)),
+		StateOrProvince: aws.String(d.Get("state_or_province").(string)),
+		Street1:         aws.String(d.Get("street1").(string)),
+	}
+
+	if v, ok := d.GetOk("is_restricted"); ok {
+		address.IsRestricted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("company"); ok {
+		address.Company = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("landmark"); ok {
+		address.Landmark = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefecture_or_district"); ok {
+		address.PrefectureOrDistrict = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street2"); ok {
+		address.Street2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street3"); ok {
+		address.Street3 = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAddressWithContext(ctx, &snowball.CreateAddressInput{
+		Address: address,
+	})
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameAddress, name, err)
+	}
+
+	if output == nil || output.AddressId == nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameAddress, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.AddressId))
+
+	return resourceAddressRead(ctx, d, meta)
+}
+
+func resourceAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	output, err := FindAddressByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionReading, ResNameAddress, d.Id(), err)
+	}
+
+	d.Set("city", output.City)
+	d.Set("company", output.Company)
+	d.Set("country", output.Country)
+	d.Set("is_restricted", output.IsRestricted)
+	d.Set("landmark", output.Landmark)
+	d.Set("name", output.Name)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("postal_code", output.PostalCode)
+	d.Set("prefecture_or_district", output.PrefectureOrDistrict)
+	d.Set("state_or_province", output.StateOrProvince)
+	d.Set("street1", output.Street1)
+	d.Set("street2", output.Street2)
+	d.Set("street3", output.Street3)
+
+	return nil
+}
+
+func resourceAddressDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Addresses cannot be deleted.
+	log.Printf("[DEBUG] Removing Snow Family Address (%s) from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package snowball
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"force_create_jobs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"initial_cluster_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"job_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"long_term_pricing_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notification": notificationSchema(),
+			"remote_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.RemoteManagement_Values(), false),
+			},
+			"resources": resourcesSchema(),
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	ResNameCluster = "Cluster"
+)
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.CreateClusterInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobType:        aws.String(d.Get("job_type").(string)),
+		ShippingOption: aws.String(d.Get("shipping_option").(string)),
+		SnowballType:   aws.String(d.Get("snowball_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("force_create_jobs"); ok {
+		input.ForceCreateJobs = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("initial_cluster_size"); ok {
+		input.InitialClusterSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("long_term_pricing_ids"); ok && len(v.([]interface{})) > 0 {
+		input.LongTermPricingIds = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("notification"); ok {
+		input.Notification = expandNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok {
+		input.Resources = expandJobResource(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameCluster, "", err)
+	}
+
+	if output == nil || output.ClusterId == nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameCluster, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.ClusterId))
+
+	if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionWaitingForCreation, ResNameCluster, d.Id(), err)
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	output, err := FindClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionReading, ResNameCluster, d.Id(), err)
+	}
+
+	jobs, err := FindClusterJobsByClusterID(ctx, conn, d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionReading, ResNameCluster, d.Id(), err)
+	}
+
+	var jobIDs []string
+	for _, v := range jobs {
+		jobIDs = append(jobIDs, aws.StringValue(v.JobId))
+	}
+
+	d.Set("address_id", output.AddressId)
+	d.Set("cluster_state", output.ClusterState)
+	d.Set("description", output.Description)
+	d.Set("forwarding_address_id", output.ForwardingAddressId)
+	d.Set("job_ids", jobIDs)
+	d.Set("job_type", output.JobType)
+	d.Set("kms_key_arn", output.KmsKeyARN)
+	if err := d.Set("notification", flattenNotification(output.Notification)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionSetting, ResNameCluster, d.Id(), err)
+	}
+	if err := d.Set("resources", flattenJobResource(output.Resources)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionSetting, ResNameCluster, d.Id(), err)
+	}
+	d.Set("role_arn", output.RoleARN)
+	d.Set("shipping_option", output.ShippingOption)
+	d.Set("snowball_type", output.SnowballType)
+
+	return nil
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.UpdateClusterInput{
+		ClusterId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("address_id") {
+		input.AddressId = aws.String(d.Get("address_id").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		input.Notification = expandNotification(d.Get("notification").([]interface{}))
+	}
+
+	if d.HasChange("resources") {
+		input.Resources = expandJobResource(d.Get("resources").([]interface{}))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleARN = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("shipping_option") {
+		input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+	}
+
+	_, err := conn.UpdateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionUpdating, ResNameCluster, d.Id(), err)
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	log.Printf("[INFO] Cancelling Snow Family Cluster (%s)", d.Id())
+	_, err := conn.CancelClusterWithContext(ctx, &snowball.CancelClusterInput{
+		ClusterId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionDeleting, ResNameCluster, d.Id(), err)
+	}
+
+	if _, err := waitClusterCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionWaitingForDeletion, ResNameCluster, d.Id(), err)
+	}
+
+	return nil
+}
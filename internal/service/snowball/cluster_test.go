@@ -0,0 +1,154 @@
+package snowball_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsnowball "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSnowballCluster_basic(t *testing.T) {
+	testAccPreCheckOrderDevices(t)
+
+	var v snowball.ClusterMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(snowball.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id", "aws_snowball_address.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_state"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "LOCAL_USE"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_option", "SECOND_DAY"),
+					resource.TestCheckResourceAttr(resourceName, "snowball_type", "EDGE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snowball_capacity_preference"},
+			},
+			{
+				Config: testAccClusterConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowballCluster_disappears(t *testing.T) {
+	testAccPreCheckOrderDevices(t)
+
+	var v snowball.ClusterMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(snowball.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsnowball.ResourceCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccPreCheckOrderDevices skips tests that place real device orders unless explicitly enabled.
+func testAccPreCheckOrderDevices(t *testing.T) {
+	key := "SNOWBALL_ORDER_DEVICES"
+	if os.Getenv(key) == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+}
+
+func testAccCheckClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_snowball_cluster" {
+			continue
+		}
+
+		_, err := tfsnowball.FindClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Snow Family Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckClusterExists(n string, v *snowball.ClusterMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snow Family Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+		output, err := tfsnowball.FindClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClusterConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccAddressConfig_basic(rName), fmt.Sprintf(`
+resource "aws_snowball_cluster" "test" {
+  address_id                   = aws_snowball_address.test.id
+  description                  = %[1]q
+  job_type                     = "LOCAL_USE"
+  shipping_option              = "SECOND_DAY"
+  snowball_capacity_preference = "T100"
+  snowball_type                = "EDGE"
+}
+`, description))
+}
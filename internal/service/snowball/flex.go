@@ -0,0 +1,374 @@
+package snowball
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func notificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"job_states_to_notify": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(snowball.JobState_Values(), false),
+					},
+				},
+				"notify_all": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"sns_topic_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ec2_ami_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ami_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"snowball_ami_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"lambda_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"event_trigger_resource_arns": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"lambda_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"s3_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"key_range": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"begin_marker": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"end_marker": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandNotification(tfList []interface{}) *snowball.Notification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &snowball.Notification{}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *snowball.Notification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_states_to_notify": aws.StringValueSlice(apiObject.JobStatesToNotify),
+		"notify_all":           aws.BoolValue(apiObject.NotifyAll),
+		"sns_topic_arn":        aws.StringValue(apiObject.SnsTopicARN),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandJobResource(tfList []interface{}) *snowball.JobResource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &snowball.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Ec2AmiResources = expandEc2AmiResources(v)
+	}
+
+	if v, ok := tfMap["lambda_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LambdaResources = expandLambdaResources(v)
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3Resources = expandS3Resources(v)
+	}
+
+	return apiObject
+}
+
+func expandEc2AmiResources(tfList []interface{}) []*snowball.Ec2AmiResource {
+	var apiObjects []*snowball.Ec2AmiResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.Ec2AmiResource{
+			AmiId: aws.String(tfMap["ami_id"].(string)),
+		}
+
+		if v, ok := tfMap["snowball_ami_id"].(string); ok && v != "" {
+			apiObject.SnowballAmiId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLambdaResources(tfList []interface{}) []*snowball.LambdaResource {
+	var apiObjects []*snowball.LambdaResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.LambdaResource{
+			LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+		}
+
+		if v, ok := tfMap["event_trigger_resource_arns"].([]interface{}); ok && len(v) > 0 {
+			for _, arn := range flex.ExpandStringList(v) {
+				apiObject.EventTriggers = append(apiObject.EventTriggers, &snowball.EventTriggerDefinition{
+					EventResourceARN: arn,
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Resources(tfList []interface{}) []*snowball.S3Resource {
+	var apiObjects []*snowball.S3Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.S3Resource{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			keyRange := v[0].(map[string]interface{})
+			apiObject.KeyRange = &snowball.KeyRange{}
+
+			if v, ok := keyRange["begin_marker"].(string); ok && v != "" {
+				apiObject.KeyRange.BeginMarker = aws.String(v)
+			}
+
+			if v, ok := keyRange["end_marker"].(string); ok && v != "" {
+				apiObject.KeyRange.EndMarker = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenJobResource(apiObject *snowball.JobResource) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ec2_ami_resource": flattenEc2AmiResources(apiObject.Ec2AmiResources),
+		"lambda_resource":  flattenLambdaResources(apiObject.LambdaResources),
+		"s3_resource":      flattenS3Resources(apiObject.S3Resources),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEc2AmiResources(apiObjects []*snowball.Ec2AmiResource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"ami_id":          aws.StringValue(apiObject.AmiId),
+			"snowball_ami_id": aws.StringValue(apiObject.SnowballAmiId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLambdaResources(apiObjects []*snowball.LambdaResource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var arns []interface{}
+
+		for _, v := range apiObject.EventTriggers {
+			if v != nil {
+				arns = append(arns, aws.StringValue(v.EventResourceARN))
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"event_trigger_resource_arns": arns,
+			"lambda_arn":                  aws.StringValue(apiObject.LambdaArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenS3Resources(apiObjects []*snowball.S3Resource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"bucket_arn": aws.StringValue(apiObject.BucketArn),
+		}
+
+		if v := apiObject.KeyRange; v != nil && (v.BeginMarker != nil || v.EndMarker != nil) {
+			tfMap["key_range"] = []interface{}{map[string]interface{}{
+				"begin_marker": aws.StringValue(v.BeginMarker),
+				"end_marker":   aws.StringValue(v.EndMarker),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenShippingDetails(apiObject *snowball.ShippingDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"inbound_shipment":  flattenShipment(apiObject.InboundShipment),
+		"outbound_shipment": flattenShipment(apiObject.OutboundShipment),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenShipment(apiObject *snowball.Shipment) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"status":          aws.StringValue(apiObject.Status),
+		"tracking_number": aws.StringValue(apiObject.TrackingNumber),
+	}
+
+	return []interface{}{tfMap}
+}
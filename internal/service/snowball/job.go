@@ -0,0 +1,357 @@
+package snowball
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"long_term_pricing_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"notification": notificationSchema(),
+			"remote_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.RemoteManagement_Values(), false),
+			},
+			"resources": resourcesSchema(),
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inbound_shipment":  shipmentSchema(),
+						"outbound_shipment": shipmentSchema(),
+					},
+				},
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+	}
+}
+
+func shipmentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tracking_number": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameJob = "Job"
+)
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.CreateJobInput{}
+
+	if v, ok := d.GetOk("address_id"); ok {
+		input.AddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.ClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_type"); ok {
+		input.JobType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("long_term_pricing_id"); ok {
+		input.LongTermPricingId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok {
+		input.Notification = expandNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok {
+		input.Resources = expandJobResource(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("shipping_option"); ok {
+		input.ShippingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameJob, "", err)
+	}
+
+	if output == nil || output.JobId == nil {
+		return create.DiagError(names.Snowball, create.ErrActionCreating, ResNameJob, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if _, err := waitJobCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionWaitingForCreation, ResNameJob, d.Id(), err)
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	output, err := FindJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snow Family Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionReading, ResNameJob, d.Id(), err)
+	}
+
+	d.Set("address_id", output.AddressId)
+	d.Set("cluster_id", output.ClusterId)
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("forwarding_address_id", output.ForwardingAddressId)
+	d.Set("job_state", output.JobState)
+	d.Set("job_type", output.JobType)
+	d.Set("kms_key_arn", output.KmsKeyARN)
+	d.Set("long_term_pricing_id", output.LongTermPricingId)
+	if err := d.Set("notification", flattenNotification(output.Notification)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionSetting, ResNameJob, d.Id(), err)
+	}
+	d.Set("remote_management", output.RemoteManagement)
+	if err := d.Set("resources", flattenJobResource(output.Resources)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionSetting, ResNameJob, d.Id(), err)
+	}
+	d.Set("role_arn", output.RoleARN)
+	if err := d.Set("shipping_details", flattenShippingDetails(output.ShippingDetails)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionSetting, ResNameJob, d.Id(), err)
+	}
+	if output.ShippingDetails != nil {
+		d.Set("shipping_option", output.ShippingDetails.ShippingOption)
+	} else {
+		d.Set("shipping_option", nil)
+	}
+	d.Set("snowball_capacity_preference", output.SnowballCapacityPreference)
+	d.Set("snowball_id", output.SnowballId)
+	d.Set("snowball_type", output.SnowballType)
+
+	return nil
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	input := &snowball.UpdateJobInput{
+		JobId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("address_id") {
+		input.AddressId = aws.String(d.Get("address_id").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		input.Notification = expandNotification(d.Get("notification").([]interface{}))
+	}
+
+	if d.HasChange("resources") {
+		input.Resources = expandJobResource(d.Get("resources").([]interface{}))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleARN = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("shipping_option") {
+		input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+	}
+
+	if d.HasChange("snowball_capacity_preference") {
+		input.SnowballCapacityPreference = aws.String(d.Get("snowball_capacity_preference").(string))
+	}
+
+	_, err := conn.UpdateJobWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionUpdating, ResNameJob, d.Id(), err)
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SnowballConn
+
+	log.Printf("[INFO] Cancelling Snow Family Job (%s)", d.Id())
+	_, err := conn.CancelJobWithContext(ctx, &snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionDeleting, ResNameJob, d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Snowball, create.ErrActionWaitingForDeletion, ResNameJob, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package snowball_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsnowball "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSnowballJob_basic(t *testing.T) {
+	testAccPreCheckOrderDevices(t)
+
+	var v snowball.JobMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(snowball.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id", "aws_snowball_address.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "New"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "IMPORT"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.s3_resource.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resources.0.s3_resource.0.bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_option", "SECOND_DAY"),
+					resource.TestCheckResourceAttr(resourceName, "snowball_type", "EDGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnowballJob_disappears(t *testing.T) {
+	testAccPreCheckOrderDevices(t)
+
+	var v snowball.JobMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_snowball_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(snowball.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsnowball.ResourceJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_snowball_job" {
+			continue
+		}
+
+		_, err := tfsnowball.FindJobByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Snow Family Job %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckJobExists(n string, v *snowball.JobMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Snow Family Job ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SnowballConn
+
+		output, err := tfsnowball.FindJobByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccAddressConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "importexport.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:*"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_snowball_job" "test" {
+  address_id                   = aws_snowball_address.test.id
+  description                  = %[2]q
+  job_type                     = "IMPORT"
+  role_arn                     = aws_iam_role.test.arn
+  shipping_option              = "SECOND_DAY"
+  snowball_capacity_preference = "T100"
+  snowball_type                = "EDGE"
+
+  resources {
+    s3_resource {
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
@@ -0,0 +1,42 @@
+package snowball
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusCluster(ctx context.Context, conn *snowball.Snowball, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ClusterState), nil
+	}
+}
+
+func statusJob(ctx context.Context, conn *snowball.Snowball, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.JobState), nil
+	}
+}
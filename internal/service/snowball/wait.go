@@ -0,0 +1,83 @@
+package snowball
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitClusterCreated(ctx context.Context, conn *snowball.Snowball, id string, timeout time.Duration) (*snowball.ClusterMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.ClusterStatePending},
+		Target:  []string{snowball.ClusterStateAwaitingQuorum, snowball.ClusterStateInUse},
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.ClusterMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterCancelled(ctx context.Context, conn *snowball.Snowball, id string, timeout time.Duration) (*snowball.ClusterMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.ClusterStateAwaitingQuorum, snowball.ClusterStatePending},
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.ClusterMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCreated(ctx context.Context, conn *snowball.Snowball, id string, timeout time.Duration) (*snowball.JobMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.JobStatePending},
+		Target: []string{
+			snowball.JobStateNew,
+			snowball.JobStatePreparingAppliance,
+			snowball.JobStatePreparingShipment,
+			snowball.JobStateInTransitToCustomer,
+			snowball.JobStateWithCustomer,
+		},
+		Refresh: statusJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.JobMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(ctx context.Context, conn *snowball.Snowball, id string, timeout time.Duration) (*snowball.JobMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.JobStateNew, snowball.JobStatePending, snowball.JobStatePreparingAppliance},
+		Target:  []string{},
+		Refresh: statusJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.JobMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
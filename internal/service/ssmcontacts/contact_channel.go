@@ -0,0 +1,265 @@
+package ssmcontacts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContactChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactChannelCreate,
+		ReadWithoutTimeout:   resourceContactChannelRead,
+		UpdateWithoutTimeout: resourceContactChannelUpdate,
+		DeleteWithoutTimeout: resourceContactChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customizeDiffActivationCode,
+
+		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+			},
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"defer_activation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 320),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceContactChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateContactChannelInput{
+		ContactId:       aws.String(d.Get("contact_id").(string)),
+		DeferActivation: aws.Bool(d.Get("defer_activation").(bool)),
+		DeliveryAddress: expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+		Name:            aws.String(name),
+		Type:            aws.String(d.Get("type").(string)),
+	}
+
+	output, err := conn.CreateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSM Contacts Contact Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactChannelArn))
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	channel, err := FindContactChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("activation_status", channel.ActivationStatus)
+	d.Set("arn", channel.ContactChannelArn)
+	d.Set("contact_id", channel.ContactArn)
+	if err := d.Set("delivery_address", flattenContactChannelAddress(channel.DeliveryAddress)); err != nil {
+		return diag.Errorf("setting delivery_address: %s", err)
+	}
+	d.Set("name", channel.Name)
+	d.Set("type", channel.Type)
+
+	return nil
+}
+
+func resourceContactChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChanges("delivery_address", "name") {
+		input := &ssmcontacts.UpdateContactChannelInput{
+			ContactChannelId: aws.String(d.Id()),
+			DeliveryAddress:  expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+			Name:             aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateContactChannelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("activation_code", "defer_activation", "delivery_address") {
+		channel, err := FindContactChannelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+		}
+
+		if aws.StringValue(channel.ActivationStatus) == ssmcontacts.ActivationStatusNotActivated {
+			if code := d.Get("activation_code").(string); d.HasChange("activation_code") && code != "" {
+				if err := activateContactChannel(ctx, conn, d.Id(), code); err != nil {
+					return diag.Errorf("activating SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+				}
+			} else if !d.Get("defer_activation").(bool) && d.HasChanges("defer_activation", "delivery_address") {
+				// A new delivery address, or a channel whose activation is no longer deferred, needs an activation code.
+				input := &ssmcontacts.SendActivationCodeInput{
+					ContactChannelId: aws.String(d.Id()),
+				}
+
+				_, err := conn.SendActivationCodeWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("sending SSM Contacts Contact Channel (%s) activation code: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[INFO] Deleting SSM Contacts Contact Channel: %s", d.Id())
+	_, err := conn.DeleteContactChannelWithContext(ctx, &ssmcontacts.DeleteContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// activateContactChannel activates the channel with the code delivered to its address and verifies that
+// the channel was activated.
+func activateContactChannel(ctx context.Context, conn *ssmcontacts.SSMContacts, id, code string) error {
+	input := &ssmcontacts.ActivateContactChannelInput{
+		ActivationCode:   aws.String(code),
+		ContactChannelId: aws.String(id),
+	}
+
+	_, err := conn.ActivateContactChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeValidationException) {
+		return fmt.Errorf("activation code was not accepted, it may be incorrect or expired: %w", err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	channel, err := FindContactChannelByID(ctx, conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if status := aws.StringValue(channel.ActivationStatus); status != ssmcontacts.ActivationStatusActivated {
+		return fmt.Errorf("unexpected activation status: %s", status)
+	}
+
+	return nil
+}
+
+// customizeDiffActivationCode rejects an activation code on a new channel, as the code is only delivered
+// once the channel has been created.
+func customizeDiffActivationCode(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" && diff.Get("activation_code").(string) != "" {
+		return errors.New("activation_code can only be set after the contact channel has been created and the code has been delivered")
+	}
+
+	return nil
+}
+
+func expandContactChannelAddress(tfList []interface{}) *ssmcontacts.ContactChannelAddress {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &ssmcontacts.ContactChannelAddress{
+		SimpleAddress: aws.String(tfMap["simple_address"].(string)),
+	}
+}
+
+func flattenContactChannelAddress(apiObject *ssmcontacts.ContactChannelAddress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"simple_address": aws.StringValue(apiObject.SimpleAddress),
+	}}
+}
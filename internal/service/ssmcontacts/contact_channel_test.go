@@ -0,0 +1,195 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccContactChannel_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", ssmcontacts.ActivationStatusNotActivated),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(fmt.Sprintf(`contact-channel/%s/.+`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", "aws_ssmcontacts_contact.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "defer_activation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", ssmcontacts.ChannelTypeEmail),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_code", "defer_activation"},
+			},
+		},
+	})
+}
+
+func testAccContactChannel_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceContactChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContactChannel_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccContactChannelConfig_basic(rName, rNameUpdated, "updated@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", ssmcontacts.ActivationStatusNotActivated),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "updated@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactChannel_activationCodeOnCreate(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContactChannelConfig_activationCode(rName, "123456"),
+				ExpectError: regexp.MustCompile(`activation_code can only be set after the contact channel has been created`),
+			},
+		},
+	})
+}
+
+func testAccCheckContactChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact_channel" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindContactChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindContactChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactChannelConfig_basic(rName, channelName, address string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id       = aws_ssmcontacts_contact.test.arn
+  name             = %[1]q
+  type             = "EMAIL"
+  defer_activation = true
+
+  delivery_address {
+    simple_address = %[2]q
+  }
+}
+`, channelName, address))
+}
+
+func testAccContactChannelConfig_activationCode(rName, activationCode string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id      = aws_ssmcontacts_contact.test.arn
+  name            = %[1]q
+  type            = "EMAIL"
+  activation_code = %[2]q
+
+  delivery_address {
+    simple_address = "test@example.com"
+  }
+}
+`, rName, activationCode))
+}
@@ -0,0 +1,61 @@
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindContactByID(ctx context.Context, conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactOutput, error) {
+	input := &ssmcontacts.GetContactInput{
+		ContactId: aws.String(id),
+	}
+
+	output, err := conn.GetContactWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindContactChannelByID(ctx context.Context, conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactChannelOutput, error) {
+	input := &ssmcontacts.GetContactChannelInput{
+		ContactChannelId: aws.String(id),
+	}
+
+	output, err := conn.GetContactChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
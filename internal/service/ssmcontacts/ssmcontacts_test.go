@@ -0,0 +1,56 @@
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Contacts depend on the Incident Manager replication set, which is limited
+// to one per account, so run serially locally and in TeamCity.
+func TestAccSSMContacts_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Contact": {
+			"basic":       testAccContact_basic,
+			"disappears":  testAccContact_disappears,
+			"tags":        testAccContact_tags,
+			"displayName": testAccContact_displayName,
+		},
+		"ContactChannel": {
+			"basic":                  testAccContactChannel_basic,
+			"disappears":             testAccContactChannel_disappears,
+			"update":                 testAccContactChannel_update,
+			"activationCodeOnCreate": testAccContactChannel_activationCodeOnCreate,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	input := &ssmcontacts.ListContactsInput{}
+
+	_, err := conn.ListContacts(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
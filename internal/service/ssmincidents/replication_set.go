@@ -0,0 +1,351 @@
+package ssmincidents
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// defaultKMSKeyARN is returned for Regions encrypted with an AWS owned key.
+	defaultKMSKeyARN = "DefaultKey"
+)
+
+func ResourceReplicationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationSetCreate,
+		ReadWithoutTimeout:   resourceReplicationSetRead,
+		UpdateWithoutTimeout: resourceReplicationSetUpdate,
+		DeleteWithoutTimeout: resourceReplicationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffRegionKMSKey,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  defaultKMSKeyARN,
+							ValidateFunc: validation.Any(
+								validation.StringInSlice([]string{defaultKMSKeyARN}, false),
+								verify.ValidARN,
+							),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceReplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ssmincidents.CreateReplicationSetInput{
+		Regions: expandRegionMapInputValues(d.Get("region").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateReplicationSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSM Incidents Replication Set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitReplicationSetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for SSM Incidents Replication Set (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	replicationSet, err := FindReplicationSetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Replication Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", replicationSet.Arn)
+	d.Set("created_by", replicationSet.CreatedBy)
+	d.Set("deletion_protected", replicationSet.DeletionProtected)
+	d.Set("last_modified_by", replicationSet.LastModifiedBy)
+	if err := d.Set("region", flattenRegionInfos(replicationSet.RegionMap)); err != nil {
+		return diag.Errorf("setting region: %s", err)
+	}
+	d.Set("status", replicationSet.Status)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceReplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	if d.HasChange("region") {
+		o, n := d.GetChange("region")
+		oldRegions := regionKMSKeyARNs(o.(*schema.Set).List())
+		newRegions := regionKMSKeyARNs(n.(*schema.Set).List())
+
+		// Only one Region can be added or removed per request. Regions are added before any are removed so
+		// that replacing the only Region never leaves the replication set empty.
+		var actions []*ssmincidents.UpdateReplicationSetAction
+
+		for _, name := range sortedRegionNames(newRegions) {
+			if _, ok := oldRegions[name]; ok {
+				continue
+			}
+
+			action := &ssmincidents.AddRegionAction{
+				RegionName: aws.String(name),
+			}
+
+			if v := newRegions[name]; v != defaultKMSKeyARN {
+				action.SseKmsKeyId = aws.String(v)
+			}
+
+			actions = append(actions, &ssmincidents.UpdateReplicationSetAction{AddRegionAction: action})
+		}
+
+		for _, name := range sortedRegionNames(oldRegions) {
+			if _, ok := newRegions[name]; ok {
+				continue
+			}
+
+			actions = append(actions, &ssmincidents.UpdateReplicationSetAction{
+				DeleteRegionAction: &ssmincidents.DeleteRegionAction{
+					RegionName: aws.String(name),
+				},
+			})
+		}
+
+		for _, action := range actions {
+			input := &ssmincidents.UpdateReplicationSetInput{
+				Actions: []*ssmincidents.UpdateReplicationSetAction{action},
+				Arn:     aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateReplicationSetWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating SSM Incidents Replication Set (%s) Regions: %s", d.Id(), err)
+			}
+
+			if _, err := waitReplicationSetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for SSM Incidents Replication Set (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating SSM Incidents Replication Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	log.Printf("[INFO] Deleting SSM Incidents Replication Set: %s", d.Id())
+	_, err := conn.DeleteReplicationSetWithContext(ctx, &ssmincidents.DeleteReplicationSetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationSetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for SSM Incidents Replication Set (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// customizeDiffRegionKMSKey rejects changes to the KMS key of a Region that remains in the replication set,
+// as the key can only be chosen when the Region is added.
+func customizeDiffRegionKMSKey(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("region") {
+		return nil
+	}
+
+	o, n := diff.GetChange("region")
+	oldRegions := regionKMSKeyARNs(o.(*schema.Set).List())
+	newRegions := regionKMSKeyARNs(n.(*schema.Set).List())
+
+	for _, name := range sortedRegionNames(newRegions) {
+		if old, ok := oldRegions[name]; ok && old != newRegions[name] {
+			return fmt.Errorf("the KMS key of Region (%s) cannot be changed, remove the Region and add it again instead", name)
+		}
+	}
+
+	return nil
+}
+
+func regionKMSKeyARNs(tfList []interface{}) map[string]string {
+	regions := make(map[string]string, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		regions[tfMap["name"].(string)] = tfMap["kms_key_arn"].(string)
+	}
+
+	return regions
+}
+
+func sortedRegionNames(regions map[string]string) []string {
+	names := make([]string, 0, len(regions))
+
+	for name := range regions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func expandRegionMapInputValues(tfList []interface{}) map[string]*ssmincidents.RegionMapInputValue {
+	apiObjects := make(map[string]*ssmincidents.RegionMapInputValue)
+
+	for name, kmsKeyARN := range regionKMSKeyARNs(tfList) {
+		apiObject := &ssmincidents.RegionMapInputValue{}
+
+		if kmsKeyARN != defaultKMSKeyARN {
+			apiObject.SseKmsKeyId = aws.String(kmsKeyARN)
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenRegionInfos(apiObjects map[string]*ssmincidents.RegionInfo) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"kms_key_arn": defaultKMSKeyARN,
+			"name":        name,
+		}
+
+		if v := apiObject.SseKmsKeyId; v != nil {
+			tfMap["kms_key_arn"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,642 @@
+package ssmincidents
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResponsePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResponsePlanCreate,
+		ReadWithoutTimeout:   resourceResponsePlanRead,
+		UpdateWithoutTimeout: resourceResponsePlanUpdate,
+		DeleteWithoutTimeout: resourceResponsePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_automation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"document_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dynamic_parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(ssmincidents.VariableType_Values(), false),
+										},
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"target_account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ssmincidents.SsmTargetAccount_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"engagements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"incident_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedupe_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"impact": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"incident_tags": tftags.TagsSchema(),
+						"notification_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sns_topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"summary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 4000),
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pagerduty": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+									"secret_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"service_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceResponsePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssmincidents.CreateResponsePlanInput{
+		IncidentTemplate: expandIncidentTemplate(d.Get("incident_template").([]interface{})),
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action"); ok {
+		input.Actions = expandActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("chat_channel"); ok && v.(*schema.Set).Len() > 0 {
+		input.ChatChannel = expandChatChannel(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engagements"); ok && v.(*schema.Set).Len() > 0 {
+		input.Engagements = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("integration"); ok {
+		input.Integrations = expandIntegrations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateResponsePlanWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSM Incidents Response Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	responsePlan, err := FindResponsePlanByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Response Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenActions(responsePlan.Actions)); err != nil {
+		return diag.Errorf("setting action: %s", err)
+	}
+	d.Set("arn", responsePlan.Arn)
+	if responsePlan.ChatChannel != nil {
+		d.Set("chat_channel", aws.StringValueSlice(responsePlan.ChatChannel.ChatbotSns))
+	} else {
+		d.Set("chat_channel", nil)
+	}
+	d.Set("display_name", responsePlan.DisplayName)
+	d.Set("engagements", aws.StringValueSlice(responsePlan.Engagements))
+	if err := d.Set("incident_template", flattenIncidentTemplate(responsePlan.IncidentTemplate)); err != nil {
+		return diag.Errorf("setting incident_template: %s", err)
+	}
+	if err := d.Set("integration", flattenIntegrations(responsePlan.Integrations)); err != nil {
+		return diag.Errorf("setting integration: %s", err)
+	}
+	d.Set("name", responsePlan.Name)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// Every optional list is sent, even when empty, so that removing its configuration clears it.
+		input := &ssmincidents.UpdateResponsePlanInput{
+			Actions:      expandActions(d.Get("action").([]interface{})),
+			Arn:          aws.String(d.Id()),
+			ChatChannel:  expandChatChannel(d.Get("chat_channel").(*schema.Set)),
+			DisplayName:  aws.String(d.Get("display_name").(string)),
+			Engagements:  flex.ExpandStringSet(d.Get("engagements").(*schema.Set)),
+			Integrations: expandIntegrations(d.Get("integration").([]interface{})),
+		}
+
+		if template := expandIncidentTemplate(d.Get("incident_template").([]interface{})); template != nil {
+			input.IncidentTemplateDedupeString = template.DedupeString
+			input.IncidentTemplateImpact = template.Impact
+			input.IncidentTemplateNotificationTargets = template.NotificationTargets
+			input.IncidentTemplateSummary = template.Summary
+			input.IncidentTemplateTags = template.IncidentTags
+			input.IncidentTemplateTitle = template.Title
+		}
+
+		_, err := conn.UpdateResponsePlanWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SSM Incidents Response Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating SSM Incidents Response Plan (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	log.Printf("[INFO] Deleting SSM Incidents Response Plan: %s", d.Id())
+	_, err := conn.DeleteResponsePlanWithContext(ctx, &ssmincidents.DeleteResponsePlanInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandChatChannel returns an empty chat channel when no SNS topics are configured, which removes the
+// chat channel from the response plan.
+func expandChatChannel(tfSet *schema.Set) *ssmincidents.ChatChannel {
+	if tfSet.Len() == 0 {
+		return &ssmincidents.ChatChannel{
+			Empty: &ssmincidents.EmptyChatChannel{},
+		}
+	}
+
+	return &ssmincidents.ChatChannel{
+		ChatbotSns: flex.ExpandStringSet(tfSet),
+	}
+}
+
+func expandIncidentTemplate(tfList []interface{}) *ssmincidents.IncidentTemplate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ssmincidents.IncidentTemplate{
+		Impact: aws.Int64(int64(tfMap["impact"].(int))),
+		Title:  aws.String(tfMap["title"].(string)),
+	}
+
+	if v, ok := tfMap["dedupe_string"].(string); ok && v != "" {
+		apiObject.DedupeString = aws.String(v)
+	}
+
+	if v, ok := tfMap["incident_tags"].(map[string]interface{}); ok {
+		apiObject.IncidentTags = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["notification_target"].(*schema.Set); ok {
+		apiObject.NotificationTargets = expandNotificationTargetItems(v.List())
+	}
+
+	if v, ok := tfMap["summary"].(string); ok && v != "" {
+		apiObject.Summary = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotificationTargetItems(tfList []interface{}) []*ssmincidents.NotificationTargetItem {
+	apiObjects := []*ssmincidents.NotificationTargetItem{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ssmincidents.NotificationTargetItem{
+			SnsTopicArn: aws.String(tfMap["sns_topic_arn"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandActions(tfList []interface{}) []*ssmincidents.Action {
+	apiObjects := []*ssmincidents.Action{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObjects
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["ssm_automation"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects = append(apiObjects, &ssmincidents.Action{
+				SsmAutomation: expandSSMAutomation(tfMap),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandSSMAutomation(tfMap map[string]interface{}) *ssmincidents.SsmAutomation {
+	apiObject := &ssmincidents.SsmAutomation{
+		DocumentName: aws.String(tfMap["document_name"].(string)),
+		RoleArn:      aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["document_version"].(string); ok && v != "" {
+		apiObject.DocumentVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["dynamic_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DynamicParameters = make(map[string]*ssmincidents.DynamicSsmParameterValue, len(v))
+
+		for name, variable := range v {
+			apiObject.DynamicParameters[name] = &ssmincidents.DynamicSsmParameterValue{
+				Variable: aws.String(variable.(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Parameters = make(map[string][]*string, v.Len())
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Parameters[tfMap["name"].(string)] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+		}
+	}
+
+	if v, ok := tfMap["target_account"].(string); ok && v != "" {
+		apiObject.TargetAccount = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIntegrations(tfList []interface{}) []*ssmincidents.Integration {
+	apiObjects := []*ssmincidents.Integration{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObjects
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["pagerduty"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects = append(apiObjects, &ssmincidents.Integration{
+				PagerDutyConfiguration: &ssmincidents.PagerDutyConfiguration{
+					Name: aws.String(tfMap["name"].(string)),
+					PagerDutyIncidentConfiguration: &ssmincidents.PagerDutyIncidentConfiguration{
+						ServiceId: aws.String(tfMap["service_id"].(string)),
+					},
+					SecretId: aws.String(tfMap["secret_id"].(string)),
+				},
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenIncidentTemplate(apiObject *ssmincidents.IncidentTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dedupe_string": aws.StringValue(apiObject.DedupeString),
+		"impact":        aws.Int64Value(apiObject.Impact),
+		"incident_tags": aws.StringValueMap(apiObject.IncidentTags),
+		"summary":       aws.StringValue(apiObject.Summary),
+		"title":         aws.StringValue(apiObject.Title),
+	}
+
+	var notificationTargets []interface{}
+
+	for _, v := range apiObject.NotificationTargets {
+		if v == nil {
+			continue
+		}
+
+		notificationTargets = append(notificationTargets, map[string]interface{}{
+			"sns_topic_arn": aws.StringValue(v.SnsTopicArn),
+		})
+	}
+
+	tfMap["notification_target"] = notificationTargets
+
+	return []interface{}{tfMap}
+}
+
+func flattenActions(apiObjects []*ssmincidents.Action) []interface{} {
+	var ssmAutomations []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.SsmAutomation == nil {
+			continue
+		}
+
+		ssmAutomations = append(ssmAutomations, flattenSSMAutomation(apiObject.SsmAutomation))
+	}
+
+	if len(ssmAutomations) == 0 {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ssm_automation": ssmAutomations,
+	}}
+}
+
+func flattenSSMAutomation(apiObject *ssmincidents.SsmAutomation) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"document_name":    aws.StringValue(apiObject.DocumentName),
+		"document_version": aws.StringValue(apiObject.DocumentVersion),
+		"role_arn":         aws.StringValue(apiObject.RoleArn),
+		"target_account":   aws.StringValue(apiObject.TargetAccount),
+	}
+
+	dynamicParameters := make(map[string]interface{}, len(apiObject.DynamicParameters))
+
+	for name, v := range apiObject.DynamicParameters {
+		if v == nil {
+			continue
+		}
+
+		dynamicParameters[name] = aws.StringValue(v.Variable)
+	}
+
+	tfMap["dynamic_parameters"] = dynamicParameters
+
+	var parameters []interface{}
+
+	for name, values := range apiObject.Parameters {
+		parameters = append(parameters, map[string]interface{}{
+			"name":   name,
+			"values": aws.StringValueSlice(values),
+		})
+	}
+
+	tfMap["parameter"] = parameters
+
+	return tfMap
+}
+
+func flattenIntegrations(apiObjects []*ssmincidents.Integration) []interface{} {
+	var pagerDutyConfigurations []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.PagerDutyConfiguration == nil {
+			continue
+		}
+
+		v := apiObject.PagerDutyConfiguration
+		tfMap := map[string]interface{}{
+			"name":      aws.StringValue(v.Name),
+			"secret_id": aws.StringValue(v.SecretId),
+		}
+
+		if v.PagerDutyIncidentConfiguration != nil {
+			tfMap["service_id"] = aws.StringValue(v.PagerDutyIncidentConfiguration.ServiceId)
+		}
+
+		pagerDutyConfigurations = append(pagerDutyConfigurations, tfMap)
+	}
+
+	if len(pagerDutyConfigurations) == 0 {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"pagerduty": pagerDutyConfigurations,
+	}}
+}
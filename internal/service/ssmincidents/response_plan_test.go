@@ -0,0 +1,557 @@
+package ssmincidents_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmincidents "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResponsePlan_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", fmt.Sprintf("response-plan/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", rName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmincidents.ResourceResponsePlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResponsePlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_action(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_action(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.ssm_automation.0.document_name", "aws_ssm_document.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.document_version", "$LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.dynamic_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.dynamic_parameters.incidentARN", "INCIDENT_RECORD_ARN"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.0.ssm_automation.0.parameter.*", map[string]string{
+						"name":     "key",
+						"values.#": "2",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.ssm_automation.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.target_account", "RESPONSE_PLAN_OWNER_ACCOUNT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_chatChannel(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_chatChannel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "chat_channel.*", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_pagerDuty(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	// PagerDuty forwarding needs a Secrets Manager secret holding real PagerDuty
+	// credentials and the ID of an existing PagerDuty service.
+	secretID := os.Getenv("SSMINCIDENTS_PAGERDUTY_SECRET_ID")
+	if secretID == "" {
+		t.Skip("Environment variable SSMINCIDENTS_PAGERDUTY_SECRET_ID is not set")
+	}
+
+	serviceID := os.Getenv("SSMINCIDENTS_PAGERDUTY_SERVICE_ID")
+	if serviceID == "" {
+		t.Skip("Environment variable SSMINCIDENTS_PAGERDUTY_SERVICE_ID is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_pagerDuty(rName, secretID, serviceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.secret_id", secretID),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.service_id", serviceID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_updateOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "0"),
+				),
+			},
+			{
+				Config: testAccResponsePlanConfig_options(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "engagements.*", "aws_ssmcontacts_contact.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.dedupe_string", "dedupe"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "incident_template.0.notification_target.*.sns_topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", "summary"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResponsePlanDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmincidents_response_plan" {
+			continue
+		}
+
+		_, err := tfssmincidents.FindResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Incidents Response Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckResponsePlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Incidents Response Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+		_, err := tfssmincidents.FindResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccResponsePlanConfig_base() string {
+	return testAccReplicationSetConfig_basic()
+}
+
+func testAccResponsePlanConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccResponsePlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccResponsePlanConfig_action(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm-incidents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = jsonencode({
+    schemaVersion = "0.3"
+    parameters = {
+      key = {
+        type = "StringList"
+      }
+      incidentARN = {
+        type = "String"
+      }
+    }
+    mainSteps = [{
+      name   = "sleep"
+      action = "aws:sleep"
+      inputs = {
+        Duration = "PT1S"
+      }
+    }]
+  })
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  action {
+    ssm_automation {
+      document_name    = aws_ssm_document.test.name
+      document_version = "$LATEST"
+      role_arn         = aws_iam_role.test.arn
+      target_account   = "RESPONSE_PLAN_OWNER_ACCOUNT"
+
+      parameter {
+        name   = "key"
+        values = ["value1", "value2"]
+      }
+
+      dynamic_parameters = {
+        incidentARN = "INCIDENT_RECORD_ARN"
+      }
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_chatChannel(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  chat_channel = [aws_sns_topic.test.arn]
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_pagerDuty(rName, secretID, serviceID string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  integration {
+    pagerduty {
+      name       = %[1]q
+      secret_id  = %[2]q
+      service_id = %[3]q
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, secretID, serviceID))
+}
+
+func testAccResponsePlanConfig_options(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name         = %[1]q
+  display_name = %[1]q
+
+  incident_template {
+    title         = %[1]q
+    impact        = 1
+    dedupe_string = "dedupe"
+    summary       = "summary"
+
+    incident_tags = {
+      source = "terraform"
+    }
+
+    notification_target {
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  engagements = [aws_ssmcontacts_contact.test.arn]
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
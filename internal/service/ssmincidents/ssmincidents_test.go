@@ -0,0 +1,59 @@
+package ssmincidents_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// The replication set is limited to one per account, so run serially
+// locally and in TeamCity.
+func TestAccSSMIncidents_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"ReplicationSet": {
+			"basic":         testAccReplicationSet_basic,
+			"disappears":    testAccReplicationSet_disappears,
+			"tags":          testAccReplicationSet_tags,
+			"updateRegions": testAccReplicationSet_updateRegions,
+		},
+		"ResponsePlan": {
+			"basic":         testAccResponsePlan_basic,
+			"disappears":    testAccResponsePlan_disappears,
+			"tags":          testAccResponsePlan_tags,
+			"action":        testAccResponsePlan_action,
+			"chatChannel":   testAccResponsePlan_chatChannel,
+			"pagerDuty":     testAccResponsePlan_pagerDuty,
+			"updateOptions": testAccResponsePlan_updateOptions,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+	input := &ssmincidents.ListReplicationSetsInput{}
+
+	_, err := conn.ListReplicationSets(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
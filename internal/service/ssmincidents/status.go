@@ -0,0 +1,26 @@
+package ssmincidents
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusReplicationSet(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationSetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,95 @@
+package ssmincidents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitReplicationSetCreated(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusCreating},
+		Target:  []string{ssmincidents.ReplicationSetStatusActive},
+		Refresh: statusReplicationSet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		if aws.StringValue(output.Status) == ssmincidents.ReplicationSetStatusFailed {
+			tfresource.SetLastError(err, regionStatusMessagesError(output.RegionMap))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationSetUpdated(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusUpdating},
+		Target:  []string{ssmincidents.ReplicationSetStatusActive},
+		Refresh: statusReplicationSet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		if aws.StringValue(output.Status) == ssmincidents.ReplicationSetStatusFailed {
+			tfresource.SetLastError(err, regionStatusMessagesError(output.RegionMap))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationSetDeleted(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusDeleting},
+		Target:  []string{},
+		Refresh: statusReplicationSet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// regionStatusMessagesError combines the status messages of failed replication set Regions.
+func regionStatusMessagesError(regionMap map[string]*ssmincidents.RegionInfo) error {
+	var messages []string
+
+	for name, v := range regionMap {
+		if v == nil || aws.StringValue(v.Status) != ssmincidents.RegionStatusFailed {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", name, aws.StringValue(v.StatusMessage)))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	sort.Strings(messages)
+
+	return errors.New(strings.Join(messages, "; "))
+}
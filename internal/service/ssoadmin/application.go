@@ -0,0 +1,340 @@
+package ssoadmin
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"application_provider_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"portal_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sign_in_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"origin": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.SignInOrigin](),
+									},
+								},
+							},
+						},
+						"visibility": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ApplicationVisibility](),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.ApplicationStatusEnabled),
+				ValidateDiagFunc: enum.Validate[types.ApplicationStatus](),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateApplicationInput{
+		ApplicationProviderArn: aws.String(d.Get("application_provider_arn").(string)),
+		InstanceArn:            aws.String(d.Get("instance_arn").(string)),
+		Name:                   aws.String(name),
+		Status:                 types.ApplicationStatus(d.Get("status").(string)),
+		Tags:                   applicationTags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PortalOptions = expandPortalOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateApplication(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSO Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ApplicationArn))
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSO Application (%s): %s", d.Id(), err)
+	}
+
+	instanceARN := aws.ToString(output.InstanceArn)
+	d.Set("application_account", output.ApplicationAccount)
+	d.Set("application_provider_arn", output.ApplicationProviderArn)
+	d.Set("arn", output.ApplicationArn)
+	d.Set("description", output.Description)
+	d.Set("instance_arn", instanceARN)
+	d.Set("name", output.Name)
+	if err := d.Set("portal_options", flattenPortalOptions(output.PortalOptions)); err != nil {
+		return diag.Errorf("setting portal_options: %s", err)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTagsWithContext(ctx, meta.(*conns.AWSClient).SSOAdminConn, d.Id(), instanceARN)
+
+	if err != nil {
+		return diag.Errorf("listing tags for SSO Application (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	if d.HasChanges("description", "name", "portal_options", "status") {
+		input := &ssoadmin.UpdateApplicationInput{
+			ApplicationArn: aws.String(d.Id()),
+			Name:           aws.String(d.Get("name").(string)),
+			Status:         types.ApplicationStatus(d.Get("status").(string)),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("portal_options") {
+			if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PortalOptions = &types.UpdateApplicationPortalOptions{
+					SignInOptions: expandPortalOptions(v.([]interface{})[0].(map[string]interface{})).SignInOptions,
+				}
+			}
+		}
+
+		_, err := conn.UpdateApplication(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SSO Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, meta.(*conns.AWSClient).SSOAdminConn, d.Id(), d.Get("instance_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SSO Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	log.Printf("[INFO] Deleting SSO Application: %s", d.Id())
+	_, err := conn.DeleteApplication(ctx, &ssoadmin.DeleteApplicationInput{
+		ApplicationArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSO Application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindApplicationByARN(ctx context.Context, conn *ssoadmin.Client, arn string) (*ssoadmin.DescribeApplicationOutput, error) {
+	input := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApplication(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationArn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPortalOptions(tfMap map[string]interface{}) *types.PortalOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PortalOptions{}
+
+	if v, ok := tfMap["sign_in_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		signInOptions := &types.SignInOptions{
+			Origin: types.SignInOrigin(tfMap["origin"].(string)),
+		}
+
+		if v, ok := tfMap["application_url"].(string); ok && v != "" {
+			signInOptions.ApplicationUrl = aws.String(v)
+		}
+
+		apiObject.SignInOptions = signInOptions
+	}
+
+	if v, ok := tfMap["visibility"].(string); ok && v != "" {
+		apiObject.Visibility = types.ApplicationVisibility(v)
+	}
+
+	return apiObject
+}
+
+func flattenPortalOptions(apiObject *types.PortalOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"visibility": string(apiObject.Visibility),
+	}
+
+	if v := apiObject.SignInOptions; v != nil {
+		tfMap["sign_in_options"] = []interface{}{map[string]interface{}{
+			"application_url": aws.ToString(v.ApplicationUrl),
+			"origin":          string(v.Origin),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func applicationTags(tags tftags.KeyValueTags) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
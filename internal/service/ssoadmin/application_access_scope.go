@@ -0,0 +1,183 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAccessScope() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationAccessScopePut,
+		ReadWithoutTimeout:   resourceApplicationAccessScopeRead,
+		UpdateWithoutTimeout: resourceApplicationAccessScopePut,
+		DeleteWithoutTimeout: resourceApplicationAccessScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"authorized_targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationAccessScopePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN := d.Get("application_arn").(string)
+	scope := d.Get("scope").(string)
+	id := ApplicationAccessScopeCreateResourceID(applicationARN, scope)
+	input := &ssoadmin.PutApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	}
+
+	if v, ok := d.GetOk("authorized_targets"); ok && len(v.([]interface{})) > 0 {
+		input.AuthorizedTargets = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	_, err := conn.PutApplicationAccessScope(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting SSO Application Access Scope (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceApplicationAccessScopeRead(ctx, d, meta)
+}
+
+func resourceApplicationAccessScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, scope, err := ApplicationAccessScopeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindApplicationAccessScopeByTwoPartKey(ctx, conn, applicationARN, scope)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Access Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSO Application Access Scope (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", applicationARN)
+	d.Set("authorized_targets", output.AuthorizedTargets)
+	d.Set("scope", output.Scope)
+
+	return nil
+}
+
+func resourceApplicationAccessScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, scope, err := ApplicationAccessScopeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Application Access Scope: %s", d.Id())
+	_, err = conn.DeleteApplicationAccessScope(ctx, &ssoadmin.DeleteApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSO Application Access Scope (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const applicationAccessScopeIDSeparator = ","
+
+func ApplicationAccessScopeCreateResourceID(applicationARN, scope string) string {
+	parts := []string{applicationARN, scope}
+	id := strings.Join(parts, applicationAccessScopeIDSeparator)
+
+	return id
+}
+
+func ApplicationAccessScopeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, applicationAccessScopeIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationARN%[2]sScope", id, applicationAccessScopeIDSeparator)
+}
+
+func FindApplicationAccessScopeByTwoPartKey(ctx context.Context, conn *ssoadmin.Client, applicationARN, scope string) (*ssoadmin.GetApplicationAccessScopeOutput, error) {
+	input := &ssoadmin.GetApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	}
+
+	output, err := conn.GetApplicationAccessScope(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Scope == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
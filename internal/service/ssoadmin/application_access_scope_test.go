@@ -0,0 +1,134 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	ssoadminv1 "github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationAccessScope_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_access_scope.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAccessScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAccessScopeConfig_basic(rName, "sso:account:access"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAccessScopeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_targets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorized_targets.0", applicationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "sso:account:access"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationAccessScope_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAccessScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAccessScopeConfig_basic(rName, "sso:account:access"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAccessScopeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceApplicationAccessScope(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAccessScopeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_application_access_scope" {
+			continue
+		}
+
+		applicationARN, scope, err := tfssoadmin.ApplicationAccessScopeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssoadmin.FindApplicationAccessScopeByTwoPartKey(context.Background(), conn, applicationARN, scope)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Application Access Scope %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationAccessScopeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No SSO Application Access Scope ID is set")
+		}
+
+		applicationARN, scope, err := tfssoadmin.ApplicationAccessScopeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+		_, err = tfssoadmin.FindApplicationAccessScopeByTwoPartKey(context.Background(), conn, applicationARN, scope)
+
+		return err
+	}
+}
+
+func testAccApplicationAccessScopeConfig_basic(rName, scope string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssoadmin_application_access_scope" "test" {
+  application_arn    = aws_ssoadmin_application.test.arn
+  authorized_targets = [aws_ssoadmin_application.test.arn]
+  scope              = %[1]q
+}
+`, scope))
+}
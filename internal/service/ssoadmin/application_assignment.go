@@ -0,0 +1,179 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationAssignmentCreate,
+		ReadWithoutTimeout:   resourceApplicationAssignmentRead,
+		DeleteWithoutTimeout: resourceApplicationAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+			"principal_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PrincipalType](),
+			},
+		},
+	}
+}
+
+func resourceApplicationAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN := d.Get("application_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+	id := ApplicationAssignmentCreateResourceID(applicationARN, principalID, principalType)
+	input := &ssoadmin.CreateApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  types.PrincipalType(principalType),
+	}
+
+	_, err := conn.CreateApplicationAssignment(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSO Application Assignment (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceApplicationAssignmentRead(ctx, d, meta)
+}
+
+func resourceApplicationAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindApplicationAssignmentByThreePartKey(ctx, conn, applicationARN, principalID, principalType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSO Application Assignment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", output.ApplicationArn)
+	d.Set("principal_id", output.PrincipalId)
+	d.Set("principal_type", output.PrincipalType)
+
+	return nil
+}
+
+func resourceApplicationAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Application Assignment: %s", d.Id())
+	_, err = conn.DeleteApplicationAssignment(ctx, &ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  types.PrincipalType(principalType),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSO Application Assignment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const applicationAssignmentIDSeparator = ","
+
+func ApplicationAssignmentCreateResourceID(applicationARN, principalID, principalType string) string {
+	parts := []string{applicationARN, principalID, principalType}
+	id := strings.Join(parts, applicationAssignmentIDSeparator)
+
+	return id
+}
+
+func ApplicationAssignmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, applicationAssignmentIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationARN%[2]sPrincipalID%[2]sPrincipalType", id, applicationAssignmentIDSeparator)
+}
+
+func FindApplicationAssignmentByThreePartKey(ctx context.Context, conn *ssoadmin.Client, applicationARN, principalID, principalType string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
+	input := &ssoadmin.DescribeApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  types.PrincipalType(principalType),
+	}
+
+	output, err := conn.DescribeApplicationAssignment(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PrincipalId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,139 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	ssoadminv1 "github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationAssignment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_assignment.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	groupResourceName := "aws_identitystore_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAssignmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "GROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationAssignment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_assignment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAssignmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAssignmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceApplicationAssignment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAssignmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_application_assignment" {
+			continue
+		}
+
+		applicationARN, principalID, principalType, err := tfssoadmin.ApplicationAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssoadmin.FindApplicationAssignmentByThreePartKey(context.Background(), conn, applicationARN, principalID, principalType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Application Assignment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No SSO Application Assignment ID is set")
+		}
+
+		applicationARN, principalID, principalType, err := tfssoadmin.ApplicationAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+		_, err = tfssoadmin.FindApplicationAssignmentByThreePartKey(context.Background(), conn, applicationARN, principalID, principalType)
+
+		return err
+	}
+}
+
+func testAccApplicationAssignmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+}
+
+resource "aws_ssoadmin_application_assignment" "test" {
+  application_arn = aws_ssoadmin_application.test.arn
+  principal_id    = aws_identitystore_group.test.group_id
+  principal_type  = "GROUP"
+}
+`, rName))
+}
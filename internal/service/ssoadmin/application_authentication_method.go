@@ -0,0 +1,277 @@
+package ssoadmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/document"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAuthenticationMethod() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationAuthenticationMethodPut,
+		ReadWithoutTimeout:   resourceApplicationAuthenticationMethodRead,
+		UpdateWithoutTimeout: resourceApplicationAuthenticationMethodPut,
+		DeleteWithoutTimeout: resourceApplicationAuthenticationMethodDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"authentication_method": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actor_policy": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     verify.ValidIAMPolicyJSON,
+										DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+										StateFunc: func(v interface{}) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"authentication_method_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AuthenticationMethodType](),
+			},
+		},
+	}
+}
+
+func resourceApplicationAuthenticationMethodPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN := d.Get("application_arn").(string)
+	authenticationMethodType := d.Get("authentication_method_type").(string)
+	id := ApplicationAuthenticationMethodCreateResourceID(applicationARN, authenticationMethodType)
+
+	authenticationMethod, err := expandAuthenticationMethod(d.Get("authentication_method").([]interface{}))
+
+	if err != nil {
+		return diag.Errorf("putting SSO Application Authentication Method (%s): %s", id, err)
+	}
+
+	input := &ssoadmin.PutApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(applicationARN),
+		AuthenticationMethod:     authenticationMethod,
+		AuthenticationMethodType: types.AuthenticationMethodType(authenticationMethodType),
+	}
+
+	_, err = conn.PutApplicationAuthenticationMethod(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting SSO Application Authentication Method (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceApplicationAuthenticationMethodRead(ctx, d, meta)
+}
+
+func resourceApplicationAuthenticationMethodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, authenticationMethodType, err := ApplicationAuthenticationMethodParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindApplicationAuthenticationMethodByTwoPartKey(ctx, conn, applicationARN, authenticationMethodType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Authentication Method (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSO Application Authentication Method (%s): %s", d.Id(), err)
+	}
+
+	authenticationMethod, err := flattenAuthenticationMethod(output, d.Get("authentication_method.0.iam.0.actor_policy").(string))
+
+	if err != nil {
+		return diag.Errorf("reading SSO Application Authentication Method (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_arn", applicationARN)
+	if err := d.Set("authentication_method", authenticationMethod); err != nil {
+		return diag.Errorf("setting authentication_method: %s", err)
+	}
+	d.Set("authentication_method_type", authenticationMethodType)
+
+	return nil
+}
+
+func resourceApplicationAuthenticationMethodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	applicationARN, authenticationMethodType, err := ApplicationAuthenticationMethodParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting SSO Application Authentication Method: %s", d.Id())
+	_, err = conn.DeleteApplicationAuthenticationMethod(ctx, &ssoadmin.DeleteApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(applicationARN),
+		AuthenticationMethodType: types.AuthenticationMethodType(authenticationMethodType),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSO Application Authentication Method (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const applicationAuthenticationMethodIDSeparator = ","
+
+func ApplicationAuthenticationMethodCreateResourceID(applicationARN, authenticationMethodType string) string {
+	parts := []string{applicationARN, authenticationMethodType}
+	id := strings.Join(parts, applicationAuthenticationMethodIDSeparator)
+
+	return id
+}
+
+func ApplicationAuthenticationMethodParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, applicationAuthenticationMethodIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationARN%[2]sAuthenticationMethodType", id, applicationAuthenticationMethodIDSeparator)
+}
+
+func FindApplicationAuthenticationMethodByTwoPartKey(ctx context.Context, conn *ssoadmin.Client, applicationARN, authenticationMethodType string) (types.AuthenticationMethod, error) {
+	input := &ssoadmin.GetApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(applicationARN),
+		AuthenticationMethodType: types.AuthenticationMethodType(authenticationMethodType),
+	}
+
+	output, err := conn.GetApplicationAuthenticationMethod(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AuthenticationMethod == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AuthenticationMethod, nil
+}
+
+func expandAuthenticationMethod(tfList []interface{}) (types.AuthenticationMethod, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["iam"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		policy, err := structure.NormalizeJsonString(v[0].(map[string]interface{})["actor_policy"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("actor_policy is invalid JSON: %w", err)
+		}
+
+		var actorPolicy interface{}
+
+		if err := json.Unmarshal([]byte(policy), &actorPolicy); err != nil {
+			return nil, err
+		}
+
+		return &types.AuthenticationMethodMemberIam{
+			Value: types.IamAuthenticationMethod{
+				ActorPolicy: document.NewLazyDocument(actorPolicy),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func flattenAuthenticationMethod(apiObject types.AuthenticationMethod, configuredPolicy string) ([]interface{}, error) {
+	switch v := apiObject.(type) {
+	case *types.AuthenticationMethodMemberIam:
+		tfMap := map[string]interface{}{}
+
+		if v.Value.ActorPolicy != nil {
+			b, err := v.Value.ActorPolicy.MarshalSmithyDocument()
+
+			if err != nil {
+				return nil, err
+			}
+
+			policy, err := verify.PolicyToSet(configuredPolicy, string(b))
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap["actor_policy"] = policy
+		}
+
+		return []interface{}{map[string]interface{}{
+			"iam": []interface{}{tfMap},
+		}}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,139 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	ssoadminv1 "github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationAuthenticationMethod_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_authentication_method.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAuthenticationMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:CreateTokenWithIAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_method_type", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_method.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "authentication_method.0.iam.0.actor_policy", regexp.MustCompile("sso-oauth:CreateTokenWithIAM")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "authentication_method.0.iam.0.actor_policy", regexp.MustCompile(`sso-oauth:\*`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAuthenticationMethodDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_application_authentication_method" {
+			continue
+		}
+
+		applicationARN, authenticationMethodType, err := tfssoadmin.ApplicationAuthenticationMethodParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssoadmin.FindApplicationAuthenticationMethodByTwoPartKey(context.Background(), conn, applicationARN, authenticationMethodType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Application Authentication Method %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationAuthenticationMethodExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No SSO Application Authentication Method ID is set")
+		}
+
+		applicationARN, authenticationMethodType, err := tfssoadmin.ApplicationAuthenticationMethodParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+		_, err = tfssoadmin.FindApplicationAuthenticationMethodByTwoPartKey(context.Background(), conn, applicationARN, authenticationMethodType)
+
+		return err
+	}
+}
+
+func testAccApplicationAuthenticationMethodConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_ssoadmin_application_authentication_method" "test" {
+  application_arn            = aws_ssoadmin_application.test.arn
+  authentication_method_type = "IAM"
+
+  authentication_method {
+    iam {
+      actor_policy = jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Effect   = "Allow"
+          Action   = %[1]q
+          Resource = "*"
+          Principal = {
+            AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+          }
+        }]
+      })
+    }
+  }
+}
+`, action))
+}
@@ -0,0 +1,347 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrustedTokenIssuer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustedTokenIssuerCreate,
+		ReadWithoutTimeout:   resourceTrustedTokenIssuerRead,
+		UpdateWithoutTimeout: resourceTrustedTokenIssuerUpdate,
+		DeleteWithoutTimeout: resourceTrustedTokenIssuerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trusted_token_issuer_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oidc_jwt_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"identity_store_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"issuer_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"jwks_retrieval_option": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.JwksRetrievalOption](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"trusted_token_issuer_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.TrustedTokenIssuerType](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustedTokenIssuerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateTrustedTokenIssuerInput{
+		InstanceArn:                     aws.String(d.Get("instance_arn").(string)),
+		Name:                            aws.String(name),
+		Tags:                            applicationTags(tags.IgnoreAWS()),
+		TrustedTokenIssuerConfiguration: expandTrustedTokenIssuerConfiguration(d.Get("trusted_token_issuer_configuration").([]interface{})),
+		TrustedTokenIssuerType:          types.TrustedTokenIssuerType(d.Get("trusted_token_issuer_type").(string)),
+	}
+
+	output, err := conn.CreateTrustedTokenIssuer(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSO Trusted Token Issuer (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.TrustedTokenIssuerArn))
+
+	return resourceTrustedTokenIssuerRead(ctx, d, meta)
+}
+
+func resourceTrustedTokenIssuerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindTrustedTokenIssuerByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Trusted Token Issuer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TrustedTokenIssuerArn)
+	d.Set("name", output.Name)
+	if err := d.Set("trusted_token_issuer_configuration", flattenTrustedTokenIssuerConfiguration(output.TrustedTokenIssuerConfiguration)); err != nil {
+		return diag.Errorf("setting trusted_token_issuer_configuration: %s", err)
+	}
+	d.Set("trusted_token_issuer_type", output.TrustedTokenIssuerType)
+
+	// The instance ARN is not returned by the API, derive it from the trusted token issuer ARN on import.
+	instanceARN := d.Get("instance_arn").(string)
+
+	if instanceARN == "" {
+		v, err := trustedTokenIssuerInstanceARN(d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+		}
+
+		instanceARN = v
+		d.Set("instance_arn", instanceARN)
+	}
+
+	tags, err := ListTagsWithContext(ctx, meta.(*conns.AWSClient).SSOAdminConn, d.Id(), instanceARN)
+
+	if err != nil {
+		return diag.Errorf("listing tags for SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrustedTokenIssuerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	if d.HasChanges("name", "trusted_token_issuer_configuration") {
+		input := &ssoadmin.UpdateTrustedTokenIssuerInput{
+			TrustedTokenIssuerArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("trusted_token_issuer_configuration") {
+			input.TrustedTokenIssuerConfiguration = expandTrustedTokenIssuerUpdateConfiguration(d.Get("trusted_token_issuer_configuration").([]interface{}))
+		}
+
+		_, err := conn.UpdateTrustedTokenIssuer(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, meta.(*conns.AWSClient).SSOAdminConn, d.Id(), d.Get("instance_arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SSO Trusted Token Issuer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrustedTokenIssuerRead(ctx, d, meta)
+}
+
+func resourceTrustedTokenIssuerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminClient()
+
+	log.Printf("[INFO] Deleting SSO Trusted Token Issuer: %s", d.Id())
+	_, err := conn.DeleteTrustedTokenIssuer(ctx, &ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSO Trusted Token Issuer (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.Client, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	input := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTrustedTokenIssuer(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustedTokenIssuerArn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// trustedTokenIssuerInstanceARN returns the ARN of the instance that owns a trusted token issuer.
+// Trusted token issuer ARNs have the form arn:aws:sso::123456789012:trustedTokenIssuer/ssoins-1234567890abcdef/tti-12345678-1234-1234-1234-123456789012.
+func trustedTokenIssuerInstanceARN(ttiARN string) (string, error) {
+	parsedARN, err := arn.Parse(ttiARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "trustedTokenIssuer" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for trusted token issuer ARN (%s)", ttiARN)
+	}
+
+	return arn.ARN{
+		Partition: parsedARN.Partition,
+		Service:   parsedARN.Service,
+		Resource:  "instance/" + parts[1],
+	}.String(), nil
+}
+
+func expandTrustedTokenIssuerConfiguration(tfList []interface{}) types.TrustedTokenIssuerConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.TrustedTokenIssuerConfigurationMemberOidcJwtConfiguration{
+			Value: types.OidcJwtConfiguration{
+				ClaimAttributePath:         aws.String(tfMap["claim_attribute_path"].(string)),
+				IdentityStoreAttributePath: aws.String(tfMap["identity_store_attribute_path"].(string)),
+				IssuerUrl:                  aws.String(tfMap["issuer_url"].(string)),
+				JwksRetrievalOption:        types.JwksRetrievalOption(tfMap["jwks_retrieval_option"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandTrustedTokenIssuerUpdateConfiguration(tfList []interface{}) types.TrustedTokenIssuerUpdateConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.TrustedTokenIssuerUpdateConfigurationMemberOidcJwtConfiguration{
+			Value: types.OidcJwtUpdateConfiguration{
+				ClaimAttributePath:         aws.String(tfMap["claim_attribute_path"].(string)),
+				IdentityStoreAttributePath: aws.String(tfMap["identity_store_attribute_path"].(string)),
+				JwksRetrievalOption:        types.JwksRetrievalOption(tfMap["jwks_retrieval_option"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenTrustedTokenIssuerConfiguration(apiObject types.TrustedTokenIssuerConfiguration) []interface{} {
+	switch v := apiObject.(type) {
+	case *types.TrustedTokenIssuerConfigurationMemberOidcJwtConfiguration:
+		return []interface{}{map[string]interface{}{
+			"oidc_jwt_configuration": []interface{}{map[string]interface{}{
+				"claim_attribute_path":          aws.ToString(v.Value.ClaimAttributePath),
+				"identity_store_attribute_path": aws.ToString(v.Value.IdentityStoreAttributePath),
+				"issuer_url":                    aws.ToString(v.Value.IssuerUrl),
+				"jwks_retrieval_option":         string(v.Value.JwksRetrievalOption),
+			}},
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	ssoadminv1 "github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminTrustedTokenIssuer_basic(t *testing.T) {
+	var v ssoadmin.DescribeTrustedTokenIssuerOutput
+	resourceName := "aws_ssoadmin_trusted_token_issuer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustedTokenIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedTokenIssuerConfig_basic(rName, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_type", "OIDC_JWT"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.claim_attribute_path", "email"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.identity_store_attribute_path", "emails.value"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.issuer_url", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.jwks_retrieval_option", "OPEN_ID_DISCOVERY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustedTokenIssuerConfig_basic(rName, "sub"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.claim_attribute_path", "sub"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminTrustedTokenIssuer_disappears(t *testing.T) {
+	var v ssoadmin.DescribeTrustedTokenIssuerOutput
+	resourceName := "aws_ssoadmin_trusted_token_issuer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadminv1.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustedTokenIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedTokenIssuerConfig_basic(rName, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceTrustedTokenIssuer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustedTokenIssuerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_trusted_token_issuer" {
+			continue
+		}
+
+		_, err := tfssoadmin.FindTrustedTokenIssuerByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Trusted Token Issuer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTrustedTokenIssuerExists(n string, v *ssoadmin.DescribeTrustedTokenIssuerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No SSO Trusted Token Issuer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient()
+
+		output, err := tfssoadmin.FindTrustedTokenIssuerByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTrustedTokenIssuerConfig_basic(rName, claimAttributePath string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_trusted_token_issuer" "test" {
+  name                      = %[1]q
+  instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  trusted_token_issuer_type = "OIDC_JWT"
+
+  trusted_token_issuer_configuration {
+    oidc_jwt_configuration {
+      claim_attribute_path          = %[2]q
+      identity_store_attribute_path = "emails.value"
+      issuer_url                    = "https://example.com"
+      jwks_retrieval_option         = "OPEN_ID_DISCOVERY"
+    }
+  }
+}
+`, rName, claimAttributePath)
+}
@@ -982,6 +982,43 @@ func testAccServer_authenticationLoginBanners(t *testing.T) {
 	})
 }
 
+func testAccServer_structuredLogDestinations(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_structuredLogDestinations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "structured_log_destinations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "structured_log_destinations.*", "aws_cloudwatch_log_group.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccServerConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "structured_log_destinations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccServer_workflowDetails(t *testing.T) {
 	var conf transfer.DescribedServer
 	resourceName := "aws_transfer_server.test"
@@ -1301,6 +1338,23 @@ resource "aws_transfer_server" "test" {
 `, policy)
 }
 
+func testAccServerConfig_structuredLogDestinations(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_loggingRoleBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name_prefix = %[1]q
+}
+
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+  logging_role           = aws_iam_role.test.arn
+
+  structured_log_destinations = [
+    "${aws_cloudwatch_log_group.test.arn}:*"
+  ]
+}
+`, rName))
+}
+
 func testAccServerConfig_updated(rName string) string {
 	return acctest.ConfigCompose(testAccServerConfig_loggingRoleBase(rName), `
 resource "aws_transfer_server" "test" {
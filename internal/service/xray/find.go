@@ -0,0 +1,60 @@
+package xray
+
+import (
+	"context"
+
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindResourcePolicyByName(ctx context.Context, conn *xray.XRay, name string) (*xray.ResourcePolicy, error) {
+	input := &xray.ListResourcePoliciesInput{}
+	var output *xray.ResourcePolicy
+
+	err := conn.ListResourcePoliciesPagesWithContext(ctx, input, func(page *xray.ListResourcePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourcePolicies {
+			if aws.StringValue(v.PolicyName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindTraceSegmentDestination(ctx context.Context, conn *xray_sdkv2.Client) (*xray_sdkv2.GetTraceSegmentDestinationOutput, error) {
+	input := &xray_sdkv2.GetTraceSegmentDestinationInput{}
+
+	output, err := conn.GetTraceSegmentDestination(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
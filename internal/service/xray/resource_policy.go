@@ -0,0 +1,155 @@
+package xray
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyPut,
+		ReadWithoutTimeout:   resourceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceResourcePolicyPut,
+		DeleteWithoutTimeout: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("policy_name", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policy, err)
+	}
+
+	name := d.Get("policy_name").(string)
+	input := &xray.PutResourcePolicyInput{
+		BypassPolicyLockoutCheck: aws.Bool(d.Get("bypass_policy_lockout_check").(bool)),
+		PolicyDocument:           aws.String(policy),
+		PolicyName:               aws.String(name),
+	}
+
+	// Guard against concurrent modification of an existing policy.
+	if !d.IsNewResource() {
+		input.PolicyRevisionId = aws.String(d.Get("policy_revision_id").(string))
+	}
+
+	output, err := conn.PutResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting XRay Resource Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourcePolicy.PolicyName))
+
+	return resourceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	resourcePolicy, err := FindResourcePolicyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] XRay Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading XRay Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	if resourcePolicy.LastUpdatedTime != nil {
+		d.Set("last_updated_time", aws.TimeValue(resourcePolicy.LastUpdatedTime).Format(time.RFC3339))
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), aws.StringValue(resourcePolicy.PolicyDocument))
+
+	if err != nil {
+		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("policy_document", policyToSet)
+	d.Set("policy_name", resourcePolicy.PolicyName)
+	d.Set("policy_revision_id", resourcePolicy.PolicyRevisionId)
+
+	return nil
+}
+
+func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	log.Printf("[DEBUG] Deleting XRay Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicyWithContext(ctx, &xray.DeleteResourcePolicyInput{
+		PolicyName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, xray.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting XRay Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfxray "github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccXRayResourcePolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_resource_policy.test"
+	var resourcePolicy xray.ResourcePolicy
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, xray.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName, "xray:PutTraceSegments"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName, &resourcePolicy),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_check", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bypass_policy_lockout_check"},
+			},
+			{
+				Config: testAccResourcePolicyConfig_basic(rName, "xray:PutTelemetryRecords"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName, &resourcePolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_revision_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccXRayResourcePolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_resource_policy.test"
+	var resourcePolicy xray.ResourcePolicy
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, xray.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName, "xray:PutTraceSegments"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName, &resourcePolicy),
+					acctest.CheckResourceDisappears(acctest.Provider, tfxray.ResourceResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePolicyExists(n string, v *xray.ResourcePolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Resource Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayConn
+
+		output, err := tfxray.FindResourcePolicyByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckResourcePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).XRayConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_xray_resource_policy" {
+			continue
+		}
+
+		_, err := tfxray.FindResourcePolicyByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("XRay Resource Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourcePolicyConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_xray_resource_policy" "test" {
+  policy_name = %[1]q
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowSNSPublish"
+      Effect = "Allow"
+      Principal = {
+        Service = "sns.amazonaws.com"
+      }
+      Action   = %[2]q
+      Resource = "*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        StringLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:sns:*:${data.aws_caller_identity.current.account_id}:*"
+        }
+      }
+    }]
+  })
+}
+`, rName, action)
+}
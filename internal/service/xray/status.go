@@ -1,6 +1,9 @@
 package xray
 
 import (
+	"context"
+
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,3 +25,16 @@ func statusEncryptionConfig(conn *xray.XRay) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.EncryptionConfig.Status), nil
 	}
 }
+
+// statusTraceSegmentDestination fetches the Trace Segment Destination and its Status
+func statusTraceSegmentDestination(ctx context.Context, conn *xray_sdkv2.Client) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTraceSegmentDestination(ctx, conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
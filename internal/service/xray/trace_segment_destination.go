@@ -0,0 +1,74 @@
+package xray
+
+import (
+	"context"
+
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+func ResourceTraceSegmentDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTraceSegmentDestinationPut,
+		ReadWithoutTimeout:   resourceTraceSegmentDestinationRead,
+		UpdateWithoutTimeout: resourceTraceSegmentDestinationPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.TraceSegmentDestination](),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTraceSegmentDestinationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayClient()
+
+	input := &xray_sdkv2.UpdateTraceSegmentDestinationInput{
+		Destination: types.TraceSegmentDestination(d.Get("destination").(string)),
+	}
+
+	_, err := conn.UpdateTraceSegmentDestination(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating XRay Trace Segment Destination: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if _, err := waitTraceSegmentDestinationActive(ctx, conn); err != nil {
+		return diag.Errorf("waiting for XRay Trace Segment Destination (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceTraceSegmentDestinationRead(ctx, d, meta)
+}
+
+func resourceTraceSegmentDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayClient()
+
+	output, err := FindTraceSegmentDestination(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading XRay Trace Segment Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("destination", output.Destination)
+	d.Set("status", output.Status)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/xray"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfxray "github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+)
+
+func TestAccXRayTraceSegmentDestination_basic(t *testing.T) {
+	resourceName := "aws_xray_trace_segment_destination.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, xray.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTraceSegmentDestinationConfig_cloudWatchLogs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTraceSegmentDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination", "CloudWatchLogs"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTraceSegmentDestinationConfig_xRay(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTraceSegmentDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination", "XRay"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTraceSegmentDestinationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Trace Segment Destination ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayClient()
+
+		_, err := tfxray.FindTraceSegmentDestination(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccTraceSegmentDestinationConfig_cloudWatchLogs(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_resource_policy" "test" {
+  policy_name = %[1]q
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "TransactionSearchXRayAccess"
+      Effect = "Allow"
+      Principal = {
+        Service = "xray.amazonaws.com"
+      }
+      Action = "logs:PutLogEvents"
+      Resource = [
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:aws/spans:*",
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:/aws/application-signals/data:*",
+      ]
+      Condition = {
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:xray:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"
+        }
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_xray_trace_segment_destination" "test" {
+  destination = "CloudWatchLogs"
+
+  depends_on = [aws_cloudwatch_log_resource_policy.test]
+}
+`, rName)
+}
+
+func testAccTraceSegmentDestinationConfig_xRay() string {
+	return `
+resource "aws_xray_trace_segment_destination" "test" {
+  destination = "XRay"
+}
+`
+}
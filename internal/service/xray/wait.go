@@ -1,14 +1,19 @@
 package xray
 
 import (
+	"context"
 	"time"
 
+	xray_sdkv2 "github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 const (
-	encryptionConfigAvailableTimeout = 15 * time.Minute
+	encryptionConfigAvailableTimeout     = 15 * time.Minute
+	traceSegmentDestinationActiveTimeout = 15 * time.Minute
 )
 
 // waitEncryptionConfigAvailable waits for a EncryptionConfig to return Available
@@ -28,3 +33,21 @@ func waitEncryptionConfigAvailable(conn *xray.XRay) (*xray.EncryptionConfig, err
 
 	return nil, err
 }
+
+// waitTraceSegmentDestinationActive waits for a Trace Segment Destination to return Active
+func waitTraceSegmentDestinationActive(ctx context.Context, conn *xray_sdkv2.Client) (*xray_sdkv2.GetTraceSegmentDestinationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.TraceSegmentDestinationStatusPending),
+		Target:  enum.Slice(types.TraceSegmentDestinationStatusActive),
+		Refresh: statusTraceSegmentDestination(ctx, conn),
+		Timeout: traceSegmentDestinationActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*xray_sdkv2.GetTraceSegmentDestinationOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,128 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"golang.org/x/exp/slices"
+)
+
+// ARNCheckFunc checks a parsed ARN, returning an error describing why it is not acceptable.
+type ARNCheckFunc func(arn.ARN) error
+
+// ValidARNCheck returns a SchemaValidateFunc that validates the value is an ARN
+// and that it passes each of the specified checks.
+func ValidARNCheck(checks ...ARNCheckFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		ws, errors = ValidARN(v, k)
+
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		value := v.(string)
+
+		if value == "" {
+			return ws, errors
+		}
+
+		parsedARN, err := arn.Parse(value)
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			return ws, errors
+		}
+
+		for _, check := range checks {
+			if err := check(parsedARN); err != nil {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			}
+		}
+
+		return ws, errors
+	}
+}
+
+// ARNPartition checks that the ARN is in one of the specified partitions.
+func ARNPartition(partitions ...string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if !slices.Contains(partitions, v.Partition) {
+			return fmt.Errorf("invalid partition value (expecting %s, got %q)", strings.Join(partitions, " or "), v.Partition)
+		}
+
+		return nil
+	}
+}
+
+// ARNService checks that the ARN belongs to one of the specified services, e.g. "kms".
+func ARNService(services ...string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if !slices.Contains(services, v.Service) {
+			return fmt.Errorf("invalid service value (expecting %s, got %q)", strings.Join(services, " or "), v.Service)
+		}
+
+		return nil
+	}
+}
+
+// ARNResourcePrefix checks that the ARN's resource starts with one of the specified prefixes, e.g. "key/".
+func ARNResourcePrefix(prefixes ...string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(v.Resource, prefix) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("invalid resource value (expecting prefix %s, got %q)", strings.Join(prefixes, " or "), v.Resource)
+	}
+}
+
+// ARNResourceMatch checks that the ARN's resource matches the specified regular expression.
+func ARNResourceMatch(re *regexp.Regexp) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if !re.MatchString(v.Resource) {
+			return fmt.Errorf("invalid resource value (expecting to match regular expression: %s, got %q)", re, v.Resource)
+		}
+
+		return nil
+	}
+}
+
+// ARNPartitionMatchesProvider returns a CustomizeDiffFunc that validates that the ARNs
+// configured in the specified attributes are in the same partition as the provider.
+// Partition mismatches otherwise only surface as API errors during apply.
+func ARNPartitionMatchesProvider(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		partition := meta.(*conns.AWSClient).Partition
+
+		for _, k := range keys {
+			if !d.NewValueKnown(k) {
+				continue
+			}
+
+			v, ok := d.Get(k).(string)
+
+			if !ok || v == "" || !arn.IsARN(v) {
+				continue
+			}
+
+			parsedARN, err := arn.Parse(v)
+
+			if err != nil {
+				continue
+			}
+
+			if parsedARN.Partition != partition {
+				return fmt.Errorf("%q (%s) is in partition %q, but the provider is configured for partition %q", k, v, parsedARN.Partition, partition)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,92 @@
+package verify
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidARNCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		checks      []ARNCheckFunc
+		expectError bool
+	}{
+		{
+			name:  "empty",
+			value: "",
+			checks: []ARNCheckFunc{
+				ARNService("kms"),
+			},
+		},
+		{
+			name:        "invalid ARN",
+			value:       "not-an-arn",
+			checks:      []ARNCheckFunc{ARNService("kms")},
+			expectError: true,
+		},
+		{
+			name:  "no checks",
+			value: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:   "service match",
+			value:  "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+			checks: []ARNCheckFunc{ARNService("kms")},
+		},
+		{
+			name:        "service mismatch",
+			value:       "arn:aws:secretsmanager:us-west-2:123456789012:secret:example", //lintignore:AWSAT003,AWSAT005
+			checks:      []ARNCheckFunc{ARNService("kms")},
+			expectError: true,
+		},
+		{
+			name:   "partition match",
+			value:  "arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+			checks: []ARNCheckFunc{ARNPartition("aws-us-gov")},
+		},
+		{
+			name:        "partition mismatch",
+			value:       "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+			checks:      []ARNCheckFunc{ARNPartition("aws-cn", "aws-us-gov")},
+			expectError: true,
+		},
+		{
+			name:   "resource prefix match",
+			value:  "arn:aws:kms:us-west-2:123456789012:alias/example", //lintignore:AWSAT003,AWSAT005
+			checks: []ARNCheckFunc{ARNService("kms"), ARNResourcePrefix("key/", "alias/")},
+		},
+		{
+			name:        "resource prefix mismatch",
+			value:       "arn:aws:kms:us-west-2:123456789012:grant/example", //lintignore:AWSAT003,AWSAT005
+			checks:      []ARNCheckFunc{ARNService("kms"), ARNResourcePrefix("key/", "alias/")},
+			expectError: true,
+		},
+		{
+			name:   "resource match",
+			value:  "arn:aws:secretsmanager:us-west-2:123456789012:secret:example-AbCdEf", //lintignore:AWSAT003,AWSAT005
+			checks: []ARNCheckFunc{ARNResourceMatch(regexp.MustCompile(`^secret:.+$`))},
+		},
+		{
+			name:        "resource mismatch",
+			value:       "arn:aws:secretsmanager:us-west-2:123456789012:other:example", //lintignore:AWSAT003,AWSAT005
+			checks:      []ARNCheckFunc{ARNResourceMatch(regexp.MustCompile(`^secret:.+$`))},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errors := ValidARNCheck(testCase.checks...)(testCase.value, "test_property")
+
+			if got, want := len(errors) > 0, testCase.expectError; got != want {
+				t.Errorf("ValidARNCheck(%q) errors = %v, expected error: %t", testCase.value, errors, want)
+			}
+		})
+	}
+}
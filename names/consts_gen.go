@@ -25,18 +25,21 @@ const (
 	AppSync                      = "appsync"
 	ApplicationCostProfiler      = "applicationcostprofiler"
 	ApplicationInsights          = "applicationinsights"
+	ApplicationSignals           = "applicationsignals"
 	Athena                       = "athena"
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
 	Backup                       = "backup"
 	BackupGateway                = "backupgateway"
+	BCMDataExports               = "bcmdataexports"
 	Batch                        = "batch"
 	BillingConductor             = "billingconductor"
 	Braket                       = "braket"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
+	Chatbot                      = "chatbot"
 	Chime                        = "chime"
 	ChimeSDKIdentity             = "chimesdkidentity"
 	ChimeSDKMeetings             = "chimesdkmeetings"
@@ -209,6 +212,7 @@ const (
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
 	OpenSearch                   = "opensearch"
+	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
 	OpsWorksCM                   = "opsworkscm"
 	Organizations                = "organizations"
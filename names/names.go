@@ -23,21 +23,25 @@ import (
 
 // This "should" be defined by the AWS Go SDK v2, but currently isn't.
 const (
-	CloudWatchLogsEndpointID    = "logs"
-	ComprehendEndpointID        = "comprehend"
-	ComputeOptimizerEndpointID  = "computeoptimizer"
-	IdentityStoreEndpointID     = "identitystore"
-	Inspector2EndpointID        = "inspector2"
-	IVSChatEndpointID           = "ivschat"
-	KendraEndpointID            = "kendra"
-	MediaLiveEndpointID         = "medialive"
-	ResourceExplorer2EndpointID = "resource-explorer-2"
-	RolesAnywhereEndpointID     = "rolesanywhere"
-	Route53DomainsEndpointID    = "route53domains"
-	SchedulerEndpointID         = "scheduler"
-	SESV2EndpointID             = "sesv2"
-	SSMEndpointID               = "ssm"
-	TranscribeEndpointID        = "transcribe"
+	ApplicationSignalsEndpointID = "application-signals"
+	AuditManagerEndpointID       = "auditmanager"
+	BCMDataExportsEndpointID     = "bcm-data-exports"
+	ChatbotEndpointID            = "chatbot"
+	CloudWatchLogsEndpointID     = "logs"
+	ComprehendEndpointID         = "comprehend"
+	ComputeOptimizerEndpointID   = "computeoptimizer"
+	IdentityStoreEndpointID      = "identitystore"
+	Inspector2EndpointID         = "inspector2"
+	IVSChatEndpointID            = "ivschat"
+	KendraEndpointID             = "kendra"
+	MediaLiveEndpointID          = "medialive"
+	ResourceExplorer2EndpointID  = "resource-explorer-2"
+	RolesAnywhereEndpointID      = "rolesanywhere"
+	Route53DomainsEndpointID     = "route53domains"
+	SchedulerEndpointID          = "scheduler"
+	SESV2EndpointID              = "sesv2"
+	SSMEndpointID                = "ssm"
+	TranscribeEndpointID         = "transcribe"
 )
 
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are